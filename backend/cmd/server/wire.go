@@ -71,6 +71,8 @@ func provideCleanup(
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
+	budgetAlert *service.BudgetAlertService,
+	messageBatch *service.MessageBatchService,
 	usageCleanup *service.UsageCleanupService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
@@ -80,6 +82,8 @@ func provideCleanup(
 	geminiOAuth *service.GeminiOAuthService,
 	antigravityOAuth *service.AntigravityOAuthService,
 	requestContentLog *service.RequestContentLogService,
+	auditLogCleanup *service.AuditLogCleanupService,
+	accountHealthProbe *service.AccountHealthProbeService,
 ) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -144,6 +148,14 @@ func provideCleanup(
 				subscriptionExpiry.Stop()
 				return nil
 			}},
+			{"BudgetAlertService", func() error {
+				budgetAlert.Stop()
+				return nil
+			}},
+			{"MessageBatchService", func() error {
+				messageBatch.Stop()
+				return nil
+			}},
 			{"PricingService", func() error {
 				pricing.Stop()
 				return nil
@@ -178,6 +190,18 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"AuditLogCleanupService", func() error {
+				if auditLogCleanup != nil {
+					auditLogCleanup.Stop()
+				}
+				return nil
+			}},
+			{"AccountHealthProbeService", func() error {
+				if accountHealthProbe != nil {
+					accountHealthProbe.Stop()
+				}
+				return nil
+			}},
 			{"Redis", func() error {
 				return rdb.Close()
 			}},
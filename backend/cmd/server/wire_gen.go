@@ -61,13 +61,18 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	groupRepository := repository.NewGroupRepository(client, db)
 	userGroupRateRepository := repository.NewUserGroupRateRepository(db)
 	apiKeyCache := repository.NewAPIKeyCache(redisClient)
-	apiKeyService := service.NewAPIKeyService(apiKeyRepository, userRepository, groupRepository, userSubscriptionRepository, userGroupRateRepository, apiKeyCache, configConfig)
+	apiKeyRequestLimitCache := repository.NewAPIKeyRequestLimitCache(redisClient)
+	apiKeyService := service.ProvideAPIKeyService(apiKeyRepository, userRepository, groupRepository, userSubscriptionRepository, userGroupRateRepository, apiKeyCache, apiKeyRequestLimitCache, configConfig)
 	apiKeyAuthCacheInvalidator := service.ProvideAPIKeyAuthCacheInvalidator(apiKeyService)
 	promoService := service.NewPromoService(promoCodeRepository, userRepository, billingCacheService, client, apiKeyAuthCacheInvalidator)
 	subscriptionService := service.NewSubscriptionService(groupRepository, userSubscriptionRepository, billingCacheService)
 	authService := service.NewAuthService(userRepository, groupRepository, subscriptionService, redeemCodeRepository, refreshTokenCache, configConfig, settingService, emailService, turnstileService, emailQueueService, promoService)
 	userService := service.NewUserService(userRepository, apiKeyAuthCacheInvalidator)
 	redeemCache := repository.NewRedeemCache(redisClient)
+	responseCache := repository.NewResponseCache(redisClient)
+	responseCacheService := service.NewResponseCacheService(responseCache, configConfig)
+	idempotencyCache := repository.NewIdempotencyCache(redisClient)
+	idempotencyService := service.NewIdempotencyService(idempotencyCache, configConfig)
 	redeemService := service.NewRedeemService(redeemCodeRepository, userRepository, subscriptionService, redeemCache, billingCacheService, client, apiKeyAuthCacheInvalidator)
 	secretEncryptor, err := repository.NewAESEncryptor(configConfig)
 	if err != nil {
@@ -132,7 +137,9 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	schedulerSnapshotService := service.ProvideSchedulerSnapshotService(schedulerCache, schedulerOutboxRepository, accountRepository, groupRepository, configConfig)
 	antigravityTokenProvider := service.NewAntigravityTokenProvider(accountRepository, geminiTokenCache, antigravityOAuthService)
 	antigravityGatewayService := service.NewAntigravityGatewayService(accountRepository, gatewayCache, schedulerSnapshotService, antigravityTokenProvider, rateLimitService, httpUpstream, settingService)
-	accountTestService := service.NewAccountTestService(accountRepository, geminiTokenProvider, antigravityGatewayService, httpUpstream, configConfig)
+	bedrockCredentialProvider := service.NewBedrockCredentialProvider(httpUpstream)
+	vertexTokenProvider := service.NewVertexTokenProvider(geminiTokenCache, httpUpstream)
+	accountTestService := service.NewAccountTestService(accountRepository, geminiTokenProvider, antigravityGatewayService, httpUpstream, bedrockCredentialProvider, vertexTokenProvider, configConfig)
 	crsSyncService := service.NewCRSSyncService(accountRepository, proxyRepository, oAuthService, openAIOAuthService, geminiOAuthService, configConfig)
 	sessionLimitCache := repository.ProvideSessionLimitCache(redisClient, configConfig)
 	accountHandler := admin.NewAccountHandler(adminService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, rateLimitService, accountUsageService, accountTestService, concurrencyService, crsSyncService, sessionLimitCache, compositeTokenCacheInvalidator)
@@ -155,13 +162,14 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	deferredService := service.ProvideDeferredService(accountRepository, timingWheelService)
 	claudeTokenProvider := service.NewClaudeTokenProvider(accountRepository, geminiTokenCache, oAuthService)
 	digestSessionStore := service.NewDigestSessionStore()
-	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, userGroupRateRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, digestSessionStore)
+	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, userGroupRateRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, bedrockCredentialProvider, vertexTokenProvider, sessionLimitCache, digestSessionStore, responseCacheService)
 	openAITokenProvider := service.NewOpenAITokenProvider(accountRepository, geminiTokenCache, openAIOAuthService)
-	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider)
-	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
+	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider, responseCacheService)
+	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, vertexTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
 	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, userRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService)
 	settingHandler := admin.NewSettingHandler(settingService, emailService, turnstileService, opsService)
-	opsHandler := admin.NewOpsHandler(opsService)
+	opsNotificationService := service.NewOpsNotificationService(opsService)
+	opsHandler := admin.NewOpsHandler(opsService, opsNotificationService)
 	updateCache := repository.NewUpdateCache(redisClient)
 	gitHubReleaseClient := repository.ProvideGitHubReleaseClient(configConfig)
 	serviceBuildInfo := provideServiceBuildInfo(buildInfo)
@@ -182,26 +190,51 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	requestContentLogRepository := repository.NewRequestContentLogRepository(db)
 	requestContentLogService := service.ProvideRequestContentLogService(requestContentLogRepository, configConfig)
 	requestContentLogHandler := admin.NewRequestContentLogHandler(requestContentLogService)
-	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, adminAnnouncementHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, errorPassthroughHandler, requestContentLogHandler)
-	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, usageService, apiKeyService, errorPassthroughService, configConfig)
-	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, apiKeyService, errorPassthroughService, configConfig)
+	auditLogRepository := repository.NewAuditLogRepository(client)
+	auditLogService := service.NewAuditLogService(auditLogRepository, configConfig)
+	auditLogHandler := admin.NewAuditLogHandler(auditLogService)
+	paymentOrderRepository := repository.NewPaymentOrderRepository(client)
+	paymentProviderRegistry := repository.ProvidePaymentProviderRegistry(configConfig)
+	paymentService := service.NewPaymentService(paymentOrderRepository, userRepository, subscriptionService, billingCacheService, client, apiKeyAuthCacheInvalidator, paymentProviderRegistry, configConfig)
+	paymentHandler := admin.NewPaymentHandler(paymentService)
+	responseCacheHandler := admin.NewResponseCacheHandler(responseCacheService)
+	accountHealthProbeRepository := repository.NewAccountHealthProbeRepository(client)
+	accountHealthProbeService := service.ProvideAccountHealthProbeService(accountRepository, accountHealthProbeRepository, accountTestService, opsRepository, redisClient, configConfig)
+	accountHealthProbeHandler := admin.NewAccountHealthProbeHandler(accountHealthProbeService)
+	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, adminAnnouncementHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, errorPassthroughHandler, requestContentLogHandler, auditLogHandler, paymentHandler, responseCacheHandler, accountHealthProbeHandler)
+	upstreamFileRepository := repository.NewUpstreamFileRepository(client)
+	fileService := service.NewFileService(upstreamFileRepository, gatewayService, configConfig)
+	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, openAIGatewayService, userService, concurrencyService, billingCacheService, usageService, apiKeyService, errorPassthroughService, fileService, configConfig)
+	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, apiKeyService, errorPassthroughService, gatewayHandler, configConfig)
+	chatCompletionsHandler := handler.NewChatCompletionsHandler(gatewayHandler, openAIGatewayHandler)
 	handlerSettingHandler := handler.ProvideSettingHandler(settingService, buildInfo)
 	totpHandler := handler.NewTotpHandler(totpService)
-	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, announcementHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, handlerSettingHandler, totpHandler)
+	gatewayMetricsCollector := service.NewGatewayMetricsCollector(accountRepository, concurrencyService)
+	metricsHandler := handler.NewMetricsHandler(gatewayMetricsCollector)
+	handlerPaymentHandler := handler.NewPaymentHandler(paymentService)
+	budgetAlertRepository := repository.NewBudgetAlertRepository(client)
+	budgetAlertService := service.ProvideBudgetAlertService(budgetAlertRepository, userRepository, apiKeyService, subscriptionService, emailQueueService, settingService, configConfig)
+	budgetAlertHandler := handler.NewBudgetAlertHandler(budgetAlertService)
+	messageBatchRepository := repository.NewMessageBatchRepository(client)
+	messageBatchService := service.ProvideMessageBatchService(messageBatchRepository, apiKeyRepository, gatewayService, antigravityGatewayService, concurrencyService, billingCacheService, subscriptionService, apiKeyService, configConfig)
+	messageBatchHandler := handler.NewMessageBatchHandler(messageBatchService)
+	fileHandler := handler.NewFileHandler(fileService)
+	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, announcementHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, chatCompletionsHandler, handlerSettingHandler, totpHandler, metricsHandler, handlerPaymentHandler, budgetAlertHandler, messageBatchHandler, fileHandler)
 	jwtAuthMiddleware := middleware.NewJWTAuthMiddleware(authService, userService)
 	adminAuthMiddleware := middleware.NewAdminAuthMiddleware(authService, userService, settingService)
-	apiKeyAuthMiddleware := middleware.NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, configConfig)
-	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, apiKeyService, subscriptionService, opsService, requestContentLogService, settingService, redisClient)
+	apiKeyAuthMiddleware := middleware.NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, idempotencyService, configConfig)
+	engine := server.ProvideRouter(configConfig, handlers, jwtAuthMiddleware, adminAuthMiddleware, apiKeyAuthMiddleware, apiKeyService, subscriptionService, opsService, requestContentLogService, idempotencyService, auditLogService, settingService, redisClient)
 	httpServer := server.ProvideHTTPServer(configConfig, engine)
 	opsMetricsCollector := service.ProvideOpsMetricsCollector(opsRepository, settingRepository, accountRepository, concurrencyService, db, redisClient, configConfig)
 	opsAggregationService := service.ProvideOpsAggregationService(opsRepository, settingRepository, db, redisClient, configConfig)
-	opsAlertEvaluatorService := service.ProvideOpsAlertEvaluatorService(opsService, opsRepository, emailService, redisClient, configConfig)
+	opsAlertEvaluatorService := service.ProvideOpsAlertEvaluatorService(opsService, opsRepository, emailService, opsNotificationService, redisClient, configConfig)
 	opsCleanupService := service.ProvideOpsCleanupService(opsRepository, db, redisClient, configConfig)
-	opsScheduledReportService := service.ProvideOpsScheduledReportService(opsService, userService, emailService, redisClient, configConfig)
+	auditLogCleanupService := service.ProvideAuditLogCleanupService(opsRepository, db, redisClient, configConfig)
+	opsScheduledReportService := service.ProvideOpsScheduledReportService(opsService, userService, emailService, opsNotificationService, redisClient, configConfig)
 	tokenRefreshService := service.ProvideTokenRefreshService(accountRepository, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, compositeTokenCacheInvalidator, schedulerCache, configConfig)
 	accountExpiryService := service.ProvideAccountExpiryService(accountRepository)
 	subscriptionExpiryService := service.ProvideSubscriptionExpiryService(userSubscriptionRepository)
-	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, subscriptionExpiryService, usageCleanupService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, requestContentLogService)
+	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, subscriptionExpiryService, budgetAlertService, messageBatchService, usageCleanupService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, requestContentLogService, auditLogCleanupService, accountHealthProbeService)
 	application := &Application{
 		Server:  httpServer,
 		Cleanup: v,
@@ -235,6 +268,8 @@ func provideCleanup(
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
 	subscriptionExpiry *service.SubscriptionExpiryService,
+	budgetAlert *service.BudgetAlertService,
+	messageBatch *service.MessageBatchService,
 	usageCleanup *service.UsageCleanupService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
@@ -244,6 +279,8 @@ func provideCleanup(
 	geminiOAuth *service.GeminiOAuthService,
 	antigravityOAuth *service.AntigravityOAuthService,
 	requestContentLog *service.RequestContentLogService,
+	auditLogCleanup *service.AuditLogCleanupService,
+	accountHealthProbe *service.AccountHealthProbeService,
 ) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -307,6 +344,14 @@ func provideCleanup(
 				subscriptionExpiry.Stop()
 				return nil
 			}},
+			{"BudgetAlertService", func() error {
+				budgetAlert.Stop()
+				return nil
+			}},
+			{"MessageBatchService", func() error {
+				messageBatch.Stop()
+				return nil
+			}},
 			{"PricingService", func() error {
 				pricing.Stop()
 				return nil
@@ -341,6 +386,18 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"AuditLogCleanupService", func() error {
+				if auditLogCleanup != nil {
+					auditLogCleanup.Stop()
+				}
+				return nil
+			}},
+			{"AccountHealthProbeService", func() error {
+				if accountHealthProbe != nil {
+					accountHealthProbe.Stop()
+				}
+				return nil
+			}},
 			{"Redis", func() error {
 				return rdb.Close()
 			}},
@@ -0,0 +1,1022 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/accounthealthprobe"
+)
+
+// AccountHealthProbeCreate is the builder for creating a AccountHealthProbe entity.
+type AccountHealthProbeCreate struct {
+	config
+	mutation *AccountHealthProbeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *AccountHealthProbeCreate) SetAccountID(v int64) *AccountHealthProbeCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetPlatform sets the "platform" field.
+func (_c *AccountHealthProbeCreate) SetPlatform(v string) *AccountHealthProbeCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AccountHealthProbeCreate) SetModel(v string) *AccountHealthProbeCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableModel(v *string) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *AccountHealthProbeCreate) SetResult(v string) *AccountHealthProbeCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *AccountHealthProbeCreate) SetStatusCode(v int) *AccountHealthProbeCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableStatusCode(v *int) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetStatusCode(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *AccountHealthProbeCreate) SetLatencyMs(v int64) *AccountHealthProbeCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableLatencyMs(v *int64) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetLatencyMs(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *AccountHealthProbeCreate) SetErrorMessage(v string) *AccountHealthProbeCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableErrorMessage(v *string) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AccountHealthProbeCreate) SetAction(v string) *AccountHealthProbeCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableAction(v *string) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountHealthProbeCreate) SetCreatedAt(v time.Time) *AccountHealthProbeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountHealthProbeCreate) SetNillableCreatedAt(v *time.Time) *AccountHealthProbeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AccountHealthProbeMutation object of the builder.
+func (_c *AccountHealthProbeCreate) Mutation() *AccountHealthProbeMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountHealthProbe in the database.
+func (_c *AccountHealthProbeCreate) Save(ctx context.Context) (*AccountHealthProbe, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountHealthProbeCreate) SaveX(ctx context.Context) *AccountHealthProbe {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountHealthProbeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountHealthProbeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountHealthProbeCreate) defaults() {
+	if _, ok := _c.mutation.Model(); !ok {
+		v := accounthealthprobe.DefaultModel
+		_c.mutation.SetModel(v)
+	}
+	if _, ok := _c.mutation.StatusCode(); !ok {
+		v := accounthealthprobe.DefaultStatusCode
+		_c.mutation.SetStatusCode(v)
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		v := accounthealthprobe.DefaultLatencyMs
+		_c.mutation.SetLatencyMs(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := accounthealthprobe.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accounthealthprobe.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountHealthProbeCreate) check() error {
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "AccountHealthProbe.account_id"`)}
+	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "AccountHealthProbe.platform"`)}
+	}
+	if v, ok := _c.mutation.Platform(); ok {
+		if err := accounthealthprobe.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.platform": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "AccountHealthProbe.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := accounthealthprobe.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "AccountHealthProbe.result"`)}
+	}
+	if v, ok := _c.mutation.Result(); ok {
+		if err := accounthealthprobe.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.result": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StatusCode(); !ok {
+		return &ValidationError{Name: "status_code", err: errors.New(`ent: missing required field "AccountHealthProbe.status_code"`)}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "AccountHealthProbe.latency_ms"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AccountHealthProbe.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := accounthealthprobe.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountHealthProbe.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AccountHealthProbeCreate) sqlSave(ctx context.Context) (*AccountHealthProbe, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountHealthProbeCreate) createSpec() (*AccountHealthProbe, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountHealthProbe{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accounthealthprobe.Table, sqlgraph.NewFieldSpec(accounthealthprobe.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(accounthealthprobe.FieldAccountID, field.TypeInt64, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(accounthealthprobe.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(accounthealthprobe.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(accounthealthprobe.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(accounthealthprobe.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(accounthealthprobe.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(accounthealthprobe.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(accounthealthprobe.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accounthealthprobe.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountHealthProbe.Create().
+//		SetAccountID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountHealthProbeUpsert) {
+//			SetAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountHealthProbeCreate) OnConflict(opts ...sql.ConflictOption) *AccountHealthProbeUpsertOne {
+	_c.conflict = opts
+	return &AccountHealthProbeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountHealthProbeCreate) OnConflictColumns(columns ...string) *AccountHealthProbeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountHealthProbeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AccountHealthProbeUpsertOne is the builder for "upsert"-ing
+	//  one AccountHealthProbe node.
+	AccountHealthProbeUpsertOne struct {
+		create *AccountHealthProbeCreate
+	}
+
+	// AccountHealthProbeUpsert is the "OnConflict" setter.
+	AccountHealthProbeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountHealthProbeUpsert) SetAccountID(v int64) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateAccountID() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldAccountID)
+	return u
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *AccountHealthProbeUpsert) AddAccountID(v int64) *AccountHealthProbeUpsert {
+	u.Add(accounthealthprobe.FieldAccountID, v)
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *AccountHealthProbeUpsert) SetPlatform(v string) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdatePlatform() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldPlatform)
+	return u
+}
+
+// SetModel sets the "model" field.
+func (u *AccountHealthProbeUpsert) SetModel(v string) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldModel, v)
+	return u
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateModel() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldModel)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *AccountHealthProbeUpsert) SetResult(v string) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateResult() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldResult)
+	return u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *AccountHealthProbeUpsert) SetStatusCode(v int) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldStatusCode, v)
+	return u
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateStatusCode() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldStatusCode)
+	return u
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *AccountHealthProbeUpsert) AddStatusCode(v int) *AccountHealthProbeUpsert {
+	u.Add(accounthealthprobe.FieldStatusCode, v)
+	return u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AccountHealthProbeUpsert) SetLatencyMs(v int64) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldLatencyMs, v)
+	return u
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateLatencyMs() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldLatencyMs)
+	return u
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AccountHealthProbeUpsert) AddLatencyMs(v int64) *AccountHealthProbeUpsert {
+	u.Add(accounthealthprobe.FieldLatencyMs, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AccountHealthProbeUpsert) SetErrorMessage(v string) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateErrorMessage() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AccountHealthProbeUpsert) ClearErrorMessage() *AccountHealthProbeUpsert {
+	u.SetNull(accounthealthprobe.FieldErrorMessage)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *AccountHealthProbeUpsert) SetAction(v string) *AccountHealthProbeUpsert {
+	u.Set(accounthealthprobe.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsert) UpdateAction() *AccountHealthProbeUpsert {
+	u.SetExcluded(accounthealthprobe.FieldAction)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccountHealthProbeUpsertOne) UpdateNewValues() *AccountHealthProbeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accounthealthprobe.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountHealthProbeUpsertOne) Ignore() *AccountHealthProbeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountHealthProbeUpsertOne) DoNothing() *AccountHealthProbeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountHealthProbeCreate.OnConflict
+// documentation for more info.
+func (u *AccountHealthProbeUpsertOne) Update(set func(*AccountHealthProbeUpsert)) *AccountHealthProbeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountHealthProbeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountHealthProbeUpsertOne) SetAccountID(v int64) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *AccountHealthProbeUpsertOne) AddAccountID(v int64) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateAccountID() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *AccountHealthProbeUpsertOne) SetPlatform(v string) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdatePlatform() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *AccountHealthProbeUpsertOne) SetModel(v string) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateModel() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *AccountHealthProbeUpsertOne) SetResult(v string) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateResult() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *AccountHealthProbeUpsertOne) SetStatusCode(v int) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *AccountHealthProbeUpsertOne) AddStatusCode(v int) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateStatusCode() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AccountHealthProbeUpsertOne) SetLatencyMs(v int64) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AccountHealthProbeUpsertOne) AddLatencyMs(v int64) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateLatencyMs() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AccountHealthProbeUpsertOne) SetErrorMessage(v string) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateErrorMessage() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AccountHealthProbeUpsertOne) ClearErrorMessage() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AccountHealthProbeUpsertOne) SetAction(v string) *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertOne) UpdateAction() *AccountHealthProbeUpsertOne {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountHealthProbeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountHealthProbeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountHealthProbeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountHealthProbeUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountHealthProbeUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountHealthProbeCreateBulk is the builder for creating many AccountHealthProbe entities in bulk.
+type AccountHealthProbeCreateBulk struct {
+	config
+	err      error
+	builders []*AccountHealthProbeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountHealthProbe entities in the database.
+func (_c *AccountHealthProbeCreateBulk) Save(ctx context.Context) ([]*AccountHealthProbe, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountHealthProbe, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountHealthProbeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountHealthProbeCreateBulk) SaveX(ctx context.Context) []*AccountHealthProbe {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountHealthProbeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountHealthProbeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountHealthProbe.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountHealthProbeUpsert) {
+//			SetAccountID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AccountHealthProbeCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountHealthProbeUpsertBulk {
+	_c.conflict = opts
+	return &AccountHealthProbeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AccountHealthProbeCreateBulk) OnConflictColumns(columns ...string) *AccountHealthProbeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AccountHealthProbeUpsertBulk{
+		create: _c,
+	}
+}
+
+// AccountHealthProbeUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountHealthProbe nodes.
+type AccountHealthProbeUpsertBulk struct {
+	create *AccountHealthProbeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccountHealthProbeUpsertBulk) UpdateNewValues() *AccountHealthProbeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accounthealthprobe.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountHealthProbe.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountHealthProbeUpsertBulk) Ignore() *AccountHealthProbeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountHealthProbeUpsertBulk) DoNothing() *AccountHealthProbeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountHealthProbeCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountHealthProbeUpsertBulk) Update(set func(*AccountHealthProbeUpsert)) *AccountHealthProbeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountHealthProbeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *AccountHealthProbeUpsertBulk) SetAccountID(v int64) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *AccountHealthProbeUpsertBulk) AddAccountID(v int64) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateAccountID() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetPlatform sets the "platform" field.
+func (u *AccountHealthProbeUpsertBulk) SetPlatform(v string) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdatePlatform() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetModel sets the "model" field.
+func (u *AccountHealthProbeUpsertBulk) SetModel(v string) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetModel(v)
+	})
+}
+
+// UpdateModel sets the "model" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateModel() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateModel()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *AccountHealthProbeUpsertBulk) SetResult(v string) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateResult() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *AccountHealthProbeUpsertBulk) SetStatusCode(v int) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *AccountHealthProbeUpsertBulk) AddStatusCode(v int) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateStatusCode() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (u *AccountHealthProbeUpsertBulk) SetLatencyMs(v int64) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetLatencyMs(v)
+	})
+}
+
+// AddLatencyMs adds v to the "latency_ms" field.
+func (u *AccountHealthProbeUpsertBulk) AddLatencyMs(v int64) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.AddLatencyMs(v)
+	})
+}
+
+// UpdateLatencyMs sets the "latency_ms" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateLatencyMs() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateLatencyMs()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AccountHealthProbeUpsertBulk) SetErrorMessage(v string) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateErrorMessage() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AccountHealthProbeUpsertBulk) ClearErrorMessage() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *AccountHealthProbeUpsertBulk) SetAction(v string) *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *AccountHealthProbeUpsertBulk) UpdateAction() *AccountHealthProbeUpsertBulk {
+	return u.Update(func(s *AccountHealthProbeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountHealthProbeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountHealthProbeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountHealthProbeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountHealthProbeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
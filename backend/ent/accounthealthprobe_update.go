@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/accounthealthprobe"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// AccountHealthProbeUpdate is the builder for updating AccountHealthProbe entities.
+type AccountHealthProbeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountHealthProbeMutation
+}
+
+// Where appends a list predicates to the AccountHealthProbeUpdate builder.
+func (_u *AccountHealthProbeUpdate) Where(ps ...predicate.AccountHealthProbe) *AccountHealthProbeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountHealthProbeUpdate) SetAccountID(v int64) *AccountHealthProbeUpdate {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableAccountID(v *int64) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *AccountHealthProbeUpdate) AddAccountID(v int64) *AccountHealthProbeUpdate {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *AccountHealthProbeUpdate) SetPlatform(v string) *AccountHealthProbeUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillablePlatform(v *string) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AccountHealthProbeUpdate) SetModel(v string) *AccountHealthProbeUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableModel(v *string) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *AccountHealthProbeUpdate) SetResult(v string) *AccountHealthProbeUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableResult(v *string) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *AccountHealthProbeUpdate) SetStatusCode(v int) *AccountHealthProbeUpdate {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableStatusCode(v *int) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *AccountHealthProbeUpdate) AddStatusCode(v int) *AccountHealthProbeUpdate {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *AccountHealthProbeUpdate) SetLatencyMs(v int64) *AccountHealthProbeUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableLatencyMs(v *int64) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *AccountHealthProbeUpdate) AddLatencyMs(v int64) *AccountHealthProbeUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *AccountHealthProbeUpdate) SetErrorMessage(v string) *AccountHealthProbeUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableErrorMessage(v *string) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *AccountHealthProbeUpdate) ClearErrorMessage() *AccountHealthProbeUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AccountHealthProbeUpdate) SetAction(v string) *AccountHealthProbeUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdate) SetNillableAction(v *string) *AccountHealthProbeUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AccountHealthProbeMutation object of the builder.
+func (_u *AccountHealthProbeUpdate) Mutation() *AccountHealthProbeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountHealthProbeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountHealthProbeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountHealthProbeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountHealthProbeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountHealthProbeUpdate) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := accounthealthprobe.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := accounthealthprobe.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := accounthealthprobe.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.result": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := accounthealthprobe.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountHealthProbeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accounthealthprobe.Table, accounthealthprobe.Columns, sqlgraph.NewFieldSpec(accounthealthprobe.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(accounthealthprobe.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(accounthealthprobe.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(accounthealthprobe.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(accounthealthprobe.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(accounthealthprobe.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(accounthealthprobe.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(accounthealthprobe.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(accounthealthprobe.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(accounthealthprobe.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(accounthealthprobe.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(accounthealthprobe.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(accounthealthprobe.FieldAction, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounthealthprobe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountHealthProbeUpdateOne is the builder for updating a single AccountHealthProbe entity.
+type AccountHealthProbeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountHealthProbeMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *AccountHealthProbeUpdateOne) SetAccountID(v int64) *AccountHealthProbeUpdateOne {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableAccountID(v *int64) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *AccountHealthProbeUpdateOne) AddAccountID(v int64) *AccountHealthProbeUpdateOne {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *AccountHealthProbeUpdateOne) SetPlatform(v string) *AccountHealthProbeUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillablePlatform(v *string) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AccountHealthProbeUpdateOne) SetModel(v string) *AccountHealthProbeUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableModel(v *string) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *AccountHealthProbeUpdateOne) SetResult(v string) *AccountHealthProbeUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableResult(v *string) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *AccountHealthProbeUpdateOne) SetStatusCode(v int) *AccountHealthProbeUpdateOne {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableStatusCode(v *int) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *AccountHealthProbeUpdateOne) AddStatusCode(v int) *AccountHealthProbeUpdateOne {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *AccountHealthProbeUpdateOne) SetLatencyMs(v int64) *AccountHealthProbeUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableLatencyMs(v *int64) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *AccountHealthProbeUpdateOne) AddLatencyMs(v int64) *AccountHealthProbeUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *AccountHealthProbeUpdateOne) SetErrorMessage(v string) *AccountHealthProbeUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableErrorMessage(v *string) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *AccountHealthProbeUpdateOne) ClearErrorMessage() *AccountHealthProbeUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AccountHealthProbeUpdateOne) SetAction(v string) *AccountHealthProbeUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AccountHealthProbeUpdateOne) SetNillableAction(v *string) *AccountHealthProbeUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AccountHealthProbeMutation object of the builder.
+func (_u *AccountHealthProbeUpdateOne) Mutation() *AccountHealthProbeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccountHealthProbeUpdate builder.
+func (_u *AccountHealthProbeUpdateOne) Where(ps ...predicate.AccountHealthProbe) *AccountHealthProbeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountHealthProbeUpdateOne) Select(field string, fields ...string) *AccountHealthProbeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountHealthProbe entity.
+func (_u *AccountHealthProbeUpdateOne) Save(ctx context.Context) (*AccountHealthProbe, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountHealthProbeUpdateOne) SaveX(ctx context.Context) *AccountHealthProbe {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountHealthProbeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountHealthProbeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountHealthProbeUpdateOne) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := accounthealthprobe.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := accounthealthprobe.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := accounthealthprobe.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.result": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := accounthealthprobe.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AccountHealthProbe.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AccountHealthProbeUpdateOne) sqlSave(ctx context.Context) (_node *AccountHealthProbe, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accounthealthprobe.Table, accounthealthprobe.Columns, sqlgraph.NewFieldSpec(accounthealthprobe.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountHealthProbe.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accounthealthprobe.FieldID)
+		for _, f := range fields {
+			if !accounthealthprobe.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accounthealthprobe.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(accounthealthprobe.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(accounthealthprobe.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(accounthealthprobe.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(accounthealthprobe.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(accounthealthprobe.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(accounthealthprobe.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(accounthealthprobe.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(accounthealthprobe.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(accounthealthprobe.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(accounthealthprobe.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(accounthealthprobe.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(accounthealthprobe.FieldAction, field.TypeString, value)
+	}
+	_node = &AccountHealthProbe{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounthealthprobe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -46,6 +46,14 @@ type APIKey struct {
 	QuotaUsed float64 `json:"quota_used,omitempty"`
 	// Expiration time for this API key (null = never expires)
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Allowed model glob patterns, e.g. ["claude-sonnet-*"] (empty = all models)
+	AllowedModels []string `json:"allowed_models,omitempty"`
+	// Max requests per minute (0 = unlimited)
+	RateLimitRpm int `json:"rate_limit_rpm,omitempty"`
+	// Max tokens per minute (0 = unlimited)
+	RateLimitTpm int `json:"rate_limit_tpm,omitempty"`
+	// Max requests per day (0 = unlimited)
+	DailyRequestLimit int `json:"daily_request_limit,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the APIKeyQuery when eager-loading is set.
 	Edges        APIKeyEdges `json:"edges"`
@@ -101,11 +109,11 @@ func (*APIKey) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apikey.FieldIPWhitelist, apikey.FieldIPBlacklist:
+		case apikey.FieldIPWhitelist, apikey.FieldIPBlacklist, apikey.FieldAllowedModels:
 			values[i] = new([]byte)
 		case apikey.FieldQuota, apikey.FieldQuotaUsed:
 			values[i] = new(sql.NullFloat64)
-		case apikey.FieldID, apikey.FieldUserID, apikey.FieldGroupID:
+		case apikey.FieldID, apikey.FieldUserID, apikey.FieldGroupID, apikey.FieldRateLimitRpm, apikey.FieldRateLimitTpm, apikey.FieldDailyRequestLimit:
 			values[i] = new(sql.NullInt64)
 		case apikey.FieldKey, apikey.FieldName, apikey.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -217,6 +225,32 @@ func (_m *APIKey) assignValues(columns []string, values []any) error {
 				_m.ExpiresAt = new(time.Time)
 				*_m.ExpiresAt = value.Time
 			}
+		case apikey.FieldAllowedModels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_models", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedModels); err != nil {
+					return fmt.Errorf("unmarshal field allowed_models: %w", err)
+				}
+			}
+		case apikey.FieldRateLimitRpm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_rpm", values[i])
+			} else if value.Valid {
+				_m.RateLimitRpm = int(value.Int64)
+			}
+		case apikey.FieldRateLimitTpm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_tpm", values[i])
+			} else if value.Valid {
+				_m.RateLimitTpm = int(value.Int64)
+			}
+		case apikey.FieldDailyRequestLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_request_limit", values[i])
+			} else if value.Valid {
+				_m.DailyRequestLimit = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -312,6 +346,18 @@ func (_m *APIKey) String() string {
 		builder.WriteString("expires_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("allowed_models=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedModels))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limit_rpm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimitRpm))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limit_tpm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimitTpm))
+	builder.WriteString(", ")
+	builder.WriteString("daily_request_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DailyRequestLimit))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -41,6 +41,14 @@ const (
 	FieldQuotaUsed = "quota_used"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldAllowedModels holds the string denoting the allowed_models field in the database.
+	FieldAllowedModels = "allowed_models"
+	// FieldRateLimitRpm holds the string denoting the rate_limit_rpm field in the database.
+	FieldRateLimitRpm = "rate_limit_rpm"
+	// FieldRateLimitTpm holds the string denoting the rate_limit_tpm field in the database.
+	FieldRateLimitTpm = "rate_limit_tpm"
+	// FieldDailyRequestLimit holds the string denoting the daily_request_limit field in the database.
+	FieldDailyRequestLimit = "daily_request_limit"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -88,6 +96,10 @@ var Columns = []string{
 	FieldQuota,
 	FieldQuotaUsed,
 	FieldExpiresAt,
+	FieldAllowedModels,
+	FieldRateLimitRpm,
+	FieldRateLimitTpm,
+	FieldDailyRequestLimit,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -126,6 +138,12 @@ var (
 	DefaultQuota float64
 	// DefaultQuotaUsed holds the default value on creation for the "quota_used" field.
 	DefaultQuotaUsed float64
+	// DefaultRateLimitRpm holds the default value on creation for the "rate_limit_rpm" field.
+	DefaultRateLimitRpm int
+	// DefaultRateLimitTpm holds the default value on creation for the "rate_limit_tpm" field.
+	DefaultRateLimitTpm int
+	// DefaultDailyRequestLimit holds the default value on creation for the "daily_request_limit" field.
+	DefaultDailyRequestLimit int
 )
 
 // OrderOption defines the ordering options for the APIKey queries.
@@ -191,6 +209,21 @@ func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
+// ByRateLimitRpm orders the results by the rate_limit_rpm field.
+func ByRateLimitRpm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitRpm, opts...).ToFunc()
+}
+
+// ByRateLimitTpm orders the results by the rate_limit_tpm field.
+func ByRateLimitTpm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitTpm, opts...).ToFunc()
+}
+
+// ByDailyRequestLimit orders the results by the daily_request_limit field.
+func ByDailyRequestLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyRequestLimit, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
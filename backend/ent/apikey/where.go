@@ -110,6 +110,21 @@ func ExpiresAt(v time.Time) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// RateLimitRpm applies equality check predicate on the "rate_limit_rpm" field. It's identical to RateLimitRpmEQ.
+func RateLimitRpm(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldRateLimitRpm, v))
+}
+
+// RateLimitTpm applies equality check predicate on the "rate_limit_tpm" field. It's identical to RateLimitTpmEQ.
+func RateLimitTpm(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldRateLimitTpm, v))
+}
+
+// DailyRequestLimit applies equality check predicate on the "daily_request_limit" field. It's identical to DailyRequestLimitEQ.
+func DailyRequestLimit(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldDailyRequestLimit, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldCreatedAt, v))
@@ -635,6 +650,136 @@ func ExpiresAtNotNil() predicate.APIKey {
 	return predicate.APIKey(sql.FieldNotNull(FieldExpiresAt))
 }
 
+// AllowedModelsIsNil applies the IsNil predicate on the "allowed_models" field.
+func AllowedModelsIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldAllowedModels))
+}
+
+// AllowedModelsNotNil applies the NotNil predicate on the "allowed_models" field.
+func AllowedModelsNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldAllowedModels))
+}
+
+// RateLimitRpmEQ applies the EQ predicate on the "rate_limit_rpm" field.
+func RateLimitRpmEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldRateLimitRpm, v))
+}
+
+// RateLimitRpmNEQ applies the NEQ predicate on the "rate_limit_rpm" field.
+func RateLimitRpmNEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldRateLimitRpm, v))
+}
+
+// RateLimitRpmIn applies the In predicate on the "rate_limit_rpm" field.
+func RateLimitRpmIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldRateLimitRpm, vs...))
+}
+
+// RateLimitRpmNotIn applies the NotIn predicate on the "rate_limit_rpm" field.
+func RateLimitRpmNotIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldRateLimitRpm, vs...))
+}
+
+// RateLimitRpmGT applies the GT predicate on the "rate_limit_rpm" field.
+func RateLimitRpmGT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldRateLimitRpm, v))
+}
+
+// RateLimitRpmGTE applies the GTE predicate on the "rate_limit_rpm" field.
+func RateLimitRpmGTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldRateLimitRpm, v))
+}
+
+// RateLimitRpmLT applies the LT predicate on the "rate_limit_rpm" field.
+func RateLimitRpmLT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldRateLimitRpm, v))
+}
+
+// RateLimitRpmLTE applies the LTE predicate on the "rate_limit_rpm" field.
+func RateLimitRpmLTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldRateLimitRpm, v))
+}
+
+// RateLimitTpmEQ applies the EQ predicate on the "rate_limit_tpm" field.
+func RateLimitTpmEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldRateLimitTpm, v))
+}
+
+// RateLimitTpmNEQ applies the NEQ predicate on the "rate_limit_tpm" field.
+func RateLimitTpmNEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldRateLimitTpm, v))
+}
+
+// RateLimitTpmIn applies the In predicate on the "rate_limit_tpm" field.
+func RateLimitTpmIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldRateLimitTpm, vs...))
+}
+
+// RateLimitTpmNotIn applies the NotIn predicate on the "rate_limit_tpm" field.
+func RateLimitTpmNotIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldRateLimitTpm, vs...))
+}
+
+// RateLimitTpmGT applies the GT predicate on the "rate_limit_tpm" field.
+func RateLimitTpmGT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldRateLimitTpm, v))
+}
+
+// RateLimitTpmGTE applies the GTE predicate on the "rate_limit_tpm" field.
+func RateLimitTpmGTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldRateLimitTpm, v))
+}
+
+// RateLimitTpmLT applies the LT predicate on the "rate_limit_tpm" field.
+func RateLimitTpmLT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldRateLimitTpm, v))
+}
+
+// RateLimitTpmLTE applies the LTE predicate on the "rate_limit_tpm" field.
+func RateLimitTpmLTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldRateLimitTpm, v))
+}
+
+// DailyRequestLimitEQ applies the EQ predicate on the "daily_request_limit" field.
+func DailyRequestLimitEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldDailyRequestLimit, v))
+}
+
+// DailyRequestLimitNEQ applies the NEQ predicate on the "daily_request_limit" field.
+func DailyRequestLimitNEQ(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldDailyRequestLimit, v))
+}
+
+// DailyRequestLimitIn applies the In predicate on the "daily_request_limit" field.
+func DailyRequestLimitIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldDailyRequestLimit, vs...))
+}
+
+// DailyRequestLimitNotIn applies the NotIn predicate on the "daily_request_limit" field.
+func DailyRequestLimitNotIn(vs ...int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldDailyRequestLimit, vs...))
+}
+
+// DailyRequestLimitGT applies the GT predicate on the "daily_request_limit" field.
+func DailyRequestLimitGT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldDailyRequestLimit, v))
+}
+
+// DailyRequestLimitGTE applies the GTE predicate on the "daily_request_limit" field.
+func DailyRequestLimitGTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldDailyRequestLimit, v))
+}
+
+// DailyRequestLimitLT applies the LT predicate on the "daily_request_limit" field.
+func DailyRequestLimitLT(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldDailyRequestLimit, v))
+}
+
+// DailyRequestLimitLTE applies the LTE predicate on the "daily_request_limit" field.
+func DailyRequestLimitLTE(v int) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldDailyRequestLimit, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.APIKey {
 	return predicate.APIKey(func(s *sql.Selector) {
@@ -167,6 +167,54 @@ func (_c *APIKeyCreate) SetNillableExpiresAt(v *time.Time) *APIKeyCreate {
 	return _c
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_c *APIKeyCreate) SetAllowedModels(v []string) *APIKeyCreate {
+	_c.mutation.SetAllowedModels(v)
+	return _c
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (_c *APIKeyCreate) SetRateLimitRpm(v int) *APIKeyCreate {
+	_c.mutation.SetRateLimitRpm(v)
+	return _c
+}
+
+// SetNillableRateLimitRpm sets the "rate_limit_rpm" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableRateLimitRpm(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetRateLimitRpm(*v)
+	}
+	return _c
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (_c *APIKeyCreate) SetRateLimitTpm(v int) *APIKeyCreate {
+	_c.mutation.SetRateLimitTpm(v)
+	return _c
+}
+
+// SetNillableRateLimitTpm sets the "rate_limit_tpm" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableRateLimitTpm(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetRateLimitTpm(*v)
+	}
+	return _c
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (_c *APIKeyCreate) SetDailyRequestLimit(v int) *APIKeyCreate {
+	_c.mutation.SetDailyRequestLimit(v)
+	return _c
+}
+
+// SetNillableDailyRequestLimit sets the "daily_request_limit" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableDailyRequestLimit(v *int) *APIKeyCreate {
+	if v != nil {
+		_c.SetDailyRequestLimit(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *APIKeyCreate) SetUser(v *User) *APIKeyCreate {
 	return _c.SetUserID(v.ID)
@@ -255,6 +303,18 @@ func (_c *APIKeyCreate) defaults() error {
 		v := apikey.DefaultQuotaUsed
 		_c.mutation.SetQuotaUsed(v)
 	}
+	if _, ok := _c.mutation.RateLimitRpm(); !ok {
+		v := apikey.DefaultRateLimitRpm
+		_c.mutation.SetRateLimitRpm(v)
+	}
+	if _, ok := _c.mutation.RateLimitTpm(); !ok {
+		v := apikey.DefaultRateLimitTpm
+		_c.mutation.SetRateLimitTpm(v)
+	}
+	if _, ok := _c.mutation.DailyRequestLimit(); !ok {
+		v := apikey.DefaultDailyRequestLimit
+		_c.mutation.SetDailyRequestLimit(v)
+	}
 	return nil
 }
 
@@ -299,6 +359,15 @@ func (_c *APIKeyCreate) check() error {
 	if _, ok := _c.mutation.QuotaUsed(); !ok {
 		return &ValidationError{Name: "quota_used", err: errors.New(`ent: missing required field "APIKey.quota_used"`)}
 	}
+	if _, ok := _c.mutation.RateLimitRpm(); !ok {
+		return &ValidationError{Name: "rate_limit_rpm", err: errors.New(`ent: missing required field "APIKey.rate_limit_rpm"`)}
+	}
+	if _, ok := _c.mutation.RateLimitTpm(); !ok {
+		return &ValidationError{Name: "rate_limit_tpm", err: errors.New(`ent: missing required field "APIKey.rate_limit_tpm"`)}
+	}
+	if _, ok := _c.mutation.DailyRequestLimit(); !ok {
+		return &ValidationError{Name: "daily_request_limit", err: errors.New(`ent: missing required field "APIKey.daily_request_limit"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "APIKey.user"`)}
 	}
@@ -373,6 +442,22 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = &value
 	}
+	if value, ok := _c.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+		_node.AllowedModels = value
+	}
+	if value, ok := _c.mutation.RateLimitRpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitRpm, field.TypeInt, value)
+		_node.RateLimitRpm = value
+	}
+	if value, ok := _c.mutation.RateLimitTpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitTpm, field.TypeInt, value)
+		_node.RateLimitTpm = value
+	}
+	if value, ok := _c.mutation.DailyRequestLimit(); ok {
+		_spec.SetField(apikey.FieldDailyRequestLimit, field.TypeInt, value)
+		_node.DailyRequestLimit = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -661,6 +746,78 @@ func (u *APIKeyUpsert) ClearExpiresAt() *APIKeyUpsert {
 	return u
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsert) SetAllowedModels(v []string) *APIKeyUpsert {
+	u.Set(apikey.FieldAllowedModels, v)
+	return u
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateAllowedModels() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldAllowedModels)
+	return u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsert) ClearAllowedModels() *APIKeyUpsert {
+	u.SetNull(apikey.FieldAllowedModels)
+	return u
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (u *APIKeyUpsert) SetRateLimitRpm(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldRateLimitRpm, v)
+	return u
+}
+
+// UpdateRateLimitRpm sets the "rate_limit_rpm" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateRateLimitRpm() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldRateLimitRpm)
+	return u
+}
+
+// AddRateLimitRpm adds v to the "rate_limit_rpm" field.
+func (u *APIKeyUpsert) AddRateLimitRpm(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldRateLimitRpm, v)
+	return u
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (u *APIKeyUpsert) SetRateLimitTpm(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldRateLimitTpm, v)
+	return u
+}
+
+// UpdateRateLimitTpm sets the "rate_limit_tpm" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateRateLimitTpm() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldRateLimitTpm)
+	return u
+}
+
+// AddRateLimitTpm adds v to the "rate_limit_tpm" field.
+func (u *APIKeyUpsert) AddRateLimitTpm(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldRateLimitTpm, v)
+	return u
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (u *APIKeyUpsert) SetDailyRequestLimit(v int) *APIKeyUpsert {
+	u.Set(apikey.FieldDailyRequestLimit, v)
+	return u
+}
+
+// UpdateDailyRequestLimit sets the "daily_request_limit" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateDailyRequestLimit() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldDailyRequestLimit)
+	return u
+}
+
+// AddDailyRequestLimit adds v to the "daily_request_limit" field.
+func (u *APIKeyUpsert) AddDailyRequestLimit(v int) *APIKeyUpsert {
+	u.Add(apikey.FieldDailyRequestLimit, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -923,6 +1080,90 @@ func (u *APIKeyUpsertOne) ClearExpiresAt() *APIKeyUpsertOne {
 	})
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertOne) SetAllowedModels(v []string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertOne) ClearAllowedModels() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (u *APIKeyUpsertOne) SetRateLimitRpm(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRateLimitRpm(v)
+	})
+}
+
+// AddRateLimitRpm adds v to the "rate_limit_rpm" field.
+func (u *APIKeyUpsertOne) AddRateLimitRpm(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRateLimitRpm(v)
+	})
+}
+
+// UpdateRateLimitRpm sets the "rate_limit_rpm" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateRateLimitRpm() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRateLimitRpm()
+	})
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (u *APIKeyUpsertOne) SetRateLimitTpm(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRateLimitTpm(v)
+	})
+}
+
+// AddRateLimitTpm adds v to the "rate_limit_tpm" field.
+func (u *APIKeyUpsertOne) AddRateLimitTpm(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRateLimitTpm(v)
+	})
+}
+
+// UpdateRateLimitTpm sets the "rate_limit_tpm" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateRateLimitTpm() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRateLimitTpm()
+	})
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (u *APIKeyUpsertOne) SetDailyRequestLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyRequestLimit(v)
+	})
+}
+
+// AddDailyRequestLimit adds v to the "daily_request_limit" field.
+func (u *APIKeyUpsertOne) AddDailyRequestLimit(v int) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddDailyRequestLimit(v)
+	})
+}
+
+// UpdateDailyRequestLimit sets the "daily_request_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateDailyRequestLimit() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyRequestLimit()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1351,6 +1592,90 @@ func (u *APIKeyUpsertBulk) ClearExpiresAt() *APIKeyUpsertBulk {
 	})
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (u *APIKeyUpsertBulk) SetAllowedModels(v []string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetAllowedModels(v)
+	})
+}
+
+// UpdateAllowedModels sets the "allowed_models" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateAllowedModels()
+	})
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (u *APIKeyUpsertBulk) ClearAllowedModels() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearAllowedModels()
+	})
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (u *APIKeyUpsertBulk) SetRateLimitRpm(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRateLimitRpm(v)
+	})
+}
+
+// AddRateLimitRpm adds v to the "rate_limit_rpm" field.
+func (u *APIKeyUpsertBulk) AddRateLimitRpm(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRateLimitRpm(v)
+	})
+}
+
+// UpdateRateLimitRpm sets the "rate_limit_rpm" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateRateLimitRpm() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRateLimitRpm()
+	})
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (u *APIKeyUpsertBulk) SetRateLimitTpm(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetRateLimitTpm(v)
+	})
+}
+
+// AddRateLimitTpm adds v to the "rate_limit_tpm" field.
+func (u *APIKeyUpsertBulk) AddRateLimitTpm(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddRateLimitTpm(v)
+	})
+}
+
+// UpdateRateLimitTpm sets the "rate_limit_tpm" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateRateLimitTpm() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateRateLimitTpm()
+	})
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (u *APIKeyUpsertBulk) SetDailyRequestLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetDailyRequestLimit(v)
+	})
+}
+
+// AddDailyRequestLimit adds v to the "daily_request_limit" field.
+func (u *APIKeyUpsertBulk) AddDailyRequestLimit(v int) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.AddDailyRequestLimit(v)
+	})
+}
+
+// UpdateDailyRequestLimit sets the "daily_request_limit" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateDailyRequestLimit() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateDailyRequestLimit()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -232,6 +232,87 @@ func (_u *APIKeyUpdate) ClearExpiresAt() *APIKeyUpdate {
 	return _u
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_u *APIKeyUpdate) SetAllowedModels(v []string) *APIKeyUpdate {
+	_u.mutation.SetAllowedModels(v)
+	return _u
+}
+
+// AppendAllowedModels appends value to the "allowed_models" field.
+func (_u *APIKeyUpdate) AppendAllowedModels(v []string) *APIKeyUpdate {
+	_u.mutation.AppendAllowedModels(v)
+	return _u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (_u *APIKeyUpdate) ClearAllowedModels() *APIKeyUpdate {
+	_u.mutation.ClearAllowedModels()
+	return _u
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (_u *APIKeyUpdate) SetRateLimitRpm(v int) *APIKeyUpdate {
+	_u.mutation.ResetRateLimitRpm()
+	_u.mutation.SetRateLimitRpm(v)
+	return _u
+}
+
+// SetNillableRateLimitRpm sets the "rate_limit_rpm" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRateLimitRpm(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRateLimitRpm(*v)
+	}
+	return _u
+}
+
+// AddRateLimitRpm adds value to the "rate_limit_rpm" field.
+func (_u *APIKeyUpdate) AddRateLimitRpm(v int) *APIKeyUpdate {
+	_u.mutation.AddRateLimitRpm(v)
+	return _u
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (_u *APIKeyUpdate) SetRateLimitTpm(v int) *APIKeyUpdate {
+	_u.mutation.ResetRateLimitTpm()
+	_u.mutation.SetRateLimitTpm(v)
+	return _u
+}
+
+// SetNillableRateLimitTpm sets the "rate_limit_tpm" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRateLimitTpm(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRateLimitTpm(*v)
+	}
+	return _u
+}
+
+// AddRateLimitTpm adds value to the "rate_limit_tpm" field.
+func (_u *APIKeyUpdate) AddRateLimitTpm(v int) *APIKeyUpdate {
+	_u.mutation.AddRateLimitTpm(v)
+	return _u
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (_u *APIKeyUpdate) SetDailyRequestLimit(v int) *APIKeyUpdate {
+	_u.mutation.ResetDailyRequestLimit()
+	_u.mutation.SetDailyRequestLimit(v)
+	return _u
+}
+
+// SetNillableDailyRequestLimit sets the "daily_request_limit" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableDailyRequestLimit(v *int) *APIKeyUpdate {
+	if v != nil {
+		_u.SetDailyRequestLimit(*v)
+	}
+	return _u
+}
+
+// AddDailyRequestLimit adds value to the "daily_request_limit" field.
+func (_u *APIKeyUpdate) AddDailyRequestLimit(v int) *APIKeyUpdate {
+	_u.mutation.AddDailyRequestLimit(v)
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdate) SetUser(v *User) *APIKeyUpdate {
 	return _u.SetUserID(v.ID)
@@ -430,6 +511,35 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if _u.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RateLimitRpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitRpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitRpm(); ok {
+		_spec.AddField(apikey.FieldRateLimitRpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimitTpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitTpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitTpm(); ok {
+		_spec.AddField(apikey.FieldRateLimitTpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyRequestLimit(); ok {
+		_spec.SetField(apikey.FieldDailyRequestLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyRequestLimit(); ok {
+		_spec.AddField(apikey.FieldDailyRequestLimit, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -753,6 +863,87 @@ func (_u *APIKeyUpdateOne) ClearExpiresAt() *APIKeyUpdateOne {
 	return _u
 }
 
+// SetAllowedModels sets the "allowed_models" field.
+func (_u *APIKeyUpdateOne) SetAllowedModels(v []string) *APIKeyUpdateOne {
+	_u.mutation.SetAllowedModels(v)
+	return _u
+}
+
+// AppendAllowedModels appends value to the "allowed_models" field.
+func (_u *APIKeyUpdateOne) AppendAllowedModels(v []string) *APIKeyUpdateOne {
+	_u.mutation.AppendAllowedModels(v)
+	return _u
+}
+
+// ClearAllowedModels clears the value of the "allowed_models" field.
+func (_u *APIKeyUpdateOne) ClearAllowedModels() *APIKeyUpdateOne {
+	_u.mutation.ClearAllowedModels()
+	return _u
+}
+
+// SetRateLimitRpm sets the "rate_limit_rpm" field.
+func (_u *APIKeyUpdateOne) SetRateLimitRpm(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetRateLimitRpm()
+	_u.mutation.SetRateLimitRpm(v)
+	return _u
+}
+
+// SetNillableRateLimitRpm sets the "rate_limit_rpm" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRateLimitRpm(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRateLimitRpm(*v)
+	}
+	return _u
+}
+
+// AddRateLimitRpm adds value to the "rate_limit_rpm" field.
+func (_u *APIKeyUpdateOne) AddRateLimitRpm(v int) *APIKeyUpdateOne {
+	_u.mutation.AddRateLimitRpm(v)
+	return _u
+}
+
+// SetRateLimitTpm sets the "rate_limit_tpm" field.
+func (_u *APIKeyUpdateOne) SetRateLimitTpm(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetRateLimitTpm()
+	_u.mutation.SetRateLimitTpm(v)
+	return _u
+}
+
+// SetNillableRateLimitTpm sets the "rate_limit_tpm" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRateLimitTpm(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRateLimitTpm(*v)
+	}
+	return _u
+}
+
+// AddRateLimitTpm adds value to the "rate_limit_tpm" field.
+func (_u *APIKeyUpdateOne) AddRateLimitTpm(v int) *APIKeyUpdateOne {
+	_u.mutation.AddRateLimitTpm(v)
+	return _u
+}
+
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (_u *APIKeyUpdateOne) SetDailyRequestLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.ResetDailyRequestLimit()
+	_u.mutation.SetDailyRequestLimit(v)
+	return _u
+}
+
+// SetNillableDailyRequestLimit sets the "daily_request_limit" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableDailyRequestLimit(v *int) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetDailyRequestLimit(*v)
+	}
+	return _u
+}
+
+// AddDailyRequestLimit adds value to the "daily_request_limit" field.
+func (_u *APIKeyUpdateOne) AddDailyRequestLimit(v int) *APIKeyUpdateOne {
+	_u.mutation.AddDailyRequestLimit(v)
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdateOne) SetUser(v *User) *APIKeyUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -981,6 +1172,35 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(apikey.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.AllowedModels(); ok {
+		_spec.SetField(apikey.FieldAllowedModels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedModels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldAllowedModels, value)
+		})
+	}
+	if _u.mutation.AllowedModelsCleared() {
+		_spec.ClearField(apikey.FieldAllowedModels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RateLimitRpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitRpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitRpm(); ok {
+		_spec.AddField(apikey.FieldRateLimitRpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RateLimitTpm(); ok {
+		_spec.SetField(apikey.FieldRateLimitTpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitTpm(); ok {
+		_spec.AddField(apikey.FieldRateLimitTpm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DailyRequestLimit(); ok {
+		_spec.SetField(apikey.FieldDailyRequestLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDailyRequestLimit(); ok {
+		_spec.AddField(apikey.FieldDailyRequestLimit, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
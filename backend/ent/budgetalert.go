@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/budgetalert"
+)
+
+// BudgetAlert is the model entity for the BudgetAlert schema.
+type BudgetAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// 监控指标: balance, api_key_quota, subscription_daily, subscription_weekly, subscription_monthly
+	Metric string `json:"metric,omitempty"`
+	// 阈值类型: remaining_usd, percent_used
+	ThresholdType string `json:"threshold_type,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold float64 `json:"threshold,omitempty"`
+	// api_key_quota 监控的 Key；也是 auto_disable_key 的禁用目标
+	APIKeyID *int64 `json:"api_key_id,omitempty"`
+	// subscription_* 监控的订阅
+	SubscriptionID *int64 `json:"subscription_id,omitempty"`
+	// NotifyEmail holds the value of the "notify_email" field.
+	NotifyEmail bool `json:"notify_email,omitempty"`
+	// WebhookURL holds the value of the "webhook_url" field.
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	// AutoDisableKey holds the value of the "auto_disable_key" field.
+	AutoDisableKey bool `json:"auto_disable_key,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// LastTriggeredWindow holds the value of the "last_triggered_window" field.
+	LastTriggeredWindow *string `json:"last_triggered_window,omitempty"`
+	// LastTriggeredAt holds the value of the "last_triggered_at" field.
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BudgetAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldNotifyEmail, budgetalert.FieldAutoDisableKey, budgetalert.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case budgetalert.FieldThreshold:
+			values[i] = new(sql.NullFloat64)
+		case budgetalert.FieldID, budgetalert.FieldUserID, budgetalert.FieldAPIKeyID, budgetalert.FieldSubscriptionID:
+			values[i] = new(sql.NullInt64)
+		case budgetalert.FieldName, budgetalert.FieldMetric, budgetalert.FieldThresholdType, budgetalert.FieldWebhookURL, budgetalert.FieldLastTriggeredWindow:
+			values[i] = new(sql.NullString)
+		case budgetalert.FieldCreatedAt, budgetalert.FieldUpdatedAt, budgetalert.FieldLastTriggeredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BudgetAlert fields.
+func (_m *BudgetAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case budgetalert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case budgetalert.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case budgetalert.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case budgetalert.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case budgetalert.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				_m.Metric = value.String
+			}
+		case budgetalert.FieldThresholdType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold_type", values[i])
+			} else if value.Valid {
+				_m.ThresholdType = value.String
+			}
+		case budgetalert.FieldThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = value.Float64
+			}
+		case budgetalert.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = new(int64)
+				*_m.APIKeyID = value.Int64
+			}
+		case budgetalert.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				_m.SubscriptionID = new(int64)
+				*_m.SubscriptionID = value.Int64
+			}
+		case budgetalert.FieldNotifyEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_email", values[i])
+			} else if value.Valid {
+				_m.NotifyEmail = value.Bool
+			}
+		case budgetalert.FieldWebhookURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_url", values[i])
+			} else if value.Valid {
+				_m.WebhookURL = new(string)
+				*_m.WebhookURL = value.String
+			}
+		case budgetalert.FieldAutoDisableKey:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_disable_key", values[i])
+			} else if value.Valid {
+				_m.AutoDisableKey = value.Bool
+			}
+		case budgetalert.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case budgetalert.FieldLastTriggeredWindow:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_triggered_window", values[i])
+			} else if value.Valid {
+				_m.LastTriggeredWindow = new(string)
+				*_m.LastTriggeredWindow = value.String
+			}
+		case budgetalert.FieldLastTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_triggered_at", values[i])
+			} else if value.Valid {
+				_m.LastTriggeredAt = new(time.Time)
+				*_m.LastTriggeredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BudgetAlert.
+// This includes values selected through modifiers, order, etc.
+func (_m *BudgetAlert) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BudgetAlert.
+// Note that you need to call BudgetAlert.Unwrap() before calling this method if this BudgetAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BudgetAlert) Update() *BudgetAlertUpdateOne {
+	return NewBudgetAlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BudgetAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BudgetAlert) Unwrap() *BudgetAlert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BudgetAlert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BudgetAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("BudgetAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(_m.Metric)
+	builder.WriteString(", ")
+	builder.WriteString("threshold_type=")
+	builder.WriteString(_m.ThresholdType)
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteString(", ")
+	if v := _m.APIKeyID; v != nil {
+		builder.WriteString("api_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("notify_email=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyEmail))
+	builder.WriteString(", ")
+	if v := _m.WebhookURL; v != nil {
+		builder.WriteString("webhook_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_disable_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoDisableKey))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.LastTriggeredWindow; v != nil {
+		builder.WriteString("last_triggered_window=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastTriggeredAt; v != nil {
+		builder.WriteString("last_triggered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BudgetAlerts is a parsable slice of BudgetAlert.
+type BudgetAlerts []*BudgetAlert
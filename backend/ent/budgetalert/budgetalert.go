@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetalert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the budgetalert type in the database.
+	Label = "budget_alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMetric holds the string denoting the metric field in the database.
+	FieldMetric = "metric"
+	// FieldThresholdType holds the string denoting the threshold_type field in the database.
+	FieldThresholdType = "threshold_type"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldNotifyEmail holds the string denoting the notify_email field in the database.
+	FieldNotifyEmail = "notify_email"
+	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
+	FieldWebhookURL = "webhook_url"
+	// FieldAutoDisableKey holds the string denoting the auto_disable_key field in the database.
+	FieldAutoDisableKey = "auto_disable_key"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldLastTriggeredWindow holds the string denoting the last_triggered_window field in the database.
+	FieldLastTriggeredWindow = "last_triggered_window"
+	// FieldLastTriggeredAt holds the string denoting the last_triggered_at field in the database.
+	FieldLastTriggeredAt = "last_triggered_at"
+	// Table holds the table name of the budgetalert in the database.
+	Table = "budget_alerts"
+)
+
+// Columns holds all SQL columns for budgetalert fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldName,
+	FieldMetric,
+	FieldThresholdType,
+	FieldThreshold,
+	FieldAPIKeyID,
+	FieldSubscriptionID,
+	FieldNotifyEmail,
+	FieldWebhookURL,
+	FieldAutoDisableKey,
+	FieldEnabled,
+	FieldLastTriggeredWindow,
+	FieldLastTriggeredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// MetricValidator is a validator for the "metric" field. It is called by the builders before save.
+	MetricValidator func(string) error
+	// ThresholdTypeValidator is a validator for the "threshold_type" field. It is called by the builders before save.
+	ThresholdTypeValidator func(string) error
+	// DefaultNotifyEmail holds the default value on creation for the "notify_email" field.
+	DefaultNotifyEmail bool
+	// WebhookURLValidator is a validator for the "webhook_url" field. It is called by the builders before save.
+	WebhookURLValidator func(string) error
+	// DefaultAutoDisableKey holds the default value on creation for the "auto_disable_key" field.
+	DefaultAutoDisableKey bool
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// LastTriggeredWindowValidator is a validator for the "last_triggered_window" field. It is called by the builders before save.
+	LastTriggeredWindowValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BudgetAlert queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMetric orders the results by the metric field.
+func ByMetric(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetric, opts...).ToFunc()
+}
+
+// ByThresholdType orders the results by the threshold_type field.
+func ByThresholdType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThresholdType, opts...).ToFunc()
+}
+
+// ByThreshold orders the results by the threshold field.
+func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
+}
+
+// ByAPIKeyID orders the results by the api_key_id field.
+func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByNotifyEmail orders the results by the notify_email field.
+func ByNotifyEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyEmail, opts...).ToFunc()
+}
+
+// ByWebhookURL orders the results by the webhook_url field.
+func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
+}
+
+// ByAutoDisableKey orders the results by the auto_disable_key field.
+func ByAutoDisableKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoDisableKey, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByLastTriggeredWindow orders the results by the last_triggered_window field.
+func ByLastTriggeredWindow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTriggeredWindow, opts...).ToFunc()
+}
+
+// ByLastTriggeredAt orders the results by the last_triggered_at field.
+func ByLastTriggeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTriggeredAt, opts...).ToFunc()
+}
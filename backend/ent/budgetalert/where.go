@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetalert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldName, v))
+}
+
+// Metric applies equality check predicate on the "metric" field. It's identical to MetricEQ.
+func Metric(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldMetric, v))
+}
+
+// ThresholdType applies equality check predicate on the "threshold_type" field. It's identical to ThresholdTypeEQ.
+func ThresholdType(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThresholdType, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// NotifyEmail applies equality check predicate on the "notify_email" field. It's identical to NotifyEmailEQ.
+func NotifyEmail(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldNotifyEmail, v))
+}
+
+// WebhookURL applies equality check predicate on the "webhook_url" field. It's identical to WebhookURLEQ.
+func WebhookURL(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// AutoDisableKey applies equality check predicate on the "auto_disable_key" field. It's identical to AutoDisableKeyEQ.
+func AutoDisableKey(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldAutoDisableKey, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastTriggeredWindow applies equality check predicate on the "last_triggered_window" field. It's identical to LastTriggeredWindowEQ.
+func LastTriggeredWindow(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredAt applies equality check predicate on the "last_triggered_at" field. It's identical to LastTriggeredAtEQ.
+func LastTriggeredAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldName, v))
+}
+
+// MetricEQ applies the EQ predicate on the "metric" field.
+func MetricEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldMetric, v))
+}
+
+// MetricNEQ applies the NEQ predicate on the "metric" field.
+func MetricNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldMetric, v))
+}
+
+// MetricIn applies the In predicate on the "metric" field.
+func MetricIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldMetric, vs...))
+}
+
+// MetricNotIn applies the NotIn predicate on the "metric" field.
+func MetricNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldMetric, vs...))
+}
+
+// MetricGT applies the GT predicate on the "metric" field.
+func MetricGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldMetric, v))
+}
+
+// MetricGTE applies the GTE predicate on the "metric" field.
+func MetricGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldMetric, v))
+}
+
+// MetricLT applies the LT predicate on the "metric" field.
+func MetricLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldMetric, v))
+}
+
+// MetricLTE applies the LTE predicate on the "metric" field.
+func MetricLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldMetric, v))
+}
+
+// MetricContains applies the Contains predicate on the "metric" field.
+func MetricContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldMetric, v))
+}
+
+// MetricHasPrefix applies the HasPrefix predicate on the "metric" field.
+func MetricHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldMetric, v))
+}
+
+// MetricHasSuffix applies the HasSuffix predicate on the "metric" field.
+func MetricHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldMetric, v))
+}
+
+// MetricEqualFold applies the EqualFold predicate on the "metric" field.
+func MetricEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldMetric, v))
+}
+
+// MetricContainsFold applies the ContainsFold predicate on the "metric" field.
+func MetricContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldMetric, v))
+}
+
+// ThresholdTypeEQ applies the EQ predicate on the "threshold_type" field.
+func ThresholdTypeEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThresholdType, v))
+}
+
+// ThresholdTypeNEQ applies the NEQ predicate on the "threshold_type" field.
+func ThresholdTypeNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldThresholdType, v))
+}
+
+// ThresholdTypeIn applies the In predicate on the "threshold_type" field.
+func ThresholdTypeIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldThresholdType, vs...))
+}
+
+// ThresholdTypeNotIn applies the NotIn predicate on the "threshold_type" field.
+func ThresholdTypeNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldThresholdType, vs...))
+}
+
+// ThresholdTypeGT applies the GT predicate on the "threshold_type" field.
+func ThresholdTypeGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldThresholdType, v))
+}
+
+// ThresholdTypeGTE applies the GTE predicate on the "threshold_type" field.
+func ThresholdTypeGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldThresholdType, v))
+}
+
+// ThresholdTypeLT applies the LT predicate on the "threshold_type" field.
+func ThresholdTypeLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldThresholdType, v))
+}
+
+// ThresholdTypeLTE applies the LTE predicate on the "threshold_type" field.
+func ThresholdTypeLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldThresholdType, v))
+}
+
+// ThresholdTypeContains applies the Contains predicate on the "threshold_type" field.
+func ThresholdTypeContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldThresholdType, v))
+}
+
+// ThresholdTypeHasPrefix applies the HasPrefix predicate on the "threshold_type" field.
+func ThresholdTypeHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldThresholdType, v))
+}
+
+// ThresholdTypeHasSuffix applies the HasSuffix predicate on the "threshold_type" field.
+func ThresholdTypeHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldThresholdType, v))
+}
+
+// ThresholdTypeEqualFold applies the EqualFold predicate on the "threshold_type" field.
+func ThresholdTypeEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldThresholdType, v))
+}
+
+// ThresholdTypeContainsFold applies the ContainsFold predicate on the "threshold_type" field.
+func ThresholdTypeContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldThresholdType, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v float64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldThreshold, v))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIsNil applies the IsNil predicate on the "api_key_id" field.
+func APIKeyIDIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldAPIKeyID))
+}
+
+// APIKeyIDNotNil applies the NotNil predicate on the "api_key_id" field.
+func APIKeyIDNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldAPIKeyID))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// NotifyEmailEQ applies the EQ predicate on the "notify_email" field.
+func NotifyEmailEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldNotifyEmail, v))
+}
+
+// NotifyEmailNEQ applies the NEQ predicate on the "notify_email" field.
+func NotifyEmailNEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldNotifyEmail, v))
+}
+
+// WebhookURLEQ applies the EQ predicate on the "webhook_url" field.
+func WebhookURLEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLNEQ applies the NEQ predicate on the "webhook_url" field.
+func WebhookURLNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldWebhookURL, v))
+}
+
+// WebhookURLIn applies the In predicate on the "webhook_url" field.
+func WebhookURLIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLNotIn applies the NotIn predicate on the "webhook_url" field.
+func WebhookURLNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldWebhookURL, vs...))
+}
+
+// WebhookURLGT applies the GT predicate on the "webhook_url" field.
+func WebhookURLGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldWebhookURL, v))
+}
+
+// WebhookURLGTE applies the GTE predicate on the "webhook_url" field.
+func WebhookURLGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldWebhookURL, v))
+}
+
+// WebhookURLLT applies the LT predicate on the "webhook_url" field.
+func WebhookURLLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldWebhookURL, v))
+}
+
+// WebhookURLLTE applies the LTE predicate on the "webhook_url" field.
+func WebhookURLLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldWebhookURL, v))
+}
+
+// WebhookURLContains applies the Contains predicate on the "webhook_url" field.
+func WebhookURLContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldWebhookURL, v))
+}
+
+// WebhookURLHasPrefix applies the HasPrefix predicate on the "webhook_url" field.
+func WebhookURLHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldWebhookURL, v))
+}
+
+// WebhookURLHasSuffix applies the HasSuffix predicate on the "webhook_url" field.
+func WebhookURLHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldWebhookURL, v))
+}
+
+// WebhookURLIsNil applies the IsNil predicate on the "webhook_url" field.
+func WebhookURLIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldWebhookURL))
+}
+
+// WebhookURLNotNil applies the NotNil predicate on the "webhook_url" field.
+func WebhookURLNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldWebhookURL))
+}
+
+// WebhookURLEqualFold applies the EqualFold predicate on the "webhook_url" field.
+func WebhookURLEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldWebhookURL, v))
+}
+
+// WebhookURLContainsFold applies the ContainsFold predicate on the "webhook_url" field.
+func WebhookURLContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldWebhookURL, v))
+}
+
+// AutoDisableKeyEQ applies the EQ predicate on the "auto_disable_key" field.
+func AutoDisableKeyEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldAutoDisableKey, v))
+}
+
+// AutoDisableKeyNEQ applies the NEQ predicate on the "auto_disable_key" field.
+func AutoDisableKeyNEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldAutoDisableKey, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// LastTriggeredWindowEQ applies the EQ predicate on the "last_triggered_window" field.
+func LastTriggeredWindowEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowNEQ applies the NEQ predicate on the "last_triggered_window" field.
+func LastTriggeredWindowNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowIn applies the In predicate on the "last_triggered_window" field.
+func LastTriggeredWindowIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldLastTriggeredWindow, vs...))
+}
+
+// LastTriggeredWindowNotIn applies the NotIn predicate on the "last_triggered_window" field.
+func LastTriggeredWindowNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldLastTriggeredWindow, vs...))
+}
+
+// LastTriggeredWindowGT applies the GT predicate on the "last_triggered_window" field.
+func LastTriggeredWindowGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowGTE applies the GTE predicate on the "last_triggered_window" field.
+func LastTriggeredWindowGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowLT applies the LT predicate on the "last_triggered_window" field.
+func LastTriggeredWindowLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowLTE applies the LTE predicate on the "last_triggered_window" field.
+func LastTriggeredWindowLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowContains applies the Contains predicate on the "last_triggered_window" field.
+func LastTriggeredWindowContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowHasPrefix applies the HasPrefix predicate on the "last_triggered_window" field.
+func LastTriggeredWindowHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowHasSuffix applies the HasSuffix predicate on the "last_triggered_window" field.
+func LastTriggeredWindowHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowIsNil applies the IsNil predicate on the "last_triggered_window" field.
+func LastTriggeredWindowIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldLastTriggeredWindow))
+}
+
+// LastTriggeredWindowNotNil applies the NotNil predicate on the "last_triggered_window" field.
+func LastTriggeredWindowNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldLastTriggeredWindow))
+}
+
+// LastTriggeredWindowEqualFold applies the EqualFold predicate on the "last_triggered_window" field.
+func LastTriggeredWindowEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredWindowContainsFold applies the ContainsFold predicate on the "last_triggered_window" field.
+func LastTriggeredWindowContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldLastTriggeredWindow, v))
+}
+
+// LastTriggeredAtEQ applies the EQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtNEQ applies the NEQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIn applies the In predicate on the "last_triggered_at" field.
+func LastTriggeredAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtNotIn applies the NotIn predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtGT applies the GT predicate on the "last_triggered_at" field.
+func LastTriggeredAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtGTE applies the GTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLT applies the LT predicate on the "last_triggered_at" field.
+func LastTriggeredAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLTE applies the LTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIsNil applies the IsNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldLastTriggeredAt))
+}
+
+// LastTriggeredAtNotNil applies the NotNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldLastTriggeredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.NotPredicates(p))
+}
@@ -0,0 +1,1477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/budgetalert"
+)
+
+// BudgetAlertCreate is the builder for creating a BudgetAlert entity.
+type BudgetAlertCreate struct {
+	config
+	mutation *BudgetAlertMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetAlertCreate) SetCreatedAt(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableCreatedAt(v *time.Time) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BudgetAlertCreate) SetUpdatedAt(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableUpdatedAt(v *time.Time) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BudgetAlertCreate) SetUserID(v int64) *BudgetAlertCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BudgetAlertCreate) SetName(v string) *BudgetAlertCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableName(v *string) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetMetric sets the "metric" field.
+func (_c *BudgetAlertCreate) SetMetric(v string) *BudgetAlertCreate {
+	_c.mutation.SetMetric(v)
+	return _c
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (_c *BudgetAlertCreate) SetThresholdType(v string) *BudgetAlertCreate {
+	_c.mutation.SetThresholdType(v)
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *BudgetAlertCreate) SetThreshold(v float64) *BudgetAlertCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *BudgetAlertCreate) SetAPIKeyID(v int64) *BudgetAlertCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableAPIKeyID(v *int64) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *BudgetAlertCreate) SetSubscriptionID(v int64) *BudgetAlertCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableSubscriptionID(v *int64) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetSubscriptionID(*v)
+	}
+	return _c
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (_c *BudgetAlertCreate) SetNotifyEmail(v bool) *BudgetAlertCreate {
+	_c.mutation.SetNotifyEmail(v)
+	return _c
+}
+
+// SetNillableNotifyEmail sets the "notify_email" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableNotifyEmail(v *bool) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetNotifyEmail(*v)
+	}
+	return _c
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_c *BudgetAlertCreate) SetWebhookURL(v string) *BudgetAlertCreate {
+	_c.mutation.SetWebhookURL(v)
+	return _c
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableWebhookURL(v *string) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetWebhookURL(*v)
+	}
+	return _c
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (_c *BudgetAlertCreate) SetAutoDisableKey(v bool) *BudgetAlertCreate {
+	_c.mutation.SetAutoDisableKey(v)
+	return _c
+}
+
+// SetNillableAutoDisableKey sets the "auto_disable_key" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableAutoDisableKey(v *bool) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetAutoDisableKey(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *BudgetAlertCreate) SetEnabled(v bool) *BudgetAlertCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableEnabled(v *bool) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (_c *BudgetAlertCreate) SetLastTriggeredWindow(v string) *BudgetAlertCreate {
+	_c.mutation.SetLastTriggeredWindow(v)
+	return _c
+}
+
+// SetNillableLastTriggeredWindow sets the "last_triggered_window" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableLastTriggeredWindow(v *string) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetLastTriggeredWindow(*v)
+	}
+	return _c
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (_c *BudgetAlertCreate) SetLastTriggeredAt(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetLastTriggeredAt(v)
+	return _c
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableLastTriggeredAt(v *time.Time) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetLastTriggeredAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_c *BudgetAlertCreate) Mutation() *BudgetAlertMutation {
+	return _c.mutation
+}
+
+// Save creates the BudgetAlert in the database.
+func (_c *BudgetAlertCreate) Save(ctx context.Context) (*BudgetAlert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetAlertCreate) SaveX(ctx context.Context) *BudgetAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetAlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetAlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetAlertCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := budgetalert.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := budgetalert.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := budgetalert.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.NotifyEmail(); !ok {
+		v := budgetalert.DefaultNotifyEmail
+		_c.mutation.SetNotifyEmail(v)
+	}
+	if _, ok := _c.mutation.AutoDisableKey(); !ok {
+		v := budgetalert.DefaultAutoDisableKey
+		_c.mutation.SetAutoDisableKey(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := budgetalert.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetAlertCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BudgetAlert.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BudgetAlert.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BudgetAlert.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BudgetAlert.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := budgetalert.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`ent: missing required field "BudgetAlert.metric"`)}
+	}
+	if v, ok := _c.mutation.Metric(); ok {
+		if err := budgetalert.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.metric": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ThresholdType(); !ok {
+		return &ValidationError{Name: "threshold_type", err: errors.New(`ent: missing required field "BudgetAlert.threshold_type"`)}
+	}
+	if v, ok := _c.mutation.ThresholdType(); ok {
+		if err := budgetalert.ThresholdTypeValidator(v); err != nil {
+			return &ValidationError{Name: "threshold_type", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.threshold_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "BudgetAlert.threshold"`)}
+	}
+	if _, ok := _c.mutation.NotifyEmail(); !ok {
+		return &ValidationError{Name: "notify_email", err: errors.New(`ent: missing required field "BudgetAlert.notify_email"`)}
+	}
+	if v, ok := _c.mutation.WebhookURL(); ok {
+		if err := budgetalert.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.webhook_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AutoDisableKey(); !ok {
+		return &ValidationError{Name: "auto_disable_key", err: errors.New(`ent: missing required field "BudgetAlert.auto_disable_key"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "BudgetAlert.enabled"`)}
+	}
+	if v, ok := _c.mutation.LastTriggeredWindow(); ok {
+		if err := budgetalert.LastTriggeredWindowValidator(v); err != nil {
+			return &ValidationError{Name: "last_triggered_window", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.last_triggered_window": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BudgetAlertCreate) sqlSave(ctx context.Context) (*BudgetAlert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetAlertCreate) createSpec() (*BudgetAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BudgetAlert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budgetalert.Table, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetalert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(budgetalert.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(budgetalert.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(budgetalert.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Metric(); ok {
+		_spec.SetField(budgetalert.FieldMetric, field.TypeString, value)
+		_node.Metric = value
+	}
+	if value, ok := _c.mutation.ThresholdType(); ok {
+		_spec.SetField(budgetalert.FieldThresholdType, field.TypeString, value)
+		_node.ThresholdType = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeFloat64, value)
+		_node.Threshold = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(budgetalert.FieldAPIKeyID, field.TypeInt64, value)
+		_node.APIKeyID = &value
+	}
+	if value, ok := _c.mutation.SubscriptionID(); ok {
+		_spec.SetField(budgetalert.FieldSubscriptionID, field.TypeInt64, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := _c.mutation.NotifyEmail(); ok {
+		_spec.SetField(budgetalert.FieldNotifyEmail, field.TypeBool, value)
+		_node.NotifyEmail = value
+	}
+	if value, ok := _c.mutation.WebhookURL(); ok {
+		_spec.SetField(budgetalert.FieldWebhookURL, field.TypeString, value)
+		_node.WebhookURL = &value
+	}
+	if value, ok := _c.mutation.AutoDisableKey(); ok {
+		_spec.SetField(budgetalert.FieldAutoDisableKey, field.TypeBool, value)
+		_node.AutoDisableKey = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(budgetalert.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.LastTriggeredWindow(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredWindow, field.TypeString, value)
+		_node.LastTriggeredWindow = &value
+	}
+	if value, ok := _c.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredAt, field.TypeTime, value)
+		_node.LastTriggeredAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetAlertCreate) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertOne {
+	_c.conflict = opts
+	return &BudgetAlertUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetAlertCreate) OnConflictColumns(columns ...string) *BudgetAlertUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetAlertUpsertOne is the builder for "upsert"-ing
+	//  one BudgetAlert node.
+	BudgetAlertUpsertOne struct {
+		create *BudgetAlertCreate
+	}
+
+	// BudgetAlertUpsert is the "OnConflict" setter.
+	BudgetAlertUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetAlertUpsert) SetUpdatedAt(v time.Time) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateUpdatedAt() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetAlertUpsert) SetUserID(v int64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateUserID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetAlertUpsert) AddUserID(v int64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldUserID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BudgetAlertUpsert) SetName(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateName() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldName)
+	return u
+}
+
+// SetMetric sets the "metric" field.
+func (u *BudgetAlertUpsert) SetMetric(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldMetric, v)
+	return u
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateMetric() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldMetric)
+	return u
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (u *BudgetAlertUpsert) SetThresholdType(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldThresholdType, v)
+	return u
+}
+
+// UpdateThresholdType sets the "threshold_type" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateThresholdType() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldThresholdType)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsert) SetThreshold(v float64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateThreshold() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsert) AddThreshold(v float64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetAlertUpsert) SetAPIKeyID(v int64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldAPIKeyID, v)
+	return u
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateAPIKeyID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldAPIKeyID)
+	return u
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetAlertUpsert) AddAPIKeyID(v int64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldAPIKeyID, v)
+	return u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetAlertUpsert) ClearAPIKeyID() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldAPIKeyID)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BudgetAlertUpsert) SetSubscriptionID(v int64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateSubscriptionID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldSubscriptionID)
+	return u
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *BudgetAlertUpsert) AddSubscriptionID(v int64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldSubscriptionID, v)
+	return u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *BudgetAlertUpsert) ClearSubscriptionID() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldSubscriptionID)
+	return u
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (u *BudgetAlertUpsert) SetNotifyEmail(v bool) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldNotifyEmail, v)
+	return u
+}
+
+// UpdateNotifyEmail sets the "notify_email" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateNotifyEmail() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldNotifyEmail)
+	return u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *BudgetAlertUpsert) SetWebhookURL(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldWebhookURL, v)
+	return u
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateWebhookURL() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldWebhookURL)
+	return u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *BudgetAlertUpsert) ClearWebhookURL() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldWebhookURL)
+	return u
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (u *BudgetAlertUpsert) SetAutoDisableKey(v bool) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldAutoDisableKey, v)
+	return u
+}
+
+// UpdateAutoDisableKey sets the "auto_disable_key" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateAutoDisableKey() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldAutoDisableKey)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BudgetAlertUpsert) SetEnabled(v bool) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateEnabled() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldEnabled)
+	return u
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (u *BudgetAlertUpsert) SetLastTriggeredWindow(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldLastTriggeredWindow, v)
+	return u
+}
+
+// UpdateLastTriggeredWindow sets the "last_triggered_window" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateLastTriggeredWindow() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldLastTriggeredWindow)
+	return u
+}
+
+// ClearLastTriggeredWindow clears the value of the "last_triggered_window" field.
+func (u *BudgetAlertUpsert) ClearLastTriggeredWindow() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldLastTriggeredWindow)
+	return u
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *BudgetAlertUpsert) SetLastTriggeredAt(v time.Time) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldLastTriggeredAt, v)
+	return u
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateLastTriggeredAt() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldLastTriggeredAt)
+	return u
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *BudgetAlertUpsert) ClearLastTriggeredAt() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldLastTriggeredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertOne) UpdateNewValues() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(budgetalert.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetAlertUpsertOne) Ignore() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertOne) DoNothing() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreate.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertOne) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetAlertUpsertOne) SetUpdatedAt(v time.Time) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateUpdatedAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetAlertUpsertOne) SetUserID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetAlertUpsertOne) AddUserID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateUserID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BudgetAlertUpsertOne) SetName(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateName() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *BudgetAlertUpsertOne) SetMetric(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateMetric() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (u *BudgetAlertUpsertOne) SetThresholdType(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThresholdType(v)
+	})
+}
+
+// UpdateThresholdType sets the "threshold_type" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateThresholdType() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThresholdType()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertOne) SetThreshold(v float64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertOne) AddThreshold(v float64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateThreshold() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetAlertUpsertOne) SetAPIKeyID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetAlertUpsertOne) AddAPIKeyID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateAPIKeyID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetAlertUpsertOne) ClearAPIKeyID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearAPIKeyID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BudgetAlertUpsertOne) SetSubscriptionID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *BudgetAlertUpsertOne) AddSubscriptionID(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateSubscriptionID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *BudgetAlertUpsertOne) ClearSubscriptionID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (u *BudgetAlertUpsertOne) SetNotifyEmail(v bool) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetNotifyEmail(v)
+	})
+}
+
+// UpdateNotifyEmail sets the "notify_email" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateNotifyEmail() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateNotifyEmail()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *BudgetAlertUpsertOne) SetWebhookURL(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateWebhookURL() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *BudgetAlertUpsertOne) ClearWebhookURL() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (u *BudgetAlertUpsertOne) SetAutoDisableKey(v bool) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetAutoDisableKey(v)
+	})
+}
+
+// UpdateAutoDisableKey sets the "auto_disable_key" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateAutoDisableKey() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateAutoDisableKey()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BudgetAlertUpsertOne) SetEnabled(v bool) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateEnabled() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (u *BudgetAlertUpsertOne) SetLastTriggeredWindow(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetLastTriggeredWindow(v)
+	})
+}
+
+// UpdateLastTriggeredWindow sets the "last_triggered_window" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateLastTriggeredWindow() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateLastTriggeredWindow()
+	})
+}
+
+// ClearLastTriggeredWindow clears the value of the "last_triggered_window" field.
+func (u *BudgetAlertUpsertOne) ClearLastTriggeredWindow() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearLastTriggeredWindow()
+	})
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *BudgetAlertUpsertOne) SetLastTriggeredAt(v time.Time) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetLastTriggeredAt(v)
+	})
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateLastTriggeredAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateLastTriggeredAt()
+	})
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *BudgetAlertUpsertOne) ClearLastTriggeredAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearLastTriggeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetAlertCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetAlertUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetAlertCreateBulk is the builder for creating many BudgetAlert entities in bulk.
+type BudgetAlertCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetAlertCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BudgetAlert entities in the database.
+func (_c *BudgetAlertCreateBulk) Save(ctx context.Context) ([]*BudgetAlert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BudgetAlert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetAlertCreateBulk) SaveX(ctx context.Context) []*BudgetAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetAlertCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertBulk {
+	_c.conflict = opts
+	return &BudgetAlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetAlertCreateBulk) OnConflictColumns(columns ...string) *BudgetAlertUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetAlertUpsertBulk is the builder for "upsert"-ing
+// a bulk of BudgetAlert nodes.
+type BudgetAlertUpsertBulk struct {
+	create *BudgetAlertCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) UpdateNewValues() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(budgetalert.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) Ignore() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertBulk) DoNothing() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertBulk) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetAlertUpsertBulk) SetUpdatedAt(v time.Time) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateUpdatedAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetAlertUpsertBulk) SetUserID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetAlertUpsertBulk) AddUserID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateUserID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BudgetAlertUpsertBulk) SetName(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateName() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *BudgetAlertUpsertBulk) SetMetric(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateMetric() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (u *BudgetAlertUpsertBulk) SetThresholdType(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThresholdType(v)
+	})
+}
+
+// UpdateThresholdType sets the "threshold_type" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateThresholdType() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThresholdType()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertBulk) SetThreshold(v float64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertBulk) AddThreshold(v float64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateThreshold() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetAlertUpsertBulk) SetAPIKeyID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetAlertUpsertBulk) AddAPIKeyID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateAPIKeyID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetAlertUpsertBulk) ClearAPIKeyID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearAPIKeyID()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *BudgetAlertUpsertBulk) SetSubscriptionID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// AddSubscriptionID adds v to the "subscription_id" field.
+func (u *BudgetAlertUpsertBulk) AddSubscriptionID(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateSubscriptionID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *BudgetAlertUpsertBulk) ClearSubscriptionID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (u *BudgetAlertUpsertBulk) SetNotifyEmail(v bool) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetNotifyEmail(v)
+	})
+}
+
+// UpdateNotifyEmail sets the "notify_email" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateNotifyEmail() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateNotifyEmail()
+	})
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (u *BudgetAlertUpsertBulk) SetWebhookURL(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetWebhookURL(v)
+	})
+}
+
+// UpdateWebhookURL sets the "webhook_url" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateWebhookURL() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateWebhookURL()
+	})
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (u *BudgetAlertUpsertBulk) ClearWebhookURL() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearWebhookURL()
+	})
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (u *BudgetAlertUpsertBulk) SetAutoDisableKey(v bool) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetAutoDisableKey(v)
+	})
+}
+
+// UpdateAutoDisableKey sets the "auto_disable_key" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateAutoDisableKey() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateAutoDisableKey()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *BudgetAlertUpsertBulk) SetEnabled(v bool) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateEnabled() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (u *BudgetAlertUpsertBulk) SetLastTriggeredWindow(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetLastTriggeredWindow(v)
+	})
+}
+
+// UpdateLastTriggeredWindow sets the "last_triggered_window" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateLastTriggeredWindow() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateLastTriggeredWindow()
+	})
+}
+
+// ClearLastTriggeredWindow clears the value of the "last_triggered_window" field.
+func (u *BudgetAlertUpsertBulk) ClearLastTriggeredWindow() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearLastTriggeredWindow()
+	})
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *BudgetAlertUpsertBulk) SetLastTriggeredAt(v time.Time) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetLastTriggeredAt(v)
+	})
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateLastTriggeredAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateLastTriggeredAt()
+	})
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *BudgetAlertUpsertBulk) ClearLastTriggeredAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearLastTriggeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetAlertCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetAlertCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/budgetalert"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// BudgetAlertUpdate is the builder for updating BudgetAlert entities.
+type BudgetAlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BudgetAlertMutation
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (_u *BudgetAlertUpdate) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetAlertUpdate) SetUpdatedAt(v time.Time) *BudgetAlertUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BudgetAlertUpdate) SetUserID(v int64) *BudgetAlertUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableUserID(v *int64) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BudgetAlertUpdate) AddUserID(v int64) *BudgetAlertUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BudgetAlertUpdate) SetName(v string) *BudgetAlertUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableName(v *string) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMetric sets the "metric" field.
+func (_u *BudgetAlertUpdate) SetMetric(v string) *BudgetAlertUpdate {
+	_u.mutation.SetMetric(v)
+	return _u
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableMetric(v *string) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetMetric(*v)
+	}
+	return _u
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (_u *BudgetAlertUpdate) SetThresholdType(v string) *BudgetAlertUpdate {
+	_u.mutation.SetThresholdType(v)
+	return _u
+}
+
+// SetNillableThresholdType sets the "threshold_type" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableThresholdType(v *string) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetThresholdType(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *BudgetAlertUpdate) SetThreshold(v float64) *BudgetAlertUpdate {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableThreshold(v *float64) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *BudgetAlertUpdate) AddThreshold(v float64) *BudgetAlertUpdate {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *BudgetAlertUpdate) SetAPIKeyID(v int64) *BudgetAlertUpdate {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableAPIKeyID(v *int64) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *BudgetAlertUpdate) AddAPIKeyID(v int64) *BudgetAlertUpdate {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *BudgetAlertUpdate) ClearAPIKeyID() *BudgetAlertUpdate {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *BudgetAlertUpdate) SetSubscriptionID(v int64) *BudgetAlertUpdate {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableSubscriptionID(v *int64) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *BudgetAlertUpdate) AddSubscriptionID(v int64) *BudgetAlertUpdate {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *BudgetAlertUpdate) ClearSubscriptionID() *BudgetAlertUpdate {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (_u *BudgetAlertUpdate) SetNotifyEmail(v bool) *BudgetAlertUpdate {
+	_u.mutation.SetNotifyEmail(v)
+	return _u
+}
+
+// SetNillableNotifyEmail sets the "notify_email" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableNotifyEmail(v *bool) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetNotifyEmail(*v)
+	}
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *BudgetAlertUpdate) SetWebhookURL(v string) *BudgetAlertUpdate {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableWebhookURL(v *string) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *BudgetAlertUpdate) ClearWebhookURL() *BudgetAlertUpdate {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (_u *BudgetAlertUpdate) SetAutoDisableKey(v bool) *BudgetAlertUpdate {
+	_u.mutation.SetAutoDisableKey(v)
+	return _u
+}
+
+// SetNillableAutoDisableKey sets the "auto_disable_key" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableAutoDisableKey(v *bool) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetAutoDisableKey(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BudgetAlertUpdate) SetEnabled(v bool) *BudgetAlertUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableEnabled(v *bool) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (_u *BudgetAlertUpdate) SetLastTriggeredWindow(v string) *BudgetAlertUpdate {
+	_u.mutation.SetLastTriggeredWindow(v)
+	return _u
+}
+
+// SetNillableLastTriggeredWindow sets the "last_triggered_window" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableLastTriggeredWindow(v *string) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetLastTriggeredWindow(*v)
+	}
+	return _u
+}
+
+// ClearLastTriggeredWindow clears the value of the "last_triggered_window" field.
+func (_u *BudgetAlertUpdate) ClearLastTriggeredWindow() *BudgetAlertUpdate {
+	_u.mutation.ClearLastTriggeredWindow()
+	return _u
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (_u *BudgetAlertUpdate) SetLastTriggeredAt(v time.Time) *BudgetAlertUpdate {
+	_u.mutation.SetLastTriggeredAt(v)
+	return _u
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableLastTriggeredAt(v *time.Time) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetLastTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (_u *BudgetAlertUpdate) ClearLastTriggeredAt() *BudgetAlertUpdate {
+	_u.mutation.ClearLastTriggeredAt()
+	return _u
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_u *BudgetAlertUpdate) Mutation() *BudgetAlertMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BudgetAlertUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BudgetAlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetAlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetAlertUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := budgetalert.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetAlertUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := budgetalert.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Metric(); ok {
+		if err := budgetalert.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.metric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThresholdType(); ok {
+		if err := budgetalert.ThresholdTypeValidator(v); err != nil {
+			return &ValidationError{Name: "threshold_type", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.threshold_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookURL(); ok {
+		if err := budgetalert.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.webhook_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastTriggeredWindow(); ok {
+		if err := budgetalert.LastTriggeredWindowValidator(v); err != nil {
+			return &ValidationError{Name: "last_triggered_window", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.last_triggered_window": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BudgetAlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budgetalert.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(budgetalert.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(budgetalert.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(budgetalert.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metric(); ok {
+		_spec.SetField(budgetalert.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThresholdType(); ok {
+		_spec.SetField(budgetalert.FieldThresholdType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(budgetalert.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(budgetalert.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(budgetalert.FieldAPIKeyID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(budgetalert.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(budgetalert.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(budgetalert.FieldSubscriptionID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NotifyEmail(); ok {
+		_spec.SetField(budgetalert.FieldNotifyEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(budgetalert.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(budgetalert.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoDisableKey(); ok {
+		_spec.SetField(budgetalert.FieldAutoDisableKey, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(budgetalert.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastTriggeredWindow(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredWindow, field.TypeString, value)
+	}
+	if _u.mutation.LastTriggeredWindowCleared() {
+		_spec.ClearField(budgetalert.FieldLastTriggeredWindow, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(budgetalert.FieldLastTriggeredAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BudgetAlertUpdateOne is the builder for updating a single BudgetAlert entity.
+type BudgetAlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BudgetAlertMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetAlertUpdateOne) SetUpdatedAt(v time.Time) *BudgetAlertUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BudgetAlertUpdateOne) SetUserID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableUserID(v *int64) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BudgetAlertUpdateOne) AddUserID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BudgetAlertUpdateOne) SetName(v string) *BudgetAlertUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableName(v *string) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMetric sets the "metric" field.
+func (_u *BudgetAlertUpdateOne) SetMetric(v string) *BudgetAlertUpdateOne {
+	_u.mutation.SetMetric(v)
+	return _u
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableMetric(v *string) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetMetric(*v)
+	}
+	return _u
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (_u *BudgetAlertUpdateOne) SetThresholdType(v string) *BudgetAlertUpdateOne {
+	_u.mutation.SetThresholdType(v)
+	return _u
+}
+
+// SetNillableThresholdType sets the "threshold_type" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableThresholdType(v *string) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetThresholdType(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *BudgetAlertUpdateOne) SetThreshold(v float64) *BudgetAlertUpdateOne {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableThreshold(v *float64) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *BudgetAlertUpdateOne) AddThreshold(v float64) *BudgetAlertUpdateOne {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *BudgetAlertUpdateOne) SetAPIKeyID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableAPIKeyID(v *int64) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *BudgetAlertUpdateOne) AddAPIKeyID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *BudgetAlertUpdateOne) ClearAPIKeyID() *BudgetAlertUpdateOne {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *BudgetAlertUpdateOne) SetSubscriptionID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableSubscriptionID(v *int64) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *BudgetAlertUpdateOne) AddSubscriptionID(v int64) *BudgetAlertUpdateOne {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *BudgetAlertUpdateOne) ClearSubscriptionID() *BudgetAlertUpdateOne {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetNotifyEmail sets the "notify_email" field.
+func (_u *BudgetAlertUpdateOne) SetNotifyEmail(v bool) *BudgetAlertUpdateOne {
+	_u.mutation.SetNotifyEmail(v)
+	return _u
+}
+
+// SetNillableNotifyEmail sets the "notify_email" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableNotifyEmail(v *bool) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetNotifyEmail(*v)
+	}
+	return _u
+}
+
+// SetWebhookURL sets the "webhook_url" field.
+func (_u *BudgetAlertUpdateOne) SetWebhookURL(v string) *BudgetAlertUpdateOne {
+	_u.mutation.SetWebhookURL(v)
+	return _u
+}
+
+// SetNillableWebhookURL sets the "webhook_url" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableWebhookURL(v *string) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetWebhookURL(*v)
+	}
+	return _u
+}
+
+// ClearWebhookURL clears the value of the "webhook_url" field.
+func (_u *BudgetAlertUpdateOne) ClearWebhookURL() *BudgetAlertUpdateOne {
+	_u.mutation.ClearWebhookURL()
+	return _u
+}
+
+// SetAutoDisableKey sets the "auto_disable_key" field.
+func (_u *BudgetAlertUpdateOne) SetAutoDisableKey(v bool) *BudgetAlertUpdateOne {
+	_u.mutation.SetAutoDisableKey(v)
+	return _u
+}
+
+// SetNillableAutoDisableKey sets the "auto_disable_key" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableAutoDisableKey(v *bool) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetAutoDisableKey(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *BudgetAlertUpdateOne) SetEnabled(v bool) *BudgetAlertUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableEnabled(v *bool) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetLastTriggeredWindow sets the "last_triggered_window" field.
+func (_u *BudgetAlertUpdateOne) SetLastTriggeredWindow(v string) *BudgetAlertUpdateOne {
+	_u.mutation.SetLastTriggeredWindow(v)
+	return _u
+}
+
+// SetNillableLastTriggeredWindow sets the "last_triggered_window" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableLastTriggeredWindow(v *string) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetLastTriggeredWindow(*v)
+	}
+	return _u
+}
+
+// ClearLastTriggeredWindow clears the value of the "last_triggered_window" field.
+func (_u *BudgetAlertUpdateOne) ClearLastTriggeredWindow() *BudgetAlertUpdateOne {
+	_u.mutation.ClearLastTriggeredWindow()
+	return _u
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (_u *BudgetAlertUpdateOne) SetLastTriggeredAt(v time.Time) *BudgetAlertUpdateOne {
+	_u.mutation.SetLastTriggeredAt(v)
+	return _u
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableLastTriggeredAt(v *time.Time) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetLastTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (_u *BudgetAlertUpdateOne) ClearLastTriggeredAt() *BudgetAlertUpdateOne {
+	_u.mutation.ClearLastTriggeredAt()
+	return _u
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_u *BudgetAlertUpdateOne) Mutation() *BudgetAlertMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (_u *BudgetAlertUpdateOne) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BudgetAlertUpdateOne) Select(field string, fields ...string) *BudgetAlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BudgetAlert entity.
+func (_u *BudgetAlertUpdateOne) Save(ctx context.Context) (*BudgetAlert, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetAlertUpdateOne) SaveX(ctx context.Context) *BudgetAlert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BudgetAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetAlertUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := budgetalert.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetAlertUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := budgetalert.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Metric(); ok {
+		if err := budgetalert.MetricValidator(v); err != nil {
+			return &ValidationError{Name: "metric", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.metric": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThresholdType(); ok {
+		if err := budgetalert.ThresholdTypeValidator(v); err != nil {
+			return &ValidationError{Name: "threshold_type", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.threshold_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WebhookURL(); ok {
+		if err := budgetalert.WebhookURLValidator(v); err != nil {
+			return &ValidationError{Name: "webhook_url", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.webhook_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastTriggeredWindow(); ok {
+		if err := budgetalert.LastTriggeredWindowValidator(v); err != nil {
+			return &ValidationError{Name: "last_triggered_window", err: fmt.Errorf(`ent: validator failed for field "BudgetAlert.last_triggered_window": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BudgetAlertUpdateOne) sqlSave(ctx context.Context) (_node *BudgetAlert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BudgetAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetalert.FieldID)
+		for _, f := range fields {
+			if !budgetalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != budgetalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budgetalert.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(budgetalert.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(budgetalert.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(budgetalert.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metric(); ok {
+		_spec.SetField(budgetalert.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThresholdType(); ok {
+		_spec.SetField(budgetalert.FieldThresholdType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(budgetalert.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(budgetalert.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(budgetalert.FieldAPIKeyID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(budgetalert.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(budgetalert.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(budgetalert.FieldSubscriptionID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NotifyEmail(); ok {
+		_spec.SetField(budgetalert.FieldNotifyEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebhookURL(); ok {
+		_spec.SetField(budgetalert.FieldWebhookURL, field.TypeString, value)
+	}
+	if _u.mutation.WebhookURLCleared() {
+		_spec.ClearField(budgetalert.FieldWebhookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AutoDisableKey(); ok {
+		_spec.SetField(budgetalert.FieldAutoDisableKey, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(budgetalert.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastTriggeredWindow(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredWindow, field.TypeString, value)
+	}
+	if _u.mutation.LastTriggeredWindowCleared() {
+		_spec.ClearField(budgetalert.FieldLastTriggeredWindow, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(budgetalert.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(budgetalert.FieldLastTriggeredAt, field.TypeTime)
+	}
+	_node = &BudgetAlert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
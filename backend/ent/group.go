@@ -68,6 +68,20 @@ type Group struct {
 	SupportedModelScopes []string `json:"supported_model_scopes,omitempty"`
 	// 分组显示排序，数值越小越靠前
 	SortOrder int `json:"sort_order,omitempty"`
+	// 是否对确定性请求启用精确响应缓存
+	ResponseCacheEnabled bool `json:"response_cache_enabled,omitempty"`
+	// 响应缓存 TTL（秒），0 表示使用全局默认值
+	ResponseCacheTTLSeconds int `json:"response_cache_ttl_seconds,omitempty"`
+	// 批处理请求按正常费用的该比例计费，1 表示不打折
+	BatchDiscountMultiplier float64 `json:"batch_discount_multiplier,omitempty"`
+	// 是否对流式请求启用对冲
+	HedgeEnabled bool `json:"hedge_enabled,omitempty"`
+	// 发起对冲请求前等待首字节的时间（毫秒），0 表示按滚动 p95 首字时间自动计算
+	HedgeDelayMs int `json:"hedge_delay_ms,omitempty"`
+	// 是否按 cache_control 前缀将请求路由到最近服务过该前缀的账号
+	PromptCacheRoutingEnabled bool `json:"prompt_cache_routing_enabled,omitempty"`
+	// 是否为未设置 cache_control 的请求自动插入缓存断点
+	AutoCacheControlEnabled bool `json:"auto_cache_control_enabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -176,11 +190,11 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case group.FieldModelRouting, group.FieldSupportedModelScopes:
 			values[i] = new([]byte)
-		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldModelRoutingEnabled, group.FieldMcpXMLInject:
+		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldModelRoutingEnabled, group.FieldMcpXMLInject, group.FieldResponseCacheEnabled, group.FieldHedgeEnabled, group.FieldPromptCacheRoutingEnabled, group.FieldAutoCacheControlEnabled:
 			values[i] = new(sql.NullBool)
-		case group.FieldRateMultiplier, group.FieldDailyLimitUsd, group.FieldWeeklyLimitUsd, group.FieldMonthlyLimitUsd, group.FieldImagePrice1k, group.FieldImagePrice2k, group.FieldImagePrice4k:
+		case group.FieldRateMultiplier, group.FieldDailyLimitUsd, group.FieldWeeklyLimitUsd, group.FieldMonthlyLimitUsd, group.FieldImagePrice1k, group.FieldImagePrice2k, group.FieldImagePrice4k, group.FieldBatchDiscountMultiplier:
 			values[i] = new(sql.NullFloat64)
-		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID, group.FieldFallbackGroupIDOnInvalidRequest, group.FieldSortOrder:
+		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID, group.FieldFallbackGroupIDOnInvalidRequest, group.FieldSortOrder, group.FieldResponseCacheTTLSeconds, group.FieldHedgeDelayMs:
 			values[i] = new(sql.NullInt64)
 		case group.FieldName, group.FieldDescription, group.FieldStatus, group.FieldPlatform, group.FieldSubscriptionType:
 			values[i] = new(sql.NullString)
@@ -371,6 +385,48 @@ func (_m *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SortOrder = int(value.Int64)
 			}
+		case group.FieldResponseCacheEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field response_cache_enabled", values[i])
+			} else if value.Valid {
+				_m.ResponseCacheEnabled = value.Bool
+			}
+		case group.FieldResponseCacheTTLSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_cache_ttl_seconds", values[i])
+			} else if value.Valid {
+				_m.ResponseCacheTTLSeconds = int(value.Int64)
+			}
+		case group.FieldBatchDiscountMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_discount_multiplier", values[i])
+			} else if value.Valid {
+				_m.BatchDiscountMultiplier = value.Float64
+			}
+		case group.FieldHedgeEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hedge_enabled", values[i])
+			} else if value.Valid {
+				_m.HedgeEnabled = value.Bool
+			}
+		case group.FieldHedgeDelayMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hedge_delay_ms", values[i])
+			} else if value.Valid {
+				_m.HedgeDelayMs = int(value.Int64)
+			}
+		case group.FieldPromptCacheRoutingEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_cache_routing_enabled", values[i])
+			} else if value.Valid {
+				_m.PromptCacheRoutingEnabled = value.Bool
+			}
+		case group.FieldAutoCacheControlEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_cache_control_enabled", values[i])
+			} else if value.Valid {
+				_m.AutoCacheControlEnabled = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -541,6 +597,27 @@ func (_m *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("sort_order=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("response_cache_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResponseCacheEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("response_cache_ttl_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResponseCacheTTLSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("batch_discount_multiplier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatchDiscountMultiplier))
+	builder.WriteString(", ")
+	builder.WriteString("hedge_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HedgeEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("hedge_delay_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HedgeDelayMs))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_cache_routing_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptCacheRoutingEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("auto_cache_control_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoCacheControlEnabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
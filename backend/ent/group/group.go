@@ -65,6 +65,20 @@ const (
 	FieldSupportedModelScopes = "supported_model_scopes"
 	// FieldSortOrder holds the string denoting the sort_order field in the database.
 	FieldSortOrder = "sort_order"
+	// FieldResponseCacheEnabled holds the string denoting the response_cache_enabled field in the database.
+	FieldResponseCacheEnabled = "response_cache_enabled"
+	// FieldResponseCacheTTLSeconds holds the string denoting the response_cache_ttl_seconds field in the database.
+	FieldResponseCacheTTLSeconds = "response_cache_ttl_seconds"
+	// FieldBatchDiscountMultiplier holds the string denoting the batch_discount_multiplier field in the database.
+	FieldBatchDiscountMultiplier = "batch_discount_multiplier"
+	// FieldHedgeEnabled holds the string denoting the hedge_enabled field in the database.
+	FieldHedgeEnabled = "hedge_enabled"
+	// FieldHedgeDelayMs holds the string denoting the hedge_delay_ms field in the database.
+	FieldHedgeDelayMs = "hedge_delay_ms"
+	// FieldPromptCacheRoutingEnabled holds the string denoting the prompt_cache_routing_enabled field in the database.
+	FieldPromptCacheRoutingEnabled = "prompt_cache_routing_enabled"
+	// FieldAutoCacheControlEnabled holds the string denoting the auto_cache_control_enabled field in the database.
+	FieldAutoCacheControlEnabled = "auto_cache_control_enabled"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
 	// EdgeRedeemCodes holds the string denoting the redeem_codes edge name in mutations.
@@ -165,6 +179,13 @@ var Columns = []string{
 	FieldMcpXMLInject,
 	FieldSupportedModelScopes,
 	FieldSortOrder,
+	FieldResponseCacheEnabled,
+	FieldResponseCacheTTLSeconds,
+	FieldBatchDiscountMultiplier,
+	FieldHedgeEnabled,
+	FieldHedgeDelayMs,
+	FieldPromptCacheRoutingEnabled,
+	FieldAutoCacheControlEnabled,
 }
 
 var (
@@ -230,6 +251,20 @@ var (
 	DefaultSupportedModelScopes []string
 	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
 	DefaultSortOrder int
+	// DefaultResponseCacheEnabled holds the default value on creation for the "response_cache_enabled" field.
+	DefaultResponseCacheEnabled bool
+	// DefaultResponseCacheTTLSeconds holds the default value on creation for the "response_cache_ttl_seconds" field.
+	DefaultResponseCacheTTLSeconds int
+	// DefaultBatchDiscountMultiplier holds the default value on creation for the "batch_discount_multiplier" field.
+	DefaultBatchDiscountMultiplier float64
+	// DefaultHedgeEnabled holds the default value on creation for the "hedge_enabled" field.
+	DefaultHedgeEnabled bool
+	// DefaultHedgeDelayMs holds the default value on creation for the "hedge_delay_ms" field.
+	DefaultHedgeDelayMs int
+	// DefaultPromptCacheRoutingEnabled holds the default value on creation for the "prompt_cache_routing_enabled" field.
+	DefaultPromptCacheRoutingEnabled bool
+	// DefaultAutoCacheControlEnabled holds the default value on creation for the "auto_cache_control_enabled" field.
+	DefaultAutoCacheControlEnabled bool
 )
 
 // OrderOption defines the ordering options for the Group queries.
@@ -355,6 +390,41 @@ func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
 }
 
+// ByResponseCacheEnabled orders the results by the response_cache_enabled field.
+func ByResponseCacheEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheEnabled, opts...).ToFunc()
+}
+
+// ByResponseCacheTTLSeconds orders the results by the response_cache_ttl_seconds field.
+func ByResponseCacheTTLSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheTTLSeconds, opts...).ToFunc()
+}
+
+// ByBatchDiscountMultiplier orders the results by the batch_discount_multiplier field.
+func ByBatchDiscountMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchDiscountMultiplier, opts...).ToFunc()
+}
+
+// ByHedgeEnabled orders the results by the hedge_enabled field.
+func ByHedgeEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHedgeEnabled, opts...).ToFunc()
+}
+
+// ByHedgeDelayMs orders the results by the hedge_delay_ms field.
+func ByHedgeDelayMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHedgeDelayMs, opts...).ToFunc()
+}
+
+// ByPromptCacheRoutingEnabled orders the results by the prompt_cache_routing_enabled field.
+func ByPromptCacheRoutingEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptCacheRoutingEnabled, opts...).ToFunc()
+}
+
+// ByAutoCacheControlEnabled orders the results by the auto_cache_control_enabled field.
+func ByAutoCacheControlEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoCacheControlEnabled, opts...).ToFunc()
+}
+
 // ByAPIKeysCount orders the results by api_keys count.
 func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -170,6 +170,41 @@ func SortOrder(v int) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldSortOrder, v))
 }
 
+// ResponseCacheEnabled applies equality check predicate on the "response_cache_enabled" field. It's identical to ResponseCacheEnabledEQ.
+func ResponseCacheEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheTTLSeconds applies equality check predicate on the "response_cache_ttl_seconds" field. It's identical to ResponseCacheTTLSecondsEQ.
+func ResponseCacheTTLSeconds(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheTTLSeconds, v))
+}
+
+// BatchDiscountMultiplier applies equality check predicate on the "batch_discount_multiplier" field. It's identical to BatchDiscountMultiplierEQ.
+func BatchDiscountMultiplier(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldBatchDiscountMultiplier, v))
+}
+
+// HedgeEnabled applies equality check predicate on the "hedge_enabled" field. It's identical to HedgeEnabledEQ.
+func HedgeEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeDelayMs applies equality check predicate on the "hedge_delay_ms" field. It's identical to HedgeDelayMsEQ.
+func HedgeDelayMs(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeDelayMs, v))
+}
+
+// PromptCacheRoutingEnabled applies equality check predicate on the "prompt_cache_routing_enabled" field. It's identical to PromptCacheRoutingEnabledEQ.
+func PromptCacheRoutingEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldPromptCacheRoutingEnabled, v))
+}
+
+// AutoCacheControlEnabled applies equality check predicate on the "auto_cache_control_enabled" field. It's identical to AutoCacheControlEnabledEQ.
+func AutoCacheControlEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAutoCacheControlEnabled, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -1205,6 +1240,166 @@ func SortOrderLTE(v int) predicate.Group {
 	return predicate.Group(sql.FieldLTE(FieldSortOrder, v))
 }
 
+// ResponseCacheEnabledEQ applies the EQ predicate on the "response_cache_enabled" field.
+func ResponseCacheEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheEnabledNEQ applies the NEQ predicate on the "response_cache_enabled" field.
+func ResponseCacheEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldResponseCacheEnabled, v))
+}
+
+// ResponseCacheTTLSecondsEQ applies the EQ predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsNEQ applies the NEQ predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsIn applies the In predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldResponseCacheTTLSeconds, vs...))
+}
+
+// ResponseCacheTTLSecondsNotIn applies the NotIn predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldResponseCacheTTLSeconds, vs...))
+}
+
+// ResponseCacheTTLSecondsGT applies the GT predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsGTE applies the GTE predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsLT applies the LT predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldResponseCacheTTLSeconds, v))
+}
+
+// ResponseCacheTTLSecondsLTE applies the LTE predicate on the "response_cache_ttl_seconds" field.
+func ResponseCacheTTLSecondsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldResponseCacheTTLSeconds, v))
+}
+
+// BatchDiscountMultiplierEQ applies the EQ predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldBatchDiscountMultiplier, v))
+}
+
+// BatchDiscountMultiplierNEQ applies the NEQ predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierNEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldBatchDiscountMultiplier, v))
+}
+
+// BatchDiscountMultiplierIn applies the In predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldBatchDiscountMultiplier, vs...))
+}
+
+// BatchDiscountMultiplierNotIn applies the NotIn predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierNotIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldBatchDiscountMultiplier, vs...))
+}
+
+// BatchDiscountMultiplierGT applies the GT predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierGT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldBatchDiscountMultiplier, v))
+}
+
+// BatchDiscountMultiplierGTE applies the GTE predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierGTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldBatchDiscountMultiplier, v))
+}
+
+// BatchDiscountMultiplierLT applies the LT predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierLT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldBatchDiscountMultiplier, v))
+}
+
+// BatchDiscountMultiplierLTE applies the LTE predicate on the "batch_discount_multiplier" field.
+func BatchDiscountMultiplierLTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldBatchDiscountMultiplier, v))
+}
+
+// HedgeEnabledEQ applies the EQ predicate on the "hedge_enabled" field.
+func HedgeEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeEnabledNEQ applies the NEQ predicate on the "hedge_enabled" field.
+func HedgeEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeDelayMsEQ applies the EQ predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsNEQ applies the NEQ predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsIn applies the In predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldHedgeDelayMs, vs...))
+}
+
+// HedgeDelayMsNotIn applies the NotIn predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldHedgeDelayMs, vs...))
+}
+
+// HedgeDelayMsGT applies the GT predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsGTE applies the GTE predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsLT applies the LT predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsLTE applies the LTE predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldHedgeDelayMs, v))
+}
+
+// PromptCacheRoutingEnabledEQ applies the EQ predicate on the "prompt_cache_routing_enabled" field.
+func PromptCacheRoutingEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldPromptCacheRoutingEnabled, v))
+}
+
+// PromptCacheRoutingEnabledNEQ applies the NEQ predicate on the "prompt_cache_routing_enabled" field.
+func PromptCacheRoutingEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldPromptCacheRoutingEnabled, v))
+}
+
+// AutoCacheControlEnabledEQ applies the EQ predicate on the "auto_cache_control_enabled" field.
+func AutoCacheControlEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAutoCacheControlEnabled, v))
+}
+
+// AutoCacheControlEnabledNEQ applies the NEQ predicate on the "auto_cache_control_enabled" field.
+func AutoCacheControlEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAutoCacheControlEnabled, v))
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
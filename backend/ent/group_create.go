@@ -354,6 +354,104 @@ func (_c *GroupCreate) SetNillableSortOrder(v *int) *GroupCreate {
 	return _c
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_c *GroupCreate) SetResponseCacheEnabled(v bool) *GroupCreate {
+	_c.mutation.SetResponseCacheEnabled(v)
+	return _c
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableResponseCacheEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetResponseCacheEnabled(*v)
+	}
+	return _c
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_c *GroupCreate) SetResponseCacheTTLSeconds(v int) *GroupCreate {
+	_c.mutation.SetResponseCacheTTLSeconds(v)
+	return _c
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableResponseCacheTTLSeconds(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetResponseCacheTTLSeconds(*v)
+	}
+	return _c
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (_c *GroupCreate) SetBatchDiscountMultiplier(v float64) *GroupCreate {
+	_c.mutation.SetBatchDiscountMultiplier(v)
+	return _c
+}
+
+// SetNillableBatchDiscountMultiplier sets the "batch_discount_multiplier" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableBatchDiscountMultiplier(v *float64) *GroupCreate {
+	if v != nil {
+		_c.SetBatchDiscountMultiplier(*v)
+	}
+	return _c
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_c *GroupCreate) SetHedgeEnabled(v bool) *GroupCreate {
+	_c.mutation.SetHedgeEnabled(v)
+	return _c
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableHedgeEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetHedgeEnabled(*v)
+	}
+	return _c
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_c *GroupCreate) SetHedgeDelayMs(v int) *GroupCreate {
+	_c.mutation.SetHedgeDelayMs(v)
+	return _c
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableHedgeDelayMs(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetHedgeDelayMs(*v)
+	}
+	return _c
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (_c *GroupCreate) SetPromptCacheRoutingEnabled(v bool) *GroupCreate {
+	_c.mutation.SetPromptCacheRoutingEnabled(v)
+	return _c
+}
+
+// SetNillablePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillablePromptCacheRoutingEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetPromptCacheRoutingEnabled(*v)
+	}
+	return _c
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (_c *GroupCreate) SetAutoCacheControlEnabled(v bool) *GroupCreate {
+	_c.mutation.SetAutoCacheControlEnabled(v)
+	return _c
+}
+
+// SetNillableAutoCacheControlEnabled sets the "auto_cache_control_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAutoCacheControlEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetAutoCacheControlEnabled(*v)
+	}
+	return _c
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *GroupCreate) AddAPIKeyIDs(ids ...int64) *GroupCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -539,6 +637,34 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		v := group.DefaultResponseCacheEnabled
+		_c.mutation.SetResponseCacheEnabled(v)
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		v := group.DefaultResponseCacheTTLSeconds
+		_c.mutation.SetResponseCacheTTLSeconds(v)
+	}
+	if _, ok := _c.mutation.BatchDiscountMultiplier(); !ok {
+		v := group.DefaultBatchDiscountMultiplier
+		_c.mutation.SetBatchDiscountMultiplier(v)
+	}
+	if _, ok := _c.mutation.HedgeEnabled(); !ok {
+		v := group.DefaultHedgeEnabled
+		_c.mutation.SetHedgeEnabled(v)
+	}
+	if _, ok := _c.mutation.HedgeDelayMs(); !ok {
+		v := group.DefaultHedgeDelayMs
+		_c.mutation.SetHedgeDelayMs(v)
+	}
+	if _, ok := _c.mutation.PromptCacheRoutingEnabled(); !ok {
+		v := group.DefaultPromptCacheRoutingEnabled
+		_c.mutation.SetPromptCacheRoutingEnabled(v)
+	}
+	if _, ok := _c.mutation.AutoCacheControlEnabled(); !ok {
+		v := group.DefaultAutoCacheControlEnabled
+		_c.mutation.SetAutoCacheControlEnabled(v)
+	}
 	return nil
 }
 
@@ -606,6 +732,27 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Group.sort_order"`)}
 	}
+	if _, ok := _c.mutation.ResponseCacheEnabled(); !ok {
+		return &ValidationError{Name: "response_cache_enabled", err: errors.New(`ent: missing required field "Group.response_cache_enabled"`)}
+	}
+	if _, ok := _c.mutation.ResponseCacheTTLSeconds(); !ok {
+		return &ValidationError{Name: "response_cache_ttl_seconds", err: errors.New(`ent: missing required field "Group.response_cache_ttl_seconds"`)}
+	}
+	if _, ok := _c.mutation.BatchDiscountMultiplier(); !ok {
+		return &ValidationError{Name: "batch_discount_multiplier", err: errors.New(`ent: missing required field "Group.batch_discount_multiplier"`)}
+	}
+	if _, ok := _c.mutation.HedgeEnabled(); !ok {
+		return &ValidationError{Name: "hedge_enabled", err: errors.New(`ent: missing required field "Group.hedge_enabled"`)}
+	}
+	if _, ok := _c.mutation.HedgeDelayMs(); !ok {
+		return &ValidationError{Name: "hedge_delay_ms", err: errors.New(`ent: missing required field "Group.hedge_delay_ms"`)}
+	}
+	if _, ok := _c.mutation.PromptCacheRoutingEnabled(); !ok {
+		return &ValidationError{Name: "prompt_cache_routing_enabled", err: errors.New(`ent: missing required field "Group.prompt_cache_routing_enabled"`)}
+	}
+	if _, ok := _c.mutation.AutoCacheControlEnabled(); !ok {
+		return &ValidationError{Name: "auto_cache_control_enabled", err: errors.New(`ent: missing required field "Group.auto_cache_control_enabled"`)}
+	}
 	return nil
 }
 
@@ -733,6 +880,34 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldSortOrder, field.TypeInt, value)
 		_node.SortOrder = value
 	}
+	if value, ok := _c.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+		_node.ResponseCacheEnabled = value
+	}
+	if value, ok := _c.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+		_node.ResponseCacheTTLSeconds = value
+	}
+	if value, ok := _c.mutation.BatchDiscountMultiplier(); ok {
+		_spec.SetField(group.FieldBatchDiscountMultiplier, field.TypeFloat64, value)
+		_node.BatchDiscountMultiplier = value
+	}
+	if value, ok := _c.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+		_node.HedgeEnabled = value
+	}
+	if value, ok := _c.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+		_node.HedgeDelayMs = value
+	}
+	if value, ok := _c.mutation.PromptCacheRoutingEnabled(); ok {
+		_spec.SetField(group.FieldPromptCacheRoutingEnabled, field.TypeBool, value)
+		_node.PromptCacheRoutingEnabled = value
+	}
+	if value, ok := _c.mutation.AutoCacheControlEnabled(); ok {
+		_spec.SetField(group.FieldAutoCacheControlEnabled, field.TypeBool, value)
+		_node.AutoCacheControlEnabled = value
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1309,6 +1484,108 @@ func (u *GroupUpsert) AddSortOrder(v int) *GroupUpsert {
 	return u
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsert) SetResponseCacheEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldResponseCacheEnabled, v)
+	return u
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateResponseCacheEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldResponseCacheEnabled)
+	return u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsert) SetResponseCacheTTLSeconds(v int) *GroupUpsert {
+	u.Set(group.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateResponseCacheTTLSeconds() *GroupUpsert {
+	u.SetExcluded(group.FieldResponseCacheTTLSeconds)
+	return u
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsert) AddResponseCacheTTLSeconds(v int) *GroupUpsert {
+	u.Add(group.FieldResponseCacheTTLSeconds, v)
+	return u
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (u *GroupUpsert) SetBatchDiscountMultiplier(v float64) *GroupUpsert {
+	u.Set(group.FieldBatchDiscountMultiplier, v)
+	return u
+}
+
+// UpdateBatchDiscountMultiplier sets the "batch_discount_multiplier" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateBatchDiscountMultiplier() *GroupUpsert {
+	u.SetExcluded(group.FieldBatchDiscountMultiplier)
+	return u
+}
+
+// AddBatchDiscountMultiplier adds v to the "batch_discount_multiplier" field.
+func (u *GroupUpsert) AddBatchDiscountMultiplier(v float64) *GroupUpsert {
+	u.Add(group.FieldBatchDiscountMultiplier, v)
+	return u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsert) SetHedgeEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldHedgeEnabled, v)
+	return u
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateHedgeEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldHedgeEnabled)
+	return u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsert) SetHedgeDelayMs(v int) *GroupUpsert {
+	u.Set(group.FieldHedgeDelayMs, v)
+	return u
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateHedgeDelayMs() *GroupUpsert {
+	u.SetExcluded(group.FieldHedgeDelayMs)
+	return u
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsert) AddHedgeDelayMs(v int) *GroupUpsert {
+	u.Add(group.FieldHedgeDelayMs, v)
+	return u
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (u *GroupUpsert) SetPromptCacheRoutingEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldPromptCacheRoutingEnabled, v)
+	return u
+}
+
+// UpdatePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdatePromptCacheRoutingEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldPromptCacheRoutingEnabled)
+	return u
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (u *GroupUpsert) SetAutoCacheControlEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldAutoCacheControlEnabled, v)
+	return u
+}
+
+// UpdateAutoCacheControlEnabled sets the "auto_cache_control_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateAutoCacheControlEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldAutoCacheControlEnabled)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1844,6 +2121,125 @@ func (u *GroupUpsertOne) UpdateSortOrder() *GroupUpsertOne {
 	})
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsertOne) SetResponseCacheEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateResponseCacheEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertOne) SetResponseCacheTTLSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertOne) AddResponseCacheTTLSeconds(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateResponseCacheTTLSeconds() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (u *GroupUpsertOne) SetBatchDiscountMultiplier(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetBatchDiscountMultiplier(v)
+	})
+}
+
+// AddBatchDiscountMultiplier adds v to the "batch_discount_multiplier" field.
+func (u *GroupUpsertOne) AddBatchDiscountMultiplier(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddBatchDiscountMultiplier(v)
+	})
+}
+
+// UpdateBatchDiscountMultiplier sets the "batch_discount_multiplier" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateBatchDiscountMultiplier() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateBatchDiscountMultiplier()
+	})
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsertOne) SetHedgeEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeEnabled(v)
+	})
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateHedgeEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeEnabled()
+	})
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsertOne) SetHedgeDelayMs(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeDelayMs(v)
+	})
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsertOne) AddHedgeDelayMs(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddHedgeDelayMs(v)
+	})
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateHedgeDelayMs() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeDelayMs()
+	})
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (u *GroupUpsertOne) SetPromptCacheRoutingEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPromptCacheRoutingEnabled(v)
+	})
+}
+
+// UpdatePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdatePromptCacheRoutingEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePromptCacheRoutingEnabled()
+	})
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (u *GroupUpsertOne) SetAutoCacheControlEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetAutoCacheControlEnabled(v)
+	})
+}
+
+// UpdateAutoCacheControlEnabled sets the "auto_cache_control_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateAutoCacheControlEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateAutoCacheControlEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2545,6 +2941,125 @@ func (u *GroupUpsertBulk) UpdateSortOrder() *GroupUpsertBulk {
 	})
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (u *GroupUpsertBulk) SetResponseCacheEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheEnabled(v)
+	})
+}
+
+// UpdateResponseCacheEnabled sets the "response_cache_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateResponseCacheEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheEnabled()
+	})
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertBulk) SetResponseCacheTTLSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetResponseCacheTTLSeconds(v)
+	})
+}
+
+// AddResponseCacheTTLSeconds adds v to the "response_cache_ttl_seconds" field.
+func (u *GroupUpsertBulk) AddResponseCacheTTLSeconds(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddResponseCacheTTLSeconds(v)
+	})
+}
+
+// UpdateResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateResponseCacheTTLSeconds() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateResponseCacheTTLSeconds()
+	})
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (u *GroupUpsertBulk) SetBatchDiscountMultiplier(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetBatchDiscountMultiplier(v)
+	})
+}
+
+// AddBatchDiscountMultiplier adds v to the "batch_discount_multiplier" field.
+func (u *GroupUpsertBulk) AddBatchDiscountMultiplier(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddBatchDiscountMultiplier(v)
+	})
+}
+
+// UpdateBatchDiscountMultiplier sets the "batch_discount_multiplier" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateBatchDiscountMultiplier() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateBatchDiscountMultiplier()
+	})
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsertBulk) SetHedgeEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeEnabled(v)
+	})
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateHedgeEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeEnabled()
+	})
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsertBulk) SetHedgeDelayMs(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeDelayMs(v)
+	})
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsertBulk) AddHedgeDelayMs(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddHedgeDelayMs(v)
+	})
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateHedgeDelayMs() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeDelayMs()
+	})
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (u *GroupUpsertBulk) SetPromptCacheRoutingEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPromptCacheRoutingEnabled(v)
+	})
+}
+
+// UpdatePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdatePromptCacheRoutingEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePromptCacheRoutingEnabled()
+	})
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (u *GroupUpsertBulk) SetAutoCacheControlEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetAutoCacheControlEnabled(v)
+	})
+}
+
+// UpdateAutoCacheControlEnabled sets the "auto_cache_control_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateAutoCacheControlEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateAutoCacheControlEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -496,6 +496,125 @@ func (_u *GroupUpdate) AddSortOrder(v int) *GroupUpdate {
 	return _u
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *GroupUpdate) SetResponseCacheEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableResponseCacheEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdate) SetResponseCacheTTLSeconds(v int) *GroupUpdate {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableResponseCacheTTLSeconds(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdate) AddResponseCacheTTLSeconds(v int) *GroupUpdate {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (_u *GroupUpdate) SetBatchDiscountMultiplier(v float64) *GroupUpdate {
+	_u.mutation.ResetBatchDiscountMultiplier()
+	_u.mutation.SetBatchDiscountMultiplier(v)
+	return _u
+}
+
+// SetNillableBatchDiscountMultiplier sets the "batch_discount_multiplier" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableBatchDiscountMultiplier(v *float64) *GroupUpdate {
+	if v != nil {
+		_u.SetBatchDiscountMultiplier(*v)
+	}
+	return _u
+}
+
+// AddBatchDiscountMultiplier adds value to the "batch_discount_multiplier" field.
+func (_u *GroupUpdate) AddBatchDiscountMultiplier(v float64) *GroupUpdate {
+	_u.mutation.AddBatchDiscountMultiplier(v)
+	return _u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_u *GroupUpdate) SetHedgeEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetHedgeEnabled(v)
+	return _u
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableHedgeEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetHedgeEnabled(*v)
+	}
+	return _u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_u *GroupUpdate) SetHedgeDelayMs(v int) *GroupUpdate {
+	_u.mutation.ResetHedgeDelayMs()
+	_u.mutation.SetHedgeDelayMs(v)
+	return _u
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableHedgeDelayMs(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetHedgeDelayMs(*v)
+	}
+	return _u
+}
+
+// AddHedgeDelayMs adds value to the "hedge_delay_ms" field.
+func (_u *GroupUpdate) AddHedgeDelayMs(v int) *GroupUpdate {
+	_u.mutation.AddHedgeDelayMs(v)
+	return _u
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (_u *GroupUpdate) SetPromptCacheRoutingEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetPromptCacheRoutingEnabled(v)
+	return _u
+}
+
+// SetNillablePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillablePromptCacheRoutingEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetPromptCacheRoutingEnabled(*v)
+	}
+	return _u
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (_u *GroupUpdate) SetAutoCacheControlEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetAutoCacheControlEnabled(v)
+	return _u
+}
+
+// SetNillableAutoCacheControlEnabled sets the "auto_cache_control_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAutoCacheControlEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetAutoCacheControlEnabled(*v)
+	}
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdate) AddAPIKeyIDs(ids ...int64) *GroupUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -939,6 +1058,36 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(group.FieldSortOrder, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchDiscountMultiplier(); ok {
+		_spec.SetField(group.FieldBatchDiscountMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchDiscountMultiplier(); ok {
+		_spec.AddField(group.FieldBatchDiscountMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHedgeDelayMs(); ok {
+		_spec.AddField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptCacheRoutingEnabled(); ok {
+		_spec.SetField(group.FieldPromptCacheRoutingEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoCacheControlEnabled(); ok {
+		_spec.SetField(group.FieldAutoCacheControlEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1714,6 +1863,125 @@ func (_u *GroupUpdateOne) AddSortOrder(v int) *GroupUpdateOne {
 	return _u
 }
 
+// SetResponseCacheEnabled sets the "response_cache_enabled" field.
+func (_u *GroupUpdateOne) SetResponseCacheEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetResponseCacheEnabled(v)
+	return _u
+}
+
+// SetNillableResponseCacheEnabled sets the "response_cache_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableResponseCacheEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheEnabled(*v)
+	}
+	return _u
+}
+
+// SetResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdateOne) SetResponseCacheTTLSeconds(v int) *GroupUpdateOne {
+	_u.mutation.ResetResponseCacheTTLSeconds()
+	_u.mutation.SetResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetNillableResponseCacheTTLSeconds sets the "response_cache_ttl_seconds" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableResponseCacheTTLSeconds(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheTTLSeconds(*v)
+	}
+	return _u
+}
+
+// AddResponseCacheTTLSeconds adds value to the "response_cache_ttl_seconds" field.
+func (_u *GroupUpdateOne) AddResponseCacheTTLSeconds(v int) *GroupUpdateOne {
+	_u.mutation.AddResponseCacheTTLSeconds(v)
+	return _u
+}
+
+// SetBatchDiscountMultiplier sets the "batch_discount_multiplier" field.
+func (_u *GroupUpdateOne) SetBatchDiscountMultiplier(v float64) *GroupUpdateOne {
+	_u.mutation.ResetBatchDiscountMultiplier()
+	_u.mutation.SetBatchDiscountMultiplier(v)
+	return _u
+}
+
+// SetNillableBatchDiscountMultiplier sets the "batch_discount_multiplier" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableBatchDiscountMultiplier(v *float64) *GroupUpdateOne {
+	if v != nil {
+		_u.SetBatchDiscountMultiplier(*v)
+	}
+	return _u
+}
+
+// AddBatchDiscountMultiplier adds value to the "batch_discount_multiplier" field.
+func (_u *GroupUpdateOne) AddBatchDiscountMultiplier(v float64) *GroupUpdateOne {
+	_u.mutation.AddBatchDiscountMultiplier(v)
+	return _u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_u *GroupUpdateOne) SetHedgeEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetHedgeEnabled(v)
+	return _u
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableHedgeEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetHedgeEnabled(*v)
+	}
+	return _u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_u *GroupUpdateOne) SetHedgeDelayMs(v int) *GroupUpdateOne {
+	_u.mutation.ResetHedgeDelayMs()
+	_u.mutation.SetHedgeDelayMs(v)
+	return _u
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableHedgeDelayMs(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetHedgeDelayMs(*v)
+	}
+	return _u
+}
+
+// AddHedgeDelayMs adds value to the "hedge_delay_ms" field.
+func (_u *GroupUpdateOne) AddHedgeDelayMs(v int) *GroupUpdateOne {
+	_u.mutation.AddHedgeDelayMs(v)
+	return _u
+}
+
+// SetPromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field.
+func (_u *GroupUpdateOne) SetPromptCacheRoutingEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetPromptCacheRoutingEnabled(v)
+	return _u
+}
+
+// SetNillablePromptCacheRoutingEnabled sets the "prompt_cache_routing_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillablePromptCacheRoutingEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetPromptCacheRoutingEnabled(*v)
+	}
+	return _u
+}
+
+// SetAutoCacheControlEnabled sets the "auto_cache_control_enabled" field.
+func (_u *GroupUpdateOne) SetAutoCacheControlEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetAutoCacheControlEnabled(v)
+	return _u
+}
+
+// SetNillableAutoCacheControlEnabled sets the "auto_cache_control_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAutoCacheControlEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAutoCacheControlEnabled(*v)
+	}
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdateOne) AddAPIKeyIDs(ids ...int64) *GroupUpdateOne {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -2187,6 +2455,36 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if value, ok := _u.mutation.AddedSortOrder(); ok {
 		_spec.AddField(group.FieldSortOrder, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheEnabled(); ok {
+		_spec.SetField(group.FieldResponseCacheEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ResponseCacheTTLSeconds(); ok {
+		_spec.SetField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseCacheTTLSeconds(); ok {
+		_spec.AddField(group.FieldResponseCacheTTLSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchDiscountMultiplier(); ok {
+		_spec.SetField(group.FieldBatchDiscountMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchDiscountMultiplier(); ok {
+		_spec.AddField(group.FieldBatchDiscountMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHedgeDelayMs(); ok {
+		_spec.AddField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptCacheRoutingEnabled(); ok {
+		_spec.SetField(group.FieldPromptCacheRoutingEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoCacheControlEnabled(); ok {
+		_spec.SetField(group.FieldAutoCacheControlEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatch"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// MessageBatchUpdate is the builder for updating MessageBatch entities.
+type MessageBatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageBatchMutation
+}
+
+// Where appends a list predicates to the MessageBatchUpdate builder.
+func (_u *MessageBatchUpdate) Where(ps ...predicate.MessageBatch) *MessageBatchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageBatchUpdate) SetUpdatedAt(v time.Time) *MessageBatchUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *MessageBatchUpdate) SetBatchID(v string) *MessageBatchUpdate {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableBatchID(v *string) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MessageBatchUpdate) SetUserID(v int64) *MessageBatchUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableUserID(v *int64) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *MessageBatchUpdate) AddUserID(v int64) *MessageBatchUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *MessageBatchUpdate) SetAPIKeyID(v int64) *MessageBatchUpdate {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableAPIKeyID(v *int64) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *MessageBatchUpdate) AddAPIKeyID(v int64) *MessageBatchUpdate {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *MessageBatchUpdate) SetGroupID(v int64) *MessageBatchUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableGroupID(v *int64) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *MessageBatchUpdate) AddGroupID(v int64) *MessageBatchUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *MessageBatchUpdate) ClearGroupID() *MessageBatchUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *MessageBatchUpdate) SetProcessingStatus(v string) *MessageBatchUpdate {
+	_u.mutation.SetProcessingStatus(v)
+	return _u
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableProcessingStatus(v *string) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetProcessingStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *MessageBatchUpdate) SetRequestCount(v int) *MessageBatchUpdate {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableRequestCount(v *int) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *MessageBatchUpdate) AddRequestCount(v int) *MessageBatchUpdate {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MessageBatchUpdate) SetExpiresAt(v time.Time) *MessageBatchUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableExpiresAt(v *time.Time) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCancelInitiatedAt sets the "cancel_initiated_at" field.
+func (_u *MessageBatchUpdate) SetCancelInitiatedAt(v time.Time) *MessageBatchUpdate {
+	_u.mutation.SetCancelInitiatedAt(v)
+	return _u
+}
+
+// SetNillableCancelInitiatedAt sets the "cancel_initiated_at" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableCancelInitiatedAt(v *time.Time) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetCancelInitiatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelInitiatedAt clears the value of the "cancel_initiated_at" field.
+func (_u *MessageBatchUpdate) ClearCancelInitiatedAt() *MessageBatchUpdate {
+	_u.mutation.ClearCancelInitiatedAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *MessageBatchUpdate) SetEndedAt(v time.Time) *MessageBatchUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *MessageBatchUpdate) SetNillableEndedAt(v *time.Time) *MessageBatchUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *MessageBatchUpdate) ClearEndedAt() *MessageBatchUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the MessageBatchMutation object of the builder.
+func (_u *MessageBatchUpdate) Mutation() *MessageBatchMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageBatchUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageBatchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageBatchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageBatchUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagebatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageBatchUpdate) check() error {
+	if v, ok := _u.mutation.BatchID(); ok {
+		if err := messagebatch.BatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "batch_id", err: fmt.Errorf(`ent: validator failed for field "MessageBatch.batch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessingStatus(); ok {
+		if err := messagebatch.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "MessageBatch.processing_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MessageBatchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagebatch.Table, messagebatch.Columns, sqlgraph.NewFieldSpec(messagebatch.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagebatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(messagebatch.FieldBatchID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(messagebatch.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(messagebatch.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(messagebatch.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(messagebatch.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(messagebatch.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(messagebatch.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(messagebatch.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(messagebatch.FieldProcessingStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(messagebatch.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(messagebatch.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(messagebatch.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelInitiatedAt(); ok {
+		_spec.SetField(messagebatch.FieldCancelInitiatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelInitiatedAtCleared() {
+		_spec.ClearField(messagebatch.FieldCancelInitiatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(messagebatch.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(messagebatch.FieldEndedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagebatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageBatchUpdateOne is the builder for updating a single MessageBatch entity.
+type MessageBatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageBatchMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageBatchUpdateOne) SetUpdatedAt(v time.Time) *MessageBatchUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *MessageBatchUpdateOne) SetBatchID(v string) *MessageBatchUpdateOne {
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableBatchID(v *string) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MessageBatchUpdateOne) SetUserID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableUserID(v *int64) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *MessageBatchUpdateOne) AddUserID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *MessageBatchUpdateOne) SetAPIKeyID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableAPIKeyID(v *int64) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *MessageBatchUpdateOne) AddAPIKeyID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *MessageBatchUpdateOne) SetGroupID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableGroupID(v *int64) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *MessageBatchUpdateOne) AddGroupID(v int64) *MessageBatchUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *MessageBatchUpdateOne) ClearGroupID() *MessageBatchUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (_u *MessageBatchUpdateOne) SetProcessingStatus(v string) *MessageBatchUpdateOne {
+	_u.mutation.SetProcessingStatus(v)
+	return _u
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableProcessingStatus(v *string) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetProcessingStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *MessageBatchUpdateOne) SetRequestCount(v int) *MessageBatchUpdateOne {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableRequestCount(v *int) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *MessageBatchUpdateOne) AddRequestCount(v int) *MessageBatchUpdateOne {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MessageBatchUpdateOne) SetExpiresAt(v time.Time) *MessageBatchUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableExpiresAt(v *time.Time) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCancelInitiatedAt sets the "cancel_initiated_at" field.
+func (_u *MessageBatchUpdateOne) SetCancelInitiatedAt(v time.Time) *MessageBatchUpdateOne {
+	_u.mutation.SetCancelInitiatedAt(v)
+	return _u
+}
+
+// SetNillableCancelInitiatedAt sets the "cancel_initiated_at" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableCancelInitiatedAt(v *time.Time) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetCancelInitiatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelInitiatedAt clears the value of the "cancel_initiated_at" field.
+func (_u *MessageBatchUpdateOne) ClearCancelInitiatedAt() *MessageBatchUpdateOne {
+	_u.mutation.ClearCancelInitiatedAt()
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *MessageBatchUpdateOne) SetEndedAt(v time.Time) *MessageBatchUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *MessageBatchUpdateOne) SetNillableEndedAt(v *time.Time) *MessageBatchUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *MessageBatchUpdateOne) ClearEndedAt() *MessageBatchUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the MessageBatchMutation object of the builder.
+func (_u *MessageBatchUpdateOne) Mutation() *MessageBatchMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MessageBatchUpdate builder.
+func (_u *MessageBatchUpdateOne) Where(ps ...predicate.MessageBatch) *MessageBatchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageBatchUpdateOne) Select(field string, fields ...string) *MessageBatchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MessageBatch entity.
+func (_u *MessageBatchUpdateOne) Save(ctx context.Context) (*MessageBatch, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageBatchUpdateOne) SaveX(ctx context.Context) *MessageBatch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageBatchUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagebatch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageBatchUpdateOne) check() error {
+	if v, ok := _u.mutation.BatchID(); ok {
+		if err := messagebatch.BatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "batch_id", err: fmt.Errorf(`ent: validator failed for field "MessageBatch.batch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessingStatus(); ok {
+		if err := messagebatch.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "MessageBatch.processing_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MessageBatchUpdateOne) sqlSave(ctx context.Context) (_node *MessageBatch, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagebatch.Table, messagebatch.Columns, sqlgraph.NewFieldSpec(messagebatch.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagebatch.FieldID)
+		for _, f := range fields {
+			if !messagebatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagebatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagebatch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(messagebatch.FieldBatchID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(messagebatch.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(messagebatch.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(messagebatch.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(messagebatch.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(messagebatch.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(messagebatch.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(messagebatch.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProcessingStatus(); ok {
+		_spec.SetField(messagebatch.FieldProcessingStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(messagebatch.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(messagebatch.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(messagebatch.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CancelInitiatedAt(); ok {
+		_spec.SetField(messagebatch.FieldCancelInitiatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelInitiatedAtCleared() {
+		_spec.ClearField(messagebatch.FieldCancelInitiatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(messagebatch.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(messagebatch.FieldEndedAt, field.TypeTime)
+	}
+	_node = &MessageBatch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagebatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagebatchitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// CustomID applies equality check predicate on the "custom_id" field. It's identical to CustomIDEQ.
+func CustomID(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCustomID, v))
+}
+
+// Params applies equality check predicate on the "params" field. It's identical to ParamsEQ.
+func Params(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldParams, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldResult, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// BatchIDGT applies the GT predicate on the "batch_id" field.
+func BatchIDGT(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldBatchID, v))
+}
+
+// BatchIDGTE applies the GTE predicate on the "batch_id" field.
+func BatchIDGTE(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldBatchID, v))
+}
+
+// BatchIDLT applies the LT predicate on the "batch_id" field.
+func BatchIDLT(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldBatchID, v))
+}
+
+// BatchIDLTE applies the LTE predicate on the "batch_id" field.
+func BatchIDLTE(v int64) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldBatchID, v))
+}
+
+// CustomIDEQ applies the EQ predicate on the "custom_id" field.
+func CustomIDEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCustomID, v))
+}
+
+// CustomIDNEQ applies the NEQ predicate on the "custom_id" field.
+func CustomIDNEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldCustomID, v))
+}
+
+// CustomIDIn applies the In predicate on the "custom_id" field.
+func CustomIDIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldCustomID, vs...))
+}
+
+// CustomIDNotIn applies the NotIn predicate on the "custom_id" field.
+func CustomIDNotIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldCustomID, vs...))
+}
+
+// CustomIDGT applies the GT predicate on the "custom_id" field.
+func CustomIDGT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldCustomID, v))
+}
+
+// CustomIDGTE applies the GTE predicate on the "custom_id" field.
+func CustomIDGTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldCustomID, v))
+}
+
+// CustomIDLT applies the LT predicate on the "custom_id" field.
+func CustomIDLT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldCustomID, v))
+}
+
+// CustomIDLTE applies the LTE predicate on the "custom_id" field.
+func CustomIDLTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldCustomID, v))
+}
+
+// CustomIDContains applies the Contains predicate on the "custom_id" field.
+func CustomIDContains(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContains(FieldCustomID, v))
+}
+
+// CustomIDHasPrefix applies the HasPrefix predicate on the "custom_id" field.
+func CustomIDHasPrefix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasPrefix(FieldCustomID, v))
+}
+
+// CustomIDHasSuffix applies the HasSuffix predicate on the "custom_id" field.
+func CustomIDHasSuffix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasSuffix(FieldCustomID, v))
+}
+
+// CustomIDEqualFold applies the EqualFold predicate on the "custom_id" field.
+func CustomIDEqualFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEqualFold(FieldCustomID, v))
+}
+
+// CustomIDContainsFold applies the ContainsFold predicate on the "custom_id" field.
+func CustomIDContainsFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContainsFold(FieldCustomID, v))
+}
+
+// ParamsEQ applies the EQ predicate on the "params" field.
+func ParamsEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldParams, v))
+}
+
+// ParamsNEQ applies the NEQ predicate on the "params" field.
+func ParamsNEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldParams, v))
+}
+
+// ParamsIn applies the In predicate on the "params" field.
+func ParamsIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldParams, vs...))
+}
+
+// ParamsNotIn applies the NotIn predicate on the "params" field.
+func ParamsNotIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldParams, vs...))
+}
+
+// ParamsGT applies the GT predicate on the "params" field.
+func ParamsGT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldParams, v))
+}
+
+// ParamsGTE applies the GTE predicate on the "params" field.
+func ParamsGTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldParams, v))
+}
+
+// ParamsLT applies the LT predicate on the "params" field.
+func ParamsLT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldParams, v))
+}
+
+// ParamsLTE applies the LTE predicate on the "params" field.
+func ParamsLTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldParams, v))
+}
+
+// ParamsContains applies the Contains predicate on the "params" field.
+func ParamsContains(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContains(FieldParams, v))
+}
+
+// ParamsHasPrefix applies the HasPrefix predicate on the "params" field.
+func ParamsHasPrefix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasPrefix(FieldParams, v))
+}
+
+// ParamsHasSuffix applies the HasSuffix predicate on the "params" field.
+func ParamsHasSuffix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasSuffix(FieldParams, v))
+}
+
+// ParamsEqualFold applies the EqualFold predicate on the "params" field.
+func ParamsEqualFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEqualFold(FieldParams, v))
+}
+
+// ParamsContainsFold applies the ContainsFold predicate on the "params" field.
+func ParamsContainsFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContainsFold(FieldParams, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIsNull(FieldResult))
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotNull(FieldResult))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldContainsFold(FieldResult, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotNull(FieldStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageBatchItem) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageBatchItem) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageBatchItem) predicate.MessageBatchItem {
+	return predicate.MessageBatchItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,1136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatchitem"
+)
+
+// MessageBatchItemCreate is the builder for creating a MessageBatchItem entity.
+type MessageBatchItemCreate struct {
+	config
+	mutation *MessageBatchItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageBatchItemCreate) SetCreatedAt(v time.Time) *MessageBatchItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableCreatedAt(v *time.Time) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MessageBatchItemCreate) SetUpdatedAt(v time.Time) *MessageBatchItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableUpdatedAt(v *time.Time) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_c *MessageBatchItemCreate) SetBatchID(v int64) *MessageBatchItemCreate {
+	_c.mutation.SetBatchID(v)
+	return _c
+}
+
+// SetCustomID sets the "custom_id" field.
+func (_c *MessageBatchItemCreate) SetCustomID(v string) *MessageBatchItemCreate {
+	_c.mutation.SetCustomID(v)
+	return _c
+}
+
+// SetParams sets the "params" field.
+func (_c *MessageBatchItemCreate) SetParams(v string) *MessageBatchItemCreate {
+	_c.mutation.SetParams(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MessageBatchItemCreate) SetStatus(v string) *MessageBatchItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableStatus(v *string) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *MessageBatchItemCreate) SetResult(v string) *MessageBatchItemCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableResult(v *string) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetResult(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *MessageBatchItemCreate) SetAttempts(v int) *MessageBatchItemCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableAttempts(v *int) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *MessageBatchItemCreate) SetNextAttemptAt(v time.Time) *MessageBatchItemCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *MessageBatchItemCreate) SetStartedAt(v time.Time) *MessageBatchItemCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableStartedAt(v *time.Time) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *MessageBatchItemCreate) SetCompletedAt(v time.Time) *MessageBatchItemCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *MessageBatchItemCreate) SetNillableCompletedAt(v *time.Time) *MessageBatchItemCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MessageBatchItemMutation object of the builder.
+func (_c *MessageBatchItemCreate) Mutation() *MessageBatchItemMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageBatchItem in the database.
+func (_c *MessageBatchItemCreate) Save(ctx context.Context) (*MessageBatchItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageBatchItemCreate) SaveX(ctx context.Context) *MessageBatchItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageBatchItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageBatchItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageBatchItemCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := messagebatchitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := messagebatchitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := messagebatchitem.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := messagebatchitem.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageBatchItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageBatchItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageBatchItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.BatchID(); !ok {
+		return &ValidationError{Name: "batch_id", err: errors.New(`ent: missing required field "MessageBatchItem.batch_id"`)}
+	}
+	if _, ok := _c.mutation.CustomID(); !ok {
+		return &ValidationError{Name: "custom_id", err: errors.New(`ent: missing required field "MessageBatchItem.custom_id"`)}
+	}
+	if v, ok := _c.mutation.CustomID(); ok {
+		if err := messagebatchitem.CustomIDValidator(v); err != nil {
+			return &ValidationError{Name: "custom_id", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.custom_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Params(); !ok {
+		return &ValidationError{Name: "params", err: errors.New(`ent: missing required field "MessageBatchItem.params"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MessageBatchItem.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := messagebatchitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MessageBatchItem.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "MessageBatchItem.next_attempt_at"`)}
+	}
+	return nil
+}
+
+func (_c *MessageBatchItemCreate) sqlSave(ctx context.Context) (*MessageBatchItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageBatchItemCreate) createSpec() (*MessageBatchItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageBatchItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messagebatchitem.Table, sqlgraph.NewFieldSpec(messagebatchitem.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.BatchID(); ok {
+		_spec.SetField(messagebatchitem.FieldBatchID, field.TypeInt64, value)
+		_node.BatchID = value
+	}
+	if value, ok := _c.mutation.CustomID(); ok {
+		_spec.SetField(messagebatchitem.FieldCustomID, field.TypeString, value)
+		_node.CustomID = value
+	}
+	if value, ok := _c.mutation.Params(); ok {
+		_spec.SetField(messagebatchitem.FieldParams, field.TypeString, value)
+		_node.Params = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(messagebatchitem.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(messagebatchitem.FieldResult, field.TypeString, value)
+		_node.Result = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(messagebatchitem.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(messagebatchitem.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageBatchItem.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageBatchItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageBatchItemCreate) OnConflict(opts ...sql.ConflictOption) *MessageBatchItemUpsertOne {
+	_c.conflict = opts
+	return &MessageBatchItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageBatchItemCreate) OnConflictColumns(columns ...string) *MessageBatchItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageBatchItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageBatchItemUpsertOne is the builder for "upsert"-ing
+	//  one MessageBatchItem node.
+	MessageBatchItemUpsertOne struct {
+		create *MessageBatchItemCreate
+	}
+
+	// MessageBatchItemUpsert is the "OnConflict" setter.
+	MessageBatchItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageBatchItemUpsert) SetUpdatedAt(v time.Time) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateUpdatedAt() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldUpdatedAt)
+	return u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *MessageBatchItemUpsert) SetBatchID(v int64) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldBatchID, v)
+	return u
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateBatchID() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldBatchID)
+	return u
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *MessageBatchItemUpsert) AddBatchID(v int64) *MessageBatchItemUpsert {
+	u.Add(messagebatchitem.FieldBatchID, v)
+	return u
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *MessageBatchItemUpsert) SetCustomID(v string) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldCustomID, v)
+	return u
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateCustomID() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldCustomID)
+	return u
+}
+
+// SetParams sets the "params" field.
+func (u *MessageBatchItemUpsert) SetParams(v string) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldParams, v)
+	return u
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateParams() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldParams)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *MessageBatchItemUpsert) SetStatus(v string) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateStatus() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldStatus)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *MessageBatchItemUpsert) SetResult(v string) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateResult() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *MessageBatchItemUpsert) ClearResult() *MessageBatchItemUpsert {
+	u.SetNull(messagebatchitem.FieldResult)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *MessageBatchItemUpsert) SetAttempts(v int) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateAttempts() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *MessageBatchItemUpsert) AddAttempts(v int) *MessageBatchItemUpsert {
+	u.Add(messagebatchitem.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *MessageBatchItemUpsert) SetNextAttemptAt(v time.Time) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateNextAttemptAt() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldNextAttemptAt)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MessageBatchItemUpsert) SetStartedAt(v time.Time) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateStartedAt() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MessageBatchItemUpsert) ClearStartedAt() *MessageBatchItemUpsert {
+	u.SetNull(messagebatchitem.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MessageBatchItemUpsert) SetCompletedAt(v time.Time) *MessageBatchItemUpsert {
+	u.Set(messagebatchitem.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsert) UpdateCompletedAt() *MessageBatchItemUpsert {
+	u.SetExcluded(messagebatchitem.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MessageBatchItemUpsert) ClearCompletedAt() *MessageBatchItemUpsert {
+	u.SetNull(messagebatchitem.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MessageBatchItemUpsertOne) UpdateNewValues() *MessageBatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagebatchitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageBatchItemUpsertOne) Ignore() *MessageBatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageBatchItemUpsertOne) DoNothing() *MessageBatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageBatchItemCreate.OnConflict
+// documentation for more info.
+func (u *MessageBatchItemUpsertOne) Update(set func(*MessageBatchItemUpsert)) *MessageBatchItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageBatchItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageBatchItemUpsertOne) SetUpdatedAt(v time.Time) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateUpdatedAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *MessageBatchItemUpsertOne) SetBatchID(v int64) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *MessageBatchItemUpsertOne) AddBatchID(v int64) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.AddBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateBatchID() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *MessageBatchItemUpsertOne) SetCustomID(v string) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetCustomID(v)
+	})
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateCustomID() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateCustomID()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *MessageBatchItemUpsertOne) SetParams(v string) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateParams() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MessageBatchItemUpsertOne) SetStatus(v string) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateStatus() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *MessageBatchItemUpsertOne) SetResult(v string) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateResult() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *MessageBatchItemUpsertOne) ClearResult() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *MessageBatchItemUpsertOne) SetAttempts(v int) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *MessageBatchItemUpsertOne) AddAttempts(v int) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateAttempts() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *MessageBatchItemUpsertOne) SetNextAttemptAt(v time.Time) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateNextAttemptAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MessageBatchItemUpsertOne) SetStartedAt(v time.Time) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateStartedAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MessageBatchItemUpsertOne) ClearStartedAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MessageBatchItemUpsertOne) SetCompletedAt(v time.Time) *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertOne) UpdateCompletedAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MessageBatchItemUpsertOne) ClearCompletedAt() *MessageBatchItemUpsertOne {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageBatchItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageBatchItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageBatchItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageBatchItemUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageBatchItemUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageBatchItemCreateBulk is the builder for creating many MessageBatchItem entities in bulk.
+type MessageBatchItemCreateBulk struct {
+	config
+	err      error
+	builders []*MessageBatchItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageBatchItem entities in the database.
+func (_c *MessageBatchItemCreateBulk) Save(ctx context.Context) ([]*MessageBatchItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageBatchItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageBatchItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageBatchItemCreateBulk) SaveX(ctx context.Context) []*MessageBatchItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageBatchItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageBatchItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageBatchItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageBatchItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageBatchItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageBatchItemUpsertBulk {
+	_c.conflict = opts
+	return &MessageBatchItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageBatchItemCreateBulk) OnConflictColumns(columns ...string) *MessageBatchItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageBatchItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageBatchItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageBatchItem nodes.
+type MessageBatchItemUpsertBulk struct {
+	create *MessageBatchItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MessageBatchItemUpsertBulk) UpdateNewValues() *MessageBatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagebatchitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageBatchItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageBatchItemUpsertBulk) Ignore() *MessageBatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageBatchItemUpsertBulk) DoNothing() *MessageBatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageBatchItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageBatchItemUpsertBulk) Update(set func(*MessageBatchItemUpsert)) *MessageBatchItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageBatchItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageBatchItemUpsertBulk) SetUpdatedAt(v time.Time) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateUpdatedAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *MessageBatchItemUpsertBulk) SetBatchID(v int64) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *MessageBatchItemUpsertBulk) AddBatchID(v int64) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.AddBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateBatchID() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *MessageBatchItemUpsertBulk) SetCustomID(v string) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetCustomID(v)
+	})
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateCustomID() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateCustomID()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *MessageBatchItemUpsertBulk) SetParams(v string) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateParams() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MessageBatchItemUpsertBulk) SetStatus(v string) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateStatus() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *MessageBatchItemUpsertBulk) SetResult(v string) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateResult() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *MessageBatchItemUpsertBulk) ClearResult() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *MessageBatchItemUpsertBulk) SetAttempts(v int) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *MessageBatchItemUpsertBulk) AddAttempts(v int) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateAttempts() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *MessageBatchItemUpsertBulk) SetNextAttemptAt(v time.Time) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateNextAttemptAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MessageBatchItemUpsertBulk) SetStartedAt(v time.Time) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateStartedAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MessageBatchItemUpsertBulk) ClearStartedAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MessageBatchItemUpsertBulk) SetCompletedAt(v time.Time) *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MessageBatchItemUpsertBulk) UpdateCompletedAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MessageBatchItemUpsertBulk) ClearCompletedAt() *MessageBatchItemUpsertBulk {
+	return u.Update(func(s *MessageBatchItemUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageBatchItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageBatchItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageBatchItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageBatchItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatchitem"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// MessageBatchItemUpdate is the builder for updating MessageBatchItem entities.
+type MessageBatchItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageBatchItemMutation
+}
+
+// Where appends a list predicates to the MessageBatchItemUpdate builder.
+func (_u *MessageBatchItemUpdate) Where(ps ...predicate.MessageBatchItem) *MessageBatchItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageBatchItemUpdate) SetUpdatedAt(v time.Time) *MessageBatchItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *MessageBatchItemUpdate) SetBatchID(v int64) *MessageBatchItemUpdate {
+	_u.mutation.ResetBatchID()
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableBatchID(v *int64) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// AddBatchID adds value to the "batch_id" field.
+func (_u *MessageBatchItemUpdate) AddBatchID(v int64) *MessageBatchItemUpdate {
+	_u.mutation.AddBatchID(v)
+	return _u
+}
+
+// SetCustomID sets the "custom_id" field.
+func (_u *MessageBatchItemUpdate) SetCustomID(v string) *MessageBatchItemUpdate {
+	_u.mutation.SetCustomID(v)
+	return _u
+}
+
+// SetNillableCustomID sets the "custom_id" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableCustomID(v *string) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetCustomID(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *MessageBatchItemUpdate) SetParams(v string) *MessageBatchItemUpdate {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableParams(v *string) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetParams(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MessageBatchItemUpdate) SetStatus(v string) *MessageBatchItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableStatus(v *string) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *MessageBatchItemUpdate) SetResult(v string) *MessageBatchItemUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableResult(v *string) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *MessageBatchItemUpdate) ClearResult() *MessageBatchItemUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MessageBatchItemUpdate) SetAttempts(v int) *MessageBatchItemUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableAttempts(v *int) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MessageBatchItemUpdate) AddAttempts(v int) *MessageBatchItemUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *MessageBatchItemUpdate) SetNextAttemptAt(v time.Time) *MessageBatchItemUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableNextAttemptAt(v *time.Time) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *MessageBatchItemUpdate) SetStartedAt(v time.Time) *MessageBatchItemUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableStartedAt(v *time.Time) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *MessageBatchItemUpdate) ClearStartedAt() *MessageBatchItemUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MessageBatchItemUpdate) SetCompletedAt(v time.Time) *MessageBatchItemUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdate) SetNillableCompletedAt(v *time.Time) *MessageBatchItemUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MessageBatchItemUpdate) ClearCompletedAt() *MessageBatchItemUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the MessageBatchItemMutation object of the builder.
+func (_u *MessageBatchItemUpdate) Mutation() *MessageBatchItemMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageBatchItemUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageBatchItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageBatchItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageBatchItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageBatchItemUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagebatchitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageBatchItemUpdate) check() error {
+	if v, ok := _u.mutation.CustomID(); ok {
+		if err := messagebatchitem.CustomIDValidator(v); err != nil {
+			return &ValidationError{Name: "custom_id", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.custom_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := messagebatchitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MessageBatchItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagebatchitem.Table, messagebatchitem.Columns, sqlgraph.NewFieldSpec(messagebatchitem.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(messagebatchitem.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchID(); ok {
+		_spec.AddField(messagebatchitem.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CustomID(); ok {
+		_spec.SetField(messagebatchitem.FieldCustomID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(messagebatchitem.FieldParams, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(messagebatchitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(messagebatchitem.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(messagebatchitem.FieldResult, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(messagebatchitem.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(messagebatchitem.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(messagebatchitem.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(messagebatchitem.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(messagebatchitem.FieldCompletedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagebatchitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageBatchItemUpdateOne is the builder for updating a single MessageBatchItem entity.
+type MessageBatchItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageBatchItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageBatchItemUpdateOne) SetUpdatedAt(v time.Time) *MessageBatchItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *MessageBatchItemUpdateOne) SetBatchID(v int64) *MessageBatchItemUpdateOne {
+	_u.mutation.ResetBatchID()
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableBatchID(v *int64) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// AddBatchID adds value to the "batch_id" field.
+func (_u *MessageBatchItemUpdateOne) AddBatchID(v int64) *MessageBatchItemUpdateOne {
+	_u.mutation.AddBatchID(v)
+	return _u
+}
+
+// SetCustomID sets the "custom_id" field.
+func (_u *MessageBatchItemUpdateOne) SetCustomID(v string) *MessageBatchItemUpdateOne {
+	_u.mutation.SetCustomID(v)
+	return _u
+}
+
+// SetNillableCustomID sets the "custom_id" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableCustomID(v *string) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetCustomID(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *MessageBatchItemUpdateOne) SetParams(v string) *MessageBatchItemUpdateOne {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableParams(v *string) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetParams(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MessageBatchItemUpdateOne) SetStatus(v string) *MessageBatchItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableStatus(v *string) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *MessageBatchItemUpdateOne) SetResult(v string) *MessageBatchItemUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableResult(v *string) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *MessageBatchItemUpdateOne) ClearResult() *MessageBatchItemUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MessageBatchItemUpdateOne) SetAttempts(v int) *MessageBatchItemUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableAttempts(v *int) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MessageBatchItemUpdateOne) AddAttempts(v int) *MessageBatchItemUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *MessageBatchItemUpdateOne) SetNextAttemptAt(v time.Time) *MessageBatchItemUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableNextAttemptAt(v *time.Time) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *MessageBatchItemUpdateOne) SetStartedAt(v time.Time) *MessageBatchItemUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableStartedAt(v *time.Time) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *MessageBatchItemUpdateOne) ClearStartedAt() *MessageBatchItemUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MessageBatchItemUpdateOne) SetCompletedAt(v time.Time) *MessageBatchItemUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MessageBatchItemUpdateOne) SetNillableCompletedAt(v *time.Time) *MessageBatchItemUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MessageBatchItemUpdateOne) ClearCompletedAt() *MessageBatchItemUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the MessageBatchItemMutation object of the builder.
+func (_u *MessageBatchItemUpdateOne) Mutation() *MessageBatchItemMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MessageBatchItemUpdate builder.
+func (_u *MessageBatchItemUpdateOne) Where(ps ...predicate.MessageBatchItem) *MessageBatchItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageBatchItemUpdateOne) Select(field string, fields ...string) *MessageBatchItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MessageBatchItem entity.
+func (_u *MessageBatchItemUpdateOne) Save(ctx context.Context) (*MessageBatchItem, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageBatchItemUpdateOne) SaveX(ctx context.Context) *MessageBatchItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageBatchItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageBatchItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageBatchItemUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagebatchitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageBatchItemUpdateOne) check() error {
+	if v, ok := _u.mutation.CustomID(); ok {
+		if err := messagebatchitem.CustomIDValidator(v); err != nil {
+			return &ValidationError{Name: "custom_id", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.custom_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := messagebatchitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MessageBatchItem.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MessageBatchItemUpdateOne) sqlSave(ctx context.Context) (_node *MessageBatchItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagebatchitem.Table, messagebatchitem.Columns, sqlgraph.NewFieldSpec(messagebatchitem.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageBatchItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagebatchitem.FieldID)
+		for _, f := range fields {
+			if !messagebatchitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagebatchitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(messagebatchitem.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchID(); ok {
+		_spec.AddField(messagebatchitem.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CustomID(); ok {
+		_spec.SetField(messagebatchitem.FieldCustomID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(messagebatchitem.FieldParams, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(messagebatchitem.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(messagebatchitem.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(messagebatchitem.FieldResult, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(messagebatchitem.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(messagebatchitem.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(messagebatchitem.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(messagebatchitem.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(messagebatchitem.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(messagebatchitem.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &MessageBatchItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagebatchitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
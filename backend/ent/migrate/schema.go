@@ -23,6 +23,10 @@ var (
 		{Name: "quota", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
 		{Name: "quota_used", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "allowed_models", Type: field.TypeJSON, Nullable: true},
+		{Name: "rate_limit_rpm", Type: field.TypeInt, Default: 0},
+		{Name: "rate_limit_tpm", Type: field.TypeInt, Default: 0},
+		{Name: "daily_request_limit", Type: field.TypeInt, Default: 0},
 		{Name: "group_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt64},
 	}
@@ -34,13 +38,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_groups_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[12]},
+				Columns:    []*schema.Column{APIKeysColumns[16]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[13]},
+				Columns:    []*schema.Column{APIKeysColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -49,12 +53,12 @@ var (
 			{
 				Name:    "apikey_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[13]},
+				Columns: []*schema.Column{APIKeysColumns[17]},
 			},
 			{
 				Name:    "apikey_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[12]},
+				Columns: []*schema.Column{APIKeysColumns[16]},
 			},
 			{
 				Name:    "apikey_status",
@@ -217,6 +221,37 @@ var (
 			},
 		},
 	}
+	// AccountHealthProbesColumns holds the columns for the "account_health_probes" table.
+	AccountHealthProbesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "account_id", Type: field.TypeInt64},
+		{Name: "platform", Type: field.TypeString, Size: 50},
+		{Name: "model", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "result", Type: field.TypeString, Size: 20},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "action", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// AccountHealthProbesTable holds the schema information for the "account_health_probes" table.
+	AccountHealthProbesTable = &schema.Table{
+		Name:       "account_health_probes",
+		Columns:    AccountHealthProbesColumns,
+		PrimaryKey: []*schema.Column{AccountHealthProbesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accounthealthprobe_account_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountHealthProbesColumns[1], AccountHealthProbesColumns[9]},
+			},
+			{
+				Name:    "accounthealthprobe_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountHealthProbesColumns[9]},
+			},
+		},
+	}
 	// AnnouncementsColumns holds the columns for the "announcements" table.
 	AnnouncementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -309,6 +344,88 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "actor_user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "auth_method", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "method", Type: field.TypeString, Size: 10},
+		{Name: "route", Type: field.TypeString, Size: 255},
+		{Name: "path", Type: field.TypeString, Size: 512},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "target_type", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "target_id", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "request_body", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[13]},
+			},
+			{
+				Name:    "auditlog_actor_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[13]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9], AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_route",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6]},
+			},
+		},
+	}
+	// BudgetAlertsColumns holds the columns for the "budget_alerts" table.
+	BudgetAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "name", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "metric", Type: field.TypeString, Size: 32},
+		{Name: "threshold_type", Type: field.TypeString, Size: 20},
+		{Name: "threshold", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "api_key_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "subscription_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "notify_email", Type: field.TypeBool, Default: true},
+		{Name: "webhook_url", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "auto_disable_key", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_triggered_window", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "last_triggered_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// BudgetAlertsTable holds the schema information for the "budget_alerts" table.
+	BudgetAlertsTable = &schema.Table{
+		Name:       "budget_alerts",
+		Columns:    BudgetAlertsColumns,
+		PrimaryKey: []*schema.Column{BudgetAlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetalert_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetAlertsColumns[3]},
+			},
+			{
+				Name:    "budgetalert_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetAlertsColumns[13]},
+			},
+		},
+	}
 	// ErrorPassthroughRulesColumns holds the columns for the "error_passthrough_rules" table.
 	ErrorPassthroughRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -374,6 +491,13 @@ var (
 		{Name: "mcp_xml_inject", Type: field.TypeBool, Default: true},
 		{Name: "supported_model_scopes", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "response_cache_enabled", Type: field.TypeBool, Default: false},
+		{Name: "response_cache_ttl_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "batch_discount_multiplier", Type: field.TypeFloat64, Default: 1, SchemaType: map[string]string{"postgres": "decimal(10,4)"}},
+		{Name: "hedge_enabled", Type: field.TypeBool, Default: false},
+		{Name: "hedge_delay_ms", Type: field.TypeInt, Default: 0},
+		{Name: "prompt_cache_routing_enabled", Type: field.TypeBool, Default: false},
+		{Name: "auto_cache_control_enabled", Type: field.TypeBool, Default: false},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -413,6 +537,120 @@ var (
 			},
 		},
 	}
+	// MessageBatchesColumns holds the columns for the "message_batches" table.
+	MessageBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "batch_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "api_key_id", Type: field.TypeInt64},
+		{Name: "group_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "processing_status", Type: field.TypeString, Size: 20, Default: "in_progress"},
+		{Name: "request_count", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "cancel_initiated_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// MessageBatchesTable holds the schema information for the "message_batches" table.
+	MessageBatchesTable = &schema.Table{
+		Name:       "message_batches",
+		Columns:    MessageBatchesColumns,
+		PrimaryKey: []*schema.Column{MessageBatchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagebatch_user_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageBatchesColumns[4], MessageBatchesColumns[0]},
+			},
+			{
+				Name:    "messagebatch_processing_status",
+				Unique:  false,
+				Columns: []*schema.Column{MessageBatchesColumns[7]},
+			},
+		},
+	}
+	// MessageBatchItemsColumns holds the columns for the "message_batch_items" table.
+	MessageBatchItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "batch_id", Type: field.TypeInt64},
+		{Name: "custom_id", Type: field.TypeString, Size: 64},
+		{Name: "params", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "pending"},
+		{Name: "result", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// MessageBatchItemsTable holds the schema information for the "message_batch_items" table.
+	MessageBatchItemsTable = &schema.Table{
+		Name:       "message_batch_items",
+		Columns:    MessageBatchItemsColumns,
+		PrimaryKey: []*schema.Column{MessageBatchItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagebatchitem_batch_id_custom_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageBatchItemsColumns[3], MessageBatchItemsColumns[4]},
+			},
+			{
+				Name:    "messagebatchitem_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageBatchItemsColumns[6], MessageBatchItemsColumns[9]},
+			},
+		},
+	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "order_no", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "type", Type: field.TypeString, Size: 20, Default: "balance"},
+		{Name: "provider", Type: field.TypeString, Size: 20},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "pending"},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "currency", Type: field.TypeString, Size: 10},
+		{Name: "credit_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "plan_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "group_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "validity_days", Type: field.TypeInt, Default: 0},
+		{Name: "subscription_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "provider_trade_no", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "pay_url", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "client_ip", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "notes", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "refunded_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "voided_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentorder_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[4], OrdersColumns[1]},
+			},
+			{
+				Name:    "paymentorder_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[7]},
+			},
+			{
+				Name:    "paymentorder_provider_provider_trade_no",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[6], OrdersColumns[15]},
+			},
+		},
+	}
 	// PromoCodesColumns holds the columns for the "promo_codes" table.
 	PromoCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -585,6 +823,38 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// UpstreamFilesColumns holds the columns for the "upstream_files" table.
+	UpstreamFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "file_id", Type: field.TypeString, Unique: true, Size: 128},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "api_key_id", Type: field.TypeInt64},
+		{Name: "account_id", Type: field.TypeInt64},
+		{Name: "filename", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "mime_type", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "size_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "downloadable", Type: field.TypeBool, Default: false},
+	}
+	// UpstreamFilesTable holds the schema information for the "upstream_files" table.
+	UpstreamFilesTable = &schema.Table{
+		Name:       "upstream_files",
+		Columns:    UpstreamFilesColumns,
+		PrimaryKey: []*schema.Column{UpstreamFilesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "upstreamfile_user_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{UpstreamFilesColumns[4], UpstreamFilesColumns[0]},
+			},
+			{
+				Name:    "upstreamfile_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{UpstreamFilesColumns[6]},
+			},
+		},
+	}
 	// UsageCleanupTasksColumns holds the columns for the "usage_cleanup_tasks" table.
 	UsageCleanupTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -651,6 +921,8 @@ var (
 		{Name: "image_count", Type: field.TypeInt, Default: 0},
 		{Name: "image_size", Type: field.TypeString, Nullable: true, Size: 10},
 		{Name: "cache_ttl_overridden", Type: field.TypeBool, Default: false},
+		{Name: "response_cache_hit", Type: field.TypeBool, Default: false},
+		{Name: "request_type", Type: field.TypeString, Size: 20, Default: ""},
 		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "api_key_id", Type: field.TypeInt64},
 		{Name: "account_id", Type: field.TypeInt64},
@@ -666,31 +938,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "usage_logs_api_keys_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[27]},
+				Columns:    []*schema.Column{UsageLogsColumns[29]},
 				RefColumns: []*schema.Column{APIKeysColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "usage_logs_accounts_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[28]},
+				Columns:    []*schema.Column{UsageLogsColumns[30]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "usage_logs_groups_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[29]},
+				Columns:    []*schema.Column{UsageLogsColumns[31]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "usage_logs_users_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[30]},
+				Columns:    []*schema.Column{UsageLogsColumns[32]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "usage_logs_user_subscriptions_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[31]},
+				Columns:    []*schema.Column{UsageLogsColumns[33]},
 				RefColumns: []*schema.Column{UserSubscriptionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -699,32 +971,32 @@ var (
 			{
 				Name:    "usagelog_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[30]},
+				Columns: []*schema.Column{UsageLogsColumns[32]},
 			},
 			{
 				Name:    "usagelog_api_key_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[27]},
+				Columns: []*schema.Column{UsageLogsColumns[29]},
 			},
 			{
 				Name:    "usagelog_account_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[28]},
+				Columns: []*schema.Column{UsageLogsColumns[30]},
 			},
 			{
 				Name:    "usagelog_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[29]},
+				Columns: []*schema.Column{UsageLogsColumns[31]},
 			},
 			{
 				Name:    "usagelog_subscription_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[31]},
+				Columns: []*schema.Column{UsageLogsColumns[33]},
 			},
 			{
 				Name:    "usagelog_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[26]},
+				Columns: []*schema.Column{UsageLogsColumns[28]},
 			},
 			{
 				Name:    "usagelog_model",
@@ -739,12 +1011,12 @@ var (
 			{
 				Name:    "usagelog_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[30], UsageLogsColumns[26]},
+				Columns: []*schema.Column{UsageLogsColumns[32], UsageLogsColumns[28]},
 			},
 			{
 				Name:    "usagelog_api_key_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[27], UsageLogsColumns[26]},
+				Columns: []*schema.Column{UsageLogsColumns[29], UsageLogsColumns[28]},
 			},
 		},
 	}
@@ -992,15 +1264,22 @@ var (
 		APIKeysTable,
 		AccountsTable,
 		AccountGroupsTable,
+		AccountHealthProbesTable,
 		AnnouncementsTable,
 		AnnouncementReadsTable,
+		AuditLogsTable,
+		BudgetAlertsTable,
 		ErrorPassthroughRulesTable,
 		GroupsTable,
+		MessageBatchesTable,
+		MessageBatchItemsTable,
+		OrdersTable,
 		PromoCodesTable,
 		PromoCodeUsagesTable,
 		ProxiesTable,
 		RedeemCodesTable,
 		SettingsTable,
+		UpstreamFilesTable,
 		UsageCleanupTasksTable,
 		UsageLogsTable,
 		UsersTable,
@@ -1026,6 +1305,9 @@ func init() {
 	AccountGroupsTable.Annotation = &entsql.Annotation{
 		Table: "account_groups",
 	}
+	AccountHealthProbesTable.Annotation = &entsql.Annotation{
+		Table: "account_health_probes",
+	}
 	AnnouncementsTable.Annotation = &entsql.Annotation{
 		Table: "announcements",
 	}
@@ -1034,12 +1316,27 @@ func init() {
 	AnnouncementReadsTable.Annotation = &entsql.Annotation{
 		Table: "announcement_reads",
 	}
+	AuditLogsTable.Annotation = &entsql.Annotation{
+		Table: "audit_logs",
+	}
+	BudgetAlertsTable.Annotation = &entsql.Annotation{
+		Table: "budget_alerts",
+	}
 	ErrorPassthroughRulesTable.Annotation = &entsql.Annotation{
 		Table: "error_passthrough_rules",
 	}
 	GroupsTable.Annotation = &entsql.Annotation{
 		Table: "groups",
 	}
+	MessageBatchesTable.Annotation = &entsql.Annotation{
+		Table: "message_batches",
+	}
+	MessageBatchItemsTable.Annotation = &entsql.Annotation{
+		Table: "message_batch_items",
+	}
+	OrdersTable.Annotation = &entsql.Annotation{
+		Table: "orders",
+	}
 	PromoCodesTable.Annotation = &entsql.Annotation{
 		Table: "promo_codes",
 	}
@@ -1059,6 +1356,9 @@ func init() {
 	SettingsTable.Annotation = &entsql.Annotation{
 		Table: "settings",
 	}
+	UpstreamFilesTable.Annotation = &entsql.Annotation{
+		Table: "upstream_files",
+	}
 	UsageCleanupTasksTable.Annotation = &entsql.Annotation{
 		Table: "usage_cleanup_tasks",
 	}
@@ -0,0 +1,1236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// PaymentOrderUpdate is the builder for updating PaymentOrder entities.
+type PaymentOrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentOrderMutation
+}
+
+// Where appends a list predicates to the PaymentOrderUpdate builder.
+func (_u *PaymentOrderUpdate) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentOrderUpdate) SetUpdatedAt(v time.Time) *PaymentOrderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderNo sets the "order_no" field.
+func (_u *PaymentOrderUpdate) SetOrderNo(v string) *PaymentOrderUpdate {
+	_u.mutation.SetOrderNo(v)
+	return _u
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableOrderNo(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetOrderNo(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PaymentOrderUpdate) SetUserID(v int64) *PaymentOrderUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableUserID(v *int64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PaymentOrderUpdate) AddUserID(v int64) *PaymentOrderUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PaymentOrderUpdate) SetType(v string) *PaymentOrderUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableType(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentOrderUpdate) SetProvider(v string) *PaymentOrderUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableProvider(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentOrderUpdate) SetStatus(v string) *PaymentOrderUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableStatus(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentOrderUpdate) SetAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableAmount(v *float64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentOrderUpdate) AddAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentOrderUpdate) SetCurrency(v string) *PaymentOrderUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableCurrency(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *PaymentOrderUpdate) SetCreditAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableCreditAmount(v *float64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *PaymentOrderUpdate) AddCreditAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *PaymentOrderUpdate) SetPlanID(v string) *PaymentOrderUpdate {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillablePlanID(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *PaymentOrderUpdate) ClearPlanID() *PaymentOrderUpdate {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PaymentOrderUpdate) SetGroupID(v int64) *PaymentOrderUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableGroupID(v *int64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *PaymentOrderUpdate) AddGroupID(v int64) *PaymentOrderUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *PaymentOrderUpdate) ClearGroupID() *PaymentOrderUpdate {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (_u *PaymentOrderUpdate) SetValidityDays(v int) *PaymentOrderUpdate {
+	_u.mutation.ResetValidityDays()
+	_u.mutation.SetValidityDays(v)
+	return _u
+}
+
+// SetNillableValidityDays sets the "validity_days" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableValidityDays(v *int) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetValidityDays(*v)
+	}
+	return _u
+}
+
+// AddValidityDays adds value to the "validity_days" field.
+func (_u *PaymentOrderUpdate) AddValidityDays(v int) *PaymentOrderUpdate {
+	_u.mutation.AddValidityDays(v)
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *PaymentOrderUpdate) SetSubscriptionID(v int64) *PaymentOrderUpdate {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableSubscriptionID(v *int64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *PaymentOrderUpdate) AddSubscriptionID(v int64) *PaymentOrderUpdate {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *PaymentOrderUpdate) ClearSubscriptionID() *PaymentOrderUpdate {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetProviderTradeNo sets the "provider_trade_no" field.
+func (_u *PaymentOrderUpdate) SetProviderTradeNo(v string) *PaymentOrderUpdate {
+	_u.mutation.SetProviderTradeNo(v)
+	return _u
+}
+
+// SetNillableProviderTradeNo sets the "provider_trade_no" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableProviderTradeNo(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetProviderTradeNo(*v)
+	}
+	return _u
+}
+
+// ClearProviderTradeNo clears the value of the "provider_trade_no" field.
+func (_u *PaymentOrderUpdate) ClearProviderTradeNo() *PaymentOrderUpdate {
+	_u.mutation.ClearProviderTradeNo()
+	return _u
+}
+
+// SetPayURL sets the "pay_url" field.
+func (_u *PaymentOrderUpdate) SetPayURL(v string) *PaymentOrderUpdate {
+	_u.mutation.SetPayURL(v)
+	return _u
+}
+
+// SetNillablePayURL sets the "pay_url" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillablePayURL(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetPayURL(*v)
+	}
+	return _u
+}
+
+// ClearPayURL clears the value of the "pay_url" field.
+func (_u *PaymentOrderUpdate) ClearPayURL() *PaymentOrderUpdate {
+	_u.mutation.ClearPayURL()
+	return _u
+}
+
+// SetClientIP sets the "client_ip" field.
+func (_u *PaymentOrderUpdate) SetClientIP(v string) *PaymentOrderUpdate {
+	_u.mutation.SetClientIP(v)
+	return _u
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableClientIP(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetClientIP(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentOrderUpdate) SetNotes(v string) *PaymentOrderUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableNotes(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentOrderUpdate) ClearNotes() *PaymentOrderUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *PaymentOrderUpdate) SetPaidAt(v time.Time) *PaymentOrderUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillablePaidAt(v *time.Time) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *PaymentOrderUpdate) ClearPaidAt() *PaymentOrderUpdate {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *PaymentOrderUpdate) SetRefundedAt(v time.Time) *PaymentOrderUpdate {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableRefundedAt(v *time.Time) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *PaymentOrderUpdate) ClearRefundedAt() *PaymentOrderUpdate {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *PaymentOrderUpdate) SetVoidedAt(v time.Time) *PaymentOrderUpdate {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableVoidedAt(v *time.Time) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *PaymentOrderUpdate) ClearVoidedAt() *PaymentOrderUpdate {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// Mutation returns the PaymentOrderMutation object of the builder.
+func (_u *PaymentOrderUpdate) Mutation() *PaymentOrderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentOrderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentOrderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentOrderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentOrderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentorder.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentOrderUpdate) check() error {
+	if v, ok := _u.mutation.OrderNo(); ok {
+		if err := paymentorder.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.order_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := paymentorder.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := paymentorder.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := paymentorder.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlanID(); ok {
+		if err := paymentorder.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderTradeNo(); ok {
+		if err := paymentorder.ProviderTradeNoValidator(v); err != nil {
+			return &ValidationError{Name: "provider_trade_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.provider_trade_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientIP(); ok {
+		if err := paymentorder.ClientIPValidator(v); err != nil {
+			return &ValidationError{Name: "client_ip", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.client_ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentOrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentorder.Table, paymentorder.Columns, sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OrderNo(); ok {
+		_spec.SetField(paymentorder.FieldOrderNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(paymentorder.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(paymentorder.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(paymentorder.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentorder.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentorder.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentorder.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(paymentorder.FieldCreditAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(paymentorder.FieldCreditAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(paymentorder.FieldPlanID, field.TypeString, value)
+	}
+	if _u.mutation.PlanIDCleared() {
+		_spec.ClearField(paymentorder.FieldPlanID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(paymentorder.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(paymentorder.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(paymentorder.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ValidityDays(); ok {
+		_spec.SetField(paymentorder.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidityDays(); ok {
+		_spec.AddField(paymentorder.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(paymentorder.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(paymentorder.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(paymentorder.FieldSubscriptionID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProviderTradeNo(); ok {
+		_spec.SetField(paymentorder.FieldProviderTradeNo, field.TypeString, value)
+	}
+	if _u.mutation.ProviderTradeNoCleared() {
+		_spec.ClearField(paymentorder.FieldProviderTradeNo, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayURL(); ok {
+		_spec.SetField(paymentorder.FieldPayURL, field.TypeString, value)
+	}
+	if _u.mutation.PayURLCleared() {
+		_spec.ClearField(paymentorder.FieldPayURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIP(); ok {
+		_spec.SetField(paymentorder.FieldClientIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentorder.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentorder.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(paymentorder.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(paymentorder.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(paymentorder.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(paymentorder.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(paymentorder.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(paymentorder.FieldVoidedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentOrderUpdateOne is the builder for updating a single PaymentOrder entity.
+type PaymentOrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentOrderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentOrderUpdateOne) SetUpdatedAt(v time.Time) *PaymentOrderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrderNo sets the "order_no" field.
+func (_u *PaymentOrderUpdateOne) SetOrderNo(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetOrderNo(v)
+	return _u
+}
+
+// SetNillableOrderNo sets the "order_no" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableOrderNo(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetOrderNo(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PaymentOrderUpdateOne) SetUserID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableUserID(v *int64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PaymentOrderUpdateOne) AddUserID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PaymentOrderUpdateOne) SetType(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableType(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentOrderUpdateOne) SetProvider(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableProvider(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentOrderUpdateOne) SetStatus(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableStatus(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentOrderUpdateOne) SetAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableAmount(v *float64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentOrderUpdateOne) AddAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentOrderUpdateOne) SetCurrency(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableCurrency(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *PaymentOrderUpdateOne) SetCreditAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableCreditAmount(v *float64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *PaymentOrderUpdateOne) AddCreditAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *PaymentOrderUpdateOne) SetPlanID(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillablePlanID(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *PaymentOrderUpdateOne) ClearPlanID() *PaymentOrderUpdateOne {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *PaymentOrderUpdateOne) SetGroupID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableGroupID(v *int64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *PaymentOrderUpdateOne) AddGroupID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (_u *PaymentOrderUpdateOne) ClearGroupID() *PaymentOrderUpdateOne {
+	_u.mutation.ClearGroupID()
+	return _u
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (_u *PaymentOrderUpdateOne) SetValidityDays(v int) *PaymentOrderUpdateOne {
+	_u.mutation.ResetValidityDays()
+	_u.mutation.SetValidityDays(v)
+	return _u
+}
+
+// SetNillableValidityDays sets the "validity_days" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableValidityDays(v *int) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetValidityDays(*v)
+	}
+	return _u
+}
+
+// AddValidityDays adds value to the "validity_days" field.
+func (_u *PaymentOrderUpdateOne) AddValidityDays(v int) *PaymentOrderUpdateOne {
+	_u.mutation.AddValidityDays(v)
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *PaymentOrderUpdateOne) SetSubscriptionID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableSubscriptionID(v *int64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *PaymentOrderUpdateOne) AddSubscriptionID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *PaymentOrderUpdateOne) ClearSubscriptionID() *PaymentOrderUpdateOne {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetProviderTradeNo sets the "provider_trade_no" field.
+func (_u *PaymentOrderUpdateOne) SetProviderTradeNo(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetProviderTradeNo(v)
+	return _u
+}
+
+// SetNillableProviderTradeNo sets the "provider_trade_no" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableProviderTradeNo(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetProviderTradeNo(*v)
+	}
+	return _u
+}
+
+// ClearProviderTradeNo clears the value of the "provider_trade_no" field.
+func (_u *PaymentOrderUpdateOne) ClearProviderTradeNo() *PaymentOrderUpdateOne {
+	_u.mutation.ClearProviderTradeNo()
+	return _u
+}
+
+// SetPayURL sets the "pay_url" field.
+func (_u *PaymentOrderUpdateOne) SetPayURL(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetPayURL(v)
+	return _u
+}
+
+// SetNillablePayURL sets the "pay_url" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillablePayURL(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetPayURL(*v)
+	}
+	return _u
+}
+
+// ClearPayURL clears the value of the "pay_url" field.
+func (_u *PaymentOrderUpdateOne) ClearPayURL() *PaymentOrderUpdateOne {
+	_u.mutation.ClearPayURL()
+	return _u
+}
+
+// SetClientIP sets the "client_ip" field.
+func (_u *PaymentOrderUpdateOne) SetClientIP(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetClientIP(v)
+	return _u
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableClientIP(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetClientIP(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentOrderUpdateOne) SetNotes(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableNotes(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentOrderUpdateOne) ClearNotes() *PaymentOrderUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *PaymentOrderUpdateOne) SetPaidAt(v time.Time) *PaymentOrderUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillablePaidAt(v *time.Time) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *PaymentOrderUpdateOne) ClearPaidAt() *PaymentOrderUpdateOne {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *PaymentOrderUpdateOne) SetRefundedAt(v time.Time) *PaymentOrderUpdateOne {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableRefundedAt(v *time.Time) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *PaymentOrderUpdateOne) ClearRefundedAt() *PaymentOrderUpdateOne {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *PaymentOrderUpdateOne) SetVoidedAt(v time.Time) *PaymentOrderUpdateOne {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableVoidedAt(v *time.Time) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *PaymentOrderUpdateOne) ClearVoidedAt() *PaymentOrderUpdateOne {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// Mutation returns the PaymentOrderMutation object of the builder.
+func (_u *PaymentOrderUpdateOne) Mutation() *PaymentOrderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentOrderUpdate builder.
+func (_u *PaymentOrderUpdateOne) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentOrderUpdateOne) Select(field string, fields ...string) *PaymentOrderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentOrder entity.
+func (_u *PaymentOrderUpdateOne) Save(ctx context.Context) (*PaymentOrder, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentOrderUpdateOne) SaveX(ctx context.Context) *PaymentOrder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentOrderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentorder.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentOrderUpdateOne) check() error {
+	if v, ok := _u.mutation.OrderNo(); ok {
+		if err := paymentorder.OrderNoValidator(v); err != nil {
+			return &ValidationError{Name: "order_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.order_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := paymentorder.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := paymentorder.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := paymentorder.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlanID(); ok {
+		if err := paymentorder.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderTradeNo(); ok {
+		if err := paymentorder.ProviderTradeNoValidator(v); err != nil {
+			return &ValidationError{Name: "provider_trade_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.provider_trade_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientIP(); ok {
+		if err := paymentorder.ClientIPValidator(v); err != nil {
+			return &ValidationError{Name: "client_ip", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.client_ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentorder.Table, paymentorder.Columns, sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentorder.FieldID)
+		for _, f := range fields {
+			if !paymentorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OrderNo(); ok {
+		_spec.SetField(paymentorder.FieldOrderNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(paymentorder.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(paymentorder.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(paymentorder.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentorder.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentorder.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(paymentorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(paymentorder.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(paymentorder.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(paymentorder.FieldCreditAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(paymentorder.FieldCreditAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(paymentorder.FieldPlanID, field.TypeString, value)
+	}
+	if _u.mutation.PlanIDCleared() {
+		_spec.ClearField(paymentorder.FieldPlanID, field.TypeString)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(paymentorder.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(paymentorder.FieldGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.GroupIDCleared() {
+		_spec.ClearField(paymentorder.FieldGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ValidityDays(); ok {
+		_spec.SetField(paymentorder.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidityDays(); ok {
+		_spec.AddField(paymentorder.FieldValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(paymentorder.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(paymentorder.FieldSubscriptionID, field.TypeInt64, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(paymentorder.FieldSubscriptionID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProviderTradeNo(); ok {
+		_spec.SetField(paymentorder.FieldProviderTradeNo, field.TypeString, value)
+	}
+	if _u.mutation.ProviderTradeNoCleared() {
+		_spec.ClearField(paymentorder.FieldProviderTradeNo, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayURL(); ok {
+		_spec.SetField(paymentorder.FieldPayURL, field.TypeString, value)
+	}
+	if _u.mutation.PayURLCleared() {
+		_spec.ClearField(paymentorder.FieldPayURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientIP(); ok {
+		_spec.SetField(paymentorder.FieldClientIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentorder.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentorder.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(paymentorder.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(paymentorder.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(paymentorder.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(paymentorder.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(paymentorder.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(paymentorder.FieldVoidedAt, field.TypeTime)
+	}
+	_node = &PaymentOrder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,17 +7,24 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/ent/account"
 	"github.com/Wei-Shaw/sub2api/ent/accountgroup"
+	"github.com/Wei-Shaw/sub2api/ent/accounthealthprobe"
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/auditlog"
+	"github.com/Wei-Shaw/sub2api/ent/budgetalert"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatch"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatchitem"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
 	"github.com/Wei-Shaw/sub2api/ent/schema"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
+	"github.com/Wei-Shaw/sub2api/ent/upstreamfile"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
@@ -100,6 +107,18 @@ func init() {
 	apikeyDescQuotaUsed := apikeyFields[8].Descriptor()
 	// apikey.DefaultQuotaUsed holds the default value on creation for the quota_used field.
 	apikey.DefaultQuotaUsed = apikeyDescQuotaUsed.Default.(float64)
+	// apikeyDescRateLimitRpm is the schema descriptor for rate_limit_rpm field.
+	apikeyDescRateLimitRpm := apikeyFields[11].Descriptor()
+	// apikey.DefaultRateLimitRpm holds the default value on creation for the rate_limit_rpm field.
+	apikey.DefaultRateLimitRpm = apikeyDescRateLimitRpm.Default.(int)
+	// apikeyDescRateLimitTpm is the schema descriptor for rate_limit_tpm field.
+	apikeyDescRateLimitTpm := apikeyFields[12].Descriptor()
+	// apikey.DefaultRateLimitTpm holds the default value on creation for the rate_limit_tpm field.
+	apikey.DefaultRateLimitTpm = apikeyDescRateLimitTpm.Default.(int)
+	// apikeyDescDailyRequestLimit is the schema descriptor for daily_request_limit field.
+	apikeyDescDailyRequestLimit := apikeyFields[13].Descriptor()
+	// apikey.DefaultDailyRequestLimit holds the default value on creation for the daily_request_limit field.
+	apikey.DefaultDailyRequestLimit = apikeyDescDailyRequestLimit.Default.(int)
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinHooks1 := accountMixin[1].Hooks()
 	account.Hooks[0] = accountMixinHooks1[0]
@@ -221,6 +240,40 @@ func init() {
 	accountgroupDescCreatedAt := accountgroupFields[3].Descriptor()
 	// accountgroup.DefaultCreatedAt holds the default value on creation for the created_at field.
 	accountgroup.DefaultCreatedAt = accountgroupDescCreatedAt.Default.(func() time.Time)
+	accounthealthprobeFields := schema.AccountHealthProbe{}.Fields()
+	_ = accounthealthprobeFields
+	// accounthealthprobeDescPlatform is the schema descriptor for platform field.
+	accounthealthprobeDescPlatform := accounthealthprobeFields[1].Descriptor()
+	// accounthealthprobe.PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	accounthealthprobe.PlatformValidator = accounthealthprobeDescPlatform.Validators[0].(func(string) error)
+	// accounthealthprobeDescModel is the schema descriptor for model field.
+	accounthealthprobeDescModel := accounthealthprobeFields[2].Descriptor()
+	// accounthealthprobe.DefaultModel holds the default value on creation for the model field.
+	accounthealthprobe.DefaultModel = accounthealthprobeDescModel.Default.(string)
+	// accounthealthprobe.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	accounthealthprobe.ModelValidator = accounthealthprobeDescModel.Validators[0].(func(string) error)
+	// accounthealthprobeDescResult is the schema descriptor for result field.
+	accounthealthprobeDescResult := accounthealthprobeFields[3].Descriptor()
+	// accounthealthprobe.ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	accounthealthprobe.ResultValidator = accounthealthprobeDescResult.Validators[0].(func(string) error)
+	// accounthealthprobeDescStatusCode is the schema descriptor for status_code field.
+	accounthealthprobeDescStatusCode := accounthealthprobeFields[4].Descriptor()
+	// accounthealthprobe.DefaultStatusCode holds the default value on creation for the status_code field.
+	accounthealthprobe.DefaultStatusCode = accounthealthprobeDescStatusCode.Default.(int)
+	// accounthealthprobeDescLatencyMs is the schema descriptor for latency_ms field.
+	accounthealthprobeDescLatencyMs := accounthealthprobeFields[5].Descriptor()
+	// accounthealthprobe.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	accounthealthprobe.DefaultLatencyMs = accounthealthprobeDescLatencyMs.Default.(int64)
+	// accounthealthprobeDescAction is the schema descriptor for action field.
+	accounthealthprobeDescAction := accounthealthprobeFields[7].Descriptor()
+	// accounthealthprobe.DefaultAction holds the default value on creation for the action field.
+	accounthealthprobe.DefaultAction = accounthealthprobeDescAction.Default.(string)
+	// accounthealthprobe.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	accounthealthprobe.ActionValidator = accounthealthprobeDescAction.Validators[0].(func(string) error)
+	// accounthealthprobeDescCreatedAt is the schema descriptor for created_at field.
+	accounthealthprobeDescCreatedAt := accounthealthprobeFields[8].Descriptor()
+	// accounthealthprobe.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accounthealthprobe.DefaultCreatedAt = accounthealthprobeDescCreatedAt.Default.(func() time.Time)
 	announcementFields := schema.Announcement{}.Fields()
 	_ = announcementFields
 	// announcementDescTitle is the schema descriptor for title field.
@@ -271,6 +324,107 @@ func init() {
 	announcementreadDescCreatedAt := announcementreadFields[3].Descriptor()
 	// announcementread.DefaultCreatedAt holds the default value on creation for the created_at field.
 	announcementread.DefaultCreatedAt = announcementreadDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAuthMethod is the schema descriptor for auth_method field.
+	auditlogDescAuthMethod := auditlogFields[1].Descriptor()
+	// auditlog.DefaultAuthMethod holds the default value on creation for the auth_method field.
+	auditlog.DefaultAuthMethod = auditlogDescAuthMethod.Default.(string)
+	// auditlog.AuthMethodValidator is a validator for the "auth_method" field. It is called by the builders before save.
+	auditlog.AuthMethodValidator = auditlogDescAuthMethod.Validators[0].(func(string) error)
+	// auditlogDescIPAddress is the schema descriptor for ip_address field.
+	auditlogDescIPAddress := auditlogFields[2].Descriptor()
+	// auditlog.DefaultIPAddress holds the default value on creation for the ip_address field.
+	auditlog.DefaultIPAddress = auditlogDescIPAddress.Default.(string)
+	// auditlog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	auditlog.IPAddressValidator = auditlogDescIPAddress.Validators[0].(func(string) error)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[3].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	auditlog.UserAgentValidator = auditlogDescUserAgent.Validators[0].(func(string) error)
+	// auditlogDescMethod is the schema descriptor for method field.
+	auditlogDescMethod := auditlogFields[4].Descriptor()
+	// auditlog.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	auditlog.MethodValidator = auditlogDescMethod.Validators[0].(func(string) error)
+	// auditlogDescRoute is the schema descriptor for route field.
+	auditlogDescRoute := auditlogFields[5].Descriptor()
+	// auditlog.RouteValidator is a validator for the "route" field. It is called by the builders before save.
+	auditlog.RouteValidator = auditlogDescRoute.Validators[0].(func(string) error)
+	// auditlogDescPath is the schema descriptor for path field.
+	auditlogDescPath := auditlogFields[6].Descriptor()
+	// auditlog.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	auditlog.PathValidator = auditlogDescPath.Validators[0].(func(string) error)
+	// auditlogDescStatusCode is the schema descriptor for status_code field.
+	auditlogDescStatusCode := auditlogFields[7].Descriptor()
+	// auditlog.DefaultStatusCode holds the default value on creation for the status_code field.
+	auditlog.DefaultStatusCode = auditlogDescStatusCode.Default.(int)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[8].Descriptor()
+	// auditlog.DefaultTargetType holds the default value on creation for the target_type field.
+	auditlog.DefaultTargetType = auditlogDescTargetType.Default.(string)
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[9].Descriptor()
+	// auditlog.DefaultTargetID holds the default value on creation for the target_id field.
+	auditlog.DefaultTargetID = auditlogDescTargetID.Default.(string)
+	// auditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	auditlog.TargetIDValidator = auditlogDescTargetID.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[12].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	budgetalertMixin := schema.BudgetAlert{}.Mixin()
+	budgetalertMixinFields0 := budgetalertMixin[0].Fields()
+	_ = budgetalertMixinFields0
+	budgetalertFields := schema.BudgetAlert{}.Fields()
+	_ = budgetalertFields
+	// budgetalertDescCreatedAt is the schema descriptor for created_at field.
+	budgetalertDescCreatedAt := budgetalertMixinFields0[0].Descriptor()
+	// budgetalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budgetalert.DefaultCreatedAt = budgetalertDescCreatedAt.Default.(func() time.Time)
+	// budgetalertDescUpdatedAt is the schema descriptor for updated_at field.
+	budgetalertDescUpdatedAt := budgetalertMixinFields0[1].Descriptor()
+	// budgetalert.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	budgetalert.DefaultUpdatedAt = budgetalertDescUpdatedAt.Default.(func() time.Time)
+	// budgetalert.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	budgetalert.UpdateDefaultUpdatedAt = budgetalertDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// budgetalertDescName is the schema descriptor for name field.
+	budgetalertDescName := budgetalertFields[1].Descriptor()
+	// budgetalert.DefaultName holds the default value on creation for the name field.
+	budgetalert.DefaultName = budgetalertDescName.Default.(string)
+	// budgetalert.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	budgetalert.NameValidator = budgetalertDescName.Validators[0].(func(string) error)
+	// budgetalertDescMetric is the schema descriptor for metric field.
+	budgetalertDescMetric := budgetalertFields[2].Descriptor()
+	// budgetalert.MetricValidator is a validator for the "metric" field. It is called by the builders before save.
+	budgetalert.MetricValidator = budgetalertDescMetric.Validators[0].(func(string) error)
+	// budgetalertDescThresholdType is the schema descriptor for threshold_type field.
+	budgetalertDescThresholdType := budgetalertFields[3].Descriptor()
+	// budgetalert.ThresholdTypeValidator is a validator for the "threshold_type" field. It is called by the builders before save.
+	budgetalert.ThresholdTypeValidator = budgetalertDescThresholdType.Validators[0].(func(string) error)
+	// budgetalertDescNotifyEmail is the schema descriptor for notify_email field.
+	budgetalertDescNotifyEmail := budgetalertFields[7].Descriptor()
+	// budgetalert.DefaultNotifyEmail holds the default value on creation for the notify_email field.
+	budgetalert.DefaultNotifyEmail = budgetalertDescNotifyEmail.Default.(bool)
+	// budgetalertDescWebhookURL is the schema descriptor for webhook_url field.
+	budgetalertDescWebhookURL := budgetalertFields[8].Descriptor()
+	// budgetalert.WebhookURLValidator is a validator for the "webhook_url" field. It is called by the builders before save.
+	budgetalert.WebhookURLValidator = budgetalertDescWebhookURL.Validators[0].(func(string) error)
+	// budgetalertDescAutoDisableKey is the schema descriptor for auto_disable_key field.
+	budgetalertDescAutoDisableKey := budgetalertFields[9].Descriptor()
+	// budgetalert.DefaultAutoDisableKey holds the default value on creation for the auto_disable_key field.
+	budgetalert.DefaultAutoDisableKey = budgetalertDescAutoDisableKey.Default.(bool)
+	// budgetalertDescEnabled is the schema descriptor for enabled field.
+	budgetalertDescEnabled := budgetalertFields[10].Descriptor()
+	// budgetalert.DefaultEnabled holds the default value on creation for the enabled field.
+	budgetalert.DefaultEnabled = budgetalertDescEnabled.Default.(bool)
+	// budgetalertDescLastTriggeredWindow is the schema descriptor for last_triggered_window field.
+	budgetalertDescLastTriggeredWindow := budgetalertFields[11].Descriptor()
+	// budgetalert.LastTriggeredWindowValidator is a validator for the "last_triggered_window" field. It is called by the builders before save.
+	budgetalert.LastTriggeredWindowValidator = budgetalertDescLastTriggeredWindow.Validators[0].(func(string) error)
 	errorpassthroughruleMixin := schema.ErrorPassthroughRule{}.Mixin()
 	errorpassthroughruleMixinFields0 := errorpassthroughruleMixin[0].Fields()
 	_ = errorpassthroughruleMixinFields0
@@ -417,6 +571,167 @@ func init() {
 	groupDescSortOrder := groupFields[21].Descriptor()
 	// group.DefaultSortOrder holds the default value on creation for the sort_order field.
 	group.DefaultSortOrder = groupDescSortOrder.Default.(int)
+	// groupDescResponseCacheEnabled is the schema descriptor for response_cache_enabled field.
+	groupDescResponseCacheEnabled := groupFields[22].Descriptor()
+	// group.DefaultResponseCacheEnabled holds the default value on creation for the response_cache_enabled field.
+	group.DefaultResponseCacheEnabled = groupDescResponseCacheEnabled.Default.(bool)
+	// groupDescResponseCacheTTLSeconds is the schema descriptor for response_cache_ttl_seconds field.
+	groupDescResponseCacheTTLSeconds := groupFields[23].Descriptor()
+	// group.DefaultResponseCacheTTLSeconds holds the default value on creation for the response_cache_ttl_seconds field.
+	group.DefaultResponseCacheTTLSeconds = groupDescResponseCacheTTLSeconds.Default.(int)
+	// groupDescBatchDiscountMultiplier is the schema descriptor for batch_discount_multiplier field.
+	groupDescBatchDiscountMultiplier := groupFields[24].Descriptor()
+	// group.DefaultBatchDiscountMultiplier holds the default value on creation for the batch_discount_multiplier field.
+	group.DefaultBatchDiscountMultiplier = groupDescBatchDiscountMultiplier.Default.(float64)
+	// groupDescHedgeEnabled is the schema descriptor for hedge_enabled field.
+	groupDescHedgeEnabled := groupFields[25].Descriptor()
+	// group.DefaultHedgeEnabled holds the default value on creation for the hedge_enabled field.
+	group.DefaultHedgeEnabled = groupDescHedgeEnabled.Default.(bool)
+	// groupDescHedgeDelayMs is the schema descriptor for hedge_delay_ms field.
+	groupDescHedgeDelayMs := groupFields[26].Descriptor()
+	// group.DefaultHedgeDelayMs holds the default value on creation for the hedge_delay_ms field.
+	group.DefaultHedgeDelayMs = groupDescHedgeDelayMs.Default.(int)
+	// groupDescPromptCacheRoutingEnabled is the schema descriptor for prompt_cache_routing_enabled field.
+	groupDescPromptCacheRoutingEnabled := groupFields[27].Descriptor()
+	// group.DefaultPromptCacheRoutingEnabled holds the default value on creation for the prompt_cache_routing_enabled field.
+	group.DefaultPromptCacheRoutingEnabled = groupDescPromptCacheRoutingEnabled.Default.(bool)
+	// groupDescAutoCacheControlEnabled is the schema descriptor for auto_cache_control_enabled field.
+	groupDescAutoCacheControlEnabled := groupFields[28].Descriptor()
+	// group.DefaultAutoCacheControlEnabled holds the default value on creation for the auto_cache_control_enabled field.
+	group.DefaultAutoCacheControlEnabled = groupDescAutoCacheControlEnabled.Default.(bool)
+	messagebatchMixin := schema.MessageBatch{}.Mixin()
+	messagebatchMixinFields0 := messagebatchMixin[0].Fields()
+	_ = messagebatchMixinFields0
+	messagebatchFields := schema.MessageBatch{}.Fields()
+	_ = messagebatchFields
+	// messagebatchDescCreatedAt is the schema descriptor for created_at field.
+	messagebatchDescCreatedAt := messagebatchMixinFields0[0].Descriptor()
+	// messagebatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagebatch.DefaultCreatedAt = messagebatchDescCreatedAt.Default.(func() time.Time)
+	// messagebatchDescUpdatedAt is the schema descriptor for updated_at field.
+	messagebatchDescUpdatedAt := messagebatchMixinFields0[1].Descriptor()
+	// messagebatch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagebatch.DefaultUpdatedAt = messagebatchDescUpdatedAt.Default.(func() time.Time)
+	// messagebatch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagebatch.UpdateDefaultUpdatedAt = messagebatchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagebatchDescBatchID is the schema descriptor for batch_id field.
+	messagebatchDescBatchID := messagebatchFields[0].Descriptor()
+	// messagebatch.BatchIDValidator is a validator for the "batch_id" field. It is called by the builders before save.
+	messagebatch.BatchIDValidator = messagebatchDescBatchID.Validators[0].(func(string) error)
+	// messagebatchDescProcessingStatus is the schema descriptor for processing_status field.
+	messagebatchDescProcessingStatus := messagebatchFields[4].Descriptor()
+	// messagebatch.DefaultProcessingStatus holds the default value on creation for the processing_status field.
+	messagebatch.DefaultProcessingStatus = messagebatchDescProcessingStatus.Default.(string)
+	// messagebatch.ProcessingStatusValidator is a validator for the "processing_status" field. It is called by the builders before save.
+	messagebatch.ProcessingStatusValidator = messagebatchDescProcessingStatus.Validators[0].(func(string) error)
+	// messagebatchDescRequestCount is the schema descriptor for request_count field.
+	messagebatchDescRequestCount := messagebatchFields[5].Descriptor()
+	// messagebatch.DefaultRequestCount holds the default value on creation for the request_count field.
+	messagebatch.DefaultRequestCount = messagebatchDescRequestCount.Default.(int)
+	messagebatchitemMixin := schema.MessageBatchItem{}.Mixin()
+	messagebatchitemMixinFields0 := messagebatchitemMixin[0].Fields()
+	_ = messagebatchitemMixinFields0
+	messagebatchitemFields := schema.MessageBatchItem{}.Fields()
+	_ = messagebatchitemFields
+	// messagebatchitemDescCreatedAt is the schema descriptor for created_at field.
+	messagebatchitemDescCreatedAt := messagebatchitemMixinFields0[0].Descriptor()
+	// messagebatchitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagebatchitem.DefaultCreatedAt = messagebatchitemDescCreatedAt.Default.(func() time.Time)
+	// messagebatchitemDescUpdatedAt is the schema descriptor for updated_at field.
+	messagebatchitemDescUpdatedAt := messagebatchitemMixinFields0[1].Descriptor()
+	// messagebatchitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagebatchitem.DefaultUpdatedAt = messagebatchitemDescUpdatedAt.Default.(func() time.Time)
+	// messagebatchitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagebatchitem.UpdateDefaultUpdatedAt = messagebatchitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagebatchitemDescCustomID is the schema descriptor for custom_id field.
+	messagebatchitemDescCustomID := messagebatchitemFields[1].Descriptor()
+	// messagebatchitem.CustomIDValidator is a validator for the "custom_id" field. It is called by the builders before save.
+	messagebatchitem.CustomIDValidator = messagebatchitemDescCustomID.Validators[0].(func(string) error)
+	// messagebatchitemDescStatus is the schema descriptor for status field.
+	messagebatchitemDescStatus := messagebatchitemFields[3].Descriptor()
+	// messagebatchitem.DefaultStatus holds the default value on creation for the status field.
+	messagebatchitem.DefaultStatus = messagebatchitemDescStatus.Default.(string)
+	// messagebatchitem.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	messagebatchitem.StatusValidator = messagebatchitemDescStatus.Validators[0].(func(string) error)
+	// messagebatchitemDescAttempts is the schema descriptor for attempts field.
+	messagebatchitemDescAttempts := messagebatchitemFields[5].Descriptor()
+	// messagebatchitem.DefaultAttempts holds the default value on creation for the attempts field.
+	messagebatchitem.DefaultAttempts = messagebatchitemDescAttempts.Default.(int)
+	paymentorderMixin := schema.PaymentOrder{}.Mixin()
+	paymentorderMixinFields0 := paymentorderMixin[0].Fields()
+	_ = paymentorderMixinFields0
+	paymentorderFields := schema.PaymentOrder{}.Fields()
+	_ = paymentorderFields
+	// paymentorderDescCreatedAt is the schema descriptor for created_at field.
+	paymentorderDescCreatedAt := paymentorderMixinFields0[0].Descriptor()
+	// paymentorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentorder.DefaultCreatedAt = paymentorderDescCreatedAt.Default.(func() time.Time)
+	// paymentorderDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentorderDescUpdatedAt := paymentorderMixinFields0[1].Descriptor()
+	// paymentorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentorder.DefaultUpdatedAt = paymentorderDescUpdatedAt.Default.(func() time.Time)
+	// paymentorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentorder.UpdateDefaultUpdatedAt = paymentorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentorderDescOrderNo is the schema descriptor for order_no field.
+	paymentorderDescOrderNo := paymentorderFields[0].Descriptor()
+	// paymentorder.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	paymentorder.OrderNoValidator = func() func(string) error {
+		validators := paymentorderDescOrderNo.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(order_no string) error {
+			for _, fn := range fns {
+				if err := fn(order_no); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentorderDescType is the schema descriptor for type field.
+	paymentorderDescType := paymentorderFields[2].Descriptor()
+	// paymentorder.DefaultType holds the default value on creation for the type field.
+	paymentorder.DefaultType = paymentorderDescType.Default.(string)
+	// paymentorder.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	paymentorder.TypeValidator = paymentorderDescType.Validators[0].(func(string) error)
+	// paymentorderDescProvider is the schema descriptor for provider field.
+	paymentorderDescProvider := paymentorderFields[3].Descriptor()
+	// paymentorder.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	paymentorder.ProviderValidator = paymentorderDescProvider.Validators[0].(func(string) error)
+	// paymentorderDescStatus is the schema descriptor for status field.
+	paymentorderDescStatus := paymentorderFields[4].Descriptor()
+	// paymentorder.DefaultStatus holds the default value on creation for the status field.
+	paymentorder.DefaultStatus = paymentorderDescStatus.Default.(string)
+	// paymentorder.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	paymentorder.StatusValidator = paymentorderDescStatus.Validators[0].(func(string) error)
+	// paymentorderDescCurrency is the schema descriptor for currency field.
+	paymentorderDescCurrency := paymentorderFields[6].Descriptor()
+	// paymentorder.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	paymentorder.CurrencyValidator = paymentorderDescCurrency.Validators[0].(func(string) error)
+	// paymentorderDescCreditAmount is the schema descriptor for credit_amount field.
+	paymentorderDescCreditAmount := paymentorderFields[7].Descriptor()
+	// paymentorder.DefaultCreditAmount holds the default value on creation for the credit_amount field.
+	paymentorder.DefaultCreditAmount = paymentorderDescCreditAmount.Default.(float64)
+	// paymentorderDescPlanID is the schema descriptor for plan_id field.
+	paymentorderDescPlanID := paymentorderFields[8].Descriptor()
+	// paymentorder.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	paymentorder.PlanIDValidator = paymentorderDescPlanID.Validators[0].(func(string) error)
+	// paymentorderDescValidityDays is the schema descriptor for validity_days field.
+	paymentorderDescValidityDays := paymentorderFields[10].Descriptor()
+	// paymentorder.DefaultValidityDays holds the default value on creation for the validity_days field.
+	paymentorder.DefaultValidityDays = paymentorderDescValidityDays.Default.(int)
+	// paymentorderDescProviderTradeNo is the schema descriptor for provider_trade_no field.
+	paymentorderDescProviderTradeNo := paymentorderFields[12].Descriptor()
+	// paymentorder.ProviderTradeNoValidator is a validator for the "provider_trade_no" field. It is called by the builders before save.
+	paymentorder.ProviderTradeNoValidator = paymentorderDescProviderTradeNo.Validators[0].(func(string) error)
+	// paymentorderDescClientIP is the schema descriptor for client_ip field.
+	paymentorderDescClientIP := paymentorderFields[14].Descriptor()
+	// paymentorder.DefaultClientIP holds the default value on creation for the client_ip field.
+	paymentorder.DefaultClientIP = paymentorderDescClientIP.Default.(string)
+	// paymentorder.ClientIPValidator is a validator for the "client_ip" field. It is called by the builders before save.
+	paymentorder.ClientIPValidator = paymentorderDescClientIP.Validators[0].(func(string) error)
 	promocodeFields := schema.PromoCode{}.Fields()
 	_ = promocodeFields
 	// promocodeDescCode is the schema descriptor for code field.
@@ -628,6 +943,45 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	upstreamfileMixin := schema.UpstreamFile{}.Mixin()
+	upstreamfileMixinFields0 := upstreamfileMixin[0].Fields()
+	_ = upstreamfileMixinFields0
+	upstreamfileFields := schema.UpstreamFile{}.Fields()
+	_ = upstreamfileFields
+	// upstreamfileDescCreatedAt is the schema descriptor for created_at field.
+	upstreamfileDescCreatedAt := upstreamfileMixinFields0[0].Descriptor()
+	// upstreamfile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	upstreamfile.DefaultCreatedAt = upstreamfileDescCreatedAt.Default.(func() time.Time)
+	// upstreamfileDescUpdatedAt is the schema descriptor for updated_at field.
+	upstreamfileDescUpdatedAt := upstreamfileMixinFields0[1].Descriptor()
+	// upstreamfile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	upstreamfile.DefaultUpdatedAt = upstreamfileDescUpdatedAt.Default.(func() time.Time)
+	// upstreamfile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	upstreamfile.UpdateDefaultUpdatedAt = upstreamfileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// upstreamfileDescFileID is the schema descriptor for file_id field.
+	upstreamfileDescFileID := upstreamfileFields[0].Descriptor()
+	// upstreamfile.FileIDValidator is a validator for the "file_id" field. It is called by the builders before save.
+	upstreamfile.FileIDValidator = upstreamfileDescFileID.Validators[0].(func(string) error)
+	// upstreamfileDescFilename is the schema descriptor for filename field.
+	upstreamfileDescFilename := upstreamfileFields[4].Descriptor()
+	// upstreamfile.DefaultFilename holds the default value on creation for the filename field.
+	upstreamfile.DefaultFilename = upstreamfileDescFilename.Default.(string)
+	// upstreamfile.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	upstreamfile.FilenameValidator = upstreamfileDescFilename.Validators[0].(func(string) error)
+	// upstreamfileDescMimeType is the schema descriptor for mime_type field.
+	upstreamfileDescMimeType := upstreamfileFields[5].Descriptor()
+	// upstreamfile.DefaultMimeType holds the default value on creation for the mime_type field.
+	upstreamfile.DefaultMimeType = upstreamfileDescMimeType.Default.(string)
+	// upstreamfile.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	upstreamfile.MimeTypeValidator = upstreamfileDescMimeType.Validators[0].(func(string) error)
+	// upstreamfileDescSizeBytes is the schema descriptor for size_bytes field.
+	upstreamfileDescSizeBytes := upstreamfileFields[6].Descriptor()
+	// upstreamfile.DefaultSizeBytes holds the default value on creation for the size_bytes field.
+	upstreamfile.DefaultSizeBytes = upstreamfileDescSizeBytes.Default.(int64)
+	// upstreamfileDescDownloadable is the schema descriptor for downloadable field.
+	upstreamfileDescDownloadable := upstreamfileFields[7].Descriptor()
+	// upstreamfile.DefaultDownloadable holds the default value on creation for the downloadable field.
+	upstreamfile.DefaultDownloadable = upstreamfileDescDownloadable.Default.(bool)
 	usagecleanuptaskMixin := schema.UsageCleanupTask{}.Mixin()
 	usagecleanuptaskMixinFields0 := usagecleanuptaskMixin[0].Fields()
 	_ = usagecleanuptaskMixinFields0
@@ -783,8 +1137,18 @@ func init() {
 	usagelogDescCacheTTLOverridden := usagelogFields[29].Descriptor()
 	// usagelog.DefaultCacheTTLOverridden holds the default value on creation for the cache_ttl_overridden field.
 	usagelog.DefaultCacheTTLOverridden = usagelogDescCacheTTLOverridden.Default.(bool)
+	// usagelogDescResponseCacheHit is the schema descriptor for response_cache_hit field.
+	usagelogDescResponseCacheHit := usagelogFields[30].Descriptor()
+	// usagelog.DefaultResponseCacheHit holds the default value on creation for the response_cache_hit field.
+	usagelog.DefaultResponseCacheHit = usagelogDescResponseCacheHit.Default.(bool)
+	// usagelogDescRequestType is the schema descriptor for request_type field.
+	usagelogDescRequestType := usagelogFields[31].Descriptor()
+	// usagelog.DefaultRequestType holds the default value on creation for the request_type field.
+	usagelog.DefaultRequestType = usagelogDescRequestType.Default.(string)
+	// usagelog.RequestTypeValidator is a validator for the "request_type" field. It is called by the builders before save.
+	usagelog.RequestTypeValidator = usagelogDescRequestType.Validators[0].(func(string) error)
 	// usagelogDescCreatedAt is the schema descriptor for created_at field.
-	usagelogDescCreatedAt := usagelogFields[30].Descriptor()
+	usagelogDescCreatedAt := usagelogFields[32].Descriptor()
 	// usagelog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	usagelog.DefaultCreatedAt = usagelogDescCreatedAt.Default.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
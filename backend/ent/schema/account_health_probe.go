@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountHealthProbe 定义账号定时健康探测记录的 schema。
+//
+// 每条记录对应后台探测任务对一个账号发起的一次最小请求。
+// 连续失败/成功次数由最近的探测记录推导，用于自动隔离与恢复账号。
+// 这是一个只追加的表，仅由保留期清理删除。
+type AccountHealthProbe struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (AccountHealthProbe) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "account_health_probes"},
+	}
+}
+
+// Fields 定义健康探测记录的所有字段。
+func (AccountHealthProbe) Fields() []ent.Field {
+	return []ent.Field{
+		// 不建外键：账号删除后历史记录随保留期自然清理
+		field.Int64("account_id"),
+		field.String("platform").
+			MaxLen(50),
+		field.String("model").
+			MaxLen(100).
+			Default(""),
+		// result: success / failure / rate_limited（限流不计入连续失败）
+		field.String("result").
+			MaxLen(20),
+		field.Int("status_code").
+			Default(0),
+		field.Int64("latency_ms").
+			Default(0),
+		field.String("error_message").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// action: 本次探测触发的状态变更（quarantined / recovered），无变更为空
+		field.String("action").
+			MaxLen(20).
+			Default(""),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (AccountHealthProbe) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -70,6 +70,20 @@ func (APIKey) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Expiration time for this API key (null = never expires)"),
+
+		// ========== Request limit fields (added by migration 061) ==========
+		field.JSON("allowed_models", []string{}).
+			Optional().
+			Comment("Allowed model glob patterns, e.g. [\"claude-sonnet-*\"] (empty = all models)"),
+		field.Int("rate_limit_rpm").
+			Default(0).
+			Comment("Max requests per minute (0 = unlimited)"),
+		field.Int("rate_limit_tpm").
+			Default(0).
+			Comment("Max tokens per minute (0 = unlimited)"),
+		field.Int("daily_request_limit").
+			Default(0).
+			Comment("Max requests per day (0 = unlimited)"),
 	}
 }
 
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog 定义管理员操作审计日志的 schema。
+//
+// 每条记录对应一次管理后台的变更请求（POST/PUT/PATCH/DELETE），
+// 包含操作者、来源 IP、路由、目标实体以及脱敏后的变更前后差异。
+// 这是一个只追加的表，仅由保留期清理任务删除。
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_logs"},
+	}
+}
+
+// Fields 定义审计日志实体的所有字段。
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// 操作者：JWT 登录的管理员，或 Admin API Key（归属到首个管理员）
+		field.Int64("actor_user_id").
+			Optional().
+			Nillable(),
+		// auth_method: jwt / admin_api_key
+		field.String("auth_method").
+			MaxLen(20).
+			Default(""),
+		field.String("ip_address").
+			MaxLen(45).
+			Default(""),
+		field.String("user_agent").
+			MaxLen(512).
+			Default(""),
+
+		// 请求信息：route 为路由模板（如 /api/v1/admin/users/:id/balance）
+		field.String("method").
+			MaxLen(10),
+		field.String("route").
+			MaxLen(255),
+		field.String("path").
+			MaxLen(512),
+		field.Int("status_code").
+			Default(0),
+
+		// 目标实体：target_type 取自路由资源段（users/accounts/groups/settings...）
+		field.String("target_type").
+			MaxLen(50).
+			Default(""),
+		field.String("target_id").
+			MaxLen(100).
+			Default(""),
+
+		// changes: 脱敏后的字段级差异 {"path": {"before": ..., "after": ...}}
+		field.JSON("changes", map[string]any{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// request_body: 脱敏并截断后的请求体（JSON 文本）
+		field.String("request_body").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		// 时间戳（只有 created_at，日志不可修改）
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_user_id", "created_at"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("route"),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BudgetAlert holds the schema definition for the BudgetAlert entity.
+//
+// 用户自定义的预算告警规则：余额、API Key 额度、订阅日/周/月窗口达到阈值时
+// 通过邮件/Webhook 通知，可选自动禁用指定 API Key。
+// last_triggered_window 记录已通知的窗口，用于多实例下的按窗口去重。
+//
+// 删除策略：硬删除
+type BudgetAlert struct {
+	ent.Schema
+}
+
+func (BudgetAlert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "budget_alerts"},
+	}
+}
+
+func (BudgetAlert) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (BudgetAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.String("name").
+			MaxLen(100).
+			Default(""),
+		field.String("metric").
+			MaxLen(32).
+			Comment("监控指标: balance, api_key_quota, subscription_daily, subscription_weekly, subscription_monthly"),
+		field.String("threshold_type").
+			MaxLen(20).
+			Comment("阈值类型: remaining_usd, percent_used"),
+		field.Float("threshold").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Int64("api_key_id").
+			Optional().
+			Nillable().
+			Comment("api_key_quota 监控的 Key；也是 auto_disable_key 的禁用目标"),
+		field.Int64("subscription_id").
+			Optional().
+			Nillable().
+			Comment("subscription_* 监控的订阅"),
+
+		// 通知与动作
+		field.Bool("notify_email").
+			Default(true),
+		field.String("webhook_url").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+		field.Bool("auto_disable_key").
+			Default(false),
+		field.Bool("enabled").
+			Default(true),
+
+		// 去重状态
+		field.String("last_triggered_window").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+		field.Time("last_triggered_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (BudgetAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("enabled"),
+	}
+}
@@ -126,6 +126,38 @@ func (Group) Fields() []ent.Field {
 		field.Int("sort_order").
 			Default(0).
 			Comment("分组显示排序，数值越小越靠前"),
+
+		// 精确响应缓存 (added by migration 060)
+		field.Bool("response_cache_enabled").
+			Default(false).
+			Comment("是否对确定性请求启用精确响应缓存"),
+		field.Int("response_cache_ttl_seconds").
+			Default(0).
+			Comment("响应缓存 TTL（秒），0 表示使用全局默认值"),
+
+		// Message Batches 计费折扣
+		field.Float("batch_discount_multiplier").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}).
+			Default(1.0).
+			Comment("批处理请求按正常费用的该比例计费，1 表示不打折"),
+
+		// 对冲请求（首字节超时后向第二个账号发起同一请求）
+		field.Bool("hedge_enabled").
+			Default(false).
+			Comment("是否对流式请求启用对冲"),
+		field.Int("hedge_delay_ms").
+			Default(0).
+			Comment("发起对冲请求前等待首字节的时间（毫秒），0 表示按滚动 p95 首字时间自动计算"),
+
+		// Prompt Cache 前缀路由
+		field.Bool("prompt_cache_routing_enabled").
+			Default(false).
+			Comment("是否按 cache_control 前缀将请求路由到最近服务过该前缀的账号"),
+
+		// 自动插入 cache_control 断点
+		field.Bool("auto_cache_control_enabled").
+			Default(false).
+			Comment("是否为未设置 cache_control 的请求自动插入缓存断点"),
 	}
 }
 
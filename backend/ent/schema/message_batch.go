@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageBatch holds the schema definition for the MessageBatch entity.
+//
+// Anthropic Message Batches API 的批次：由本地 worker 池逐条执行
+// message_batch_items 中的请求，全部结束（或取消/过期）后进入 ended 状态。
+//
+// 删除策略：硬删除（连同批次内请求）
+type MessageBatch struct {
+	ent.Schema
+}
+
+func (MessageBatch) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "message_batches"},
+	}
+}
+
+func (MessageBatch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (MessageBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("batch_id").
+			MaxLen(64).
+			Unique().
+			Comment("对外暴露的批次 ID（msgbatch_ 前缀）"),
+		field.Int64("user_id"),
+		field.Int64("api_key_id"),
+		field.Int64("group_id").
+			Optional().
+			Nillable(),
+		field.String("processing_status").
+			MaxLen(20).
+			Default("in_progress").
+			Comment("处理状态: in_progress, canceling, ended"),
+		field.Int("request_count").
+			Default(0),
+		field.Time("expires_at").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("cancel_initiated_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (MessageBatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "id"),
+		index.Fields("processing_status"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageBatchItem holds the schema definition for the MessageBatchItem entity.
+//
+// 批次中的单条 Messages 请求，同时作为 worker 池的任务队列：
+// pending 且 next_attempt_at 已到期的记录会被 worker 抢占为 processing。
+//
+// 删除策略：随批次硬删除
+type MessageBatchItem struct {
+	ent.Schema
+}
+
+func (MessageBatchItem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "message_batch_items"},
+	}
+}
+
+func (MessageBatchItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (MessageBatchItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("batch_id").
+			Comment("message_batches.id"),
+		field.String("custom_id").
+			MaxLen(64),
+		field.String("params").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Comment("Messages 请求体（JSON）"),
+		field.String("status").
+			MaxLen(20).
+			Default("pending").
+			Comment("状态: pending, processing, succeeded, errored, canceled, expired"),
+		field.String("result").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Comment("成功时为 message 对象，失败时为 error 对象（JSON）"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (MessageBatchItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("batch_id", "custom_id").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentOrder holds the schema definition for the PaymentOrder entity.
+//
+// 在线支付订单：余额充值或订阅套餐购买。
+// 订单状态流转：pending -> paid -> refunded，或 pending -> voided。
+// 状态更新均带条件（WHERE status = ?），保证回调幂等与并发安全。
+//
+// 删除策略：不删除（财务记录）
+type PaymentOrder struct {
+	ent.Schema
+}
+
+func (PaymentOrder) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "orders"},
+	}
+}
+
+func (PaymentOrder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (PaymentOrder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("order_no").
+			MaxLen(32).
+			NotEmpty().
+			Unique().
+			Comment("商户订单号"),
+		field.Int64("user_id"),
+		field.String("type").
+			MaxLen(20).
+			Default(domain.PaymentOrderTypeBalance).
+			Comment("订单类型: balance, subscription"),
+		field.String("provider").
+			MaxLen(20).
+			Comment("支付渠道: epay, stripe"),
+		field.String("status").
+			MaxLen(20).
+			Default(domain.PaymentOrderStatusPending).
+			Comment("状态: pending, paid, refunding, refunded, voided"),
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Comment("支付金额（支付币种）"),
+		field.String("currency").
+			MaxLen(10),
+		field.Float("credit_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0).
+			Comment("到账余额（USD），仅余额充值订单"),
+
+		// 订阅套餐订单
+		field.String("plan_id").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+		field.Int64("group_id").
+			Optional().
+			Nillable(),
+		field.Int("validity_days").
+			Default(0),
+		field.Int64("subscription_id").
+			Optional().
+			Nillable().
+			Comment("支付成功后分配/续期的订阅 ID"),
+
+		// 支付渠道信息
+		field.String("provider_trade_no").
+			MaxLen(128).
+			Optional().
+			Nillable().
+			Comment("渠道交易号"),
+		field.String("pay_url").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("client_ip").
+			MaxLen(45).
+			Default(""),
+		field.String("notes").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		field.Time("paid_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("refunded_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("voided_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (PaymentOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		// order_no 字段已在 Fields() 中声明 Unique()，无需重复索引
+		index.Fields("user_id", "created_at"),
+		index.Fields("status"),
+		index.Fields("provider", "provider_trade_no"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UpstreamFile holds the schema definition for the UpstreamFile entity.
+//
+// 通过 /v1/files 上传到上游账号的文件：file_id 只在接收上传的账号上有效，
+// 记录 file_id → account 的映射用于固定调度，并按用户统计存储配额。
+//
+// 删除策略：硬删除（上游删除成功后移除）
+type UpstreamFile struct {
+	ent.Schema
+}
+
+func (UpstreamFile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "upstream_files"},
+	}
+}
+
+func (UpstreamFile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+func (UpstreamFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_id").
+			MaxLen(128).
+			Unique().
+			Comment("上游返回的文件 ID"),
+		field.Int64("user_id"),
+		field.Int64("api_key_id"),
+		field.Int64("account_id").
+			Comment("持有该文件的上游账号"),
+		field.String("filename").
+			MaxLen(512).
+			Default(""),
+		field.String("mime_type").
+			MaxLen(255).
+			Default(""),
+		field.Int64("size_bytes").
+			Default(0),
+		field.Bool("downloadable").
+			Default(false),
+	}
+}
+
+func (UpstreamFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "id"),
+		index.Fields("account_id"),
+	}
+}
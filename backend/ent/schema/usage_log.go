@@ -123,6 +123,15 @@ func (UsageLog) Fields() []ent.Field {
 		field.Bool("cache_ttl_overridden").
 			Default(false),
 
+		// 精确响应缓存命中标记（命中时未请求上游，按折扣计费）
+		field.Bool("response_cache_hit").
+			Default(false),
+
+		// 请求类型标记（空表示常规对话/生成请求，embedding 表示向量请求）
+		field.String("request_type").
+			MaxLen(20).
+			Default(""),
+
 		// 时间戳（只有 created_at，日志不可修改）
 		field.Time("created_at").
 			Default(time.Now).
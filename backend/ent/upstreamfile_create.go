@@ -0,0 +1,1077 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/upstreamfile"
+)
+
+// UpstreamFileCreate is the builder for creating a UpstreamFile entity.
+type UpstreamFileCreate struct {
+	config
+	mutation *UpstreamFileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UpstreamFileCreate) SetCreatedAt(v time.Time) *UpstreamFileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableCreatedAt(v *time.Time) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UpstreamFileCreate) SetUpdatedAt(v time.Time) *UpstreamFileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableUpdatedAt(v *time.Time) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *UpstreamFileCreate) SetFileID(v string) *UpstreamFileCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UpstreamFileCreate) SetUserID(v int64) *UpstreamFileCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *UpstreamFileCreate) SetAPIKeyID(v int64) *UpstreamFileCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *UpstreamFileCreate) SetAccountID(v int64) *UpstreamFileCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *UpstreamFileCreate) SetFilename(v string) *UpstreamFileCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableFilename(v *string) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetFilename(*v)
+	}
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *UpstreamFileCreate) SetMimeType(v string) *UpstreamFileCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableMimeType(v *string) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *UpstreamFileCreate) SetSizeBytes(v int64) *UpstreamFileCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableSizeBytes(v *int64) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (_c *UpstreamFileCreate) SetDownloadable(v bool) *UpstreamFileCreate {
+	_c.mutation.SetDownloadable(v)
+	return _c
+}
+
+// SetNillableDownloadable sets the "downloadable" field if the given value is not nil.
+func (_c *UpstreamFileCreate) SetNillableDownloadable(v *bool) *UpstreamFileCreate {
+	if v != nil {
+		_c.SetDownloadable(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UpstreamFileMutation object of the builder.
+func (_c *UpstreamFileCreate) Mutation() *UpstreamFileMutation {
+	return _c.mutation
+}
+
+// Save creates the UpstreamFile in the database.
+func (_c *UpstreamFileCreate) Save(ctx context.Context) (*UpstreamFile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UpstreamFileCreate) SaveX(ctx context.Context) *UpstreamFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UpstreamFileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UpstreamFileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UpstreamFileCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := upstreamfile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := upstreamfile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		v := upstreamfile.DefaultFilename
+		_c.mutation.SetFilename(v)
+	}
+	if _, ok := _c.mutation.MimeType(); !ok {
+		v := upstreamfile.DefaultMimeType
+		_c.mutation.SetMimeType(v)
+	}
+	if _, ok := _c.mutation.SizeBytes(); !ok {
+		v := upstreamfile.DefaultSizeBytes
+		_c.mutation.SetSizeBytes(v)
+	}
+	if _, ok := _c.mutation.Downloadable(); !ok {
+		v := upstreamfile.DefaultDownloadable
+		_c.mutation.SetDownloadable(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UpstreamFileCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UpstreamFile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UpstreamFile.updated_at"`)}
+	}
+	if _, ok := _c.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "UpstreamFile.file_id"`)}
+	}
+	if v, ok := _c.mutation.FileID(); ok {
+		if err := upstreamfile.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.file_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UpstreamFile.user_id"`)}
+	}
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "UpstreamFile.api_key_id"`)}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "UpstreamFile.account_id"`)}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "UpstreamFile.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := upstreamfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`ent: missing required field "UpstreamFile.mime_type"`)}
+	}
+	if v, ok := _c.mutation.MimeType(); ok {
+		if err := upstreamfile.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.mime_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SizeBytes(); !ok {
+		return &ValidationError{Name: "size_bytes", err: errors.New(`ent: missing required field "UpstreamFile.size_bytes"`)}
+	}
+	if _, ok := _c.mutation.Downloadable(); !ok {
+		return &ValidationError{Name: "downloadable", err: errors.New(`ent: missing required field "UpstreamFile.downloadable"`)}
+	}
+	return nil
+}
+
+func (_c *UpstreamFileCreate) sqlSave(ctx context.Context) (*UpstreamFile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UpstreamFileCreate) createSpec() (*UpstreamFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UpstreamFile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(upstreamfile.Table, sqlgraph.NewFieldSpec(upstreamfile.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(upstreamfile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(upstreamfile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FileID(); ok {
+		_spec.SetField(upstreamfile.FieldFileID, field.TypeString, value)
+		_node.FileID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(upstreamfile.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(upstreamfile.FieldAPIKeyID, field.TypeInt64, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(upstreamfile.FieldAccountID, field.TypeInt64, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(upstreamfile.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(upstreamfile.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(upstreamfile.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := _c.mutation.Downloadable(); ok {
+		_spec.SetField(upstreamfile.FieldDownloadable, field.TypeBool, value)
+		_node.Downloadable = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UpstreamFile.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UpstreamFileUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UpstreamFileCreate) OnConflict(opts ...sql.ConflictOption) *UpstreamFileUpsertOne {
+	_c.conflict = opts
+	return &UpstreamFileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UpstreamFileCreate) OnConflictColumns(columns ...string) *UpstreamFileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UpstreamFileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UpstreamFileUpsertOne is the builder for "upsert"-ing
+	//  one UpstreamFile node.
+	UpstreamFileUpsertOne struct {
+		create *UpstreamFileCreate
+	}
+
+	// UpstreamFileUpsert is the "OnConflict" setter.
+	UpstreamFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UpstreamFileUpsert) SetUpdatedAt(v time.Time) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateUpdatedAt() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldUpdatedAt)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *UpstreamFileUpsert) SetFileID(v string) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateFileID() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldFileID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UpstreamFileUpsert) SetUserID(v int64) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateUserID() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UpstreamFileUpsert) AddUserID(v int64) *UpstreamFileUpsert {
+	u.Add(upstreamfile.FieldUserID, v)
+	return u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *UpstreamFileUpsert) SetAPIKeyID(v int64) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldAPIKeyID, v)
+	return u
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateAPIKeyID() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldAPIKeyID)
+	return u
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *UpstreamFileUpsert) AddAPIKeyID(v int64) *UpstreamFileUpsert {
+	u.Add(upstreamfile.FieldAPIKeyID, v)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UpstreamFileUpsert) SetAccountID(v int64) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateAccountID() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldAccountID)
+	return u
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *UpstreamFileUpsert) AddAccountID(v int64) *UpstreamFileUpsert {
+	u.Add(upstreamfile.FieldAccountID, v)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *UpstreamFileUpsert) SetFilename(v string) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateFilename() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldFilename)
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *UpstreamFileUpsert) SetMimeType(v string) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldMimeType, v)
+	return u
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateMimeType() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldMimeType)
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *UpstreamFileUpsert) SetSizeBytes(v int64) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateSizeBytes() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *UpstreamFileUpsert) AddSizeBytes(v int64) *UpstreamFileUpsert {
+	u.Add(upstreamfile.FieldSizeBytes, v)
+	return u
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (u *UpstreamFileUpsert) SetDownloadable(v bool) *UpstreamFileUpsert {
+	u.Set(upstreamfile.FieldDownloadable, v)
+	return u
+}
+
+// UpdateDownloadable sets the "downloadable" field to the value that was provided on create.
+func (u *UpstreamFileUpsert) UpdateDownloadable() *UpstreamFileUpsert {
+	u.SetExcluded(upstreamfile.FieldDownloadable)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UpstreamFileUpsertOne) UpdateNewValues() *UpstreamFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(upstreamfile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UpstreamFileUpsertOne) Ignore() *UpstreamFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UpstreamFileUpsertOne) DoNothing() *UpstreamFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UpstreamFileCreate.OnConflict
+// documentation for more info.
+func (u *UpstreamFileUpsertOne) Update(set func(*UpstreamFileUpsert)) *UpstreamFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UpstreamFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UpstreamFileUpsertOne) SetUpdatedAt(v time.Time) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateUpdatedAt() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *UpstreamFileUpsertOne) SetFileID(v string) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateFileID() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UpstreamFileUpsertOne) SetUserID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UpstreamFileUpsertOne) AddUserID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateUserID() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *UpstreamFileUpsertOne) SetAPIKeyID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *UpstreamFileUpsertOne) AddAPIKeyID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateAPIKeyID() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UpstreamFileUpsertOne) SetAccountID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *UpstreamFileUpsertOne) AddAccountID(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateAccountID() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *UpstreamFileUpsertOne) SetFilename(v string) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateFilename() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *UpstreamFileUpsertOne) SetMimeType(v string) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateMimeType() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *UpstreamFileUpsertOne) SetSizeBytes(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *UpstreamFileUpsertOne) AddSizeBytes(v int64) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateSizeBytes() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (u *UpstreamFileUpsertOne) SetDownloadable(v bool) *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetDownloadable(v)
+	})
+}
+
+// UpdateDownloadable sets the "downloadable" field to the value that was provided on create.
+func (u *UpstreamFileUpsertOne) UpdateDownloadable() *UpstreamFileUpsertOne {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateDownloadable()
+	})
+}
+
+// Exec executes the query.
+func (u *UpstreamFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UpstreamFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UpstreamFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UpstreamFileUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UpstreamFileUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UpstreamFileCreateBulk is the builder for creating many UpstreamFile entities in bulk.
+type UpstreamFileCreateBulk struct {
+	config
+	err      error
+	builders []*UpstreamFileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UpstreamFile entities in the database.
+func (_c *UpstreamFileCreateBulk) Save(ctx context.Context) ([]*UpstreamFile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UpstreamFile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UpstreamFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UpstreamFileCreateBulk) SaveX(ctx context.Context) []*UpstreamFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UpstreamFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UpstreamFileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UpstreamFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UpstreamFileUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UpstreamFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *UpstreamFileUpsertBulk {
+	_c.conflict = opts
+	return &UpstreamFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UpstreamFileCreateBulk) OnConflictColumns(columns ...string) *UpstreamFileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UpstreamFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// UpstreamFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of UpstreamFile nodes.
+type UpstreamFileUpsertBulk struct {
+	create *UpstreamFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UpstreamFileUpsertBulk) UpdateNewValues() *UpstreamFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(upstreamfile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UpstreamFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UpstreamFileUpsertBulk) Ignore() *UpstreamFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UpstreamFileUpsertBulk) DoNothing() *UpstreamFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UpstreamFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *UpstreamFileUpsertBulk) Update(set func(*UpstreamFileUpsert)) *UpstreamFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UpstreamFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UpstreamFileUpsertBulk) SetUpdatedAt(v time.Time) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateUpdatedAt() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *UpstreamFileUpsertBulk) SetFileID(v string) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateFileID() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UpstreamFileUpsertBulk) SetUserID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UpstreamFileUpsertBulk) AddUserID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateUserID() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *UpstreamFileUpsertBulk) SetAPIKeyID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *UpstreamFileUpsertBulk) AddAPIKeyID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateAPIKeyID() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *UpstreamFileUpsertBulk) SetAccountID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// AddAccountID adds v to the "account_id" field.
+func (u *UpstreamFileUpsertBulk) AddAccountID(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateAccountID() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *UpstreamFileUpsertBulk) SetFilename(v string) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateFilename() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *UpstreamFileUpsertBulk) SetMimeType(v string) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateMimeType() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *UpstreamFileUpsertBulk) SetSizeBytes(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *UpstreamFileUpsertBulk) AddSizeBytes(v int64) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateSizeBytes() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (u *UpstreamFileUpsertBulk) SetDownloadable(v bool) *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.SetDownloadable(v)
+	})
+}
+
+// UpdateDownloadable sets the "downloadable" field to the value that was provided on create.
+func (u *UpstreamFileUpsertBulk) UpdateDownloadable() *UpstreamFileUpsertBulk {
+	return u.Update(func(s *UpstreamFileUpsert) {
+		s.UpdateDownloadable()
+	})
+}
+
+// Exec executes the query.
+func (u *UpstreamFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UpstreamFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UpstreamFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UpstreamFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/upstreamfile"
+)
+
+// UpstreamFileUpdate is the builder for updating UpstreamFile entities.
+type UpstreamFileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UpstreamFileMutation
+}
+
+// Where appends a list predicates to the UpstreamFileUpdate builder.
+func (_u *UpstreamFileUpdate) Where(ps ...predicate.UpstreamFile) *UpstreamFileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UpstreamFileUpdate) SetUpdatedAt(v time.Time) *UpstreamFileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *UpstreamFileUpdate) SetFileID(v string) *UpstreamFileUpdate {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableFileID(v *string) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UpstreamFileUpdate) SetUserID(v int64) *UpstreamFileUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableUserID(v *int64) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UpstreamFileUpdate) AddUserID(v int64) *UpstreamFileUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *UpstreamFileUpdate) SetAPIKeyID(v int64) *UpstreamFileUpdate {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableAPIKeyID(v *int64) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *UpstreamFileUpdate) AddAPIKeyID(v int64) *UpstreamFileUpdate {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *UpstreamFileUpdate) SetAccountID(v int64) *UpstreamFileUpdate {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableAccountID(v *int64) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *UpstreamFileUpdate) AddAccountID(v int64) *UpstreamFileUpdate {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *UpstreamFileUpdate) SetFilename(v string) *UpstreamFileUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableFilename(v *string) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *UpstreamFileUpdate) SetMimeType(v string) *UpstreamFileUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableMimeType(v *string) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *UpstreamFileUpdate) SetSizeBytes(v int64) *UpstreamFileUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableSizeBytes(v *int64) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *UpstreamFileUpdate) AddSizeBytes(v int64) *UpstreamFileUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (_u *UpstreamFileUpdate) SetDownloadable(v bool) *UpstreamFileUpdate {
+	_u.mutation.SetDownloadable(v)
+	return _u
+}
+
+// SetNillableDownloadable sets the "downloadable" field if the given value is not nil.
+func (_u *UpstreamFileUpdate) SetNillableDownloadable(v *bool) *UpstreamFileUpdate {
+	if v != nil {
+		_u.SetDownloadable(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UpstreamFileMutation object of the builder.
+func (_u *UpstreamFileUpdate) Mutation() *UpstreamFileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UpstreamFileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UpstreamFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UpstreamFileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UpstreamFileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UpstreamFileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := upstreamfile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UpstreamFileUpdate) check() error {
+	if v, ok := _u.mutation.FileID(); ok {
+		if err := upstreamfile.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.file_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := upstreamfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeType(); ok {
+		if err := upstreamfile.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.mime_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UpstreamFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(upstreamfile.Table, upstreamfile.Columns, sqlgraph.NewFieldSpec(upstreamfile.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(upstreamfile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(upstreamfile.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(upstreamfile.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(upstreamfile.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(upstreamfile.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(upstreamfile.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(upstreamfile.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(upstreamfile.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(upstreamfile.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(upstreamfile.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(upstreamfile.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(upstreamfile.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Downloadable(); ok {
+		_spec.SetField(upstreamfile.FieldDownloadable, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upstreamfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UpstreamFileUpdateOne is the builder for updating a single UpstreamFile entity.
+type UpstreamFileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UpstreamFileMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UpstreamFileUpdateOne) SetUpdatedAt(v time.Time) *UpstreamFileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *UpstreamFileUpdateOne) SetFileID(v string) *UpstreamFileUpdateOne {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableFileID(v *string) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UpstreamFileUpdateOne) SetUserID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableUserID(v *int64) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UpstreamFileUpdateOne) AddUserID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *UpstreamFileUpdateOne) SetAPIKeyID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableAPIKeyID(v *int64) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *UpstreamFileUpdateOne) AddAPIKeyID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *UpstreamFileUpdateOne) SetAccountID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableAccountID(v *int64) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *UpstreamFileUpdateOne) AddAccountID(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *UpstreamFileUpdateOne) SetFilename(v string) *UpstreamFileUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableFilename(v *string) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *UpstreamFileUpdateOne) SetMimeType(v string) *UpstreamFileUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableMimeType(v *string) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *UpstreamFileUpdateOne) SetSizeBytes(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableSizeBytes(v *int64) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *UpstreamFileUpdateOne) AddSizeBytes(v int64) *UpstreamFileUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetDownloadable sets the "downloadable" field.
+func (_u *UpstreamFileUpdateOne) SetDownloadable(v bool) *UpstreamFileUpdateOne {
+	_u.mutation.SetDownloadable(v)
+	return _u
+}
+
+// SetNillableDownloadable sets the "downloadable" field if the given value is not nil.
+func (_u *UpstreamFileUpdateOne) SetNillableDownloadable(v *bool) *UpstreamFileUpdateOne {
+	if v != nil {
+		_u.SetDownloadable(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UpstreamFileMutation object of the builder.
+func (_u *UpstreamFileUpdateOne) Mutation() *UpstreamFileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UpstreamFileUpdate builder.
+func (_u *UpstreamFileUpdateOne) Where(ps ...predicate.UpstreamFile) *UpstreamFileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UpstreamFileUpdateOne) Select(field string, fields ...string) *UpstreamFileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UpstreamFile entity.
+func (_u *UpstreamFileUpdateOne) Save(ctx context.Context) (*UpstreamFile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UpstreamFileUpdateOne) SaveX(ctx context.Context) *UpstreamFile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UpstreamFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UpstreamFileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UpstreamFileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := upstreamfile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UpstreamFileUpdateOne) check() error {
+	if v, ok := _u.mutation.FileID(); ok {
+		if err := upstreamfile.FileIDValidator(v); err != nil {
+			return &ValidationError{Name: "file_id", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.file_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := upstreamfile.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeType(); ok {
+		if err := upstreamfile.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "UpstreamFile.mime_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UpstreamFileUpdateOne) sqlSave(ctx context.Context) (_node *UpstreamFile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(upstreamfile.Table, upstreamfile.Columns, sqlgraph.NewFieldSpec(upstreamfile.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UpstreamFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, upstreamfile.FieldID)
+		for _, f := range fields {
+			if !upstreamfile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != upstreamfile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(upstreamfile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(upstreamfile.FieldFileID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(upstreamfile.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(upstreamfile.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(upstreamfile.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(upstreamfile.FieldAPIKeyID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(upstreamfile.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(upstreamfile.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(upstreamfile.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(upstreamfile.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(upstreamfile.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(upstreamfile.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Downloadable(); ok {
+		_spec.SetField(upstreamfile.FieldDownloadable, field.TypeBool, value)
+	}
+	_node = &UpstreamFile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{upstreamfile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -74,6 +74,10 @@ const (
 	FieldImageSize = "image_size"
 	// FieldCacheTTLOverridden holds the string denoting the cache_ttl_overridden field in the database.
 	FieldCacheTTLOverridden = "cache_ttl_overridden"
+	// FieldResponseCacheHit holds the string denoting the response_cache_hit field in the database.
+	FieldResponseCacheHit = "response_cache_hit"
+	// FieldRequestType holds the string denoting the request_type field in the database.
+	FieldRequestType = "request_type"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -158,6 +162,8 @@ var Columns = []string{
 	FieldImageCount,
 	FieldImageSize,
 	FieldCacheTTLOverridden,
+	FieldResponseCacheHit,
+	FieldRequestType,
 	FieldCreatedAt,
 }
 
@@ -216,6 +222,12 @@ var (
 	ImageSizeValidator func(string) error
 	// DefaultCacheTTLOverridden holds the default value on creation for the "cache_ttl_overridden" field.
 	DefaultCacheTTLOverridden bool
+	// DefaultResponseCacheHit holds the default value on creation for the "response_cache_hit" field.
+	DefaultResponseCacheHit bool
+	// DefaultRequestType holds the default value on creation for the "request_type" field.
+	DefaultRequestType string
+	// RequestTypeValidator is a validator for the "request_type" field. It is called by the builders before save.
+	RequestTypeValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -378,6 +390,16 @@ func ByCacheTTLOverridden(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCacheTTLOverridden, opts...).ToFunc()
 }
 
+// ByResponseCacheHit orders the results by the response_cache_hit field.
+func ByResponseCacheHit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseCacheHit, opts...).ToFunc()
+}
+
+// ByRequestType orders the results by the request_type field.
+func ByRequestType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestType, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
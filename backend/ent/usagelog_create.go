@@ -407,6 +407,34 @@ func (_c *UsageLogCreate) SetNillableCacheTTLOverridden(v *bool) *UsageLogCreate
 	return _c
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (_c *UsageLogCreate) SetResponseCacheHit(v bool) *UsageLogCreate {
+	_c.mutation.SetResponseCacheHit(v)
+	return _c
+}
+
+// SetNillableResponseCacheHit sets the "response_cache_hit" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableResponseCacheHit(v *bool) *UsageLogCreate {
+	if v != nil {
+		_c.SetResponseCacheHit(*v)
+	}
+	return _c
+}
+
+// SetRequestType sets the "request_type" field.
+func (_c *UsageLogCreate) SetRequestType(v string) *UsageLogCreate {
+	_c.mutation.SetRequestType(v)
+	return _c
+}
+
+// SetNillableRequestType sets the "request_type" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableRequestType(v *string) *UsageLogCreate {
+	if v != nil {
+		_c.SetRequestType(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UsageLogCreate) SetCreatedAt(v time.Time) *UsageLogCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -549,6 +577,14 @@ func (_c *UsageLogCreate) defaults() {
 		v := usagelog.DefaultCacheTTLOverridden
 		_c.mutation.SetCacheTTLOverridden(v)
 	}
+	if _, ok := _c.mutation.ResponseCacheHit(); !ok {
+		v := usagelog.DefaultResponseCacheHit
+		_c.mutation.SetResponseCacheHit(v)
+	}
+	if _, ok := _c.mutation.RequestType(); !ok {
+		v := usagelog.DefaultRequestType
+		_c.mutation.SetRequestType(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := usagelog.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -648,6 +684,17 @@ func (_c *UsageLogCreate) check() error {
 	if _, ok := _c.mutation.CacheTTLOverridden(); !ok {
 		return &ValidationError{Name: "cache_ttl_overridden", err: errors.New(`ent: missing required field "UsageLog.cache_ttl_overridden"`)}
 	}
+	if _, ok := _c.mutation.ResponseCacheHit(); !ok {
+		return &ValidationError{Name: "response_cache_hit", err: errors.New(`ent: missing required field "UsageLog.response_cache_hit"`)}
+	}
+	if _, ok := _c.mutation.RequestType(); !ok {
+		return &ValidationError{Name: "request_type", err: errors.New(`ent: missing required field "UsageLog.request_type"`)}
+	}
+	if v, ok := _c.mutation.RequestType(); ok {
+		if err := usagelog.RequestTypeValidator(v); err != nil {
+			return &ValidationError{Name: "request_type", err: fmt.Errorf(`ent: validator failed for field "UsageLog.request_type": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UsageLog.created_at"`)}
 	}
@@ -787,6 +834,14 @@ func (_c *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldCacheTTLOverridden, field.TypeBool, value)
 		_node.CacheTTLOverridden = value
 	}
+	if value, ok := _c.mutation.ResponseCacheHit(); ok {
+		_spec.SetField(usagelog.FieldResponseCacheHit, field.TypeBool, value)
+		_node.ResponseCacheHit = value
+	}
+	if value, ok := _c.mutation.RequestType(); ok {
+		_spec.SetField(usagelog.FieldRequestType, field.TypeString, value)
+		_node.RequestType = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(usagelog.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -1444,6 +1499,30 @@ func (u *UsageLogUpsert) UpdateCacheTTLOverridden() *UsageLogUpsert {
 	return u
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (u *UsageLogUpsert) SetResponseCacheHit(v bool) *UsageLogUpsert {
+	u.Set(usagelog.FieldResponseCacheHit, v)
+	return u
+}
+
+// UpdateResponseCacheHit sets the "response_cache_hit" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateResponseCacheHit() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldResponseCacheHit)
+	return u
+}
+
+// SetRequestType sets the "request_type" field.
+func (u *UsageLogUpsert) SetRequestType(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldRequestType, v)
+	return u
+}
+
+// UpdateRequestType sets the "request_type" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateRequestType() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldRequestType)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -2091,6 +2170,34 @@ func (u *UsageLogUpsertOne) UpdateCacheTTLOverridden() *UsageLogUpsertOne {
 	})
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (u *UsageLogUpsertOne) SetResponseCacheHit(v bool) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetResponseCacheHit(v)
+	})
+}
+
+// UpdateResponseCacheHit sets the "response_cache_hit" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateResponseCacheHit() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateResponseCacheHit()
+	})
+}
+
+// SetRequestType sets the "request_type" field.
+func (u *UsageLogUpsertOne) SetRequestType(v string) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetRequestType(v)
+	})
+}
+
+// UpdateRequestType sets the "request_type" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateRequestType() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateRequestType()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2904,6 +3011,34 @@ func (u *UsageLogUpsertBulk) UpdateCacheTTLOverridden() *UsageLogUpsertBulk {
 	})
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (u *UsageLogUpsertBulk) SetResponseCacheHit(v bool) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetResponseCacheHit(v)
+	})
+}
+
+// UpdateResponseCacheHit sets the "response_cache_hit" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateResponseCacheHit() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateResponseCacheHit()
+	})
+}
+
+// SetRequestType sets the "request_type" field.
+func (u *UsageLogUpsertBulk) SetRequestType(v string) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetRequestType(v)
+	})
+}
+
+// UpdateRequestType sets the "request_type" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateRequestType() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateRequestType()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -626,6 +626,34 @@ func (_u *UsageLogUpdate) SetNillableCacheTTLOverridden(v *bool) *UsageLogUpdate
 	return _u
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (_u *UsageLogUpdate) SetResponseCacheHit(v bool) *UsageLogUpdate {
+	_u.mutation.SetResponseCacheHit(v)
+	return _u
+}
+
+// SetNillableResponseCacheHit sets the "response_cache_hit" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableResponseCacheHit(v *bool) *UsageLogUpdate {
+	if v != nil {
+		_u.SetResponseCacheHit(*v)
+	}
+	return _u
+}
+
+// SetRequestType sets the "request_type" field.
+func (_u *UsageLogUpdate) SetRequestType(v string) *UsageLogUpdate {
+	_u.mutation.SetRequestType(v)
+	return _u
+}
+
+// SetNillableRequestType sets the "request_type" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableRequestType(v *string) *UsageLogUpdate {
+	if v != nil {
+		_u.SetRequestType(*v)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *UsageLogUpdate) SetUser(v *User) *UsageLogUpdate {
 	return _u.SetUserID(v.ID)
@@ -740,6 +768,11 @@ func (_u *UsageLogUpdate) check() error {
 			return &ValidationError{Name: "image_size", err: fmt.Errorf(`ent: validator failed for field "UsageLog.image_size": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RequestType(); ok {
+		if err := usagelog.RequestTypeValidator(v); err != nil {
+			return &ValidationError{Name: "request_type", err: fmt.Errorf(`ent: validator failed for field "UsageLog.request_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UsageLog.user"`)
 	}
@@ -911,6 +944,12 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CacheTTLOverridden(); ok {
 		_spec.SetField(usagelog.FieldCacheTTLOverridden, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheHit(); ok {
+		_spec.SetField(usagelog.FieldResponseCacheHit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequestType(); ok {
+		_spec.SetField(usagelog.FieldRequestType, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1670,6 +1709,34 @@ func (_u *UsageLogUpdateOne) SetNillableCacheTTLOverridden(v *bool) *UsageLogUpd
 	return _u
 }
 
+// SetResponseCacheHit sets the "response_cache_hit" field.
+func (_u *UsageLogUpdateOne) SetResponseCacheHit(v bool) *UsageLogUpdateOne {
+	_u.mutation.SetResponseCacheHit(v)
+	return _u
+}
+
+// SetNillableResponseCacheHit sets the "response_cache_hit" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableResponseCacheHit(v *bool) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetResponseCacheHit(*v)
+	}
+	return _u
+}
+
+// SetRequestType sets the "request_type" field.
+func (_u *UsageLogUpdateOne) SetRequestType(v string) *UsageLogUpdateOne {
+	_u.mutation.SetRequestType(v)
+	return _u
+}
+
+// SetNillableRequestType sets the "request_type" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableRequestType(v *string) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetRequestType(*v)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *UsageLogUpdateOne) SetUser(v *User) *UsageLogUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -1797,6 +1864,11 @@ func (_u *UsageLogUpdateOne) check() error {
 			return &ValidationError{Name: "image_size", err: fmt.Errorf(`ent: validator failed for field "UsageLog.image_size": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RequestType(); ok {
+		if err := usagelog.RequestTypeValidator(v); err != nil {
+			return &ValidationError{Name: "request_type", err: fmt.Errorf(`ent: validator failed for field "UsageLog.request_type": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UsageLog.user"`)
 	}
@@ -1985,6 +2057,12 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 	if value, ok := _u.mutation.CacheTTLOverridden(); ok {
 		_spec.SetField(usagelog.FieldCacheTTLOverridden, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ResponseCacheHit(); ok {
+		_spec.SetField(usagelog.FieldResponseCacheHit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequestType(); ok {
+		_spec.SetField(usagelog.FieldRequestType, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -66,6 +66,11 @@ type Config struct {
 	Gemini            GeminiConfig            `mapstructure:"gemini"`
 	Update            UpdateConfig            `mapstructure:"update"`
 	RequestContentLog RequestContentLogConfig `mapstructure:"request_content_log"`
+	Metrics           MetricsConfig           `mapstructure:"metrics"`
+	Tracing           TracingConfig           `mapstructure:"tracing"`
+	AuditLog          AuditLogConfig          `mapstructure:"audit_log"`
+	Payment           PaymentConfig           `mapstructure:"payment"`
+	HealthProbe       HealthProbeConfig       `mapstructure:"health_probe"`
 }
 
 type GeminiConfig struct {
@@ -107,6 +112,117 @@ type RequestContentLogConfig struct {
 	MaxSize int `mapstructure:"max_size"`
 }
 
+// MetricsConfig Prometheus 指标导出配置
+type MetricsConfig struct {
+	// Enabled 是否暴露 /metrics（默认关闭）
+	Enabled bool `mapstructure:"enabled"`
+	// AuthToken 抓取时需携带的 Bearer Token（启用时必填）
+	AuthToken string `mapstructure:"auth_token"`
+}
+
+// TracingConfig OpenTelemetry 链路追踪配置（OTLP/HTTP 导出）
+type TracingConfig struct {
+	// Enabled 是否启用链路追踪（默认关闭）
+	Enabled bool `mapstructure:"enabled"`
+	// Endpoint OTLP/HTTP collector 地址（host:port，默认 localhost:4318）
+	Endpoint string `mapstructure:"endpoint"`
+	// URLPath 导出路径（默认 /v1/traces）
+	URLPath string `mapstructure:"url_path"`
+	// Insecure 是否使用明文 HTTP（本地 collector 通常为 true）
+	Insecure bool `mapstructure:"insecure"`
+	// ServiceName 上报的 service.name（默认 sub2api）
+	ServiceName string `mapstructure:"service_name"`
+	// SampleRatio 根 span 采样比例（0-1，默认 1；已携带 traceparent 的请求沿用上游采样决定）
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// AuditLogConfig 管理员操作审计日志配置
+type AuditLogConfig struct {
+	// Enabled 是否记录管理后台变更操作（默认开启）
+	Enabled bool `mapstructure:"enabled"`
+	// RetentionDays 保留天数（默认 180，0 表示不清理）
+	RetentionDays int `mapstructure:"retention_days"`
+	// CleanupSchedule 清理任务 cron 表达式（5 段，默认每天 03:30）
+	CleanupSchedule string `mapstructure:"cleanup_schedule"`
+	// MaxBodySize 单条记录保存的请求体最大字节（超出截断，默认 16384）
+	MaxBodySize int `mapstructure:"max_body_size"`
+}
+
+// HealthProbeConfig 账号定时健康探测配置
+type HealthProbeConfig struct {
+	// Enabled 是否启用后台健康探测（默认关闭；每次探测都会消耗少量上游额度）
+	Enabled bool `mapstructure:"enabled"`
+	// FailureThreshold 连续失败多少次后隔离账号（置为 error 状态，默认 3）
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// RecoveryThreshold error 状态账号连续探测成功多少次后自动恢复（默认 2）
+	RecoveryThreshold int `mapstructure:"recovery_threshold"`
+	// Concurrency 同时进行的探测数（默认 4）
+	Concurrency int `mapstructure:"concurrency"`
+	// TimeoutSeconds 单次探测超时秒数（默认 60）
+	TimeoutSeconds int `mapstructure:"timeout_seconds"`
+	// RetentionDays 探测记录保留天数（默认 7，0 表示不清理）
+	RetentionDays int `mapstructure:"retention_days"`
+	// Platforms 按平台配置探测间隔与模型；未配置或 interval_seconds 为 0 的平台不探测
+	Platforms map[string]HealthProbePlatformConfig `mapstructure:"platforms"`
+}
+
+// HealthProbePlatformConfig 单个平台的探测配置
+type HealthProbePlatformConfig struct {
+	// IntervalSeconds 探测间隔秒数（0 表示不探测该平台）
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+	// Model 探测使用的模型（为空时使用账号测试的默认模型）
+	Model string `mapstructure:"model"`
+}
+
+// PaymentConfig 在线支付/充值配置
+type PaymentConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// NotifyBaseURL 支付回调使用的公网地址前缀（如 https://api.example.com），
+	// 回调地址为 {notify_base_url}/api/v1/payments/callback/{provider}
+	NotifyBaseURL string `mapstructure:"notify_base_url"`
+	// ReturnURL 支付完成后浏览器跳转地址（可选）
+	ReturnURL string `mapstructure:"return_url"`
+	// Currency 支付币种（ISO 4217，默认 CNY）
+	Currency string `mapstructure:"currency"`
+	// CreditRate 每 1 单位支付金额兑换的余额（USD），默认 1
+	CreditRate float64 `mapstructure:"credit_rate"`
+	// MinAmount/MaxAmount 单笔余额充值金额范围（支付币种）
+	MinAmount float64 `mapstructure:"min_amount"`
+	MaxAmount float64 `mapstructure:"max_amount"`
+	// Plans 可购买的订阅套餐
+	Plans []PaymentPlanConfig `mapstructure:"plans"`
+
+	EPay   EPayConfig   `mapstructure:"epay"`
+	Stripe StripeConfig `mapstructure:"stripe"`
+}
+
+// PaymentPlanConfig 订阅套餐：支付 Price 后为用户分配/续期 GroupID 订阅 ValidityDays 天
+type PaymentPlanConfig struct {
+	ID           string  `mapstructure:"id"`
+	Name         string  `mapstructure:"name"`
+	GroupID      int64   `mapstructure:"group_id"`
+	ValidityDays int     `mapstructure:"validity_days"`
+	Price        float64 `mapstructure:"price"`
+}
+
+// EPayConfig 易支付（MD5 签名回调）
+type EPayConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	GatewayURL string `mapstructure:"gateway_url"`
+	PID        string `mapstructure:"pid"`
+	Key        string `mapstructure:"key"`
+	// PayType 支付方式（alipay/wxpay/qqpay），为空时由收银台选择
+	PayType string `mapstructure:"pay_type"`
+}
+
+// StripeConfig Stripe Checkout
+type StripeConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	SecretKey     string `mapstructure:"secret_key"`
+	WebhookSecret string `mapstructure:"webhook_secret"`
+	APIBaseURL    string `mapstructure:"api_base_url"`
+}
+
 type LinuxDoConnectConfig struct {
 	Enabled             bool   `mapstructure:"enabled"`
 	ClientID            string `mapstructure:"client_id"`
@@ -313,6 +429,114 @@ type GatewayConfig struct {
 
 	// TLSFingerprint: TLS指纹伪装配置
 	TLSFingerprint TLSFingerprintConfig `mapstructure:"tls_fingerprint"`
+
+	// ResponseCache: 确定性请求的精确响应缓存（需分组单独开启）
+	ResponseCache GatewayResponseCacheConfig `mapstructure:"response_cache"`
+
+	// Batch: Message Batches 本地批处理执行器
+	Batch GatewayBatchConfig `mapstructure:"batch"`
+
+	// Files: Anthropic Files API 透传
+	Files GatewayFilesConfig `mapstructure:"files"`
+
+	// Hedging: 对冲请求（需分组单独开启）
+	Hedging GatewayHedgingConfig `mapstructure:"hedging"`
+
+	// StreamRecovery: 流式响应中途断开后在其他账号上续写
+	StreamRecovery GatewayStreamRecoveryConfig `mapstructure:"stream_recovery"`
+
+	// Idempotency: Idempotency-Key 请求头去重（防止客户端超时重试导致重复执行与重复计费）
+	Idempotency GatewayIdempotencyConfig `mapstructure:"idempotency"`
+}
+
+// GatewayIdempotencyConfig Idempotency-Key 去重配置
+// 同一 API Key 下相同 Idempotency-Key 的请求只执行一次，重复请求回放首次结果或接入执行中的流。
+type GatewayIdempotencyConfig struct {
+	// Enabled: 是否启用（仅对携带 Idempotency-Key 请求头的请求生效）
+	Enabled bool `mapstructure:"enabled"`
+	// TTLSeconds: 首次请求完成后结果的保留时间（秒）
+	TTLSeconds int `mapstructure:"ttl_seconds"`
+	// LockTTLSeconds: 首次请求执行期间的占位时间（秒），超时未完成视为失败，可重新执行
+	LockTTLSeconds int `mapstructure:"lock_ttl_seconds"`
+	// MaxResponseBytes: 超过该大小的响应不保存，重复请求返回 409
+	MaxResponseBytes int `mapstructure:"max_response_bytes"`
+}
+
+// GatewayStreamRecoveryConfig 流中断恢复配置
+// 已向客户端输出部分内容后上游连接断开时，以已输出文本作为 assistant 预填充，
+// 在其他账号上重新发起请求，并将续写内容拼接到同一 SSE 流中。
+type GatewayStreamRecoveryConfig struct {
+	// Enabled: 是否启用（仅对未开启 thinking、只输出文本块的 /v1/messages 流式请求生效）
+	Enabled bool `mapstructure:"enabled"`
+	// MaxAttempts: 单个请求最多续写次数
+	MaxAttempts int `mapstructure:"max_attempts"`
+}
+
+// GatewayHedgingConfig 对冲请求配置
+// 分组未设置固定阈值时，按该分组最近流式请求首字时间的 p95 计算阈值，并限制在 [MinDelay, MaxDelay] 内。
+type GatewayHedgingConfig struct {
+	// DefaultDelay: 样本不足时使用的阈值
+	DefaultDelay time.Duration `mapstructure:"default_delay"`
+	// MinDelay: 自适应阈值下限
+	MinDelay time.Duration `mapstructure:"min_delay"`
+	// MaxDelay: 自适应阈值上限
+	MaxDelay time.Duration `mapstructure:"max_delay"`
+	// WindowSize: 每个分组保留的首字时间样本数
+	WindowSize int `mapstructure:"window_size"`
+	// MinSamples: 使用 p95 前至少需要的样本数
+	MinSamples int `mapstructure:"min_samples"`
+}
+
+// GatewayFilesConfig Files API 透传配置
+// 上传的文件绑定到接收它的上游账号，引用 file_id 的请求会被固定调度到该账号。
+type GatewayFilesConfig struct {
+	// Enabled: 是否启用 /v1/files
+	Enabled bool `mapstructure:"enabled"`
+	// MaxFileSize: 单个文件最大字节数
+	MaxFileSize int64 `mapstructure:"max_file_size"`
+	// UserQuotaBytes: 每个用户的文件存储配额（字节），0 表示不限制
+	UserQuotaBytes int64 `mapstructure:"user_quota_bytes"`
+}
+
+// GatewayBatchConfig Message Batches 执行器配置
+// 批处理请求以低优先级执行：仅在账号负载低于阈值时占用空闲并发，否则延后重试。
+type GatewayBatchConfig struct {
+	// Enabled: 是否启用 /v1/messages/batches 与后台执行器
+	Enabled bool `mapstructure:"enabled"`
+	// Workers: 每个实例的并发 worker 数
+	Workers int `mapstructure:"workers"`
+	// PollInterval: 无待处理请求时的轮询间隔
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// MaxRequestsPerBatch: 单个批次最多包含的请求数
+	MaxRequestsPerBatch int `mapstructure:"max_requests_per_batch"`
+	// MaxAttempts: 单个请求的最大尝试次数（上游暂时性错误或无空闲账号时会延后重试）
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryDelay: 延后重试的等待时间
+	RetryDelay time.Duration `mapstructure:"retry_delay"`
+	// IdleLoadThreshold: 账号负载率（百分比）不超过该值时才会被批处理占用
+	IdleLoadThreshold int `mapstructure:"idle_load_threshold"`
+	// RequestTimeout: 单个请求的上游超时时间
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+	// ProcessingTimeout: 处理中状态超过该时间视为 worker 中断，重新放回队列
+	ProcessingTimeout time.Duration `mapstructure:"processing_timeout"`
+}
+
+// GatewayResponseCacheConfig 精确响应缓存配置
+// 仅缓存非流式、temperature=0 的 /v1/messages 与 /v1/responses 请求；
+// 缓存键为规范化请求体 + 模型，按分组隔离。
+type GatewayResponseCacheConfig struct {
+	// Enabled: 全局开关（关闭后即使分组开启也不生效）
+	Enabled bool `mapstructure:"enabled"`
+	// DefaultTTLSeconds: 分组未设置 TTL 时使用的默认缓存时间（秒）
+	DefaultTTLSeconds int `mapstructure:"default_ttl_seconds"`
+	// MaxTTLSeconds: 分组可设置的最大 TTL（秒）
+	MaxTTLSeconds int `mapstructure:"max_ttl_seconds"`
+	// MaxRequestBytes: 超过该大小的请求体不参与缓存
+	MaxRequestBytes int `mapstructure:"max_request_bytes"`
+	// MaxResponseBytes: 超过该大小的响应体不写入缓存
+	MaxResponseBytes int `mapstructure:"max_response_bytes"`
+	// HitCostMultiplier: 命中缓存时按正常费用的该比例计费（0 表示免费，1 表示不打折）
+	HitCostMultiplier float64 `mapstructure:"hit_cost_multiplier"`
 }
 
 // TLSFingerprintConfig TLS指纹伪装配置
@@ -964,6 +1188,36 @@ func setDefaults() {
 	viper.SetDefault("gateway.scheduling.outbox_lag_rebuild_failures", 3)
 	viper.SetDefault("gateway.scheduling.outbox_backlog_rebuild_rows", 10000)
 	viper.SetDefault("gateway.scheduling.full_rebuild_interval_seconds", 300)
+	// 精确响应缓存（需分组单独开启）
+	viper.SetDefault("gateway.response_cache.enabled", true)
+	viper.SetDefault("gateway.response_cache.default_ttl_seconds", 3600)
+	viper.SetDefault("gateway.response_cache.max_ttl_seconds", 7*24*3600)
+	viper.SetDefault("gateway.response_cache.max_request_bytes", 1<<20)
+	viper.SetDefault("gateway.response_cache.max_response_bytes", 1<<20)
+	viper.SetDefault("gateway.response_cache.hit_cost_multiplier", 0.1)
+	viper.SetDefault("gateway.batch.enabled", true)
+	viper.SetDefault("gateway.batch.workers", 4)
+	viper.SetDefault("gateway.batch.poll_interval", 5*time.Second)
+	viper.SetDefault("gateway.batch.max_requests_per_batch", 10000)
+	viper.SetDefault("gateway.batch.max_attempts", 5)
+	viper.SetDefault("gateway.batch.retry_delay", 30*time.Second)
+	viper.SetDefault("gateway.batch.idle_load_threshold", 50)
+	viper.SetDefault("gateway.batch.request_timeout", 10*time.Minute)
+	viper.SetDefault("gateway.batch.processing_timeout", 30*time.Minute)
+	viper.SetDefault("gateway.files.enabled", true)
+	viper.SetDefault("gateway.files.max_file_size", int64(500*1024*1024))
+	viper.SetDefault("gateway.files.user_quota_bytes", int64(1024*1024*1024))
+	viper.SetDefault("gateway.hedging.default_delay", 5*time.Second)
+	viper.SetDefault("gateway.hedging.min_delay", time.Second)
+	viper.SetDefault("gateway.hedging.max_delay", 30*time.Second)
+	viper.SetDefault("gateway.hedging.window_size", 200)
+	viper.SetDefault("gateway.hedging.min_samples", 20)
+	viper.SetDefault("gateway.stream_recovery.enabled", false)
+	viper.SetDefault("gateway.stream_recovery.max_attempts", 2)
+	viper.SetDefault("gateway.idempotency.enabled", true)
+	viper.SetDefault("gateway.idempotency.ttl_seconds", 24*3600)
+	viper.SetDefault("gateway.idempotency.lock_ttl_seconds", 15*60)
+	viper.SetDefault("gateway.idempotency.max_response_bytes", 10<<20)
 	// TLS指纹伪装配置（默认关闭，需要账号级别单独启用）
 	viper.SetDefault("gateway.tls_fingerprint.enabled", true)
 	viper.SetDefault("concurrency.ping_interval", 10)
@@ -987,6 +1241,54 @@ func setDefaults() {
 	viper.SetDefault("request_content_log.enabled", false)
 	viper.SetDefault("request_content_log.retention_days", 30)
 	viper.SetDefault("request_content_log.max_size", 65536)
+
+	// Metrics
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.auth_token", "")
+
+	// Tracing
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.endpoint", "localhost:4318")
+	viper.SetDefault("tracing.url_path", "/v1/traces")
+	viper.SetDefault("tracing.insecure", true)
+	viper.SetDefault("tracing.service_name", "sub2api")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+
+	// AuditLog
+	viper.SetDefault("audit_log.enabled", true)
+	viper.SetDefault("audit_log.retention_days", 180)
+	viper.SetDefault("audit_log.cleanup_schedule", "30 3 * * *")
+	viper.SetDefault("audit_log.max_body_size", 16384)
+
+	// Payment
+	viper.SetDefault("payment.enabled", false)
+	viper.SetDefault("payment.notify_base_url", "")
+	viper.SetDefault("payment.return_url", "")
+	viper.SetDefault("payment.currency", "CNY")
+	viper.SetDefault("payment.credit_rate", 1.0)
+	viper.SetDefault("payment.min_amount", 1.0)
+	viper.SetDefault("payment.max_amount", 10000.0)
+	viper.SetDefault("payment.epay.enabled", false)
+	viper.SetDefault("payment.epay.gateway_url", "")
+	viper.SetDefault("payment.epay.pid", "")
+	viper.SetDefault("payment.epay.key", "")
+	viper.SetDefault("payment.epay.pay_type", "")
+	viper.SetDefault("payment.stripe.enabled", false)
+	viper.SetDefault("payment.stripe.secret_key", "")
+	viper.SetDefault("payment.stripe.webhook_secret", "")
+	viper.SetDefault("payment.stripe.api_base_url", "https://api.stripe.com")
+
+	// HealthProbe
+	viper.SetDefault("health_probe.enabled", false)
+	viper.SetDefault("health_probe.failure_threshold", 3)
+	viper.SetDefault("health_probe.recovery_threshold", 2)
+	viper.SetDefault("health_probe.concurrency", 4)
+	viper.SetDefault("health_probe.timeout_seconds", 60)
+	viper.SetDefault("health_probe.retention_days", 7)
+	for _, platform := range []string{"anthropic", "openai", "gemini", "antigravity"} {
+		viper.SetDefault("health_probe.platforms."+platform+".interval_seconds", 1800)
+		viper.SetDefault("health_probe.platforms."+platform+".model", "")
+	}
 }
 
 func (c *Config) Validate() error {
@@ -1015,6 +1317,44 @@ func (c *Config) Validate() error {
 	if c.JWT.RefreshWindowMinutes < 0 {
 		return fmt.Errorf("jwt.refresh_window_minutes must be non-negative")
 	}
+	if c.Metrics.Enabled && strings.TrimSpace(c.Metrics.AuthToken) == "" {
+		return fmt.Errorf("metrics.auth_token is required when metrics.enabled is true")
+	}
+	if c.Tracing.Enabled {
+		if strings.TrimSpace(c.Tracing.Endpoint) == "" {
+			return fmt.Errorf("tracing.endpoint is required when tracing.enabled is true")
+		}
+		if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+			return fmt.Errorf("tracing.sample_ratio must be between 0 and 1")
+		}
+	}
+	if c.AuditLog.RetentionDays < 0 {
+		return fmt.Errorf("audit_log.retention_days must be non-negative")
+	}
+	if c.AuditLog.RetentionDays > 0 && strings.TrimSpace(c.AuditLog.CleanupSchedule) == "" {
+		return fmt.Errorf("audit_log.cleanup_schedule is required when audit_log.retention_days > 0")
+	}
+	if c.Payment.Enabled {
+		if err := c.Payment.validate(); err != nil {
+			return err
+		}
+	}
+	if c.HealthProbe.Enabled {
+		if c.HealthProbe.FailureThreshold <= 0 || c.HealthProbe.RecoveryThreshold <= 0 {
+			return fmt.Errorf("health_probe.failure_threshold and health_probe.recovery_threshold must be positive")
+		}
+		if c.HealthProbe.Concurrency <= 0 || c.HealthProbe.TimeoutSeconds <= 0 {
+			return fmt.Errorf("health_probe.concurrency and health_probe.timeout_seconds must be positive")
+		}
+		if c.HealthProbe.RetentionDays < 0 {
+			return fmt.Errorf("health_probe.retention_days must be non-negative")
+		}
+		for platform, probe := range c.HealthProbe.Platforms {
+			if probe.IntervalSeconds < 0 || (probe.IntervalSeconds > 0 && probe.IntervalSeconds < 60) {
+				return fmt.Errorf("health_probe.platforms.%s.interval_seconds must be 0 or at least 60", platform)
+			}
+		}
+	}
 	if c.Security.CSP.Enabled && strings.TrimSpace(c.Security.CSP.Policy) == "" {
 		return fmt.Errorf("security.csp.policy is required when CSP is enabled")
 	}
@@ -1316,6 +1656,55 @@ func (c *Config) Validate() error {
 	if c.Gateway.MaxLineSize != 0 && c.Gateway.MaxLineSize < 1024*1024 {
 		return fmt.Errorf("gateway.max_line_size must be at least 1MB")
 	}
+	if c.Gateway.ResponseCache.DefaultTTLSeconds <= 0 {
+		return fmt.Errorf("gateway.response_cache.default_ttl_seconds must be positive")
+	}
+	if c.Gateway.ResponseCache.MaxTTLSeconds < c.Gateway.ResponseCache.DefaultTTLSeconds {
+		return fmt.Errorf("gateway.response_cache.max_ttl_seconds must be >= default_ttl_seconds")
+	}
+	if c.Gateway.ResponseCache.MaxRequestBytes <= 0 || c.Gateway.ResponseCache.MaxResponseBytes <= 0 {
+		return fmt.Errorf("gateway.response_cache.max_request_bytes and max_response_bytes must be positive")
+	}
+	if c.Gateway.ResponseCache.HitCostMultiplier < 0 || c.Gateway.ResponseCache.HitCostMultiplier > 1 {
+		return fmt.Errorf("gateway.response_cache.hit_cost_multiplier must be between 0 and 1")
+	}
+	if c.Gateway.Batch.Enabled {
+		if c.Gateway.Batch.Workers <= 0 || c.Gateway.Batch.MaxRequestsPerBatch <= 0 || c.Gateway.Batch.MaxAttempts <= 0 {
+			return fmt.Errorf("gateway.batch.workers, max_requests_per_batch and max_attempts must be positive")
+		}
+		if c.Gateway.Batch.PollInterval <= 0 || c.Gateway.Batch.RequestTimeout <= 0 || c.Gateway.Batch.ProcessingTimeout <= c.Gateway.Batch.RequestTimeout {
+			return fmt.Errorf("gateway.batch.poll_interval and request_timeout must be positive, processing_timeout must exceed request_timeout")
+		}
+		if c.Gateway.Batch.IdleLoadThreshold <= 0 || c.Gateway.Batch.IdleLoadThreshold > 100 {
+			return fmt.Errorf("gateway.batch.idle_load_threshold must be between 1 and 100")
+		}
+	}
+	if c.Gateway.Files.Enabled && c.Gateway.Files.MaxFileSize <= 0 {
+		return fmt.Errorf("gateway.files.max_file_size must be positive")
+	}
+	if c.Gateway.Files.UserQuotaBytes < 0 {
+		return fmt.Errorf("gateway.files.user_quota_bytes must be non-negative")
+	}
+	if c.Gateway.Hedging.MinDelay <= 0 || c.Gateway.Hedging.MaxDelay < c.Gateway.Hedging.MinDelay {
+		return fmt.Errorf("gateway.hedging.min_delay must be positive and max_delay must be >= min_delay")
+	}
+	if c.Gateway.Hedging.DefaultDelay <= 0 {
+		return fmt.Errorf("gateway.hedging.default_delay must be positive")
+	}
+	if c.Gateway.Hedging.WindowSize <= 0 || c.Gateway.Hedging.MinSamples <= 0 || c.Gateway.Hedging.MinSamples > c.Gateway.Hedging.WindowSize {
+		return fmt.Errorf("gateway.hedging.window_size and min_samples must be positive, min_samples must not exceed window_size")
+	}
+	if c.Gateway.StreamRecovery.Enabled && c.Gateway.StreamRecovery.MaxAttempts <= 0 {
+		return fmt.Errorf("gateway.stream_recovery.max_attempts must be positive")
+	}
+	if c.Gateway.Idempotency.Enabled {
+		if c.Gateway.Idempotency.TTLSeconds <= 0 || c.Gateway.Idempotency.LockTTLSeconds <= 0 {
+			return fmt.Errorf("gateway.idempotency.ttl_seconds and lock_ttl_seconds must be positive")
+		}
+		if c.Gateway.Idempotency.MaxResponseBytes <= 0 {
+			return fmt.Errorf("gateway.idempotency.max_response_bytes must be positive")
+		}
+	}
 	if c.Gateway.Scheduling.StickySessionMaxWaiting <= 0 {
 		return fmt.Errorf("gateway.scheduling.sticky_session_max_waiting must be positive")
 	}
@@ -1381,6 +1770,58 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func (p *PaymentConfig) validate() error {
+	if err := ValidateAbsoluteHTTPURL(p.NotifyBaseURL); err != nil {
+		return fmt.Errorf("payment.notify_base_url invalid: %w", err)
+	}
+	if strings.TrimSpace(p.Currency) == "" {
+		return fmt.Errorf("payment.currency is required when payment.enabled=true")
+	}
+	if p.CreditRate <= 0 {
+		return fmt.Errorf("payment.credit_rate must be positive")
+	}
+	if p.MinAmount <= 0 || p.MaxAmount < p.MinAmount {
+		return fmt.Errorf("payment.min_amount must be positive and not greater than payment.max_amount")
+	}
+	if !p.EPay.Enabled && !p.Stripe.Enabled {
+		return fmt.Errorf("payment.enabled=true requires at least one provider (epay/stripe)")
+	}
+	if p.EPay.Enabled {
+		if err := ValidateAbsoluteHTTPURL(p.EPay.GatewayURL); err != nil {
+			return fmt.Errorf("payment.epay.gateway_url invalid: %w", err)
+		}
+		if strings.TrimSpace(p.EPay.PID) == "" || strings.TrimSpace(p.EPay.Key) == "" {
+			return fmt.Errorf("payment.epay.pid and payment.epay.key are required when payment.epay.enabled=true")
+		}
+		if !strings.EqualFold(strings.TrimSpace(p.Currency), "CNY") {
+			return fmt.Errorf("payment.epay only supports payment.currency=CNY")
+		}
+	}
+	if p.Stripe.Enabled {
+		if strings.TrimSpace(p.Stripe.SecretKey) == "" || strings.TrimSpace(p.Stripe.WebhookSecret) == "" {
+			return fmt.Errorf("payment.stripe.secret_key and payment.stripe.webhook_secret are required when payment.stripe.enabled=true")
+		}
+		if err := ValidateAbsoluteHTTPURL(p.Stripe.APIBaseURL); err != nil {
+			return fmt.Errorf("payment.stripe.api_base_url invalid: %w", err)
+		}
+	}
+	seen := make(map[string]struct{}, len(p.Plans))
+	for i, plan := range p.Plans {
+		id := strings.TrimSpace(plan.ID)
+		if id == "" {
+			return fmt.Errorf("payment.plans[%d].id is required", i)
+		}
+		if _, dup := seen[id]; dup {
+			return fmt.Errorf("payment.plans[%d].id %q is duplicated", i, id)
+		}
+		seen[id] = struct{}{}
+		if plan.GroupID <= 0 || plan.ValidityDays <= 0 || plan.Price <= 0 {
+			return fmt.Errorf("payment.plans[%d] requires positive group_id, validity_days and price", i)
+		}
+	}
+	return nil
+}
+
 func normalizeStringSlice(values []string) []string {
 	if len(values) == 0 {
 		return values
@@ -167,6 +167,25 @@ func TestLoadDefaultDashboardCacheConfig(t *testing.T) {
 	}
 }
 
+func TestLoadDefaultHealthProbeConfig(t *testing.T) {
+	viper.Reset()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	if cfg.HealthProbe.Enabled {
+		t.Fatalf("HealthProbe.Enabled = true, want false")
+	}
+	if cfg.HealthProbe.FailureThreshold != 3 || cfg.HealthProbe.RecoveryThreshold != 2 {
+		t.Fatalf("HealthProbe thresholds = %d/%d, want 3/2", cfg.HealthProbe.FailureThreshold, cfg.HealthProbe.RecoveryThreshold)
+	}
+	if got := cfg.HealthProbe.Platforms["anthropic"].IntervalSeconds; got != 1800 {
+		t.Fatalf("HealthProbe.Platforms[anthropic].IntervalSeconds = %d, want 1800", got)
+	}
+}
+
 func TestValidateDashboardCacheConfigEnabled(t *testing.T) {
 	viper.Reset()
 
@@ -837,6 +856,22 @@ func TestValidateConfigErrors(t *testing.T) {
 			mutate:  func(c *Config) { c.Ops.Cleanup.MinuteMetricsRetentionDays = -1 },
 			wantErr: "ops.cleanup.minute_metrics_retention_days",
 		},
+		{
+			name: "health probe thresholds",
+			mutate: func(c *Config) {
+				c.HealthProbe.Enabled = true
+				c.HealthProbe.FailureThreshold = 0
+			},
+			wantErr: "health_probe.failure_threshold",
+		},
+		{
+			name: "health probe interval",
+			mutate: func(c *Config) {
+				c.HealthProbe.Enabled = true
+				c.HealthProbe.Platforms["anthropic"] = HealthProbePlatformConfig{IntervalSeconds: 30}
+			},
+			wantErr: "health_probe.platforms.anthropic.interval_seconds",
+		},
 	}
 
 	for _, tt := range cases {
@@ -26,10 +26,14 @@ const (
 
 // Account type constants
 const (
-	AccountTypeOAuth      = "oauth"       // OAuth类型账号（full scope: profile + inference）
-	AccountTypeSetupToken = "setup-token" // Setup Token类型账号（inference only scope）
-	AccountTypeAPIKey     = "apikey"      // API Key类型账号
-	AccountTypeUpstream   = "upstream"    // 上游透传类型账号（通过 Base URL + API Key 连接上游）
+	AccountTypeOAuth        = "oauth"         // OAuth类型账号（full scope: profile + inference）
+	AccountTypeSetupToken   = "setup-token"   // Setup Token类型账号（inference only scope）
+	AccountTypeAPIKey       = "apikey"        // API Key类型账号
+	AccountTypeUpstream     = "upstream"      // 上游透传类型账号（通过 Base URL + API Key 连接上游）
+	AccountTypeBedrock      = "bedrock"       // AWS Bedrock 类型账号（AWS 凭证 + SigV4 签名，仅 anthropic 平台）
+	AccountTypeVertex       = "vertex"        // Google Vertex AI 类型账号（服务账号 JSON 密钥，gemini / anthropic 平台）
+	AccountTypeAzure        = "azure"         // Azure OpenAI 类型账号（资源端点 + api-key，仅 openai 平台）
+	AccountTypeOpenAICompat = "openai-compat" // OpenAI 兼容 Chat Completions 上游（Base URL + API Key，anthropic / openai 平台）
 )
 
 // Redeem type constants
@@ -46,6 +50,21 @@ const (
 	PromoCodeStatusDisabled = "disabled"
 )
 
+// Payment order type constants
+const (
+	PaymentOrderTypeBalance      = "balance"      // 余额充值
+	PaymentOrderTypeSubscription = "subscription" // 购买订阅套餐
+)
+
+// Payment order status constants
+const (
+	PaymentOrderStatusPending   = "pending"
+	PaymentOrderStatusPaid      = "paid"
+	PaymentOrderStatusRefunding = "refunding"
+	PaymentOrderStatusRefunded  = "refunded"
+	PaymentOrderStatusVoided    = "voided"
+)
+
 // Admin adjustment type constants
 const (
 	AdjustmentTypeAdminBalance     = "admin_balance"     // 管理员调整余额
@@ -99,3 +118,39 @@ var DefaultAntigravityModelMapping = map[string]string{
 	"gpt-oss-120b-medium":    "gpt-oss-120b-medium",
 	"tab_flash_lite_preview": "tab_flash_lite_preview",
 }
+
+// DefaultBedrockModelMapping 是 Bedrock 账号的默认模型映射（Claude 模型 ID → Bedrock 基础模型 ID）
+// 当账号未配置 model_mapping 时使用此默认值；跨区域推理配置文件前缀（us./eu./apac./global.）在映射后按账号区域追加
+var DefaultBedrockModelMapping = map[string]string{
+	"claude-opus-4-6":            "anthropic.claude-opus-4-6-v1",
+	"claude-opus-4-5":            "anthropic.claude-opus-4-5-20251101-v1:0",
+	"claude-opus-4-5-20251101":   "anthropic.claude-opus-4-5-20251101-v1:0",
+	"claude-sonnet-4-5":          "anthropic.claude-sonnet-4-5-20250929-v1:0",
+	"claude-sonnet-4-5-20250929": "anthropic.claude-sonnet-4-5-20250929-v1:0",
+	"claude-haiku-4-5":           "anthropic.claude-haiku-4-5-20251001-v1:0",
+	"claude-haiku-4-5-20251001":  "anthropic.claude-haiku-4-5-20251001-v1:0",
+	"claude-opus-4-1":            "anthropic.claude-opus-4-1-20250805-v1:0",
+	"claude-opus-4-1-20250805":   "anthropic.claude-opus-4-1-20250805-v1:0",
+	"claude-opus-4-20250514":     "anthropic.claude-opus-4-20250514-v1:0",
+	"claude-sonnet-4-20250514":   "anthropic.claude-sonnet-4-20250514-v1:0",
+	"claude-3-7-sonnet-20250219": "anthropic.claude-3-7-sonnet-20250219-v1:0",
+	"claude-3-5-haiku-20241022":  "anthropic.claude-3-5-haiku-20241022-v1:0",
+}
+
+// DefaultVertexClaudeModelMapping 是 anthropic 平台 Vertex 账号的默认模型映射（Claude 模型 ID → Vertex 模型 ID）
+// Vertex 上的 Claude 模型以 @ 分隔版本日期（如 claude-sonnet-4-5@20250929）
+var DefaultVertexClaudeModelMapping = map[string]string{
+	"claude-opus-4-6":            "claude-opus-4-6",
+	"claude-opus-4-5":            "claude-opus-4-5@20251101",
+	"claude-opus-4-5-20251101":   "claude-opus-4-5@20251101",
+	"claude-sonnet-4-5":          "claude-sonnet-4-5@20250929",
+	"claude-sonnet-4-5-20250929": "claude-sonnet-4-5@20250929",
+	"claude-haiku-4-5":           "claude-haiku-4-5@20251001",
+	"claude-haiku-4-5-20251001":  "claude-haiku-4-5@20251001",
+	"claude-opus-4-1":            "claude-opus-4-1@20250805",
+	"claude-opus-4-1-20250805":   "claude-opus-4-1@20250805",
+	"claude-opus-4-20250514":     "claude-opus-4@20250514",
+	"claude-sonnet-4-20250514":   "claude-sonnet-4@20250514",
+	"claude-3-7-sonnet-20250219": "claude-3-7-sonnet@20250219",
+	"claude-3-5-haiku-20241022":  "claude-3-5-haiku@20241022",
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -85,7 +86,7 @@ type CreateAccountRequest struct {
 	Name                    string         `json:"name" binding:"required"`
 	Notes                   *string        `json:"notes"`
 	Platform                string         `json:"platform" binding:"required"`
-	Type                    string         `json:"type" binding:"required,oneof=oauth setup-token apikey upstream"`
+	Type                    string         `json:"type" binding:"required,oneof=oauth setup-token apikey upstream bedrock vertex azure openai-compat"`
 	Credentials             map[string]any `json:"credentials" binding:"required"`
 	Extra                   map[string]any `json:"extra"`
 	ProxyID                 *int64         `json:"proxy_id"`
@@ -103,7 +104,7 @@ type CreateAccountRequest struct {
 type UpdateAccountRequest struct {
 	Name                    string         `json:"name"`
 	Notes                   *string        `json:"notes"`
-	Type                    string         `json:"type" binding:"omitempty,oneof=oauth setup-token apikey upstream"`
+	Type                    string         `json:"type" binding:"omitempty,oneof=oauth setup-token apikey upstream bedrock vertex azure openai-compat"`
 	Credentials             map[string]any `json:"credentials"`
 	Extra                   map[string]any `json:"extra"`
 	ProxyID                 *int64         `json:"proxy_id"`
@@ -363,6 +364,8 @@ func (h *AccountHandler) Update(c *gin.Context) {
 	// 确定是否跳过混合渠道检查
 	skipCheck := req.ConfirmMixedChannelRisk != nil && *req.ConfirmMixedChannelRisk
 
+	before, _ := h.adminService.GetAccount(c.Request.Context(), accountID)
+
 	account, err := h.adminService.UpdateAccount(c.Request.Context(), accountID, &service.UpdateAccountInput{
 		Name:                  req.Name,
 		Notes:                 req.Notes,
@@ -402,7 +405,11 @@ func (h *AccountHandler) Update(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, dto.AccountFromService(account))
+	out := dto.AccountFromService(account)
+	if before != nil {
+		middleware.SetAuditChange(c, dto.AccountFromService(before), out)
+	}
+	response.Success(c, out)
 }
 
 // Delete handles deleting an account
@@ -414,11 +421,16 @@ func (h *AccountHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetAccount(c.Request.Context(), accountID)
+
 	err = h.adminService.DeleteAccount(c.Request.Context(), accountID)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
+	if before != nil {
+		middleware.SetAuditChange(c, dto.AccountFromService(before), nil)
+	}
 
 	response.Success(c, gin.H{"message": "Account deleted successfully"})
 }
@@ -827,6 +839,9 @@ func (h *AccountHandler) BatchUpdateCredentials(c *gin.Context) {
 	success := 0
 	failed := 0
 	results := []gin.H{}
+	// 审计快照：account_id -> {field: value}
+	auditBefore := map[string]any{}
+	auditAfter := map[string]any{}
 
 	for _, accountID := range req.AccountIDs {
 		// Get account
@@ -846,6 +861,7 @@ func (h *AccountHandler) BatchUpdateCredentials(c *gin.Context) {
 			account.Credentials = make(map[string]any)
 		}
 
+		oldValue := account.Credentials[req.Field]
 		account.Credentials[req.Field] = req.Value
 
 		// Update account
@@ -869,7 +885,11 @@ func (h *AccountHandler) BatchUpdateCredentials(c *gin.Context) {
 			"account_id": accountID,
 			"success":    true,
 		})
+		auditKey := strconv.FormatInt(accountID, 10)
+		auditBefore[auditKey] = map[string]any{req.Field: oldValue}
+		auditAfter[auditKey] = map[string]any{req.Field: req.Value}
 	}
+	middleware.SetAuditChange(c, auditBefore, auditAfter)
 
 	response.Success(c, gin.H{
 		"success": success,
@@ -1532,3 +1552,15 @@ func (h *AccountHandler) BatchRefreshTier(c *gin.Context) {
 func (h *AccountHandler) GetAntigravityDefaultModelMapping(c *gin.Context) {
 	response.Success(c, domain.DefaultAntigravityModelMapping)
 }
+
+// GetBedrockDefaultModelMapping 获取 Bedrock 账号的默认模型映射
+// GET /api/v1/admin/accounts/bedrock/default-model-mapping
+func (h *AccountHandler) GetBedrockDefaultModelMapping(c *gin.Context) {
+	response.Success(c, domain.DefaultBedrockModelMapping)
+}
+
+// GetVertexDefaultModelMapping 获取 anthropic 平台 Vertex 账号的默认模型映射
+// GET /api/v1/admin/accounts/vertex/default-model-mapping
+func (h *AccountHandler) GetVertexDefaultModelMapping(c *gin.Context) {
+	response.Success(c, domain.DefaultVertexClaudeModelMapping)
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountHealthProbeHandler 账号定时健康探测记录 Handler
+type AccountHealthProbeHandler struct {
+	svc *service.AccountHealthProbeService
+}
+
+// NewAccountHealthProbeHandler 创建健康探测记录 Handler
+func NewAccountHealthProbeHandler(svc *service.AccountHealthProbeService) *AccountHealthProbeHandler {
+	return &AccountHealthProbeHandler{svc: svc}
+}
+
+// List 分页查询账号的健康探测记录
+// GET /api/v1/admin/accounts/:id/health-probes
+func (h *AccountHealthProbeHandler) List(c *gin.Context) {
+	accountID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account ID")
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+
+	probes, total, err := h.svc.ListProbes(c.Request.Context(), accountID, page, pageSize)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Paginated(c, probes, total, page, pageSize)
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditLogHandler 管理员操作审计日志 Handler
+type AuditLogHandler struct {
+	svc *service.AuditLogService
+}
+
+// NewAuditLogHandler 创建审计日志 Handler
+func NewAuditLogHandler(svc *service.AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{svc: svc}
+}
+
+// List 分页查询审计日志
+// GET /api/v1/admin/audit-logs
+func (h *AuditLogHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+
+	filters := service.AuditLogFilters{}
+
+	if v := c.Query("actor_user_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid actor_user_id")
+			return
+		}
+		filters.ActorUserID = id
+	}
+	if v := c.Query("target_type"); v != "" {
+		filters.TargetType = v
+	}
+	if v := c.Query("target_id"); v != "" {
+		filters.TargetID = v
+	}
+	if v := c.Query("method"); v != "" {
+		filters.Method = strings.ToUpper(v)
+	}
+	if v := c.Query("route"); v != "" {
+		filters.Route = v
+	}
+	if v := c.Query("start_date"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date, use RFC3339")
+			return
+		}
+		filters.StartTime = t
+	}
+	if v := c.Query("end_date"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date, use RFC3339")
+			return
+		}
+		filters.EndTime = t
+	}
+
+	logs, total, err := h.svc.List(c.Request.Context(), filters, page, pageSize)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Paginated(c, logs, total, page, pageSize)
+}
@@ -308,6 +308,25 @@ func (h *DashboardHandler) GetModelStats(c *gin.Context) {
 	})
 }
 
+// GetGroupCacheStats handles getting per-group prompt cache hit rate
+// GET /api/v1/admin/dashboard/groups-cache
+// Query params: start_date, end_date (YYYY-MM-DD)
+func (h *DashboardHandler) GetGroupCacheStats(c *gin.Context) {
+	startTime, endTime := parseTimeRange(c)
+
+	stats, err := h.dashboardService.GetGroupCacheStats(c.Request.Context(), startTime, endTime)
+	if err != nil {
+		response.Error(c, 500, "Failed to get group cache statistics")
+		return
+	}
+
+	response.Success(c, gin.H{
+		"groups":     stats,
+		"start_date": startTime.Format("2006-01-02"),
+		"end_date":   endTime.Add(-24 * time.Hour).Format("2006-01-02"),
+	})
+}
+
 // GetAPIKeyUsageTrend handles getting API key usage trend data
 // GET /api/v1/admin/dashboard/api-keys-trend
 // Query params: start_date, end_date (YYYY-MM-DD), granularity (day/hour), limit (default 5)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -47,6 +48,18 @@ type CreateGroupRequest struct {
 	MCPXMLInject        *bool              `json:"mcp_xml_inject"`
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string `json:"supported_model_scopes"`
+	// 精确响应缓存（TTL 为 0 时使用全局默认值）
+	ResponseCacheEnabled    bool `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int  `json:"response_cache_ttl_seconds" binding:"min=0"`
+	// 批处理计费折扣（0-1，未设置表示不打折）
+	BatchDiscountMultiplier *float64 `json:"batch_discount_multiplier" binding:"omitempty,min=0,max=1"`
+	// 对冲请求（hedge_delay_ms 为 0 时按滚动 p95 首字时间自动计算）
+	HedgeEnabled bool `json:"hedge_enabled"`
+	HedgeDelayMs int  `json:"hedge_delay_ms" binding:"min=0"`
+	// Prompt Cache 前缀路由（仅 anthropic 平台使用）
+	PromptCacheRoutingEnabled bool `json:"prompt_cache_routing_enabled"`
+	// 自动插入 cache_control 断点（仅 anthropic 平台使用）
+	AutoCacheControlEnabled bool `json:"auto_cache_control_enabled"`
 	// 从指定分组复制账号（创建后自动绑定）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -76,6 +89,16 @@ type UpdateGroupRequest struct {
 	MCPXMLInject        *bool              `json:"mcp_xml_inject"`
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes *[]string `json:"supported_model_scopes"`
+	// 精确响应缓存（TTL 为 0 时使用全局默认值）
+	ResponseCacheEnabled    *bool    `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds *int     `json:"response_cache_ttl_seconds" binding:"omitempty,min=0"`
+	BatchDiscountMultiplier *float64 `json:"batch_discount_multiplier" binding:"omitempty,min=0,max=1"`
+	HedgeEnabled            *bool    `json:"hedge_enabled"`
+	HedgeDelayMs            *int     `json:"hedge_delay_ms" binding:"omitempty,min=0"`
+	// Prompt Cache 前缀路由（仅 anthropic 平台使用）
+	PromptCacheRoutingEnabled *bool `json:"prompt_cache_routing_enabled"`
+	// 自动插入 cache_control 断点（仅 anthropic 平台使用）
+	AutoCacheControlEnabled *bool `json:"auto_cache_control_enabled"`
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -186,6 +209,13 @@ func (h *GroupHandler) Create(c *gin.Context) {
 		ModelRoutingEnabled:             req.ModelRoutingEnabled,
 		MCPXMLInject:                    req.MCPXMLInject,
 		SupportedModelScopes:            req.SupportedModelScopes,
+		ResponseCacheEnabled:            req.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds:         req.ResponseCacheTTLSeconds,
+		BatchDiscountMultiplier:         req.BatchDiscountMultiplier,
+		HedgeEnabled:                    req.HedgeEnabled,
+		HedgeDelayMs:                    req.HedgeDelayMs,
+		PromptCacheRoutingEnabled:       req.PromptCacheRoutingEnabled,
+		AutoCacheControlEnabled:         req.AutoCacheControlEnabled,
 		CopyAccountsFromGroupIDs:        req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -211,6 +241,8 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetGroup(c.Request.Context(), groupID)
+
 	group, err := h.adminService.UpdateGroup(c.Request.Context(), groupID, &service.UpdateGroupInput{
 		Name:                            req.Name,
 		Description:                     req.Description,
@@ -232,6 +264,13 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		ModelRoutingEnabled:             req.ModelRoutingEnabled,
 		MCPXMLInject:                    req.MCPXMLInject,
 		SupportedModelScopes:            req.SupportedModelScopes,
+		ResponseCacheEnabled:            req.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds:         req.ResponseCacheTTLSeconds,
+		BatchDiscountMultiplier:         req.BatchDiscountMultiplier,
+		HedgeEnabled:                    req.HedgeEnabled,
+		HedgeDelayMs:                    req.HedgeDelayMs,
+		PromptCacheRoutingEnabled:       req.PromptCacheRoutingEnabled,
+		AutoCacheControlEnabled:         req.AutoCacheControlEnabled,
 		CopyAccountsFromGroupIDs:        req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -239,7 +278,11 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, dto.GroupFromServiceAdmin(group))
+	out := dto.GroupFromServiceAdmin(group)
+	if before != nil {
+		middleware.SetAuditChange(c, dto.GroupFromServiceAdmin(before), out)
+	}
+	response.Success(c, out)
 }
 
 // Delete handles deleting a group
@@ -251,11 +294,16 @@ func (h *GroupHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetGroup(c.Request.Context(), groupID)
+
 	err = h.adminService.DeleteGroup(c.Request.Context(), groupID)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
+	if before != nil {
+		middleware.SetAuditChange(c, dto.GroupFromServiceAdmin(before), nil)
+	}
 
 	response.Success(c, gin.H{"message": "Group deleted successfully"})
 }
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
@@ -55,6 +56,75 @@ func (h *OpsHandler) UpdateEmailNotificationConfig(c *gin.Context) {
 	response.Success(c, updated)
 }
 
+// GetNotificationChannels returns Ops notification channels (DB-backed, secrets redacted).
+// GET /api/v1/admin/ops/notification-channels
+func (h *OpsHandler) GetNotificationChannels(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+	if err := h.opsService.RequireMonitoringEnabled(c.Request.Context()); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	cfg, err := h.opsService.GetNotificationChannelsConfig(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, "Failed to get notification channels")
+		return
+	}
+	response.Success(c, cfg)
+}
+
+// UpdateNotificationChannels replaces Ops notification channels (DB-backed).
+// PUT /api/v1/admin/ops/notification-channels
+func (h *OpsHandler) UpdateNotificationChannels(c *gin.Context) {
+	if h.opsService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+	if err := h.opsService.RequireMonitoringEnabled(c.Request.Context()); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	var req service.OpsNotificationChannelsConfig
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+
+	updated, err := h.opsService.UpdateNotificationChannelsConfig(c.Request.Context(), &req)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, updated)
+}
+
+// TestNotificationChannel sends a test message through a saved notification channel.
+// POST /api/v1/admin/ops/notification-channels/:id/test
+func (h *OpsHandler) TestNotificationChannel(c *gin.Context) {
+	if h.opsService == nil || h.notificationService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Ops service not available")
+		return
+	}
+	if err := h.opsService.RequireMonitoringEnabled(c.Request.Context()); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	if err := h.notificationService.SendTest(c.Request.Context(), c.Param("id")); err != nil {
+		if errors.Is(err, service.ErrOpsNotificationChannelNotFound) {
+			response.NotFound(c, "Notification channel not found")
+			return
+		}
+		response.Error(c, http.StatusBadGateway, "Failed to send test notification: "+err.Error())
+		return
+	}
+	response.Success(c, gin.H{"sent": true})
+}
+
 // GetAlertRuntimeSettings returns Ops alert evaluator runtime settings (DB-backed).
 // GET /api/v1/admin/ops/runtime/alert
 func (h *OpsHandler) GetAlertRuntimeSettings(c *gin.Context) {
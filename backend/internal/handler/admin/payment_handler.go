@@ -0,0 +1,122 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentHandler 管理端支付订单 Handler
+type PaymentHandler struct {
+	paymentService *service.PaymentService
+}
+
+// NewPaymentHandler 创建管理端支付订单 Handler
+func NewPaymentHandler(paymentService *service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{paymentService: paymentService}
+}
+
+// PaymentOrderActionRequest 退款/作废请求
+type PaymentOrderActionRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// List 分页查询支付订单
+// GET /api/v1/admin/payments/orders
+func (h *PaymentHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+
+	filters := service.PaymentOrderFilters{
+		Status:   c.Query("status"),
+		Type:     c.Query("type"),
+		Provider: c.Query("provider"),
+		OrderNo:  c.Query("order_no"),
+	}
+	if v := c.Query("user_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.BadRequest(c, "Invalid user_id")
+			return
+		}
+		filters.UserID = id
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	orders, result, err := h.paymentService.List(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AdminPaymentOrder, 0, len(orders))
+	for i := range orders {
+		out = append(out, *dto.PaymentOrderFromServiceAdmin(&orders[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetByID 获取订单详情
+// GET /api/v1/admin/payments/orders/:id
+func (h *PaymentHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid order ID")
+		return
+	}
+
+	order, err := h.paymentService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.PaymentOrderFromServiceAdmin(order))
+}
+
+// Refund 全额退款并撤回已发放的余额/订阅
+// POST /api/v1/admin/payments/orders/:id/refund
+func (h *PaymentHandler) Refund(c *gin.Context) {
+	h.applyAction(c, h.paymentService.Refund)
+}
+
+// Void 作废未支付订单
+// POST /api/v1/admin/payments/orders/:id/void
+func (h *PaymentHandler) Void(c *gin.Context) {
+	h.applyAction(c, h.paymentService.Void)
+}
+
+func (h *PaymentHandler) applyAction(c *gin.Context, action func(ctx context.Context, id int64, reason string) (*service.PaymentOrder, error)) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid order ID")
+		return
+	}
+
+	var req PaymentOrderActionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "Invalid request: "+err.Error())
+			return
+		}
+	}
+
+	before, err := h.paymentService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	order, err := action(c.Request.Context(), id, req.Reason)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	middleware.SetAuditChange(c, dto.PaymentOrderFromServiceAdmin(before), dto.PaymentOrderFromServiceAdmin(order))
+	response.Success(c, dto.PaymentOrderFromServiceAdmin(order))
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ResponseCacheHandler 响应缓存统计 Handler
+type ResponseCacheHandler struct {
+	responseCacheService *service.ResponseCacheService
+}
+
+// NewResponseCacheHandler 创建响应缓存统计 Handler
+func NewResponseCacheHandler(responseCacheService *service.ResponseCacheService) *ResponseCacheHandler {
+	return &ResponseCacheHandler{responseCacheService: responseCacheService}
+}
+
+// GetStats 获取各分组命中率统计
+// GET /api/v1/admin/response-cache/stats
+func (h *ResponseCacheHandler) GetStats(c *gin.Context) {
+	stats, err := h.responseCacheService.Stats(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, stats)
+}
+
+// ResetStats 清空命中率统计
+// DELETE /api/v1/admin/response-cache/stats
+func (h *ResponseCacheHandler) ResetStats(c *gin.Context) {
+	if err := h.responseCacheService.ResetStats(c.Request.Context()); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Response cache stats reset"})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -204,6 +205,8 @@ func (h *UserHandler) Update(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetUser(c.Request.Context(), userID)
+
 	// 使用指针类型直接传递，nil 表示未提供该字段
 	user, err := h.adminService.UpdateUser(c.Request.Context(), userID, &service.UpdateUserInput{
 		Email:         req.Email,
@@ -221,7 +224,11 @@ func (h *UserHandler) Update(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, dto.UserFromServiceAdmin(user))
+	out := dto.UserFromServiceAdmin(user)
+	if before != nil {
+		middleware.SetAuditChange(c, dto.UserFromServiceAdmin(before), out)
+	}
+	response.Success(c, out)
 }
 
 // Delete handles deleting a user
@@ -233,11 +240,16 @@ func (h *UserHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetUser(c.Request.Context(), userID)
+
 	err = h.adminService.DeleteUser(c.Request.Context(), userID)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
+	if before != nil {
+		middleware.SetAuditChange(c, dto.UserFromServiceAdmin(before), nil)
+	}
 
 	response.Success(c, gin.H{"message": "User deleted successfully"})
 }
@@ -257,13 +269,19 @@ func (h *UserHandler) UpdateBalance(c *gin.Context) {
 		return
 	}
 
+	before, _ := h.adminService.GetUser(c.Request.Context(), userID)
+
 	user, err := h.adminService.UpdateUserBalance(c.Request.Context(), userID, req.Balance, req.Operation, req.Notes)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
 
-	response.Success(c, dto.UserFromServiceAdmin(user))
+	out := dto.UserFromServiceAdmin(user)
+	if before != nil {
+		middleware.SetAuditChange(c, dto.UserFromServiceAdmin(before), out)
+	}
+	response.Success(c, out)
 }
 
 // GetUserAPIKeys handles getting user's API keys
@@ -35,6 +35,11 @@ type CreateAPIKeyRequest struct {
 	IPBlacklist   []string `json:"ip_blacklist"`    // IP 黑名单
 	Quota         *float64 `json:"quota"`           // 配额限制 (USD)
 	ExpiresInDays *int     `json:"expires_in_days"` // 过期天数
+
+	AllowedModels     []string `json:"allowed_models"`                                // 模型白名单（glob）
+	RateLimitRPM      *int     `json:"rate_limit_rpm" binding:"omitempty,min=0"`      // 每分钟请求数，0=无限制
+	RateLimitTPM      *int     `json:"rate_limit_tpm" binding:"omitempty,min=0"`      // 每分钟 token 数，0=无限制
+	DailyRequestLimit *int     `json:"daily_request_limit" binding:"omitempty,min=0"` // 每日请求数，0=无限制
 }
 
 // UpdateAPIKeyRequest represents the update API key request payload
@@ -47,6 +52,11 @@ type UpdateAPIKeyRequest struct {
 	Quota       *float64 `json:"quota"`        // 配额限制 (USD), 0=无限制
 	ExpiresAt   *string  `json:"expires_at"`   // 过期时间 (ISO 8601)
 	ResetQuota  *bool    `json:"reset_quota"`  // 重置已用配额
+
+	AllowedModels     []string `json:"allowed_models"`                                // 模型白名单（空数组清空）
+	RateLimitRPM      *int     `json:"rate_limit_rpm" binding:"omitempty,min=0"`      // 每分钟请求数，0=无限制
+	RateLimitTPM      *int     `json:"rate_limit_tpm" binding:"omitempty,min=0"`      // 每分钟 token 数，0=无限制
+	DailyRequestLimit *int     `json:"daily_request_limit" binding:"omitempty,min=0"` // 每日请求数，0=无限制
 }
 
 // List handles listing user's API keys with pagination
@@ -126,10 +136,20 @@ func (h *APIKeyHandler) Create(c *gin.Context) {
 		IPWhitelist:   req.IPWhitelist,
 		IPBlacklist:   req.IPBlacklist,
 		ExpiresInDays: req.ExpiresInDays,
+		AllowedModels: req.AllowedModels,
 	}
 	if req.Quota != nil {
 		svcReq.Quota = *req.Quota
 	}
+	if req.RateLimitRPM != nil {
+		svcReq.RateLimitRPM = *req.RateLimitRPM
+	}
+	if req.RateLimitTPM != nil {
+		svcReq.RateLimitTPM = *req.RateLimitTPM
+	}
+	if req.DailyRequestLimit != nil {
+		svcReq.DailyRequestLimit = *req.DailyRequestLimit
+	}
 	key, err := h.apiKeyService.Create(c.Request.Context(), subject.UserID, svcReq)
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -165,6 +185,11 @@ func (h *APIKeyHandler) Update(c *gin.Context) {
 		IPBlacklist: req.IPBlacklist,
 		Quota:       req.Quota,
 		ResetQuota:  req.ResetQuota,
+
+		AllowedModels:     req.AllowedModels,
+		RateLimitRPM:      req.RateLimitRPM,
+		RateLimitTPM:      req.RateLimitTPM,
+		DailyRequestLimit: req.DailyRequestLimit,
 	}
 	if req.Name != "" {
 		svcReq.Name = &req.Name
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BudgetAlertHandler handles user budget alert requests
+type BudgetAlertHandler struct {
+	budgetAlertService *service.BudgetAlertService
+}
+
+// NewBudgetAlertHandler creates a new BudgetAlertHandler
+func NewBudgetAlertHandler(budgetAlertService *service.BudgetAlertService) *BudgetAlertHandler {
+	return &BudgetAlertHandler{
+		budgetAlertService: budgetAlertService,
+	}
+}
+
+// CreateBudgetAlertRequest represents the create budget alert request payload
+type CreateBudgetAlertRequest struct {
+	Name           string  `json:"name"`
+	Metric         string  `json:"metric" binding:"required"`
+	ThresholdType  string  `json:"threshold_type" binding:"required"`
+	Threshold      float64 `json:"threshold"`
+	APIKeyID       *int64  `json:"api_key_id"`
+	SubscriptionID *int64  `json:"subscription_id"`
+	NotifyEmail    *bool   `json:"notify_email"` // 默认 true
+	WebhookURL     string  `json:"webhook_url"`
+	AutoDisableKey bool    `json:"auto_disable_key"`
+}
+
+// UpdateBudgetAlertRequest represents the update budget alert request payload
+type UpdateBudgetAlertRequest struct {
+	Name           *string  `json:"name"`
+	Metric         *string  `json:"metric"`
+	ThresholdType  *string  `json:"threshold_type"`
+	Threshold      *float64 `json:"threshold"`
+	APIKeyID       *int64   `json:"api_key_id"`      // 0 表示清除
+	SubscriptionID *int64   `json:"subscription_id"` // 0 表示清除
+	NotifyEmail    *bool    `json:"notify_email"`
+	WebhookURL     *string  `json:"webhook_url"` // 空字符串表示清除
+	AutoDisableKey *bool    `json:"auto_disable_key"`
+	Enabled        *bool    `json:"enabled"`
+}
+
+// List returns the current user's budget alerts
+// GET /api/v1/budget-alerts
+func (h *BudgetAlertHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	alerts, err := h.budgetAlertService.List(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.BudgetAlert, 0, len(alerts))
+	for i := range alerts {
+		out = append(out, *dto.BudgetAlertFromService(&alerts[i]))
+	}
+	response.Success(c, out)
+}
+
+// Create creates a budget alert
+// POST /api/v1/budget-alerts
+func (h *BudgetAlertHandler) Create(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req CreateBudgetAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	notifyEmail := true
+	if req.NotifyEmail != nil {
+		notifyEmail = *req.NotifyEmail
+	}
+
+	alert, err := h.budgetAlertService.Create(c.Request.Context(), subject.UserID, &service.CreateBudgetAlertInput{
+		Name:           req.Name,
+		Metric:         req.Metric,
+		ThresholdType:  req.ThresholdType,
+		Threshold:      req.Threshold,
+		APIKeyID:       req.APIKeyID,
+		SubscriptionID: req.SubscriptionID,
+		NotifyEmail:    notifyEmail,
+		WebhookURL:     req.WebhookURL,
+		AutoDisableKey: req.AutoDisableKey,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.BudgetAlertFromService(alert))
+}
+
+// Update updates a budget alert
+// PUT /api/v1/budget-alerts/:id
+func (h *BudgetAlertHandler) Update(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	alertID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid budget alert ID")
+		return
+	}
+
+	var req UpdateBudgetAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	alert, err := h.budgetAlertService.Update(c.Request.Context(), alertID, subject.UserID, &service.UpdateBudgetAlertInput{
+		Name:           req.Name,
+		Metric:         req.Metric,
+		ThresholdType:  req.ThresholdType,
+		Threshold:      req.Threshold,
+		APIKeyID:       req.APIKeyID,
+		SubscriptionID: req.SubscriptionID,
+		NotifyEmail:    req.NotifyEmail,
+		WebhookURL:     req.WebhookURL,
+		AutoDisableKey: req.AutoDisableKey,
+		Enabled:        req.Enabled,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.BudgetAlertFromService(alert))
+}
+
+// Delete deletes a budget alert
+// DELETE /api/v1/budget-alerts/:id
+func (h *BudgetAlertHandler) Delete(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	alertID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid budget alert ID")
+		return
+	}
+
+	if err := h.budgetAlertService.Delete(c.Request.Context(), alertID, subject.UserID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Budget alert deleted successfully"})
+}
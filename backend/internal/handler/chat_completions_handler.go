@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChatCompletionsHandler handles the OpenAI Chat Completions compatible endpoint.
+//
+// 请求按分组平台转换为 Responses（openai）或 Messages（anthropic/gemini/antigravity），
+// 复用对应网关 Handler 的调度、并发、failover 与计费链路，再把响应转换回 Chat Completions 格式。
+type ChatCompletionsHandler struct {
+	gatewayHandler       *GatewayHandler
+	openaiGatewayHandler *OpenAIGatewayHandler
+}
+
+// NewChatCompletionsHandler creates a new ChatCompletionsHandler
+func NewChatCompletionsHandler(gatewayHandler *GatewayHandler, openaiGatewayHandler *OpenAIGatewayHandler) *ChatCompletionsHandler {
+	return &ChatCompletionsHandler{
+		gatewayHandler:       gatewayHandler,
+		openaiGatewayHandler: openaiGatewayHandler,
+	}
+}
+
+// ChatCompletions handles OpenAI Chat Completions API endpoint
+// POST /v1/chat/completions
+func (h *ChatCompletionsHandler) ChatCompletions(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			h.errorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to read request body")
+		return
+	}
+	if len(body) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Request body is empty")
+		return
+	}
+
+	var req apicompat.ChatRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+	if strings.TrimSpace(req.Model) == "" {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "model is required")
+		return
+	}
+	if len(req.Messages) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "messages is required")
+		return
+	}
+
+	// 平台判定与 Messages 入口保持一致：强制平台优先，否则使用分组平台
+	platform := ""
+	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
+		platform = forcePlatform
+	} else if apiKey.Group != nil {
+		platform = apiKey.Group.Platform
+	}
+
+	includeUsage := req.StreamOptions != nil && req.StreamOptions.IncludeUsage
+	var (
+		converted   any
+		converter   apicompat.StreamConverter
+		convertBody func(body []byte, model string) ([]byte, error)
+		next        gin.HandlerFunc
+	)
+	if platform == service.PlatformOpenAI {
+		responsesReq, err := apicompat.ChatToResponsesRequest(&req)
+		if err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+			return
+		}
+		converted = responsesReq
+		converter = apicompat.NewResponsesToChatStream(req.Model, includeUsage)
+		convertBody = apicompat.ResponsesToChatResponse
+		next = h.openaiGatewayHandler.Responses
+	} else {
+		messagesReq, err := apicompat.ChatToAnthropicRequest(&req)
+		if err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+			return
+		}
+		converted = messagesReq
+		converter = apicompat.NewAnthropicToChatStream(req.Model, includeUsage)
+		convertBody = apicompat.AnthropicToChatResponse
+		next = h.gatewayHandler.Messages
+	}
+
+	translated, err := json.Marshal(converted)
+	if err != nil {
+		h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to process request")
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(translated))
+	c.Request.ContentLength = int64(len(translated))
+
+	writer := newCompatResponseWriter(c.Writer, req.Stream, converter, func(body []byte) ([]byte, error) {
+		return convertBody(body, req.Model)
+	}, apicompat.ChatErrorBody)
+	c.Writer = writer
+	defer func() {
+		c.Writer = writer.ResponseWriter
+	}()
+
+	next(c)
+	writer.finish()
+}
+
+// errorResponse returns OpenAI API format error response
+func (h *ChatCompletionsHandler) errorResponse(c *gin.Context, status int, errType, message string) {
+	c.JSON(status, gin.H{
+		"error": gin.H{
+			"type":    errType,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
+
+	"github.com/gin-gonic/gin"
+)
+
+// compatResponseWriter 拦截网关输出并转换为客户端请求的协议格式（Chat Completions、Messages 等）。
+//   - 流式成功响应：逐行转换上游 SSE 并立即写出
+//   - 非流式响应与错误响应：缓冲完整响应体，在 finish 时一次性转换写出
+type compatResponseWriter struct {
+	gin.ResponseWriter
+
+	stream       bool
+	converter    apicompat.StreamConverter
+	convertBody  func(body []byte) ([]byte, error)
+	convertError func(status int, body []byte) []byte
+
+	status    int
+	wrote     bool
+	streaming bool
+	lineBuf   []byte
+	buffered  bytes.Buffer
+}
+
+func newCompatResponseWriter(w gin.ResponseWriter, stream bool, converter apicompat.StreamConverter, convertBody func([]byte) ([]byte, error), convertError func(int, []byte) []byte) *compatResponseWriter {
+	return &compatResponseWriter{
+		ResponseWriter: w,
+		stream:         stream,
+		converter:      converter,
+		convertBody:    convertBody,
+		convertError:   convertError,
+	}
+}
+
+func (w *compatResponseWriter) WriteHeader(code int) {
+	if w.streaming || code <= 0 {
+		return
+	}
+	w.status = code
+}
+
+// WriteHeaderNow 延迟到确定输出格式后再写状态码
+func (w *compatResponseWriter) WriteHeaderNow() {}
+
+func (w *compatResponseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *compatResponseWriter) Written() bool {
+	return w.wrote
+}
+
+func (w *compatResponseWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	if w.stream && w.Status() < http.StatusBadRequest {
+		return w.writeStream(b)
+	}
+	return w.buffered.Write(b)
+}
+
+func (w *compatResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compatResponseWriter) Flush() {
+	if w.streaming {
+		w.ResponseWriter.Flush()
+	}
+}
+
+func (w *compatResponseWriter) writeStream(b []byte) (int, error) {
+	if !w.streaming {
+		w.streaming = true
+		header := w.ResponseWriter.Header()
+		header.Set("Content-Type", "text/event-stream")
+		header.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(w.Status())
+	}
+
+	w.lineBuf = append(w.lineBuf, b...)
+	for {
+		idx := bytes.IndexByte(w.lineBuf, '\n')
+		if idx < 0 {
+			break
+		}
+		line := string(w.lineBuf[:idx])
+		w.lineBuf = w.lineBuf[idx+1:]
+		if out := w.converter.ProcessLine(line); len(out) > 0 {
+			if _, err := w.ResponseWriter.Write(out); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(b), nil
+}
+
+// finish 内层 Handler 返回后调用，写出收尾数据或转换后的完整响应
+func (w *compatResponseWriter) finish() {
+	if w.streaming {
+		if len(w.lineBuf) > 0 {
+			if out := w.converter.ProcessLine(string(w.lineBuf)); len(out) > 0 {
+				_, _ = w.ResponseWriter.Write(out)
+			}
+			w.lineBuf = nil
+		}
+		if out := w.converter.Finish(); len(out) > 0 {
+			_, _ = w.ResponseWriter.Write(out)
+		}
+		w.ResponseWriter.Flush()
+		return
+	}
+	if !w.wrote && w.status == 0 {
+		return
+	}
+
+	status := w.Status()
+	body := w.buffered.Bytes()
+	var out []byte
+	if status >= http.StatusBadRequest {
+		out = w.convertError(status, body)
+	} else {
+		converted, err := w.convertBody(body)
+		if err != nil {
+			log.Printf("[CompatWriter] convert upstream response failed: %v", err)
+			status = http.StatusBadGateway
+			converted = w.convertError(status, []byte(`{"error":{"type":"upstream_error","message":"Failed to convert upstream response"}}`))
+		}
+		out = converted
+	}
+
+	header := w.ResponseWriter.Header()
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	header.Del("Content-Length")
+	w.ResponseWriter.WriteHeader(status)
+	_, _ = w.ResponseWriter.Write(out)
+}
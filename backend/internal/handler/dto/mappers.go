@@ -82,8 +82,14 @@ func APIKeyFromService(k *service.APIKey) *APIKey {
 		ExpiresAt:   k.ExpiresAt,
 		CreatedAt:   k.CreatedAt,
 		UpdatedAt:   k.UpdatedAt,
-		User:        UserFromServiceShallow(k.User),
-		Group:       GroupFromServiceShallow(k.Group),
+
+		AllowedModels:     k.AllowedModels,
+		RateLimitRPM:      k.RateLimitRPM,
+		RateLimitTPM:      k.RateLimitTPM,
+		DailyRequestLimit: k.DailyRequestLimit,
+
+		User:  UserFromServiceShallow(k.User),
+		Group: GroupFromServiceShallow(k.Group),
 	}
 }
 
@@ -116,6 +122,14 @@ func GroupFromServiceAdmin(g *service.Group) *AdminGroup {
 		SupportedModelScopes: g.SupportedModelScopes,
 		AccountCount:         g.AccountCount,
 		SortOrder:            g.SortOrder,
+
+		ResponseCacheEnabled:      g.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds:   g.ResponseCacheTTLSeconds,
+		BatchDiscountMultiplier:   g.BatchDiscountMultiplier,
+		HedgeEnabled:              g.HedgeEnabled,
+		HedgeDelayMs:              g.HedgeDelayMs,
+		PromptCacheRoutingEnabled: g.PromptCacheRoutingEnabled,
+		AutoCacheControlEnabled:   g.AutoCacheControlEnabled,
 	}
 	if len(g.AccountGroups) > 0 {
 		out.AccountGroups = make([]AccountGroup, 0, len(g.AccountGroups))
@@ -406,6 +420,8 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		ImageSize:             l.ImageSize,
 		UserAgent:             l.UserAgent,
 		CacheTTLOverridden:    l.CacheTTLOverridden,
+		ResponseCacheHit:      l.ResponseCacheHit,
+		RequestType:           l.RequestType,
 		CreatedAt:             l.CreatedAt,
 		User:                  UserFromServiceShallow(l.User),
 		APIKey:                APIKeyFromService(l.APIKey),
@@ -571,3 +587,75 @@ func PromoCodeUsageFromService(u *service.PromoCodeUsage) *PromoCodeUsage {
 		User:        UserFromServiceShallow(u.User),
 	}
 }
+
+func PaymentOrderFromService(o *service.PaymentOrder) *PaymentOrder {
+	if o == nil {
+		return nil
+	}
+	out := paymentOrderFromServiceBase(o)
+	return &out
+}
+
+// PaymentOrderFromServiceAdmin converts a service PaymentOrder to DTO for admin users.
+// It includes provider trade no, client IP and notes - user-facing endpoints must not use this.
+func PaymentOrderFromServiceAdmin(o *service.PaymentOrder) *AdminPaymentOrder {
+	if o == nil {
+		return nil
+	}
+	return &AdminPaymentOrder{
+		PaymentOrder:    paymentOrderFromServiceBase(o),
+		UserID:          o.UserID,
+		ProviderTradeNo: o.ProviderTradeNo,
+		ClientIP:        o.ClientIP,
+		Notes:           o.Notes,
+	}
+}
+
+func paymentOrderFromServiceBase(o *service.PaymentOrder) PaymentOrder {
+	out := PaymentOrder{
+		ID:             o.ID,
+		OrderNo:        o.OrderNo,
+		Type:           o.Type,
+		Provider:       o.Provider,
+		Status:         o.Status,
+		Amount:         o.Amount,
+		Currency:       o.Currency,
+		CreditAmount:   o.CreditAmount,
+		PlanID:         o.PlanID,
+		GroupID:        o.GroupID,
+		ValidityDays:   o.ValidityDays,
+		SubscriptionID: o.SubscriptionID,
+		PaidAt:         o.PaidAt,
+		RefundedAt:     o.RefundedAt,
+		VoidedAt:       o.VoidedAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+	// 支付链接仅对待支付订单有意义
+	if o.Status == service.PaymentOrderStatusPending {
+		out.PayURL = o.PayURL
+	}
+	return out
+}
+
+func BudgetAlertFromService(a *service.BudgetAlert) *BudgetAlert {
+	if a == nil {
+		return nil
+	}
+	return &BudgetAlert{
+		ID:              a.ID,
+		Name:            a.Name,
+		Metric:          a.Metric,
+		ThresholdType:   a.ThresholdType,
+		Threshold:       a.Threshold,
+		APIKeyID:        a.APIKeyID,
+		SubscriptionID:  a.SubscriptionID,
+		NotifyEmail:     a.NotifyEmail,
+		WebhookURL:      a.WebhookURL,
+		AutoDisableKey:  a.AutoDisableKey,
+		Enabled:         a.Enabled,
+		LastTriggeredAt: a.LastTriggeredAt,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
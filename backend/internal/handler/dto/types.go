@@ -44,6 +44,11 @@ type APIKey struct {
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 
+	AllowedModels     []string `json:"allowed_models"`      // Allowed model glob patterns (empty = all models)
+	RateLimitRPM      int      `json:"rate_limit_rpm"`      // Max requests per minute (0 = unlimited)
+	RateLimitTPM      int      `json:"rate_limit_tpm"`      // Max tokens per minute (0 = unlimited)
+	DailyRequestLimit int      `json:"daily_request_limit"` // Max requests per day (0 = unlimited)
+
 	User  *User  `json:"user,omitempty"`
 	Group *Group `json:"group,omitempty"`
 }
@@ -96,6 +101,19 @@ type AdminGroup struct {
 
 	// 分组排序
 	SortOrder int `json:"sort_order"`
+
+	// 精确响应缓存
+	ResponseCacheEnabled    bool `json:"response_cache_enabled"`
+	ResponseCacheTTLSeconds int  `json:"response_cache_ttl_seconds"`
+
+	// 批处理计费折扣
+	BatchDiscountMultiplier float64 `json:"batch_discount_multiplier"`
+	HedgeEnabled            bool    `json:"hedge_enabled"`
+	HedgeDelayMs            int     `json:"hedge_delay_ms"`
+	// Prompt Cache 前缀路由
+	PromptCacheRoutingEnabled bool `json:"prompt_cache_routing_enabled"`
+	// 自动插入 cache_control 断点
+	AutoCacheControlEnabled bool `json:"auto_cache_control_enabled"`
 }
 
 type Account struct {
@@ -281,6 +299,12 @@ type UsageLog struct {
 	// Cache TTL Override 标记
 	CacheTTLOverridden bool `json:"cache_ttl_overridden"`
 
+	// 精确响应缓存命中标记
+	ResponseCacheHit bool `json:"response_cache_hit"`
+
+	// 请求类型（空表示常规请求，embedding 表示向量请求）
+	RequestType string `json:"request_type"`
+
 	CreatedAt time.Time `json:"created_at"`
 
 	User         *User             `json:"user,omitempty"`
@@ -411,3 +435,53 @@ type PromoCodeUsage struct {
 
 	User *User `json:"user,omitempty"`
 }
+
+// PaymentOrder 是普通用户接口使用的支付订单 DTO（不包含 client_ip/notes 等内部字段）。
+type PaymentOrder struct {
+	ID             int64      `json:"id"`
+	OrderNo        string     `json:"order_no"`
+	Type           string     `json:"type"`
+	Provider       string     `json:"provider"`
+	Status         string     `json:"status"`
+	Amount         float64    `json:"amount"`
+	Currency       string     `json:"currency"`
+	CreditAmount   float64    `json:"credit_amount"`
+	PlanID         *string    `json:"plan_id"`
+	GroupID        *int64     `json:"group_id"`
+	ValidityDays   int        `json:"validity_days"`
+	SubscriptionID *int64     `json:"subscription_id"`
+	PayURL         *string    `json:"pay_url,omitempty"`
+	PaidAt         *time.Time `json:"paid_at"`
+	RefundedAt     *time.Time `json:"refunded_at"`
+	VoidedAt       *time.Time `json:"voided_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// AdminPaymentOrder 是管理员接口使用的支付订单 DTO。
+type AdminPaymentOrder struct {
+	PaymentOrder
+
+	UserID          int64   `json:"user_id"`
+	ProviderTradeNo *string `json:"provider_trade_no"`
+	ClientIP        string  `json:"client_ip"`
+	Notes           *string `json:"notes"`
+}
+
+// BudgetAlert 用户预算告警规则
+type BudgetAlert struct {
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Metric          string     `json:"metric"`
+	ThresholdType   string     `json:"threshold_type"`
+	Threshold       float64    `json:"threshold"`
+	APIKeyID        *int64     `json:"api_key_id"`
+	SubscriptionID  *int64     `json:"subscription_id"`
+	NotifyEmail     bool       `json:"notify_email"`
+	WebhookURL      *string    `json:"webhook_url"`
+	AutoDisableKey  bool       `json:"auto_disable_key"`
+	Enabled         bool       `json:"enabled"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FileHandler handles Anthropic Files API requests
+type FileHandler struct {
+	fileService *service.FileService
+}
+
+// NewFileHandler creates a new FileHandler
+func NewFileHandler(fileService *service.FileService) *FileHandler {
+	return &FileHandler{
+		fileService: fileService,
+	}
+}
+
+// fileResponse Anthropic file 对象
+type fileResponse struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	Filename     string    `json:"filename"`
+	MimeType     string    `json:"mime_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	CreatedAt    time.Time `json:"created_at"`
+	Downloadable bool      `json:"downloadable"`
+}
+
+// Upload uploads a file to an upstream account
+// POST /v1/files
+func (h *FileHandler) Upload(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	file, err := h.fileService.Upload(c.Request.Context(), apiKey, c.Request.Header, c.Request.Body, c.Request.ContentLength)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			anthropicErrorResponse(c, http.StatusRequestEntityTooLarge, "request_too_large", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		fileServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toFileResponse(file))
+}
+
+// List lists the current user's files
+// GET /v1/files
+func (h *FileHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	params := service.UpstreamFileListParams{
+		BeforeID: c.Query("before_id"),
+		AfterID:  c.Query("after_id"),
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 1000 {
+			anthropicErrorResponse(c, http.StatusBadRequest, "invalid_request_error", "limit must be an integer between 1 and 1000")
+			return
+		}
+		params.Limit = limit
+	}
+
+	page, err := h.fileService.List(c.Request.Context(), subject.UserID, params)
+	if err != nil {
+		fileServiceError(c, err)
+		return
+	}
+	data := make([]fileResponse, 0, len(page.Data))
+	for i := range page.Data {
+		data = append(data, toFileResponse(&page.Data[i]))
+	}
+	var firstID, lastID *string
+	if len(data) > 0 {
+		firstID, lastID = &data[0].ID, &data[len(data)-1].ID
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"data":     data,
+		"has_more": page.HasMore,
+		"first_id": firstID,
+		"last_id":  lastID,
+	})
+}
+
+// Get retrieves file metadata
+// GET /v1/files/:id
+func (h *FileHandler) Get(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	file, err := h.fileService.Get(c.Request.Context(), subject.UserID, c.Param("id"))
+	if err != nil {
+		fileServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toFileResponse(file))
+}
+
+// Content downloads file content from the owning upstream account
+// GET /v1/files/:id/content
+func (h *FileHandler) Content(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	resp, err := h.fileService.OpenContent(c.Request.Context(), subject.UserID, c.Param("id"))
+	if err != nil {
+		fileServiceError(c, err)
+		return
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	for _, key := range []string{"Content-Type", "Content-Length", "Content-Disposition"} {
+		if v := resp.Header.Get(key); v != "" {
+			c.Header(key, v)
+		}
+	}
+	c.Status(resp.StatusCode)
+	if _, err := io.Copy(c.Writer, resp.Body); err != nil {
+		log.Printf("[Files] stream file content failed: %v", err)
+	}
+}
+
+// Delete deletes a file
+// DELETE /v1/files/:id
+func (h *FileHandler) Delete(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	fileID := c.Param("id")
+	if err := h.fileService.Delete(c.Request.Context(), subject.UserID, fileID); err != nil {
+		fileServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"id": fileID, "type": "file_deleted"})
+}
+
+func toFileResponse(f *service.UpstreamFile) fileResponse {
+	return fileResponse{
+		ID:           f.FileID,
+		Type:         "file",
+		Filename:     f.Filename,
+		MimeType:     f.MimeType,
+		SizeBytes:    f.SizeBytes,
+		CreatedAt:    f.CreatedAt.UTC(),
+		Downloadable: f.Downloadable,
+	}
+}
+
+// fileServiceError 将业务错误/上游错误转换为 Anthropic 错误响应
+func fileServiceError(c *gin.Context, err error) {
+	var upstreamErr *service.UpstreamFileError
+	if errors.As(err, &upstreamErr) {
+		anthropicErrorResponse(c, upstreamErr.StatusCode, upstreamErr.ErrorType, upstreamErr.Message)
+		return
+	}
+
+	status := infraerrors.Code(err)
+	switch status {
+	case http.StatusBadRequest, http.StatusLengthRequired:
+		anthropicErrorResponse(c, status, "invalid_request_error", infraerrors.Message(err))
+	case http.StatusNotFound:
+		anthropicErrorResponse(c, status, "not_found_error", infraerrors.Message(err))
+	case http.StatusRequestEntityTooLarge:
+		anthropicErrorResponse(c, status, "request_too_large", infraerrors.Message(err))
+	case http.StatusServiceUnavailable:
+		anthropicErrorResponse(c, status, "api_error", infraerrors.Message(err))
+	default:
+		log.Printf("[Files] request failed: %v", err)
+		anthropicErrorResponse(c, http.StatusBadGateway, "api_error", "Upstream request failed")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	pkgerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -31,11 +32,13 @@ type GatewayHandler struct {
 	gatewayService            *service.GatewayService
 	geminiCompatService       *service.GeminiMessagesCompatService
 	antigravityGatewayService *service.AntigravityGatewayService
+	openaiGatewayService      *service.OpenAIGatewayService
 	userService               *service.UserService
 	billingCacheService       *service.BillingCacheService
 	usageService              *service.UsageService
 	apiKeyService             *service.APIKeyService
 	errorPassthroughService   *service.ErrorPassthroughService
+	fileService               *service.FileService
 	concurrencyHelper         *ConcurrencyHelper
 	maxAccountSwitches        int
 	maxAccountSwitchesGemini  int
@@ -46,12 +49,14 @@ func NewGatewayHandler(
 	gatewayService *service.GatewayService,
 	geminiCompatService *service.GeminiMessagesCompatService,
 	antigravityGatewayService *service.AntigravityGatewayService,
+	openaiGatewayService *service.OpenAIGatewayService,
 	userService *service.UserService,
 	concurrencyService *service.ConcurrencyService,
 	billingCacheService *service.BillingCacheService,
 	usageService *service.UsageService,
 	apiKeyService *service.APIKeyService,
 	errorPassthroughService *service.ErrorPassthroughService,
+	fileService *service.FileService,
 	cfg *config.Config,
 ) *GatewayHandler {
 	pingInterval := time.Duration(0)
@@ -70,11 +75,13 @@ func NewGatewayHandler(
 		gatewayService:            gatewayService,
 		geminiCompatService:       geminiCompatService,
 		antigravityGatewayService: antigravityGatewayService,
+		openaiGatewayService:      openaiGatewayService,
 		userService:               userService,
 		billingCacheService:       billingCacheService,
 		usageService:              usageService,
 		apiKeyService:             apiKeyService,
 		errorPassthroughService:   errorPassthroughService,
+		fileService:               fileService,
 		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, SSEPingFormatClaude, pingInterval),
 		maxAccountSwitches:        maxAccountSwitches,
 		maxAccountSwitchesGemini:  maxAccountSwitchesGemini,
@@ -218,6 +225,17 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	} else if apiKey.Group != nil {
 		platform = apiKey.Group.Platform
 	}
+
+	// OpenAI 分组：Messages 请求转换为 Responses 后交由 OpenAIGatewayService 转发
+	if platform == service.PlatformOpenAI {
+		h.forwardMessagesToOpenAI(c, apiKey, subscription, body, reqModel, reqStream, sessionHash, &streamStarted)
+		return
+	}
+
+	if !h.pinFileOwnerAccount(c, subject.UserID, body, streamStarted) {
+		return
+	}
+
 	sessionKey := sessionHash
 	if platform == service.PlatformGemini && sessionHash != "" {
 		sessionKey = "gemini:" + sessionHash
@@ -387,6 +405,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 						return
 					}
 					switchCount++
+					service.RecordFailoverSwitch(account.Platform)
 					log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
 					if account.Platform == service.PlatformAntigravity {
 						if !sleepFailoverDelay(c.Request.Context(), switchCount) {
@@ -403,10 +422,11 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 			userAgent := c.GetHeader("User-Agent")
 			clientIP := ip.GetClientIP(c)
+			usageTraceCtx := tracing.Detach(c.Request.Context())
 
 			// 异步记录使用量（subscription已在函数开头获取）
 			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, fcb bool) {
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				ctx, cancel := context.WithTimeout(usageTraceCtx, 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:            result,
@@ -441,6 +461,12 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		c.Request = c.Request.WithContext(ctx)
 	}
 
+	// 精确响应缓存：命中时直接写回，不选择账号、不占用账号槽位，使用量记入产生该响应的账号
+	if cached, cachedAccount := h.gatewayService.ServeResponseCacheHit(c, parsedReq); cached != nil {
+		h.recordUsageAsync(c, cached, apiKey, subscription, cachedAccount, false)
+		return
+	}
+
 	for {
 		maxAccountSwitches := h.maxAccountSwitches
 		switchCount := 0
@@ -448,7 +474,10 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		sameAccountRetryCount := make(map[int64]int) // 同账号重试计数
 		var lastFailoverErr *service.UpstreamFailoverError
 		retryWithFallback := false
-		var forceCacheBilling bool // 粘性会话切换时的缓存计费标记
+		var forceCacheBilling bool                 // 粘性会话切换时的缓存计费标记
+		var streamRecovery *service.StreamRecovery // 流中断后续写状态（非 nil 时后续账号以续写方式转发）
+		// prompt cache 亲和调度：计算请求各缓存断点的前缀哈希，供选号与转发成功后绑定账号
+		c.Request = c.Request.WithContext(h.gatewayService.WithPromptCachePrefixes(c.Request.Context(), currentAPIKey.Group, parsedReq))
 
 		for {
 			// 选择支持该模型的账号
@@ -552,8 +581,23 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if switchCount > 0 {
 				requestCtx = context.WithValue(requestCtx, ctxkey.AccountSwitchCount, switchCount)
 			}
-			if account.Platform == service.PlatformAntigravity && account.Type != service.AccountTypeAPIKey {
+			hedgeDelay, hedgeEnabled := h.gatewayService.HedgeDelay(currentAPIKey.Group)
+			if streamRecovery != nil {
+				result, err = h.gatewayService.ForwardContinuation(requestCtx, c, account, parsedReq, streamRecovery)
+			} else if account.Platform == service.PlatformAntigravity && account.Type != service.AccountTypeAPIKey {
 				result, err = h.antigravityGatewayService.Forward(requestCtx, c, account, body, hasBoundSession)
+			} else if hedgeEnabled && reqStream {
+				// 对冲请求：首字节超时后向第二个账号发起同一请求，后续失败切换与计费均以胜出账号为准
+				var winner *service.Account
+				result, winner, err = h.gatewayService.ForwardHedged(requestCtx, c, account, parsedReq, hedgeDelay, currentAPIKey.GroupID, failedAccountIDs)
+				if accountReleaseFunc != nil {
+					accountReleaseFunc()
+					accountReleaseFunc = nil
+				}
+				if winner != nil && winner.ID != account.ID {
+					account = winner
+					setOpsSelectedAccount(c, account.ID)
+				}
 			} else {
 				result, err = h.gatewayService.Forward(requestCtx, c, account, parsedReq)
 			}
@@ -596,6 +640,22 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					_ = h.antigravityGatewayService.WriteMappedClaudeError(c, account, promptTooLongErr.StatusCode, promptTooLongErr.RequestID, promptTooLongErr.Body)
 					return
 				}
+				// 流中途中断：已输出部分按当前账号计费，再到其他账号上续写并拼接到同一个流
+				var interruptedErr *service.StreamInterruptedError
+				if errors.As(err, &interruptedErr) {
+					h.recordUsageAsync(c, interruptedErr.Result, currentAPIKey, currentSubscription, account, forceCacheBilling)
+					streamRecovery = interruptedErr.Recovery
+					streamStarted = true
+					failedAccountIDs[account.ID] = struct{}{}
+					if switchCount >= maxAccountSwitches {
+						h.handleStreamingAwareError(c, http.StatusBadGateway, "api_error", "Upstream stream interrupted", true)
+						return
+					}
+					switchCount++
+					service.RecordFailoverSwitch(account.Platform)
+					log.Printf("Account %d: stream interrupted, resuming on another account %d/%d", account.ID, switchCount, maxAccountSwitches)
+					continue
+				}
 				var failoverErr *service.UpstreamFailoverError
 				if errors.As(err, &failoverErr) {
 					lastFailoverErr = failoverErr
@@ -625,6 +685,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 						return
 					}
 					switchCount++
+					service.RecordFailoverSwitch(account.Platform)
 					log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
 					if account.Platform == service.PlatformAntigravity {
 						if !sleepFailoverDelay(c.Request.Context(), switchCount) {
@@ -637,14 +698,16 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 				log.Printf("Account %d: Forward request failed: %v", account.ID, err)
 				return
 			}
+			h.gatewayService.BindPromptCachePrefixes(c.Request.Context(), currentAPIKey.Group, account.ID)
 
 			// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
 			userAgent := c.GetHeader("User-Agent")
 			clientIP := ip.GetClientIP(c)
+			usageTraceCtx := tracing.Detach(c.Request.Context())
 
 			// 异步记录使用量（subscription已在函数开头获取）
 			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, fcb bool) {
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				ctx, cancel := context.WithTimeout(usageTraceCtx, 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:            result,
@@ -668,6 +731,30 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	}
 }
 
+// recordUsageAsync 异步记录一次转发的使用量（请求信息在调用时捕获，避免在 goroutine 中访问 gin.Context）
+func (h *GatewayHandler) recordUsageAsync(c *gin.Context, result *service.ForwardResult, apiKey *service.APIKey, subscription *service.UserSubscription, account *service.Account, forceCacheBilling bool) {
+	userAgent := c.GetHeader("User-Agent")
+	clientIP := ip.GetClientIP(c)
+	usageTraceCtx := tracing.Detach(c.Request.Context())
+	go func() {
+		ctx, cancel := context.WithTimeout(usageTraceCtx, 10*time.Second)
+		defer cancel()
+		if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
+			Result:            result,
+			APIKey:            apiKey,
+			User:              apiKey.User,
+			Account:           account,
+			Subscription:      subscription,
+			UserAgent:         userAgent,
+			IPAddress:         clientIP,
+			ForceCacheBilling: forceCacheBilling,
+			APIKeyService:     h.apiKeyService,
+		}); err != nil {
+			log.Printf("Record usage failed: %v", err)
+		}
+	}()
+}
+
 // Models handles listing available models
 // GET /v1/models
 // Returns models based on account configurations (model_mapping whitelist)
@@ -1051,6 +1138,25 @@ func (h *GatewayHandler) errorResponse(c *gin.Context, status int, errType, mess
 	})
 }
 
+// pinFileOwnerAccount 请求引用了通过 /v1/files 上传的文件时，将本次调度固定到持有文件的账号
+// （file_id 只在该账号上有效）。返回 false 表示已写入错误响应。
+func (h *GatewayHandler) pinFileOwnerAccount(c *gin.Context, userID int64, body []byte, streamStarted bool) bool {
+	accountID, err := h.fileService.ResolveFileAccount(c.Request.Context(), userID, body)
+	if err != nil {
+		if pkgerrors.Code(err) == http.StatusBadRequest {
+			h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", pkgerrors.Message(err), streamStarted)
+			return false
+		}
+		log.Printf("Resolve file owner account failed: %v", err)
+		h.handleStreamingAwareError(c, http.StatusInternalServerError, "api_error", "Failed to resolve referenced files", streamStarted)
+		return false
+	}
+	if accountID > 0 {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.PinnedAccountID, accountID))
+	}
+	return true
+}
+
 // CountTokens handles token counting endpoint
 // POST /v1/messages/count_tokens
 // 特点：校验订阅/余额，但不计算并发、不记录使用量
@@ -1062,7 +1168,7 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 		return
 	}
 
-	_, ok = middleware2.GetAuthSubjectFromContext(c)
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
 	if !ok {
 		h.errorResponse(c, http.StatusInternalServerError, "api_error", "User context not found")
 		return
@@ -1116,6 +1222,12 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 		return
 	}
 
+	// OpenAI 分组没有 count_tokens 上游，与 Antigravity 一致直接返回空值
+	if _, forced := middleware2.GetForcePlatformFromContext(c); !forced && apiKey.Group != nil && apiKey.Group.Platform == service.PlatformOpenAI {
+		c.JSON(http.StatusOK, gin.H{"input_tokens": 0})
+		return
+	}
+
 	// 计算粘性会话 hash
 	parsedReq.SessionContext = &service.SessionContext{
 		ClientIP:  ip.GetClientIP(c),
@@ -1124,6 +1236,10 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 	}
 	sessionHash := h.gatewayService.GenerateSessionHash(parsedReq)
 
+	if !h.pinFileOwnerAccount(c, subject.UserID, body, false) {
+		return
+	}
+
 	// 选择支持该模型的账号
 	account, err := h.gatewayService.SelectAccountForModel(c.Request.Context(), apiKey.GroupID, sessionHash, parsedReq.Model)
 	if err != nil {
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// forwardMessagesToOpenAI 将 Claude Messages 请求转换为 Responses 请求，调度 OpenAI 账号转发，
+// 并把上游响应（含 SSE）转换回 Messages 格式。用户并发槽位与计费检查已由 Messages 入口完成。
+func (h *GatewayHandler) forwardMessagesToOpenAI(
+	c *gin.Context,
+	apiKey *service.APIKey,
+	subscription *service.UserSubscription,
+	body []byte,
+	reqModel string,
+	reqStream bool,
+	sessionHash string,
+	streamStarted *bool,
+) {
+	var anthropicReq apicompat.AnthropicRequest
+	if err := json.Unmarshal(body, &anthropicReq); err != nil {
+		h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body", *streamStarted)
+		return
+	}
+	responsesReq, err := apicompat.AnthropicToResponsesRequest(&anthropicReq)
+	if err != nil {
+		h.handleStreamingAwareError(c, http.StatusBadRequest, "invalid_request_error", err.Error(), *streamStarted)
+		return
+	}
+	// 粘性会话 hash 同时作为上游 prompt_cache_key，提升同一会话的缓存命中率
+	responsesReq.PromptCacheKey = sessionHash
+	responsesBody, err := json.Marshal(responsesReq)
+	if err != nil {
+		h.handleStreamingAwareError(c, http.StatusInternalServerError, "api_error", "Failed to process request", *streamStarted)
+		return
+	}
+
+	// Forward 按 Responses 协议写出响应，由 compatResponseWriter 转换为 Messages 协议
+	newWriter := func() *compatResponseWriter {
+		return newCompatResponseWriter(c.Writer, reqStream, apicompat.NewResponsesToAnthropicStream(reqModel), func(upstream []byte) ([]byte, error) {
+			return apicompat.ResponsesToAnthropicResponse(upstream, reqModel)
+		}, apicompat.AnthropicErrorBody)
+	}
+
+	// 精确响应缓存：命中时直接写回，不选择账号、不占用账号槽位，使用量记入产生该响应的账号
+	writer := newWriter()
+	c.Writer = writer
+	cached, cachedAccount := h.openaiGatewayService.ServeResponseCacheHit(c, responsesBody)
+	c.Writer = writer.ResponseWriter
+	if cached != nil {
+		writer.finish()
+		h.recordOpenAIUsageAsync(c, cached, apiKey, subscription, cachedAccount)
+		return
+	}
+
+	maxAccountSwitches := h.maxAccountSwitches
+	switchCount := 0
+	failedAccountIDs := make(map[int64]struct{})
+	var lastFailoverErr *service.UpstreamFailoverError
+
+	for {
+		selection, err := h.openaiGatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), apiKey.GroupID, sessionHash, reqModel, failedAccountIDs)
+		if err != nil {
+			if len(failedAccountIDs) == 0 {
+				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), *streamStarted)
+				return
+			}
+			if lastFailoverErr != nil {
+				h.handleFailoverExhausted(c, lastFailoverErr, service.PlatformOpenAI, *streamStarted)
+			} else {
+				h.handleFailoverExhaustedSimple(c, 502, *streamStarted)
+			}
+			return
+		}
+		account := selection.Account
+		setOpsSelectedAccount(c, account.ID)
+
+		// 获取账号并发槽位
+		accountReleaseFunc := selection.ReleaseFunc
+		if !selection.Acquired {
+			if selection.WaitPlan == nil {
+				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts", *streamStarted)
+				return
+			}
+			accountWaitCounted := false
+			canWait, err := h.concurrencyHelper.IncrementAccountWaitCount(c.Request.Context(), account.ID, selection.WaitPlan.MaxWaiting)
+			if err != nil {
+				log.Printf("Increment account wait count failed: %v", err)
+			} else if !canWait {
+				log.Printf("Account wait queue full: account=%d", account.ID)
+				h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Too many pending requests, please retry later", *streamStarted)
+				return
+			}
+			if err == nil && canWait {
+				accountWaitCounted = true
+			}
+			defer func() {
+				if accountWaitCounted {
+					h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+				}
+			}()
+
+			accountReleaseFunc, err = h.concurrencyHelper.AcquireAccountSlotWithWaitTimeout(
+				c,
+				account.ID,
+				selection.WaitPlan.MaxConcurrency,
+				selection.WaitPlan.Timeout,
+				reqStream,
+				streamStarted,
+			)
+			if err != nil {
+				log.Printf("Account concurrency acquire failed: %v", err)
+				h.handleConcurrencyError(c, err, "account", *streamStarted)
+				return
+			}
+			if accountWaitCounted {
+				h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+				accountWaitCounted = false
+			}
+			if err := h.openaiGatewayService.BindStickySession(c.Request.Context(), apiKey.GroupID, sessionHash, account.ID); err != nil {
+				log.Printf("Bind sticky session failed: %v", err)
+			}
+		}
+		// 账号槽位/等待计数需要在超时或断开时安全回收
+		accountReleaseFunc = wrapReleaseOnDone(c.Request.Context(), accountReleaseFunc)
+
+		writer := newWriter()
+		c.Writer = writer
+		result, err := h.openaiGatewayService.Forward(c.Request.Context(), c, account, responsesBody)
+		c.Writer = writer.ResponseWriter
+		writer.finish()
+		if accountReleaseFunc != nil {
+			accountReleaseFunc()
+		}
+		if err != nil {
+			var failoverErr *service.UpstreamFailoverError
+			if errors.As(err, &failoverErr) {
+				failedAccountIDs[account.ID] = struct{}{}
+				lastFailoverErr = failoverErr
+				if switchCount >= maxAccountSwitches {
+					h.handleFailoverExhausted(c, failoverErr, service.PlatformOpenAI, *streamStarted)
+					return
+				}
+				switchCount++
+				service.RecordFailoverSwitch(account.Platform)
+				log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
+				continue
+			}
+			// 错误响应已在Forward中处理，这里只记录日志
+			log.Printf("Account %d: Forward request failed: %v", account.ID, err)
+			return
+		}
+
+		h.recordOpenAIUsageAsync(c, result, apiKey, subscription, account)
+		return
+	}
+}
+
+// recordOpenAIUsageAsync 异步记录一次 OpenAI 转发的使用量
+func (h *GatewayHandler) recordOpenAIUsageAsync(c *gin.Context, result *service.OpenAIForwardResult, apiKey *service.APIKey, subscription *service.UserSubscription, account *service.Account) {
+	// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
+	userAgent := c.GetHeader("User-Agent")
+	clientIP := ip.GetClientIP(c)
+	usageTraceCtx := tracing.Detach(c.Request.Context())
+	go func() {
+		ctx, cancel := context.WithTimeout(usageTraceCtx, 10*time.Second)
+		defer cancel()
+		if err := h.openaiGatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
+			Result:        result,
+			APIKey:        apiKey,
+			User:          apiKey.User,
+			Account:       account,
+			Subscription:  subscription,
+			UserAgent:     userAgent,
+			IPAddress:     clientIP,
+			APIKeyService: h.apiKeyService,
+		}); err != nil {
+			log.Printf("Record usage failed: %v", err)
+		}
+	}()
+}
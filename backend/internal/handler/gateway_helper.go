@@ -9,9 +9,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // claudeCodeValidator is a singleton validator for Claude Code client detection
@@ -201,7 +204,14 @@ func (h *ConcurrencyHelper) waitForSlotWithPing(c *gin.Context, slotType string,
 
 // waitForSlotWithPingTimeout waits for a concurrency slot with a custom timeout.
 func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType string, id int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	ctx, span := tracing.Start(c.Request.Context(), "concurrency.wait",
+		attribute.String("slot_type", slotType),
+		attribute.Int64("slot_owner_id", id),
+		attribute.Int("max_concurrency", maxConcurrency),
+	)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Try immediate acquire first (avoid unnecessary wait)
@@ -247,6 +257,7 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 	for {
 		select {
 		case <-ctx.Done():
+			span.SetStatus(codes.Error, "wait timeout")
 			return nil, &ConcurrencyError{
 				SlotType:  slotType,
 				IsTimeout: true,
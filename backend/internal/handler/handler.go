@@ -26,23 +26,33 @@ type AdminHandlers struct {
 	UserAttribute    *admin.UserAttributeHandler
 	ErrorPassthrough *admin.ErrorPassthroughHandler
 
-	RequestContentLog *admin.RequestContentLogHandler
+	RequestContentLog  *admin.RequestContentLogHandler
+	AuditLog           *admin.AuditLogHandler
+	Payment            *admin.PaymentHandler
+	ResponseCache      *admin.ResponseCacheHandler
+	AccountHealthProbe *admin.AccountHealthProbeHandler
 }
 
 // Handlers contains all HTTP handlers
 type Handlers struct {
-	Auth          *AuthHandler
-	User          *UserHandler
-	APIKey        *APIKeyHandler
-	Usage         *UsageHandler
-	Redeem        *RedeemHandler
-	Subscription  *SubscriptionHandler
-	Announcement  *AnnouncementHandler
-	Admin         *AdminHandlers
-	Gateway       *GatewayHandler
-	OpenAIGateway *OpenAIGatewayHandler
-	Setting       *SettingHandler
-	Totp          *TotpHandler
+	Auth            *AuthHandler
+	User            *UserHandler
+	APIKey          *APIKeyHandler
+	Usage           *UsageHandler
+	Redeem          *RedeemHandler
+	Subscription    *SubscriptionHandler
+	Announcement    *AnnouncementHandler
+	Admin           *AdminHandlers
+	Gateway         *GatewayHandler
+	OpenAIGateway   *OpenAIGatewayHandler
+	ChatCompletions *ChatCompletionsHandler
+	Setting         *SettingHandler
+	Totp            *TotpHandler
+	Metrics         *MetricsHandler
+	Payment         *PaymentHandler
+	BudgetAlert     *BudgetAlertHandler
+	MessageBatch    *MessageBatchHandler
+	File            *FileHandler
 }
 
 // BuildInfo contains build-time information
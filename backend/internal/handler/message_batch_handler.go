@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MessageBatchHandler handles Anthropic Message Batches API requests
+type MessageBatchHandler struct {
+	messageBatchService *service.MessageBatchService
+}
+
+// NewMessageBatchHandler creates a new MessageBatchHandler
+func NewMessageBatchHandler(messageBatchService *service.MessageBatchService) *MessageBatchHandler {
+	return &MessageBatchHandler{
+		messageBatchService: messageBatchService,
+	}
+}
+
+// CreateMessageBatchRequest represents the create message batch request payload
+type CreateMessageBatchRequest struct {
+	Requests []service.MessageBatchRequest `json:"requests"`
+}
+
+// messageBatchResponse Anthropic message_batch 对象
+type messageBatchResponse struct {
+	ID                string                            `json:"id"`
+	Type              string                            `json:"type"`
+	ProcessingStatus  string                            `json:"processing_status"`
+	RequestCounts     service.MessageBatchRequestCounts `json:"request_counts"`
+	EndedAt           *time.Time                        `json:"ended_at"`
+	CreatedAt         time.Time                         `json:"created_at"`
+	ExpiresAt         time.Time                         `json:"expires_at"`
+	ArchivedAt        *time.Time                        `json:"archived_at"`
+	CancelInitiatedAt *time.Time                        `json:"cancel_initiated_at"`
+	ResultsURL        *string                           `json:"results_url"`
+}
+
+// Create creates a message batch
+// POST /v1/messages/batches
+func (h *MessageBatchHandler) Create(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			anthropicErrorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		anthropicErrorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to read request body")
+		return
+	}
+	var req CreateMessageBatchRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		anthropicErrorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+
+	batch, err := h.messageBatchService.Create(c.Request.Context(), apiKey, req.Requests)
+	if err != nil {
+		messageBatchServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toMessageBatchResponse(c, batch))
+}
+
+// List lists the current user's message batches
+// GET /v1/messages/batches
+func (h *MessageBatchHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	params := service.MessageBatchListParams{
+		BeforeID: c.Query("before_id"),
+		AfterID:  c.Query("after_id"),
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 1000 {
+			anthropicErrorResponse(c, http.StatusBadRequest, "invalid_request_error", "limit must be an integer between 1 and 1000")
+			return
+		}
+		params.Limit = limit
+	}
+
+	page, err := h.messageBatchService.List(c.Request.Context(), subject.UserID, params)
+	if err != nil {
+		messageBatchServiceError(c, err)
+		return
+	}
+	data := make([]messageBatchResponse, 0, len(page.Data))
+	for i := range page.Data {
+		data = append(data, toMessageBatchResponse(c, &page.Data[i]))
+	}
+	var firstID, lastID *string
+	if len(data) > 0 {
+		firstID, lastID = &data[0].ID, &data[len(data)-1].ID
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"data":     data,
+		"has_more": page.HasMore,
+		"first_id": firstID,
+		"last_id":  lastID,
+	})
+}
+
+// Get retrieves a message batch
+// GET /v1/messages/batches/:id
+func (h *MessageBatchHandler) Get(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	batch, err := h.messageBatchService.Get(c.Request.Context(), subject.UserID, c.Param("id"))
+	if err != nil {
+		messageBatchServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toMessageBatchResponse(c, batch))
+}
+
+// Cancel cancels a message batch
+// POST /v1/messages/batches/:id/cancel
+func (h *MessageBatchHandler) Cancel(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	batch, err := h.messageBatchService.Cancel(c.Request.Context(), subject.UserID, c.Param("id"))
+	if err != nil {
+		messageBatchServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toMessageBatchResponse(c, batch))
+}
+
+// Results streams the results of an ended message batch as JSONL
+// GET /v1/messages/batches/:id/results
+func (h *MessageBatchHandler) Results(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		anthropicErrorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	started := false
+	err := h.messageBatchService.WriteResults(c.Request.Context(), subject.UserID, c.Param("id"), func(line []byte) error {
+		if !started {
+			c.Header("Content-Type", "application/x-jsonl")
+			c.Status(http.StatusOK)
+			started = true
+		}
+		if _, err := c.Writer.Write(line); err != nil {
+			return err
+		}
+		_, err := c.Writer.Write([]byte("\n"))
+		return err
+	})
+	if err != nil {
+		if started {
+			log.Printf("[MessageBatch] write results failed: %v", err)
+			return
+		}
+		messageBatchServiceError(c, err)
+		return
+	}
+	if !started {
+		c.Data(http.StatusOK, "application/x-jsonl", nil)
+	}
+}
+
+func toMessageBatchResponse(c *gin.Context, b *service.MessageBatch) messageBatchResponse {
+	out := messageBatchResponse{
+		ID:                b.BatchID,
+		Type:              "message_batch",
+		ProcessingStatus:  b.ProcessingStatus,
+		RequestCounts:     b.RequestCounts,
+		EndedAt:           b.EndedAt,
+		CreatedAt:         b.CreatedAt.UTC(),
+		ExpiresAt:         b.ExpiresAt.UTC(),
+		CancelInitiatedAt: b.CancelInitiatedAt,
+	}
+	if b.ProcessingStatus == service.MessageBatchStatusEnded {
+		scheme := "http"
+		if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+			scheme = "https"
+		}
+		url := scheme + "://" + c.Request.Host + "/v1/messages/batches/" + b.BatchID + "/results"
+		out.ResultsURL = &url
+	}
+	return out
+}
+
+// messageBatchServiceError 将业务错误转换为 Anthropic 错误响应
+func messageBatchServiceError(c *gin.Context, err error) {
+	status := infraerrors.Code(err)
+	switch status {
+	case http.StatusBadRequest:
+		anthropicErrorResponse(c, status, "invalid_request_error", infraerrors.Message(err))
+	case http.StatusNotFound:
+		anthropicErrorResponse(c, status, "not_found_error", infraerrors.Message(err))
+	default:
+		log.Printf("[MessageBatch] request failed: %v", err)
+		anthropicErrorResponse(c, http.StatusInternalServerError, "api_error", "Internal server error")
+	}
+}
+
+// anthropicErrorResponse 以 Anthropic 错误格式返回（Message Batches / Files 等非流式接口共用）
+func anthropicErrorResponse(c *gin.Context, status int, errType, message string) {
+	c.JSON(status, gin.H{
+		"type": "error",
+		"error": gin.H{
+			"type":    errType,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsHandler 导出 Prometheus 指标
+type MetricsHandler struct {
+	collector *service.GatewayMetricsCollector
+}
+
+// NewMetricsHandler creates a new MetricsHandler
+func NewMetricsHandler(collector *service.GatewayMetricsCollector) *MetricsHandler {
+	return &MetricsHandler{collector: collector}
+}
+
+// Metrics 以 Prometheus 文本格式输出指标
+// GET /metrics
+func (h *MetricsHandler) Metrics(c *gin.Context) {
+	h.collector.Handler().ServeHTTP(c.Writer, c.Request)
+}
+
+// GatewayMetricsMiddleware 在网关请求结束后记录请求计数与延迟。
+// 平台/模型/账号来自 ops 上下文（setOpsRequestContext/setOpsSelectedAccount），仅统计 POST 推理请求。
+func GatewayMetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		if c.Request.Method != http.MethodPost {
+			return
+		}
+
+		apiKey, _ := middleware2.GetAPIKeyFromContext(c)
+		platform := resolveOpsPlatform(apiKey, guessPlatformFromPath(c.Request.URL.Path))
+		if forced, ok := middleware2.GetForcePlatformFromContext(c); ok && forced != "" {
+			platform = forced
+		}
+		var groupID *int64
+		if apiKey != nil {
+			groupID = apiKey.GroupID
+		}
+		var model string
+		if v, ok := c.Get(opsModelKey); ok {
+			model, _ = v.(string)
+		}
+		var accountID int64
+		if v, ok := c.Get(opsAccountIDKey); ok {
+			accountID, _ = v.(int64)
+		}
+
+		service.ObserveGatewayRequest(platform, groupID, model, accountID, c.Writer.Status(), time.Since(start))
+	}
+}
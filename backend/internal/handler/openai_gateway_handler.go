@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,8 +13,10 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -23,6 +26,7 @@ import (
 // OpenAIGatewayHandler handles OpenAI API gateway requests
 type OpenAIGatewayHandler struct {
 	gatewayService          *service.OpenAIGatewayService
+	gatewayHandler          *GatewayHandler
 	billingCacheService     *service.BillingCacheService
 	apiKeyService           *service.APIKeyService
 	errorPassthroughService *service.ErrorPassthroughService
@@ -37,6 +41,7 @@ func NewOpenAIGatewayHandler(
 	billingCacheService *service.BillingCacheService,
 	apiKeyService *service.APIKeyService,
 	errorPassthroughService *service.ErrorPassthroughService,
+	gatewayHandler *GatewayHandler,
 	cfg *config.Config,
 ) *OpenAIGatewayHandler {
 	pingInterval := time.Duration(0)
@@ -49,6 +54,7 @@ func NewOpenAIGatewayHandler(
 	}
 	return &OpenAIGatewayHandler{
 		gatewayService:          gatewayService,
+		gatewayHandler:          gatewayHandler,
 		billingCacheService:     billingCacheService,
 		apiKeyService:           apiKeyService,
 		errorPassthroughService: errorPassthroughService,
@@ -108,6 +114,15 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		return
 	}
 
+	// anthropic/gemini/antigravity 分组：转换为 Messages 请求，复用对应平台的转发与计费链路
+	switch responsesPlatform(c, apiKey) {
+	case service.PlatformAnthropic, service.PlatformGemini, service.PlatformAntigravity:
+		if h.gatewayHandler != nil {
+			h.responsesViaMessages(c, body, reqModel, reqStream)
+			return
+		}
+	}
+
 	userAgent := c.GetHeader("User-Agent")
 	if !openai.IsCodexCLIRequest(userAgent) {
 		existingInstructions, _ := reqBody["instructions"].(string)
@@ -206,6 +221,12 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 	// Generate session hash (header first; fallback to prompt_cache_key)
 	sessionHash := h.gatewayService.GenerateSessionHash(c, reqBody)
 
+	// 精确响应缓存：命中时直接写回，不选择账号、不占用账号槽位，使用量记入产生该响应的账号
+	if cached, cachedAccount := h.gatewayService.ServeResponseCacheHit(c, body); cached != nil {
+		h.recordUsageAsync(c, cached, apiKey, subscription, cachedAccount)
+		return
+	}
+
 	maxAccountSwitches := h.maxAccountSwitches
 	switchCount := 0
 	failedAccountIDs := make(map[int64]struct{})
@@ -296,6 +317,7 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 					return
 				}
 				switchCount++
+				service.RecordFailoverSwitch(account.Platform)
 				log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
 				continue
 			}
@@ -304,29 +326,80 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 			return
 		}
 
-		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
-		userAgent := c.GetHeader("User-Agent")
-		clientIP := ip.GetClientIP(c)
-
-		// Async record usage
-		go func(result *service.OpenAIForwardResult, usedAccount *service.Account, ua, ip string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
-				Result:        result,
-				APIKey:        apiKey,
-				User:          apiKey.User,
-				Account:       usedAccount,
-				Subscription:  subscription,
-				UserAgent:     ua,
-				IPAddress:     ip,
-				APIKeyService: h.apiKeyService,
-			}); err != nil {
-				log.Printf("Record usage failed: %v", err)
-			}
-		}(result, account, userAgent, clientIP)
+		h.recordUsageAsync(c, result, apiKey, subscription, account)
+		return
+	}
+}
+
+// recordUsageAsync 异步记录使用量
+func (h *OpenAIGatewayHandler) recordUsageAsync(c *gin.Context, result *service.OpenAIForwardResult, apiKey *service.APIKey, subscription *service.UserSubscription, account *service.Account) {
+	// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
+	userAgent := c.GetHeader("User-Agent")
+	clientIP := ip.GetClientIP(c)
+	usageTraceCtx := tracing.Detach(c.Request.Context())
+
+	// Async record usage
+	go func() {
+		ctx, cancel := context.WithTimeout(usageTraceCtx, 10*time.Second)
+		defer cancel()
+		if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
+			Result:        result,
+			APIKey:        apiKey,
+			User:          apiKey.User,
+			Account:       account,
+			Subscription:  subscription,
+			UserAgent:     userAgent,
+			IPAddress:     clientIP,
+			APIKeyService: h.apiKeyService,
+		}); err != nil {
+			log.Printf("Record usage failed: %v", err)
+		}
+	}()
+}
+
+// responsesPlatform 平台判定与 Messages 入口保持一致：强制平台优先，否则使用分组平台
+func responsesPlatform(c *gin.Context, apiKey *service.APIKey) string {
+	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
+		return forcePlatform
+	}
+	if apiKey.Group != nil {
+		return apiKey.Group.Platform
+	}
+	return ""
+}
+
+// responsesViaMessages 将 Responses 请求转换为 Messages 请求交给 GatewayHandler.Messages 处理，
+// anthropic/gemini/antigravity 分组由其分别调用对应服务的 Forward 与 RecordUsage，
+// 响应（含 SSE response.* 事件与错误体）再转换回 Responses 格式。
+func (h *OpenAIGatewayHandler) responsesViaMessages(c *gin.Context, body []byte, reqModel string, reqStream bool) {
+	var req apicompat.ResponsesRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+	messagesReq, err := apicompat.ResponsesToAnthropicRequest(&req)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	translated, err := json.Marshal(messagesReq)
+	if err != nil {
+		h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to process request")
 		return
 	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(translated))
+	c.Request.ContentLength = int64(len(translated))
+
+	writer := newCompatResponseWriter(c.Writer, reqStream, apicompat.NewAnthropicToResponsesStream(reqModel), func(body []byte) ([]byte, error) {
+		return apicompat.AnthropicToResponsesResponse(body, reqModel)
+	}, apicompat.ChatErrorBody)
+	c.Writer = writer
+	defer func() {
+		c.Writer = writer.ResponseWriter
+	}()
+
+	h.gatewayHandler.Messages(c)
+	writer.finish()
 }
 
 // handleConcurrencyError handles concurrency-related errors with proper 429 response
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultImageModel 未指定 model 时使用的图片模型
+const defaultImageModel = "gemini-2.5-flash-image"
+
+// imagesMaxMultipartMemory edits 上传图片的内存缓冲上限（整体大小仍受 bodyLimit 约束）
+const imagesMaxMultipartMemory = 32 << 20
+
+// ImageGenerations handles OpenAI Images API generations endpoint
+// POST /v1/images/generations
+func (h *OpenAIGatewayHandler) ImageGenerations(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			h.errorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to read request body")
+		return
+	}
+	if len(body) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Request body is empty")
+		return
+	}
+
+	var req apicompat.ImageRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+	h.imagesViaGemini(c, &req, nil, nil)
+}
+
+// ImageEdits handles OpenAI Images API edits endpoint (multipart/form-data)
+// POST /v1/images/edits
+func (h *OpenAIGatewayHandler) ImageEdits(c *gin.Context) {
+	if err := c.Request.ParseMultipartForm(imagesMaxMultipartMemory); err != nil {
+		if maxErr, ok := extractMaxBytesError(err); ok {
+			h.errorResponse(c, http.StatusRequestEntityTooLarge, "invalid_request_error", buildBodyTooLargeMessage(maxErr.Limit))
+			return
+		}
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Request must be multipart/form-data")
+		return
+	}
+	form := c.Request.MultipartForm
+
+	req := apicompat.ImageRequest{
+		Model:          c.Request.FormValue("model"),
+		Prompt:         c.Request.FormValue("prompt"),
+		Size:           c.Request.FormValue("size"),
+		ResponseFormat: c.Request.FormValue("response_format"),
+		User:           c.Request.FormValue("user"),
+	}
+	if raw := strings.TrimSpace(c.Request.FormValue("n")); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "n must be an integer")
+			return
+		}
+		req.N = &n
+	}
+
+	var images []apicompat.ImageInput
+	for _, field := range []string{"image", "image[]"} {
+		for _, fh := range form.File[field] {
+			img, err := readImageInput(fh)
+			if err != nil {
+				h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+				return
+			}
+			images = append(images, img)
+		}
+	}
+	if len(images) == 0 {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "image is required")
+		return
+	}
+
+	var mask *apicompat.ImageInput
+	if files := form.File["mask"]; len(files) > 0 {
+		img, err := readImageInput(files[0])
+		if err != nil {
+			h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+			return
+		}
+		mask = &img
+	}
+
+	h.imagesViaGemini(c, &req, images, mask)
+}
+
+func readImageInput(fh *multipart.FileHeader) (apicompat.ImageInput, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return apicompat.ImageInput{}, fmt.Errorf("failed to read %s", fh.Filename)
+	}
+	defer func() { _ = f.Close() }()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return apicompat.ImageInput{}, fmt.Errorf("failed to read %s", fh.Filename)
+	}
+	mimeType := fh.Header.Get("Content-Type")
+	if !strings.HasPrefix(mimeType, "image/") {
+		mimeType = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(mimeType, "image/") {
+		return apicompat.ImageInput{}, fmt.Errorf("%s is not an image", fh.Filename)
+	}
+	return apicompat.ImageInput{MimeType: mimeType, Data: data}, nil
+}
+
+// imagesViaGemini 将 Images 请求转换为 Gemini generateContent 交给 GatewayHandler.GeminiV1BetaModels 处理，
+// 复用 gemini/antigravity 的调度、failover 与按张计费（image_count/image_size）。
+// Gemini 每次调用只产出一张图片，n > 1 时串行调用 n 次，每次各自记录一条用量；
+// 中途失败时返回已生成（已计费）的图片，仅首张失败时返回错误。
+func (h *OpenAIGatewayHandler) imagesViaGemini(c *gin.Context, req *apicompat.ImageRequest, images []apicompat.ImageInput, mask *apicompat.ImageInput) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+	platform := responsesPlatform(c, apiKey)
+	if (platform != service.PlatformGemini && platform != service.PlatformAntigravity) || h.gatewayHandler == nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", fmt.Sprintf("Images API is not supported for %s groups", platform))
+		return
+	}
+
+	model := strings.TrimPrefix(strings.TrimSpace(req.Model), "models/")
+	if model == "" {
+		model = defaultImageModel
+		req.Model = model
+	}
+	// 模型白名单在确定最终模型后校验（edits 为 multipart，generations 可省略 model，中间件无法校验）
+	if !apiKey.IsModelAllowed(model) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", fmt.Sprintf("Model %s is not allowed for this API key", model))
+		return
+	}
+	if !service.IsImageGenerationModel(model) {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", fmt.Sprintf("model %q is not an image generation model", model))
+		return
+	}
+	n, err := req.Count()
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	translated, err := apicompat.ImageToGeminiRequest(req, images, mask)
+	if err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+
+	// antigravity 分组按 /antigravity/v1beta 路由处理（GeminiV1BetaModels 仅放行 gemini 分组或强制平台）
+	if platform == service.PlatformAntigravity && !middleware2.HasForcePlatform(c) {
+		c.Set(string(middleware2.ContextKeyForcePlatform), platform)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.ForcePlatform, platform))
+	}
+	c.Params = append(c.Params, gin.Param{Key: "modelAction", Value: "/" + model + ":generateContent"})
+
+	out := apicompat.ImageResponse{Created: time.Now().Unix()}
+	for i := 0; i < n; i++ {
+		c.Request.Body = io.NopCloser(bytes.NewReader(translated))
+		c.Request.ContentLength = int64(len(translated))
+
+		capture := &bufferedResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = capture
+		h.gatewayHandler.GeminiV1BetaModels(c)
+		c.Writer = capture.ResponseWriter
+
+		if status := capture.Status(); status >= http.StatusBadRequest {
+			if len(out.Data) > 0 {
+				log.Printf("[Images] generation %d/%d failed with status %d, returning %d generated images", i+1, n, status, len(out.Data))
+				break
+			}
+			c.Data(status, "application/json; charset=utf-8", apicompat.ChatErrorBody(status, capture.body.Bytes()))
+			return
+		}
+		data, err := apicompat.GeminiToImageData(capture.body.Bytes(), req.ResponseFormat)
+		if err != nil {
+			log.Printf("[Images] convert upstream response failed: %v", err)
+			if len(out.Data) > 0 {
+				break
+			}
+			h.errorResponse(c, http.StatusBadGateway, "upstream_error", err.Error())
+			return
+		}
+		out.Data = append(out.Data, data...)
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// bufferedResponseWriter 缓冲内层 Handler 的完整输出，供调用方转换或合并后再写出
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.status != 0 || w.body.Len() > 0
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Flush() {}
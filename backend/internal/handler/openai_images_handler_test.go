@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newImagesTestContext(platform string, req *http.Request) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = req
+	c.Set(string(middleware2.ContextKeyAPIKey), &service.APIKey{Group: &service.Group{Platform: platform}})
+	return c, rec
+}
+
+func decodeOpenAIError(t *testing.T, rec *httptest.ResponseRecorder) string {
+	var resp struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp.Error.Message
+}
+
+func TestImageGenerations_RejectsUnsupportedRequests(t *testing.T) {
+	h := &OpenAIGatewayHandler{gatewayHandler: &GatewayHandler{}}
+
+	cases := []struct {
+		platform string
+		body     string
+		want     string
+	}{
+		{service.PlatformAnthropic, `{"prompt":"a cat"}`, "not supported for anthropic groups"},
+		{service.PlatformGemini, `{"model":"gemini-2.5-pro","prompt":"a cat"}`, "not an image generation model"},
+		{service.PlatformGemini, `{"prompt":"a cat","n":9}`, "n must be between"},
+		{service.PlatformAntigravity, `{"prompt":"a cat","size":"huge"}`, "invalid size"},
+		{service.PlatformGemini, `{"model":"gemini-3-pro-image-preview"}`, "prompt is required"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/v1/images/generations", strings.NewReader(tc.body))
+		c, rec := newImagesTestContext(tc.platform, req)
+		h.ImageGenerations(c)
+		require.Equal(t, http.StatusBadRequest, rec.Code, tc.body)
+		require.Contains(t, decodeOpenAIError(t, rec), tc.want, tc.body)
+	}
+}
+
+func TestImageEdits_RequiresImage(t *testing.T) {
+	h := &OpenAIGatewayHandler{gatewayHandler: &GatewayHandler{}}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	require.NoError(t, mw.WriteField("prompt", "add a hat"))
+	require.NoError(t, mw.Close())
+	req := httptest.NewRequest(http.MethodPost, "/v1/images/edits", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	c, rec := newImagesTestContext(service.PlatformGemini, req)
+	h.ImageEdits(c)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, "image is required", decodeOpenAIError(t, rec))
+}
+
+func TestReadImageInput_DetectsMimeType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile("image", "cat.bin")
+	require.NoError(t, err)
+	_, _ = fw.Write(png)
+	fw, err = mw.CreateFormFile("mask", "notes.txt")
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte("plain text"))
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+
+	img, err := readImageInput(req.MultipartForm.File["image"][0])
+	require.NoError(t, err)
+	require.Equal(t, "image/png", img.MimeType)
+	require.Equal(t, png, img.Data)
+
+	_, err = readImageInput(req.MultipartForm.File["mask"][0])
+	require.ErrorContains(t, err, "not an image")
+}
+
+func TestImages_EnforceModelAllowlist(t *testing.T) {
+	h := &OpenAIGatewayHandler{gatewayHandler: &GatewayHandler{}}
+	apiKey := &service.APIKey{Group: &service.Group{Platform: service.PlatformGemini}, AllowedModels: []string{"gemini-3-pro-image-*"}}
+
+	// generations 省略 model 时使用默认模型，同样受白名单约束
+	req := httptest.NewRequest(http.MethodPost, "/v1/images/generations", strings.NewReader(`{"prompt":"a cat"}`))
+	c, rec := newImagesTestContext(service.PlatformGemini, req)
+	c.Set(string(middleware2.ContextKeyAPIKey), apiKey)
+	h.ImageGenerations(c)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Contains(t, decodeOpenAIError(t, rec), "Model "+defaultImageModel+" is not allowed")
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	require.NoError(t, mw.WriteField("model", "gemini-2.5-flash-image"))
+	require.NoError(t, mw.WriteField("prompt", "add a hat"))
+	fw, err := mw.CreateFormFile("image", "cat.png")
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))
+	require.NoError(t, mw.Close())
+	req = httptest.NewRequest(http.MethodPost, "/v1/images/edits", &buf)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	c, rec = newImagesTestContext(service.PlatformGemini, req)
+	c.Set(string(middleware2.ContextKeyAPIKey), apiKey)
+	h.ImageEdits(c)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Contains(t, decodeOpenAIError(t, rec), "is not allowed for this API key")
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// paymentCallbackMaxBodySize 支付回调请求体上限
+const paymentCallbackMaxBodySize = 1 << 20
+
+// PaymentHandler handles online payment requests
+type PaymentHandler struct {
+	paymentService *service.PaymentService
+}
+
+// NewPaymentHandler creates a new PaymentHandler
+func NewPaymentHandler(paymentService *service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+	}
+}
+
+// CreatePaymentOrderRequest represents the create order request payload
+type CreatePaymentOrderRequest struct {
+	Type     string  `json:"type" binding:"required,oneof=balance subscription"`
+	Provider string  `json:"provider" binding:"required"`
+	Amount   float64 `json:"amount"`
+	PlanID   string  `json:"plan_id"`
+}
+
+// GetOptions returns enabled providers, amount limits and plans
+// GET /api/v1/payments/options
+func (h *PaymentHandler) GetOptions(c *gin.Context) {
+	response.Success(c, h.paymentService.Options())
+}
+
+// CreateOrder creates a payment order and returns its pay url
+// POST /api/v1/payments/orders
+func (h *PaymentHandler) CreateOrder(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req CreatePaymentOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	order, err := h.paymentService.CreateOrder(c.Request.Context(), &service.CreatePaymentOrderInput{
+		UserID:   subject.UserID,
+		Type:     req.Type,
+		Provider: req.Provider,
+		Amount:   req.Amount,
+		PlanID:   req.PlanID,
+		ClientIP: ip.GetClientIP(c),
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.PaymentOrderFromService(order))
+}
+
+// ListOrders returns the user's order history
+// GET /api/v1/payments/orders
+func (h *PaymentHandler) ListOrders(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+
+	orders, result, err := h.paymentService.ListUserOrders(c.Request.Context(), subject.UserID, params, c.Query("status"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.PaymentOrder, 0, len(orders))
+	for i := range orders {
+		out = append(out, *dto.PaymentOrderFromService(&orders[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetOrder returns a single order of the current user
+// GET /api/v1/payments/orders/:order_no
+func (h *PaymentHandler) GetOrder(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	order, err := h.paymentService.GetUserOrder(c.Request.Context(), subject.UserID, c.Param("order_no"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.PaymentOrderFromService(order))
+}
+
+// Callback handles asynchronous payment notifications from providers.
+// The response body is provider specific, so it is written as plain text.
+// GET/POST /api/v1/payments/callback/:provider
+func (h *PaymentHandler) Callback(c *gin.Context) {
+	provider := c.Param("provider")
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, paymentCallbackMaxBodySize))
+	if err != nil {
+		c.String(http.StatusBadRequest, "fail")
+		return
+	}
+
+	params := url.Values{}
+	for k, v := range c.Request.URL.Query() {
+		params[k] = v
+	}
+	if mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type")); mediaType == "application/x-www-form-urlencoded" {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range form {
+				params[k] = v
+			}
+		}
+	}
+
+	ack, err := h.paymentService.HandleCallback(c.Request.Context(), provider, &service.PaymentCallbackRequest{
+		Header:  c.Request.Header,
+		Params:  params,
+		RawBody: body,
+	})
+	if err != nil {
+		status, _ := infraerrors.ToHTTP(err)
+		log.Printf("[Payment] callback from %s rejected: %v", provider, err)
+		c.String(status, "fail")
+		return
+	}
+
+	c.String(http.StatusOK, ack)
+}
@@ -29,6 +29,10 @@ func ProvideAdminHandlers(
 	userAttributeHandler *admin.UserAttributeHandler,
 	errorPassthroughHandler *admin.ErrorPassthroughHandler,
 	requestContentLogHandler *admin.RequestContentLogHandler,
+	auditLogHandler *admin.AuditLogHandler,
+	paymentHandler *admin.PaymentHandler,
+	responseCacheHandler *admin.ResponseCacheHandler,
+	accountHealthProbeHandler *admin.AccountHealthProbeHandler,
 ) *AdminHandlers {
 	return &AdminHandlers{
 		Dashboard:        dashboardHandler,
@@ -51,7 +55,11 @@ func ProvideAdminHandlers(
 		UserAttribute:    userAttributeHandler,
 		ErrorPassthrough: errorPassthroughHandler,
 
-		RequestContentLog: requestContentLogHandler,
+		RequestContentLog:  requestContentLogHandler,
+		AuditLog:           auditLogHandler,
+		Payment:            paymentHandler,
+		ResponseCache:      responseCacheHandler,
+		AccountHealthProbe: accountHealthProbeHandler,
 	}
 }
 
@@ -77,22 +85,34 @@ func ProvideHandlers(
 	adminHandlers *AdminHandlers,
 	gatewayHandler *GatewayHandler,
 	openaiGatewayHandler *OpenAIGatewayHandler,
+	chatCompletionsHandler *ChatCompletionsHandler,
 	settingHandler *SettingHandler,
 	totpHandler *TotpHandler,
+	metricsHandler *MetricsHandler,
+	paymentHandler *PaymentHandler,
+	budgetAlertHandler *BudgetAlertHandler,
+	messageBatchHandler *MessageBatchHandler,
+	fileHandler *FileHandler,
 ) *Handlers {
 	return &Handlers{
-		Auth:          authHandler,
-		User:          userHandler,
-		APIKey:        apiKeyHandler,
-		Usage:         usageHandler,
-		Redeem:        redeemHandler,
-		Subscription:  subscriptionHandler,
-		Announcement:  announcementHandler,
-		Admin:         adminHandlers,
-		Gateway:       gatewayHandler,
-		OpenAIGateway: openaiGatewayHandler,
-		Setting:       settingHandler,
-		Totp:          totpHandler,
+		Auth:            authHandler,
+		User:            userHandler,
+		APIKey:          apiKeyHandler,
+		Usage:           usageHandler,
+		Redeem:          redeemHandler,
+		Subscription:    subscriptionHandler,
+		Announcement:    announcementHandler,
+		Admin:           adminHandlers,
+		Gateway:         gatewayHandler,
+		OpenAIGateway:   openaiGatewayHandler,
+		ChatCompletions: chatCompletionsHandler,
+		Setting:         settingHandler,
+		Totp:            totpHandler,
+		Metrics:         metricsHandler,
+		Payment:         paymentHandler,
+		BudgetAlert:     budgetAlertHandler,
+		MessageBatch:    messageBatchHandler,
+		File:            fileHandler,
 	}
 }
 
@@ -108,7 +128,13 @@ var ProviderSet = wire.NewSet(
 	NewAnnouncementHandler,
 	NewGatewayHandler,
 	NewOpenAIGatewayHandler,
+	NewChatCompletionsHandler,
 	NewTotpHandler,
+	NewMetricsHandler,
+	NewPaymentHandler,
+	NewBudgetAlertHandler,
+	NewMessageBatchHandler,
+	NewFileHandler,
 	ProvideSettingHandler,
 
 	// Admin handlers
@@ -132,6 +158,10 @@ var ProviderSet = wire.NewSet(
 	admin.NewUserAttributeHandler,
 	admin.NewErrorPassthroughHandler,
 	admin.NewRequestContentLogHandler,
+	admin.NewAuditLogHandler,
+	admin.NewPaymentHandler,
+	admin.NewResponseCacheHandler,
+	admin.NewAccountHealthProbeHandler,
 
 	// AdminHandlers and Handlers constructors
 	ProvideAdminHandlers,
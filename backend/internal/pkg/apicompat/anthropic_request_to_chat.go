@@ -0,0 +1,242 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AnthropicToChatRequest 将 Anthropic Messages 请求转换为 Chat Completions 请求（用于仅支持 Chat Completions 的上游）
+//
+// 说明：
+//   - system 以 system 消息放在最前
+//   - tool_use/tool_result 分别转换为 assistant.tool_calls 与 tool 消息
+//   - thinking/redacted_thinking 块不回传上游（多数兼容上游拒绝输入中的推理内容）
+//   - 流式请求强制开启 stream_options.include_usage，以便按用量计费
+func AnthropicToChatRequest(req *AnthropicRequest) (*ChatRequest, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	out := &ChatRequest{
+		Model:       req.Model,
+		Stream:      req.Stream,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+	}
+	if req.Stream {
+		out.StreamOptions = &ChatStreamOptions{IncludeUsage: true}
+	}
+	if req.MaxTokens > 0 {
+		maxTokens := req.MaxTokens
+		out.MaxTokens = &maxTokens
+	}
+	if len(req.StopSequences) > 0 {
+		stop, err := json.Marshal(req.StopSequences)
+		if err != nil {
+			return nil, err
+		}
+		out.Stop = stop
+	}
+
+	systemText, err := anthropicSystemText(req.System)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]ChatMessage, 0, len(req.Messages)+1)
+	if systemText != "" {
+		content, err := json.Marshal(systemText)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, ChatMessage{Role: "system", Content: content})
+	}
+
+	for i, msg := range req.Messages {
+		blocks, err := parseAnthropicContent(msg.Content)
+		if err != nil {
+			return nil, fmt.Errorf("messages[%d]: %w", i, err)
+		}
+		var converted []ChatMessage
+		switch msg.Role {
+		case "user":
+			converted, err = anthropicUserBlocksToChat(blocks)
+		case "assistant":
+			converted, err = anthropicAssistantBlocksToChat(blocks)
+		default:
+			return nil, fmt.Errorf("messages[%d]: unsupported role %q", i, msg.Role)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("messages[%d]: %w", i, err)
+		}
+		messages = append(messages, converted...)
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("messages must contain at least one message")
+	}
+	out.Messages = messages
+
+	for _, tool := range req.Tools {
+		// 仅转换自定义工具；web_search 等服务端工具在 Chat Completions 侧没有等价定义
+		if (tool.Type != "" && tool.Type != "custom") || tool.Name == "" {
+			continue
+		}
+		params := tool.InputSchema
+		if len(params) == 0 || string(params) == "null" {
+			params = json.RawMessage(`{"type":"object","properties":{}}`)
+		}
+		out.Tools = append(out.Tools, ChatTool{
+			Type: "function",
+			Function: &ChatFunction{
+				Name:        tool.Name,
+				Description: tool.Description,
+				Parameters:  params,
+			},
+		})
+	}
+
+	if req.ToolChoice != nil && len(out.Tools) > 0 {
+		toolChoice, err := anthropicToolChoiceToChat(req.ToolChoice)
+		if err != nil {
+			return nil, err
+		}
+		out.ToolChoice = toolChoice
+		if req.ToolChoice.DisableParallelToolUse {
+			parallel := false
+			out.ParallelToolCalls = &parallel
+		}
+	}
+
+	if req.Thinking != nil && (req.Thinking.Type == "enabled" || req.Thinking.Type == "adaptive") {
+		out.ReasoningEffort = thinkingBudgetToEffort(req.Thinking.BudgetTokens)
+	}
+
+	if req.Metadata != nil && req.Metadata.UserID != "" {
+		out.User = req.Metadata.UserID
+	}
+
+	return out, nil
+}
+
+func anthropicUserBlocksToChat(blocks []AnthropicContentBlock) ([]ChatMessage, error) {
+	var messages []ChatMessage
+	var parts []ChatContentPart
+	flush := func() error {
+		if len(parts) == 0 {
+			return nil
+		}
+		msg, err := newChatMessage("user", parts)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+		parts = nil
+		return nil
+	}
+
+	for _, block := range blocks {
+		switch block.Type {
+		case "text":
+			if block.Text != "" {
+				parts = append(parts, ChatContentPart{Type: "text", Text: block.Text})
+			}
+		case "image":
+			if url := anthropicSourceToImageURL(block.Source); url != "" {
+				parts = append(parts, ChatContentPart{Type: "image_url", ImageURL: &ChatImageURL{URL: url}})
+			}
+		case "document":
+			if block.Source != nil && block.Source.Type == "text" && block.Source.Data != "" {
+				parts = append(parts, ChatContentPart{Type: "text", Text: block.Source.Data})
+			}
+		case "tool_result":
+			// tool 消息必须紧跟在对应 assistant.tool_calls 之后，先输出已累积的 user 内容
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			output, images, err := anthropicToolResultOutput(block)
+			if err != nil {
+				return nil, err
+			}
+			content, err := json.Marshal(output)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, ChatMessage{Role: "tool", ToolCallID: block.ToolUseID, Content: content})
+			// 工具结果中的图片无法放入 tool 消息，改为追加一条 user 图片消息
+			for _, image := range images {
+				parts = append(parts, ChatContentPart{Type: "image_url", ImageURL: &ChatImageURL{URL: image.ImageURL}})
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func anthropicAssistantBlocksToChat(blocks []AnthropicContentBlock) ([]ChatMessage, error) {
+	var text strings.Builder
+	var toolCalls []ChatToolCall
+	for _, block := range blocks {
+		switch block.Type {
+		case "text":
+			_, _ = text.WriteString(block.Text)
+		case "tool_use":
+			args := strings.TrimSpace(string(block.Input))
+			if args == "" || args == "null" {
+				args = "{}"
+			}
+			toolCalls = append(toolCalls, ChatToolCall{
+				ID:       block.ID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: block.Name, Arguments: args},
+			})
+		}
+	}
+	if text.Len() == 0 && len(toolCalls) == 0 {
+		return nil, nil
+	}
+	msg := ChatMessage{Role: "assistant", ToolCalls: toolCalls}
+	if text.Len() > 0 {
+		content, err := json.Marshal(text.String())
+		if err != nil {
+			return nil, err
+		}
+		msg.Content = content
+	}
+	return []ChatMessage{msg}, nil
+}
+
+// newChatMessage 仅含单个文本片段时以字符串形式输出 content，兼容不支持多模态数组的上游
+func newChatMessage(role string, parts []ChatContentPart) (ChatMessage, error) {
+	var content []byte
+	var err error
+	if len(parts) == 1 && parts[0].Type == "text" {
+		content, err = json.Marshal(parts[0].Text)
+	} else {
+		content, err = json.Marshal(parts)
+	}
+	if err != nil {
+		return ChatMessage{}, err
+	}
+	return ChatMessage{Role: role, Content: content}, nil
+}
+
+func anthropicToolChoiceToChat(choice *AnthropicToolChoice) (json.RawMessage, error) {
+	switch choice.Type {
+	case "", "auto":
+		return json.RawMessage(`"auto"`), nil
+	case "any":
+		return json.RawMessage(`"required"`), nil
+	case "none":
+		return json.RawMessage(`"none"`), nil
+	case "tool":
+		if choice.Name == "" {
+			return nil, errors.New("invalid tool_choice: name is required")
+		}
+		return json.Marshal(map[string]any{"type": "function", "function": map[string]string{"name": choice.Name}})
+	default:
+		return nil, fmt.Errorf("invalid tool_choice type: %q", choice.Type)
+	}
+}
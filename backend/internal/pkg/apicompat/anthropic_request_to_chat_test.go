@@ -0,0 +1,95 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnthropicToChatRequest(t *testing.T) {
+	body := `{
+		"model": "deepseek-chat",
+		"max_tokens": 1024,
+		"stream": true,
+		"system": [{"type": "text", "text": "Be brief."}],
+		"stop_sequences": ["END"],
+		"thinking": {"type": "enabled", "budget_tokens": 2000},
+		"tools": [
+			{"name": "lookup", "description": "Look up", "input_schema": {"type": "object", "properties": {"q": {"type": "string"}}}},
+			{"type": "web_search_20250305", "name": "web_search"}
+		],
+		"tool_choice": {"type": "any", "disable_parallel_tool_use": true},
+		"messages": [
+			{"role": "user", "content": "Find x"},
+			{"role": "assistant", "content": [
+				{"type": "thinking", "thinking": "hmm", "signature": "sig"},
+				{"type": "text", "text": "Checking."},
+				{"type": "tool_use", "id": "toolu_1", "name": "lookup", "input": {"q": "x"}}
+			]},
+			{"role": "user", "content": [
+				{"type": "tool_result", "tool_use_id": "toolu_1", "content": [{"type": "text", "text": "42"}]},
+				{"type": "text", "text": "Thanks"}
+			]}
+		]
+	}`
+	var req AnthropicRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+
+	out, err := AnthropicToChatRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, "deepseek-chat", out.Model)
+	require.True(t, out.Stream)
+	require.True(t, out.StreamOptions.IncludeUsage)
+	require.Equal(t, 1024, *out.MaxTokens)
+	require.JSONEq(t, `["END"]`, string(out.Stop))
+	require.Equal(t, "low", out.ReasoningEffort)
+	require.Len(t, out.Tools, 1)
+	require.Equal(t, "lookup", out.Tools[0].Function.Name)
+	require.JSONEq(t, `"required"`, string(out.ToolChoice))
+	require.False(t, *out.ParallelToolCalls)
+
+	require.Len(t, out.Messages, 5)
+	require.Equal(t, "system", out.Messages[0].Role)
+	require.JSONEq(t, `"Be brief."`, string(out.Messages[0].Content))
+	require.JSONEq(t, `"Find x"`, string(out.Messages[1].Content))
+
+	assistant := out.Messages[2]
+	require.Equal(t, "assistant", assistant.Role)
+	require.JSONEq(t, `"Checking."`, string(assistant.Content))
+	require.Len(t, assistant.ToolCalls, 1)
+	require.Equal(t, "toolu_1", assistant.ToolCalls[0].ID)
+	require.JSONEq(t, `{"q":"x"}`, assistant.ToolCalls[0].Function.Arguments)
+
+	require.Equal(t, "tool", out.Messages[3].Role)
+	require.Equal(t, "toolu_1", out.Messages[3].ToolCallID)
+	require.JSONEq(t, `"42"`, string(out.Messages[3].Content))
+	require.Equal(t, "user", out.Messages[4].Role)
+	require.JSONEq(t, `"Thanks"`, string(out.Messages[4].Content))
+}
+
+func TestAnthropicToChatRequest_ImagesAndNamedToolChoice(t *testing.T) {
+	req := &AnthropicRequest{
+		Model:     "qwen-vl",
+		MaxTokens: 256,
+		Tools:     []AnthropicTool{{Name: "lookup"}},
+		ToolChoice: &AnthropicToolChoice{
+			Type: "tool",
+			Name: "lookup",
+		},
+		Messages: []AnthropicMessage{{
+			Role:    "user",
+			Content: json.RawMessage(`[{"type":"text","text":"What is this?"},{"type":"image","source":{"type":"base64","media_type":"image/jpeg","data":"AAAA"}}]`),
+		}},
+	}
+	out, err := AnthropicToChatRequest(req)
+	require.NoError(t, err)
+	require.Nil(t, out.StreamOptions)
+	require.JSONEq(t, `{"type":"function","function":{"name":"lookup"}}`, string(out.ToolChoice))
+	require.JSONEq(t, `{"type":"object","properties":{}}`, string(out.Tools[0].Function.Parameters))
+
+	var parts []ChatContentPart
+	require.NoError(t, json.Unmarshal(out.Messages[0].Content, &parts))
+	require.Len(t, parts, 2)
+	require.Equal(t, "data:image/jpeg;base64,AAAA", parts[1].ImageURL.URL)
+}
@@ -0,0 +1,437 @@
+package apicompat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// newResponsesID 生成带前缀的 Responses 对象 ID（resp_、msg_、fc_、rs_）
+func newResponsesID(prefix string) string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+	}
+	return prefix + "_" + hex.EncodeToString(b)
+}
+
+// anthropicUsageToResponses Responses 的 input_tokens 包含缓存读写部分，缓存命中放入 cached_tokens
+func anthropicUsageToResponses(usage AnthropicUsage) *ResponsesUsage {
+	input := usage.InputTokens + usage.CacheReadInputTokens + usage.CacheCreationInputTokens
+	return &ResponsesUsage{
+		InputTokens:         input,
+		OutputTokens:        usage.OutputTokens,
+		TotalTokens:         input + usage.OutputTokens,
+		InputTokensDetails:  &ResponsesInputTokensDetails{CachedTokens: usage.CacheReadInputTokens},
+		OutputTokensDetails: &ResponsesOutputTokensDetails{},
+	}
+}
+
+// anthropicStopReasonToResponses 根据 stop_reason 推导响应状态与未完成原因
+func anthropicStopReasonToResponses(stopReason string) (string, *ResponsesIncompleteDetails) {
+	switch stopReason {
+	case "max_tokens", "model_context_window_exceeded":
+		return "incomplete", &ResponsesIncompleteDetails{Reason: "max_output_tokens"}
+	case "refusal":
+		return "incomplete", &ResponsesIncompleteDetails{Reason: "content_filter"}
+	default:
+		return "completed", nil
+	}
+}
+
+func newResponsesOutputMessage(text string) ResponsesItem {
+	content, _ := json.Marshal([]ResponsesContentPart{{Type: "output_text", Text: text}})
+	return ResponsesItem{
+		Type:    "message",
+		ID:      newResponsesID("msg"),
+		Status:  "completed",
+		Role:    "assistant",
+		Content: content,
+	}
+}
+
+func toolInputToArguments(input json.RawMessage) string {
+	args := strings.TrimSpace(string(input))
+	if args == "" || args == "null" {
+		return "{}"
+	}
+	return args
+}
+
+// AnthropicToResponsesResponse 将 Messages 非流式响应转换为 Responses 响应
+// model 为客户端请求的模型名，保证响应与请求一致。
+func AnthropicToResponsesResponse(body []byte, model string) ([]byte, error) {
+	var resp AnthropicResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse anthropic response: %w", err)
+	}
+	if model == "" {
+		model = resp.Model
+	}
+
+	output := make([]ResponsesItem, 0, len(resp.Content))
+	var text strings.Builder
+	flushText := func() {
+		if text.Len() == 0 {
+			return
+		}
+		output = append(output, newResponsesOutputMessage(text.String()))
+		text.Reset()
+	}
+	for _, block := range resp.Content {
+		switch block.Type {
+		case "text":
+			_, _ = text.WriteString(block.Text)
+		case "thinking":
+			if block.Thinking == "" {
+				continue
+			}
+			flushText()
+			output = append(output, ResponsesItem{
+				Type:    "reasoning",
+				ID:      newResponsesID("rs"),
+				Summary: []ResponsesSummaryPart{{Type: "summary_text", Text: block.Thinking}},
+			})
+		case "tool_use":
+			flushText()
+			output = append(output, ResponsesItem{
+				Type:      "function_call",
+				ID:        newResponsesID("fc"),
+				Status:    "completed",
+				CallID:    block.ID,
+				Name:      block.Name,
+				Arguments: toolInputToArguments(block.Input),
+			})
+		}
+	}
+	flushText()
+
+	status, incomplete := anthropicStopReasonToResponses(resp.StopReason)
+	return json.Marshal(ResponsesResponse{
+		ID:                newResponsesID("resp"),
+		Object:            "response",
+		CreatedAt:         time.Now().Unix(),
+		Model:             model,
+		Status:            status,
+		Output:            output,
+		Usage:             anthropicUsageToResponses(resp.Usage),
+		IncompleteDetails: incomplete,
+	})
+}
+
+// formatResponsesSSE Responses 与 Messages 的 SSE 均为 event/data 两行格式
+func formatResponsesSSE(eventType string, v any) []byte {
+	return formatAnthropicSSE(eventType, v)
+}
+
+// anthropicToResponsesBlock 一个 Messages content block 对应的 Responses output item
+type anthropicToResponsesBlock struct {
+	kind        string // text, thinking, tool_use
+	outputIndex int
+	item        ResponsesItem
+	buf         strings.Builder
+}
+
+// AnthropicToResponsesStream 将 Messages SSE 事件流转换为 Responses SSE 事件流
+type AnthropicToResponsesStream struct {
+	id        string
+	model     string
+	createdAt int64
+	sequence  int
+
+	blocks     map[int]*anthropicToResponsesBlock // content block index -> output item
+	output     []ResponsesItem
+	usage      AnthropicUsage
+	stopReason string
+
+	started  bool
+	finished bool
+	errored  bool
+}
+
+// NewAnthropicToResponsesStream 创建流式转换器
+func NewAnthropicToResponsesStream(model string) *AnthropicToResponsesStream {
+	return &AnthropicToResponsesStream{
+		id:        newResponsesID("resp"),
+		model:     model,
+		createdAt: time.Now().Unix(),
+		blocks:    make(map[int]*anthropicToResponsesBlock),
+	}
+}
+
+// ProcessLine 处理一行 Messages SSE
+func (p *AnthropicToResponsesStream) ProcessLine(line string) []byte {
+	data, ok := sseData(line)
+	if !ok || p.finished || p.errored {
+		return nil
+	}
+
+	var event AnthropicStreamEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil
+	}
+
+	// 网关自身注入的错误事件可能不带 type 字段
+	if event.Type == "" && len(event.Error) > 0 {
+		event.Type = "error"
+	}
+
+	switch event.Type {
+	case "ping":
+		return []byte(chatPingEvent)
+
+	case "message_start":
+		if event.Message != nil {
+			p.usage = event.Message.Usage
+		}
+		return p.ensureStart()
+
+	case "content_block_start":
+		if event.ContentBlock == nil || event.Index == nil {
+			return nil
+		}
+		out := p.ensureStart()
+		return append(out, p.openBlock(*event.Index, event.ContentBlock)...)
+
+	case "content_block_delta":
+		if event.Delta == nil || event.Index == nil {
+			return nil
+		}
+		block, ok := p.blocks[*event.Index]
+		if !ok {
+			return nil
+		}
+		switch {
+		case block.kind == "text" && event.Delta.Type == "text_delta" && event.Delta.Text != "":
+			_, _ = block.buf.WriteString(event.Delta.Text)
+			return p.emit("response.output_text.delta", map[string]any{
+				"item_id":       block.item.ID,
+				"output_index":  block.outputIndex,
+				"content_index": 0,
+				"delta":         event.Delta.Text,
+			})
+		case block.kind == "thinking" && event.Delta.Type == "thinking_delta" && event.Delta.Thinking != "":
+			_, _ = block.buf.WriteString(event.Delta.Thinking)
+			return p.emit("response.reasoning_summary_text.delta", map[string]any{
+				"item_id":       block.item.ID,
+				"output_index":  block.outputIndex,
+				"summary_index": 0,
+				"delta":         event.Delta.Thinking,
+			})
+		case block.kind == "tool_use" && event.Delta.Type == "input_json_delta" && event.Delta.PartialJSON != "":
+			_, _ = block.buf.WriteString(event.Delta.PartialJSON)
+			return p.emit("response.function_call_arguments.delta", map[string]any{
+				"item_id":      block.item.ID,
+				"output_index": block.outputIndex,
+				"delta":        event.Delta.PartialJSON,
+			})
+		}
+		return nil
+
+	case "content_block_stop":
+		if event.Index == nil {
+			return nil
+		}
+		return p.closeBlock(*event.Index)
+
+	case "message_delta":
+		if event.Delta != nil && event.Delta.StopReason != "" {
+			p.stopReason = event.Delta.StopReason
+		}
+		if event.Usage != nil {
+			p.mergeUsage(*event.Usage)
+		}
+		return nil
+
+	case "message_stop":
+		p.finished = true
+		out := p.ensureStart()
+		// 正常情况下所有块都已 content_block_stop，此处兜底按顺序关闭残留块
+		indexes := make([]int, 0, len(p.blocks))
+		for index := range p.blocks {
+			indexes = append(indexes, index)
+		}
+		sort.Ints(indexes)
+		for _, index := range indexes {
+			out = append(out, p.closeBlock(index)...)
+		}
+		status, incomplete := anthropicStopReasonToResponses(p.stopReason)
+		resp := p.response(status)
+		resp.Output = p.output
+		resp.Usage = anthropicUsageToResponses(p.usage)
+		resp.IncompleteDetails = incomplete
+		eventType := "response.completed"
+		if status == "incomplete" {
+			eventType = "response.incomplete"
+		}
+		return append(out, p.emit(eventType, map[string]any{"response": resp})...)
+
+	case "error":
+		p.errored = true
+		errType, message := parseErrorField(event.Error)
+		if message == "" {
+			message = "Upstream stream error"
+		}
+		if errType == "" {
+			errType = "api_error"
+		}
+		return p.emit("error", map[string]any{
+			"code":    errType,
+			"message": message,
+			"param":   nil,
+		})
+	}
+	return nil
+}
+
+// Finish Responses 流以 response.completed 结束，无需额外收尾
+func (p *AnthropicToResponsesStream) Finish() []byte {
+	return nil
+}
+
+func (p *AnthropicToResponsesStream) response(status string) *ResponsesResponse {
+	return &ResponsesResponse{
+		ID:        p.id,
+		Object:    "response",
+		CreatedAt: p.createdAt,
+		Model:     p.model,
+		Status:    status,
+		Output:    []ResponsesItem{},
+	}
+}
+
+func (p *AnthropicToResponsesStream) ensureStart() []byte {
+	if p.started {
+		return nil
+	}
+	p.started = true
+	out := p.emit("response.created", map[string]any{"response": p.response("in_progress")})
+	return append(out, p.emit("response.in_progress", map[string]any{"response": p.response("in_progress")})...)
+}
+
+func (p *AnthropicToResponsesStream) emit(eventType string, payload map[string]any) []byte {
+	payload["type"] = eventType
+	payload["sequence_number"] = p.sequence
+	p.sequence++
+	return formatResponsesSSE(eventType, payload)
+}
+
+// openBlock 为 text/thinking/tool_use 块创建 output item；redacted_thinking 等其他块忽略
+func (p *AnthropicToResponsesStream) openBlock(index int, contentBlock *AnthropicContentBlock) []byte {
+	block := &anthropicToResponsesBlock{kind: contentBlock.Type, outputIndex: len(p.output)}
+	switch contentBlock.Type {
+	case "text":
+		block.item = ResponsesItem{Type: "message", ID: newResponsesID("msg"), Status: "in_progress", Role: "assistant", Content: json.RawMessage("[]")}
+		_, _ = block.buf.WriteString(contentBlock.Text)
+	case "thinking":
+		block.item = ResponsesItem{Type: "reasoning", ID: newResponsesID("rs"), Summary: []ResponsesSummaryPart{}}
+		_, _ = block.buf.WriteString(contentBlock.Thinking)
+	case "tool_use":
+		block.item = ResponsesItem{Type: "function_call", ID: newResponsesID("fc"), Status: "in_progress", CallID: contentBlock.ID, Name: contentBlock.Name}
+	default:
+		return nil
+	}
+	p.blocks[index] = block
+	p.output = append(p.output, block.item)
+
+	out := p.emit("response.output_item.added", map[string]any{
+		"output_index": block.outputIndex,
+		"item":         block.item,
+	})
+	switch block.kind {
+	case "text":
+		out = append(out, p.emit("response.content_part.added", map[string]any{
+			"item_id":       block.item.ID,
+			"output_index":  block.outputIndex,
+			"content_index": 0,
+			"part":          ResponsesContentPart{Type: "output_text", Text: ""},
+		})...)
+	case "thinking":
+		out = append(out, p.emit("response.reasoning_summary_part.added", map[string]any{
+			"item_id":       block.item.ID,
+			"output_index":  block.outputIndex,
+			"summary_index": 0,
+			"part":          ResponsesSummaryPart{Type: "summary_text", Text: ""},
+		})...)
+	}
+	return out
+}
+
+// closeBlock 输出 *.done 事件并把最终 item 写入 output 列表
+func (p *AnthropicToResponsesStream) closeBlock(index int) []byte {
+	block, ok := p.blocks[index]
+	if !ok {
+		return nil
+	}
+	delete(p.blocks, index)
+
+	var out []byte
+	text := block.buf.String()
+	item := block.item
+	switch block.kind {
+	case "text":
+		part := ResponsesContentPart{Type: "output_text", Text: text}
+		out = append(out, p.emit("response.output_text.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  block.outputIndex,
+			"content_index": 0,
+			"text":          text,
+		})...)
+		out = append(out, p.emit("response.content_part.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  block.outputIndex,
+			"content_index": 0,
+			"part":          part,
+		})...)
+		item.Content, _ = json.Marshal([]ResponsesContentPart{part})
+		item.Status = "completed"
+	case "thinking":
+		part := ResponsesSummaryPart{Type: "summary_text", Text: text}
+		out = append(out, p.emit("response.reasoning_summary_text.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  block.outputIndex,
+			"summary_index": 0,
+			"text":          text,
+		})...)
+		out = append(out, p.emit("response.reasoning_summary_part.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  block.outputIndex,
+			"summary_index": 0,
+			"part":          part,
+		})...)
+		item.Summary = []ResponsesSummaryPart{part}
+	case "tool_use":
+		item.Arguments = toolInputToArguments(json.RawMessage(text))
+		item.Status = "completed"
+		out = append(out, p.emit("response.function_call_arguments.done", map[string]any{
+			"item_id":      item.ID,
+			"output_index": block.outputIndex,
+			"arguments":    item.Arguments,
+		})...)
+	}
+	p.output[block.outputIndex] = item
+	return append(out, p.emit("response.output_item.done", map[string]any{
+		"output_index": block.outputIndex,
+		"item":         item,
+	})...)
+}
+
+// mergeUsage message_delta.usage 为累计值，仅覆盖非零字段
+func (p *AnthropicToResponsesStream) mergeUsage(usage AnthropicUsage) {
+	if usage.InputTokens > 0 {
+		p.usage.InputTokens = usage.InputTokens
+	}
+	if usage.OutputTokens > 0 {
+		p.usage.OutputTokens = usage.OutputTokens
+	}
+	if usage.CacheCreationInputTokens > 0 {
+		p.usage.CacheCreationInputTokens = usage.CacheCreationInputTokens
+	}
+	if usage.CacheReadInputTokens > 0 {
+		p.usage.CacheReadInputTokens = usage.CacheReadInputTokens
+	}
+}
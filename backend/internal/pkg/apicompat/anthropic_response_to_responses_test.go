@@ -0,0 +1,149 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnthropicToResponsesResponse(t *testing.T) {
+	body := `{
+		"id": "msg_1", "type": "message", "role": "assistant", "model": "claude-sonnet-4-5-20250929",
+		"content": [
+			{"type": "thinking", "thinking": "hmm", "signature": "sig"},
+			{"type": "text", "text": "Let me "},
+			{"type": "text", "text": "check."},
+			{"type": "tool_use", "id": "toolu_1", "name": "lookup", "input": {"q": "x"}}
+		],
+		"stop_reason": "tool_use",
+		"usage": {"input_tokens": 10, "output_tokens": 5, "cache_read_input_tokens": 20}
+	}`
+
+	out, err := AnthropicToResponsesResponse([]byte(body), "claude-sonnet-4-5")
+	require.NoError(t, err)
+
+	var resp ResponsesResponse
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.True(t, strings.HasPrefix(resp.ID, "resp_"))
+	require.Equal(t, "response", resp.Object)
+	require.Equal(t, "claude-sonnet-4-5", resp.Model)
+	require.Equal(t, "completed", resp.Status)
+	require.Len(t, resp.Output, 3)
+
+	require.Equal(t, "reasoning", resp.Output[0].Type)
+	require.Equal(t, "hmm", resp.Output[0].Summary[0].Text)
+
+	require.Equal(t, "message", resp.Output[1].Type)
+	var parts []ResponsesContentPart
+	require.NoError(t, json.Unmarshal(resp.Output[1].Content, &parts))
+	require.Equal(t, []ResponsesContentPart{{Type: "output_text", Text: "Let me check."}}, parts)
+
+	call := resp.Output[2]
+	require.Equal(t, "function_call", call.Type)
+	require.Equal(t, "toolu_1", call.CallID)
+	require.Equal(t, "lookup", call.Name)
+	require.JSONEq(t, `{"q":"x"}`, call.Arguments)
+
+	require.Equal(t, 30, resp.Usage.InputTokens)
+	require.Equal(t, 20, resp.Usage.InputTokensDetails.CachedTokens)
+	require.Equal(t, 35, resp.Usage.TotalTokens)
+
+	out, err = AnthropicToResponsesResponse([]byte(`{"content":[{"type":"text","text":"cut"}],"stop_reason":"max_tokens","usage":{}}`), "m")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "incomplete", resp.Status)
+	require.Equal(t, "max_output_tokens", resp.IncompleteDetails.Reason)
+}
+
+func TestAnthropicToResponsesStream(t *testing.T) {
+	lines := []string{
+		`event: message_start`,
+		`data: {"type":"message_start","message":{"id":"msg_1","type":"message","role":"assistant","model":"claude","content":[],"usage":{"input_tokens":12,"output_tokens":1}}}`,
+		``,
+		`data: {"type": "ping"}`,
+		`data: {"type":"content_block_start","index":0,"content_block":{"type":"thinking","thinking":""}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"thinking_delta","thinking":"hmm"}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"signature_delta","signature":"sig"}}`,
+		`data: {"type":"content_block_stop","index":0}`,
+		`data: {"type":"content_block_start","index":1,"content_block":{"type":"text","text":""}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"text_delta","text":"Hi"}}`,
+		`data: {"type":"content_block_stop","index":1}`,
+		`data: {"type":"content_block_start","index":2,"content_block":{"type":"tool_use","id":"toolu_1","name":"lookup","input":{}}}`,
+		`data: {"type":"content_block_delta","index":2,"delta":{"type":"input_json_delta","partial_json":"{\"q\":"}}`,
+		`data: {"type":"content_block_delta","index":2,"delta":{"type":"input_json_delta","partial_json":"\"x\"}"}}`,
+		`data: {"type":"content_block_stop","index":2}`,
+		`data: {"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":7}}`,
+		`data: {"type":"message_stop"}`,
+	}
+
+	p := NewAnthropicToResponsesStream("claude-sonnet-4-5")
+	var sb strings.Builder
+	for _, line := range lines {
+		_, _ = sb.Write(p.ProcessLine(line))
+	}
+	_, _ = sb.Write(p.Finish())
+	out := sb.String()
+	require.Contains(t, out, chatPingEvent)
+
+	names, payloads := collectAnthropicEvents(t, out)
+	require.Equal(t, []string{
+		"response.created",
+		"response.in_progress",
+		"response.output_item.added",
+		"response.reasoning_summary_part.added",
+		"response.reasoning_summary_text.delta",
+		"response.reasoning_summary_text.done",
+		"response.reasoning_summary_part.done",
+		"response.output_item.done",
+		"response.output_item.added",
+		"response.content_part.added",
+		"response.output_text.delta",
+		"response.output_text.done",
+		"response.content_part.done",
+		"response.output_item.done",
+		"response.output_item.added",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.done",
+		"response.output_item.done",
+		"response.completed",
+	}, names)
+	for i, payload := range payloads {
+		require.Equal(t, names[i], payload["type"])
+		require.EqualValues(t, i, payload["sequence_number"])
+	}
+
+	require.Equal(t, "hmm", payloads[4]["delta"])
+	require.EqualValues(t, 1, payloads[10]["output_index"])
+	require.Equal(t, `{"q":"x"}`, payloads[17]["arguments"])
+	toolDone := payloads[18]["item"].(map[string]any)
+	require.Equal(t, "toolu_1", toolDone["call_id"])
+	require.Equal(t, "completed", toolDone["status"])
+
+	var completed struct {
+		Response ResponsesResponse `json:"response"`
+	}
+	raw, err := json.Marshal(payloads[19])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &completed))
+	resp := completed.Response
+	require.Equal(t, "completed", resp.Status)
+	require.Equal(t, "claude-sonnet-4-5", resp.Model)
+	require.Len(t, resp.Output, 3)
+	require.Equal(t, "hmm", resp.Output[0].Summary[0].Text)
+	require.Equal(t, "function_call", resp.Output[2].Type)
+	require.Equal(t, 12, resp.Usage.InputTokens)
+	require.Equal(t, 7, resp.Usage.OutputTokens)
+}
+
+func TestAnthropicToResponsesStream_ErrorEvent(t *testing.T) {
+	p := NewAnthropicToResponsesStream("claude")
+	_ = p.ProcessLine(`data: {"type":"message_start","message":{"id":"msg_1","usage":{"input_tokens":1}}}`)
+	names, payloads := collectAnthropicEvents(t, string(p.ProcessLine(`data: {"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`)))
+	require.Equal(t, []string{"error"}, names)
+	require.Equal(t, "overloaded_error", payloads[0]["code"])
+	require.Equal(t, "Overloaded", payloads[0]["message"])
+	require.Nil(t, p.ProcessLine(`data: {"type":"message_stop"}`))
+}
@@ -0,0 +1,251 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// anthropicStopReasonToChat stop_reason 到 finish_reason 的映射
+func anthropicStopReasonToChat(stopReason string) string {
+	switch stopReason {
+	case "max_tokens", "model_context_window_exceeded":
+		return "length"
+	case "tool_use":
+		return "tool_calls"
+	case "refusal":
+		return "content_filter"
+	default:
+		return "stop"
+	}
+}
+
+func anthropicUsageToChat(usage AnthropicUsage) *ChatUsage {
+	prompt := usage.InputTokens + usage.CacheReadInputTokens + usage.CacheCreationInputTokens
+	out := &ChatUsage{
+		PromptTokens:     prompt,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      prompt + usage.OutputTokens,
+	}
+	if usage.CacheReadInputTokens > 0 {
+		out.PromptTokensDetails = &ChatPromptTokensDetails{CachedTokens: usage.CacheReadInputTokens}
+	}
+	return out
+}
+
+// AnthropicToChatResponse 将 Messages 非流式响应转换为 Chat Completions 响应
+// model 为客户端请求的模型名，保证响应与请求一致。
+func AnthropicToChatResponse(body []byte, model string) ([]byte, error) {
+	var resp AnthropicResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse anthropic response: %w", err)
+	}
+	if model == "" {
+		model = resp.Model
+	}
+
+	var text, reasoning strings.Builder
+	var toolCalls []ChatToolCall
+	for _, block := range resp.Content {
+		switch block.Type {
+		case "text":
+			_, _ = text.WriteString(block.Text)
+		case "thinking":
+			_, _ = reasoning.WriteString(block.Thinking)
+		case "tool_use":
+			args := string(block.Input)
+			if args == "" || args == "null" {
+				args = "{}"
+			}
+			toolCalls = append(toolCalls, ChatToolCall{
+				ID:       block.ID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: block.Name, Arguments: args},
+			})
+		}
+	}
+
+	message := ChatResponseMessage{
+		Role:             "assistant",
+		ReasoningContent: reasoning.String(),
+		ToolCalls:        toolCalls,
+	}
+	if text.Len() > 0 || len(toolCalls) == 0 {
+		message.Content = strPtr(text.String())
+	}
+
+	return json.Marshal(ChatCompletion{
+		ID:      NewChatCompletionID(),
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   model,
+		Choices: []ChatChoice{{
+			Index:        0,
+			Message:      message,
+			FinishReason: anthropicStopReasonToChat(resp.StopReason),
+		}},
+		Usage: anthropicUsageToChat(resp.Usage),
+	})
+}
+
+// AnthropicToChatStream 将 Messages SSE 事件流转换为 chat.completion.chunk 事件流
+type AnthropicToChatStream struct {
+	chatChunkWriter
+
+	toolIndexes   map[int]int // content block index -> tool_calls index
+	nextToolIndex int
+	usage         AnthropicUsage
+	stopReason    string
+	roleSent      bool
+	finished      bool
+	errored       bool
+}
+
+// NewAnthropicToChatStream 创建流式转换器
+func NewAnthropicToChatStream(model string, includeUsage bool) *AnthropicToChatStream {
+	return &AnthropicToChatStream{
+		chatChunkWriter: newChatChunkWriter(model, includeUsage),
+		toolIndexes:     make(map[int]int),
+	}
+}
+
+// ProcessLine 处理一行 Messages SSE
+func (p *AnthropicToChatStream) ProcessLine(line string) []byte {
+	data, ok := sseData(line)
+	if !ok || p.finished || p.errored {
+		return nil
+	}
+
+	var event AnthropicStreamEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil
+	}
+
+	// 网关自身注入的错误事件可能不带 type 字段
+	if event.Type == "" && len(event.Error) > 0 {
+		event.Type = "error"
+	}
+
+	switch event.Type {
+	case "ping":
+		return []byte(chatPingEvent)
+
+	case "message_start":
+		if event.Message != nil {
+			p.usage = event.Message.Usage
+		}
+		return p.ensureRole()
+
+	case "content_block_start":
+		if event.ContentBlock == nil || event.Index == nil {
+			return nil
+		}
+		out := p.ensureRole()
+		switch event.ContentBlock.Type {
+		case "tool_use":
+			toolIndex := p.nextToolIndex
+			p.nextToolIndex++
+			p.toolIndexes[*event.Index] = toolIndex
+			out = append(out, p.chunk(ChatDelta{ToolCalls: []ChatToolCall{{
+				Index:    &toolIndex,
+				ID:       event.ContentBlock.ID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: event.ContentBlock.Name, Arguments: ""},
+			}}}, nil)...)
+		case "text":
+			if event.ContentBlock.Text != "" {
+				out = append(out, p.chunk(ChatDelta{Content: strPtr(event.ContentBlock.Text)}, nil)...)
+			}
+		}
+		return out
+
+	case "content_block_delta":
+		if event.Delta == nil {
+			return nil
+		}
+		switch event.Delta.Type {
+		case "text_delta":
+			if event.Delta.Text == "" {
+				return nil
+			}
+			return p.chunk(ChatDelta{Content: strPtr(event.Delta.Text)}, nil)
+		case "thinking_delta":
+			if event.Delta.Thinking == "" {
+				return nil
+			}
+			return p.chunk(ChatDelta{ReasoningContent: strPtr(event.Delta.Thinking)}, nil)
+		case "input_json_delta":
+			if event.Index == nil || event.Delta.PartialJSON == "" {
+				return nil
+			}
+			toolIndex, ok := p.toolIndexes[*event.Index]
+			if !ok {
+				return nil
+			}
+			return p.chunk(ChatDelta{ToolCalls: []ChatToolCall{{
+				Index:    &toolIndex,
+				Function: ChatFunctionCall{Arguments: event.Delta.PartialJSON},
+			}}}, nil)
+		}
+		return nil
+
+	case "message_delta":
+		if event.Delta != nil && event.Delta.StopReason != "" {
+			p.stopReason = event.Delta.StopReason
+		}
+		if event.Usage != nil {
+			p.mergeUsage(*event.Usage)
+		}
+		return nil
+
+	case "message_stop":
+		p.finished = true
+		out := p.ensureRole()
+		finishReason := anthropicStopReasonToChat(p.stopReason)
+		out = append(out, p.chunk(ChatDelta{}, &finishReason)...)
+		out = append(out, p.usageChunk(anthropicUsageToChat(p.usage))...)
+		return out
+
+	case "error":
+		p.errored = true
+		errType, message := parseErrorField(event.Error)
+		if message == "" {
+			message = "Upstream stream error"
+		}
+		return chatErrorEvent(errType, message)
+	}
+	return nil
+}
+
+// Finish 上游流正常结束时补发 [DONE]
+func (p *AnthropicToChatStream) Finish() []byte {
+	if !p.finished {
+		return nil
+	}
+	return []byte(ChatDoneEvent)
+}
+
+func (p *AnthropicToChatStream) ensureRole() []byte {
+	if p.roleSent {
+		return nil
+	}
+	p.roleSent = true
+	return p.chunk(ChatDelta{Role: "assistant", Content: strPtr("")}, nil)
+}
+
+// mergeUsage message_delta.usage 为累计值，仅覆盖非零字段
+func (p *AnthropicToChatStream) mergeUsage(usage AnthropicUsage) {
+	if usage.InputTokens > 0 {
+		p.usage.InputTokens = usage.InputTokens
+	}
+	if usage.OutputTokens > 0 {
+		p.usage.OutputTokens = usage.OutputTokens
+	}
+	if usage.CacheCreationInputTokens > 0 {
+		p.usage.CacheCreationInputTokens = usage.CacheCreationInputTokens
+	}
+	if usage.CacheReadInputTokens > 0 {
+		p.usage.CacheReadInputTokens = usage.CacheReadInputTokens
+	}
+}
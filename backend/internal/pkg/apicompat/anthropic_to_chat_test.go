@@ -0,0 +1,121 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// collectChatChunks 解析转换器输出中的 data 负载
+func collectChatChunks(t *testing.T, out string) []map[string]any {
+	t.Helper()
+	var chunks []map[string]any
+	for _, line := range strings.Split(out, "\n") {
+		data, ok := sseData(line)
+		if !ok || data == "[DONE]" {
+			continue
+		}
+		var chunk map[string]any
+		require.NoError(t, json.Unmarshal([]byte(data), &chunk))
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func TestAnthropicToChatResponse(t *testing.T) {
+	body := `{
+		"id": "msg_1", "type": "message", "role": "assistant", "model": "claude-sonnet-4-5-20250929",
+		"content": [
+			{"type": "thinking", "thinking": "hmm", "signature": "sig"},
+			{"type": "text", "text": "Let me check."},
+			{"type": "tool_use", "id": "toolu_1", "name": "lookup", "input": {"q": "x"}}
+		],
+		"stop_reason": "tool_use",
+		"usage": {"input_tokens": 10, "output_tokens": 5, "cache_read_input_tokens": 20}
+	}`
+
+	out, err := AnthropicToChatResponse([]byte(body), "claude-sonnet-4-5")
+	require.NoError(t, err)
+
+	var resp ChatCompletion
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "chat.completion", resp.Object)
+	require.Equal(t, "claude-sonnet-4-5", resp.Model)
+	require.Len(t, resp.Choices, 1)
+	choice := resp.Choices[0]
+	require.Equal(t, "tool_calls", choice.FinishReason)
+	require.Equal(t, "Let me check.", *choice.Message.Content)
+	require.Equal(t, "hmm", choice.Message.ReasoningContent)
+	require.Len(t, choice.Message.ToolCalls, 1)
+	require.Equal(t, "toolu_1", choice.Message.ToolCalls[0].ID)
+	require.JSONEq(t, `{"q":"x"}`, choice.Message.ToolCalls[0].Function.Arguments)
+	require.Equal(t, 30, resp.Usage.PromptTokens)
+	require.Equal(t, 35, resp.Usage.TotalTokens)
+	require.Equal(t, 20, resp.Usage.PromptTokensDetails.CachedTokens)
+}
+
+func TestAnthropicToChatStream(t *testing.T) {
+	lines := []string{
+		`event: message_start`,
+		`data: {"type":"message_start","message":{"id":"msg_1","type":"message","role":"assistant","model":"claude","content":[],"usage":{"input_tokens":12,"output_tokens":1}}}`,
+		``,
+		`data: {"type": "ping"}`,
+		`data: {"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hi"}}`,
+		`data: {"type":"content_block_stop","index":0}`,
+		`data: {"type":"content_block_start","index":1,"content_block":{"type":"tool_use","id":"toolu_1","name":"lookup","input":{}}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"q\":"}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"\"x\"}"}}`,
+		`data: {"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":7}}`,
+		`data: {"type":"message_stop"}`,
+	}
+
+	p := NewAnthropicToChatStream("claude-sonnet-4-5", true)
+	var sb strings.Builder
+	for _, line := range lines {
+		_, _ = sb.Write(p.ProcessLine(line))
+	}
+	_, _ = sb.Write(p.Finish())
+	out := sb.String()
+
+	require.Contains(t, out, chatPingEvent)
+	require.True(t, strings.HasSuffix(out, ChatDoneEvent))
+
+	chunks := collectChatChunks(t, out)
+	require.Len(t, chunks, 7)
+
+	delta := func(i int) map[string]any {
+		return chunks[i]["choices"].([]any)[0].(map[string]any)["delta"].(map[string]any)
+	}
+	require.Equal(t, "assistant", delta(0)["role"])
+	require.Equal(t, "Hi", delta(1)["content"])
+	toolStart := delta(2)["tool_calls"].([]any)[0].(map[string]any)
+	require.Equal(t, "toolu_1", toolStart["id"])
+	require.EqualValues(t, 0, toolStart["index"])
+	require.Equal(t, `"x"}`, delta(4)["tool_calls"].([]any)[0].(map[string]any)["function"].(map[string]any)["arguments"])
+
+	finish := chunks[5]["choices"].([]any)[0].(map[string]any)
+	require.Equal(t, "tool_calls", finish["finish_reason"])
+
+	usage := chunks[6]["usage"].(map[string]any)
+	require.EqualValues(t, 12, usage["prompt_tokens"])
+	require.EqualValues(t, 7, usage["completion_tokens"])
+}
+
+func TestAnthropicToChatStream_ErrorEvent(t *testing.T) {
+	p := NewAnthropicToChatStream("claude", false)
+	_ = p.ProcessLine(`data: {"type":"message_start","message":{"id":"msg_1","usage":{"input_tokens":1}}}`)
+	out := string(p.ProcessLine(`data: {"error":"stream_timeout"}`))
+	require.Contains(t, out, `"message":"stream_timeout"`)
+	require.Nil(t, p.Finish())
+}
+
+func TestChatErrorBody(t *testing.T) {
+	anthropic := ChatErrorBody(429, []byte(`{"type":"error","error":{"type":"rate_limit_error","message":"slow down"}}`))
+	require.JSONEq(t, `{"error":{"message":"slow down","type":"rate_limit_error","param":null,"code":null}}`, string(anthropic))
+
+	plain := ChatErrorBody(502, []byte(`not json`))
+	require.JSONEq(t, `{"error":{"message":"not json","type":"api_error","param":null,"code":null}}`, string(plain))
+}
@@ -0,0 +1,320 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// thinkingBudgetToEffort 将 thinking.budget_tokens 映射为 reasoning.effort
+func thinkingBudgetToEffort(budget int) string {
+	switch {
+	case budget <= 0:
+		return "medium"
+	case budget <= 2048:
+		return "low"
+	case budget <= 8192:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// AnthropicToResponsesRequest 将 Anthropic Messages 请求转换为 OpenAI Responses 请求
+//
+// 说明：
+//   - system 以 developer 消息放入 input（OAuth 账号会覆盖 instructions）
+//   - thinking/redacted_thinking 块不回传上游（签名无法跨协议复用）
+//   - tool_use/tool_result 分别转换为 function_call/function_call_output
+func AnthropicToResponsesRequest(req *AnthropicRequest) (*ResponsesRequest, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	store := false
+	out := &ResponsesRequest{
+		Model:       req.Model,
+		Stream:      req.Stream,
+		Store:       &store,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+	}
+	if req.MaxTokens > 0 {
+		maxTokens := req.MaxTokens
+		out.MaxOutputTokens = &maxTokens
+	}
+
+	items := make([]ResponsesItem, 0, len(req.Messages)+1)
+
+	systemText, err := anthropicSystemText(req.System)
+	if err != nil {
+		return nil, err
+	}
+	if systemText != "" {
+		item, err := newResponsesMessage("developer", []ResponsesContentPart{{Type: "input_text", Text: systemText}})
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	for i, msg := range req.Messages {
+		blocks, err := parseAnthropicContent(msg.Content)
+		if err != nil {
+			return nil, fmt.Errorf("messages[%d]: %w", i, err)
+		}
+		var converted []ResponsesItem
+		switch msg.Role {
+		case "user":
+			converted, err = anthropicUserBlocksToResponses(blocks)
+		case "assistant":
+			converted, err = anthropicAssistantBlocksToResponses(blocks)
+		default:
+			return nil, fmt.Errorf("messages[%d]: unsupported role %q", i, msg.Role)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("messages[%d]: %w", i, err)
+		}
+		items = append(items, converted...)
+	}
+	if len(items) == 0 {
+		return nil, errors.New("messages must contain at least one message")
+	}
+	input, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	out.Input = input
+
+	for _, tool := range req.Tools {
+		// 仅转换自定义工具；web_search 等服务端工具在 Responses 侧没有等价定义
+		if (tool.Type != "" && tool.Type != "custom") || tool.Name == "" {
+			continue
+		}
+		params := tool.InputSchema
+		if len(params) == 0 || string(params) == "null" {
+			params = json.RawMessage(`{"type":"object","properties":{}}`)
+		}
+		out.Tools = append(out.Tools, ResponsesTool{
+			Type:        "function",
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  params,
+		})
+	}
+
+	if req.ToolChoice != nil {
+		toolChoice, err := anthropicToolChoiceToResponses(req.ToolChoice)
+		if err != nil {
+			return nil, err
+		}
+		out.ToolChoice = toolChoice
+		if req.ToolChoice.DisableParallelToolUse {
+			parallel := false
+			out.ParallelToolCalls = &parallel
+		}
+	}
+
+	if req.Thinking != nil && (req.Thinking.Type == "enabled" || req.Thinking.Type == "adaptive") {
+		out.Reasoning = &ResponsesReasoning{
+			Effort:  thinkingBudgetToEffort(req.Thinking.BudgetTokens),
+			Summary: "auto",
+		}
+	}
+
+	return out, nil
+}
+
+// parseAnthropicContent 解析 string 或 content block 数组
+func parseAnthropicContent(raw json.RawMessage) ([]AnthropicContentBlock, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return nil, nil
+		}
+		return []AnthropicContentBlock{{Type: "text", Text: text}}, nil
+	}
+	var blocks []AnthropicContentBlock
+	if err := json.Unmarshal(raw, &blocks); err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+	return blocks, nil
+}
+
+// anthropicSystemText 拼接 system 字段中的文本
+func anthropicSystemText(raw json.RawMessage) (string, error) {
+	blocks, err := parseAnthropicContent(raw)
+	if err != nil {
+		return "", fmt.Errorf("system: %w", err)
+	}
+	texts := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Type == "text" && block.Text != "" {
+			texts = append(texts, block.Text)
+		}
+	}
+	return strings.Join(texts, "\n\n"), nil
+}
+
+func anthropicUserBlocksToResponses(blocks []AnthropicContentBlock) ([]ResponsesItem, error) {
+	var items []ResponsesItem
+	var parts []ResponsesContentPart
+	flush := func() error {
+		if len(parts) == 0 {
+			return nil
+		}
+		item, err := newResponsesMessage("user", parts)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		parts = nil
+		return nil
+	}
+
+	for _, block := range blocks {
+		switch block.Type {
+		case "text":
+			if block.Text != "" {
+				parts = append(parts, ResponsesContentPart{Type: "input_text", Text: block.Text})
+			}
+		case "image":
+			if url := anthropicSourceToImageURL(block.Source); url != "" {
+				parts = append(parts, ResponsesContentPart{Type: "input_image", ImageURL: url})
+			}
+		case "document":
+			if block.Source != nil && block.Source.Type == "text" && block.Source.Data != "" {
+				parts = append(parts, ResponsesContentPart{Type: "input_text", Text: block.Source.Data})
+			}
+		case "tool_result":
+			// function_call_output 必须紧跟在对应 function_call 之后，先输出已累积的 user 内容
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			output, images, err := anthropicToolResultOutput(block)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, ResponsesItem{
+				Type:   "function_call_output",
+				CallID: block.ToolUseID,
+				Output: output,
+			})
+			// 工具结果中的图片无法放入 output 字符串，改为追加一条 user 图片消息
+			parts = append(parts, images...)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func anthropicAssistantBlocksToResponses(blocks []AnthropicContentBlock) ([]ResponsesItem, error) {
+	var items []ResponsesItem
+	var text strings.Builder
+	flush := func() error {
+		if text.Len() == 0 {
+			return nil
+		}
+		item, err := newResponsesMessage("assistant", []ResponsesContentPart{{Type: "output_text", Text: text.String()}})
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+		text.Reset()
+		return nil
+	}
+
+	for _, block := range blocks {
+		switch block.Type {
+		case "text":
+			_, _ = text.WriteString(block.Text)
+		case "tool_use":
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			args := strings.TrimSpace(string(block.Input))
+			if args == "" || args == "null" {
+				args = "{}"
+			}
+			items = append(items, ResponsesItem{
+				Type:      "function_call",
+				CallID:    block.ID,
+				Name:      block.Name,
+				Arguments: args,
+			})
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// anthropicToolResultOutput 提取 tool_result 的文本输出与附带图片
+func anthropicToolResultOutput(block AnthropicContentBlock) (string, []ResponsesContentPart, error) {
+	inner, err := parseAnthropicContent(block.Content)
+	if err != nil {
+		return "", nil, fmt.Errorf("tool_result: %w", err)
+	}
+	texts := make([]string, 0, len(inner))
+	var images []ResponsesContentPart
+	for _, part := range inner {
+		switch part.Type {
+		case "text":
+			texts = append(texts, part.Text)
+		case "image":
+			if url := anthropicSourceToImageURL(part.Source); url != "" {
+				images = append(images, ResponsesContentPart{Type: "input_image", ImageURL: url})
+			}
+		}
+	}
+	output := strings.Join(texts, "\n")
+	if block.IsError && output != "" {
+		output = "Error: " + output
+	}
+	return output, images, nil
+}
+
+func anthropicSourceToImageURL(source *AnthropicSource) string {
+	if source == nil {
+		return ""
+	}
+	switch source.Type {
+	case "base64":
+		if source.Data == "" {
+			return ""
+		}
+		mediaType := source.MediaType
+		if mediaType == "" {
+			mediaType = "image/png"
+		}
+		return "data:" + mediaType + ";base64," + source.Data
+	case "url":
+		return source.URL
+	}
+	return ""
+}
+
+func anthropicToolChoiceToResponses(choice *AnthropicToolChoice) (json.RawMessage, error) {
+	switch choice.Type {
+	case "", "auto":
+		return json.RawMessage(`"auto"`), nil
+	case "any":
+		return json.RawMessage(`"required"`), nil
+	case "none":
+		return json.RawMessage(`"none"`), nil
+	case "tool":
+		if choice.Name == "" {
+			return nil, errors.New("invalid tool_choice: name is required")
+		}
+		return json.Marshal(map[string]string{"type": "function", "name": choice.Name})
+	default:
+		return nil, fmt.Errorf("invalid tool_choice type: %q", choice.Type)
+	}
+}
@@ -0,0 +1,91 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnthropicToResponsesRequest(t *testing.T) {
+	body := `{
+		"model": "claude-sonnet-4-5",
+		"max_tokens": 4096,
+		"stream": true,
+		"system": [{"type": "text", "text": "You are Claude Code."}, {"type": "text", "text": "Be concise."}],
+		"thinking": {"type": "enabled", "budget_tokens": 10000},
+		"messages": [
+			{"role": "user", "content": [
+				{"type": "text", "text": "Look at this"},
+				{"type": "image", "source": {"type": "base64", "media_type": "image/jpeg", "data": "AAAA"}}
+			]},
+			{"role": "assistant", "content": [
+				{"type": "thinking", "thinking": "plan", "signature": "sig"},
+				{"type": "text", "text": "Reading file."},
+				{"type": "tool_use", "id": "toolu_1", "name": "Read", "input": {"path": "a.go"}}
+			]},
+			{"role": "user", "content": [
+				{"type": "tool_result", "tool_use_id": "toolu_1", "content": [{"type": "text", "text": "package main"}]},
+				{"type": "text", "text": "continue"}
+			]}
+		],
+		"tools": [
+			{"name": "Read", "description": "read a file", "input_schema": {"type": "object", "properties": {"path": {"type": "string"}}}},
+			{"type": "web_search_20250305", "name": "web_search"}
+		],
+		"tool_choice": {"type": "any", "disable_parallel_tool_use": true}
+	}`
+	var req AnthropicRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+
+	out, err := AnthropicToResponsesRequest(&req)
+	require.NoError(t, err)
+	require.True(t, out.Stream)
+	require.False(t, *out.Store)
+	require.Equal(t, 4096, *out.MaxOutputTokens)
+	require.Equal(t, &ResponsesReasoning{Effort: "high", Summary: "auto"}, out.Reasoning)
+	require.JSONEq(t, `"required"`, string(out.ToolChoice))
+	require.NotNil(t, out.ParallelToolCalls)
+	require.False(t, *out.ParallelToolCalls)
+	require.Len(t, out.Tools, 1)
+	require.Equal(t, "Read", out.Tools[0].Name)
+
+	// thinking 块不回传，tool_result 紧跟 function_call
+	require.JSONEq(t, `[
+		{"type":"message","role":"developer","content":[{"type":"input_text","text":"You are Claude Code.\n\nBe concise."}]},
+		{"type":"message","role":"user","content":[
+			{"type":"input_text","text":"Look at this"},
+			{"type":"input_image","image_url":"data:image/jpeg;base64,AAAA"}
+		]},
+		{"type":"message","role":"assistant","content":[{"type":"output_text","text":"Reading file."}]},
+		{"type":"function_call","call_id":"toolu_1","name":"Read","arguments":"{\"path\": \"a.go\"}"},
+		{"type":"function_call_output","call_id":"toolu_1","output":"package main"},
+		{"type":"message","role":"user","content":[{"type":"input_text","text":"continue"}]}
+	]`, string(out.Input))
+}
+
+func TestAnthropicToResponsesRequest_StringContentAndErrors(t *testing.T) {
+	out, err := AnthropicToResponsesRequest(&AnthropicRequest{
+		Model:     "claude-haiku-4-5",
+		MaxTokens: 100,
+		System:    json.RawMessage(`"sys"`),
+		Messages:  []AnthropicMessage{{Role: "user", Content: json.RawMessage(`"hi"`)}},
+		ToolChoice: &AnthropicToolChoice{
+			Type: "tool",
+			Name: "Bash",
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, out.Reasoning)
+	require.JSONEq(t, `{"type":"function","name":"Bash"}`, string(out.ToolChoice))
+	require.JSONEq(t, `[
+		{"type":"message","role":"developer","content":[{"type":"input_text","text":"sys"}]},
+		{"type":"message","role":"user","content":[{"type":"input_text","text":"hi"}]}
+	]`, string(out.Input))
+
+	_, err = AnthropicToResponsesRequest(&AnthropicRequest{
+		Model:    "claude-haiku-4-5",
+		Messages: []AnthropicMessage{{Role: "system", Content: json.RawMessage(`"hi"`)}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package apicompat
+
+import "encoding/json"
+
+// Anthropic Messages 请求/响应类型定义
+
+// AnthropicRequest Messages API 请求
+type AnthropicRequest struct {
+	Model         string               `json:"model"`
+	Messages      []AnthropicMessage   `json:"messages"`
+	System        json.RawMessage      `json:"system,omitempty"` // string 或 []AnthropicContentBlock
+	MaxTokens     int                  `json:"max_tokens"`
+	Stream        bool                 `json:"stream,omitempty"`
+	Temperature   *float64             `json:"temperature,omitempty"`
+	TopP          *float64             `json:"top_p,omitempty"`
+	TopK          *int                 `json:"top_k,omitempty"`
+	StopSequences []string             `json:"stop_sequences,omitempty"`
+	Tools         []AnthropicTool      `json:"tools,omitempty"`
+	ToolChoice    *AnthropicToolChoice `json:"tool_choice,omitempty"`
+	Thinking      *AnthropicThinking   `json:"thinking,omitempty"`
+	Metadata      *AnthropicMetadata   `json:"metadata,omitempty"`
+}
+
+// AnthropicMessage 消息
+type AnthropicMessage struct {
+	Role    string          `json:"role"`    // user, assistant
+	Content json.RawMessage `json:"content"` // string 或 []AnthropicContentBlock
+}
+
+// AnthropicContentBlock 内容块（请求与响应通用）
+type AnthropicContentBlock struct {
+	Type string `json:"type"`
+	// text
+	Text string `json:"text,omitempty"`
+	// thinking / redacted_thinking
+	Thinking  string `json:"thinking,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Data      string `json:"data,omitempty"`
+	// tool_use
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Input json.RawMessage `json:"input,omitempty"`
+	// tool_result
+	ToolUseID string          `json:"tool_use_id,omitempty"`
+	Content   json.RawMessage `json:"content,omitempty"` // string 或 []AnthropicContentBlock
+	IsError   bool            `json:"is_error,omitempty"`
+	// image / document
+	Source *AnthropicSource `json:"source,omitempty"`
+
+	CacheControl json.RawMessage `json:"cache_control,omitempty"`
+}
+
+// AnthropicSource 图片/文档来源
+type AnthropicSource struct {
+	Type      string `json:"type"` // base64, url
+	MediaType string `json:"media_type,omitempty"`
+	Data      string `json:"data,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+// AnthropicTool 工具定义
+type AnthropicTool struct {
+	Type        string          `json:"type,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"input_schema,omitempty"`
+}
+
+// AnthropicToolChoice 工具选择策略
+type AnthropicToolChoice struct {
+	Type                   string `json:"type"` // auto, any, tool, none
+	Name                   string `json:"name,omitempty"`
+	DisableParallelToolUse bool   `json:"disable_parallel_tool_use,omitempty"`
+}
+
+// AnthropicThinking thinking 配置
+type AnthropicThinking struct {
+	Type         string `json:"type"` // enabled, adaptive, disabled
+	BudgetTokens int    `json:"budget_tokens,omitempty"`
+}
+
+// AnthropicMetadata 请求元数据
+type AnthropicMetadata struct {
+	UserID string `json:"user_id,omitempty"`
+}
+
+// AnthropicResponse Messages API 非流式响应
+type AnthropicResponse struct {
+	ID           string                  `json:"id"`
+	Type         string                  `json:"type"` // message
+	Role         string                  `json:"role"`
+	Model        string                  `json:"model"`
+	Content      []AnthropicContentBlock `json:"content"`
+	StopReason   string                  `json:"stop_reason,omitempty"`
+	StopSequence *string                 `json:"stop_sequence,omitempty"`
+	Usage        AnthropicUsage          `json:"usage"`
+}
+
+// AnthropicUsage 用量统计
+type AnthropicUsage struct {
+	InputTokens              int `json:"input_tokens"`
+	OutputTokens             int `json:"output_tokens"`
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens,omitempty"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens,omitempty"`
+}
+
+// AnthropicStreamEvent SSE 事件负载（message_start、content_block_delta 等）
+type AnthropicStreamEvent struct {
+	Type         string                 `json:"type"`
+	Index        *int                   `json:"index,omitempty"`
+	Message      *AnthropicResponse     `json:"message,omitempty"`
+	ContentBlock *AnthropicContentBlock `json:"content_block,omitempty"`
+	Delta        *AnthropicDelta        `json:"delta,omitempty"`
+	Usage        *AnthropicUsage        `json:"usage,omitempty"`
+	Error        json.RawMessage        `json:"error,omitempty"` // {"type","message"} 或 string
+}
+
+// AnthropicDelta content_block_delta / message_delta 的增量
+type AnthropicDelta struct {
+	Type         string  `json:"type,omitempty"` // text_delta, thinking_delta, signature_delta, input_json_delta
+	Text         string  `json:"text,omitempty"`
+	Thinking     string  `json:"thinking,omitempty"`
+	Signature    string  `json:"signature,omitempty"`
+	PartialJSON  string  `json:"partial_json,omitempty"`
+	StopReason   string  `json:"stop_reason,omitempty"`
+	StopSequence *string `json:"stop_sequence,omitempty"`
+}
+
+// AnthropicErrorResponse 错误响应
+type AnthropicErrorResponse struct {
+	Type  string               `json:"type"` // error
+	Error AnthropicErrorDetail `json:"error"`
+}
+
+// AnthropicErrorDetail 错误详情
+type AnthropicErrorDetail struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
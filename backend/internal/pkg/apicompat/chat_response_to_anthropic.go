@@ -0,0 +1,332 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// chatFinishReasonToAnthropic finish_reason 到 stop_reason 的映射
+func chatFinishReasonToAnthropic(finishReason string, hasToolUse bool) string {
+	switch finishReason {
+	case "length":
+		return "max_tokens"
+	case "content_filter":
+		return "refusal"
+	case "tool_calls", "function_call":
+		return "tool_use"
+	}
+	if hasToolUse {
+		return "tool_use"
+	}
+	return "end_turn"
+}
+
+// ChatUsageToAnthropic Chat 的 prompt_tokens 含缓存命中部分，Anthropic 需拆分为 cache_read_input_tokens
+func ChatUsageToAnthropic(usage *ChatUsage) AnthropicUsage {
+	if usage == nil {
+		return AnthropicUsage{}
+	}
+	out := AnthropicUsage{
+		InputTokens:  usage.PromptTokens,
+		OutputTokens: usage.CompletionTokens,
+	}
+	cached := usage.PromptCacheHitTokens
+	if usage.PromptTokensDetails != nil && usage.PromptTokensDetails.CachedTokens > 0 {
+		cached = usage.PromptTokensDetails.CachedTokens
+	}
+	if cached > out.InputTokens {
+		cached = out.InputTokens
+	}
+	if cached > 0 {
+		out.InputTokens -= cached
+		out.CacheReadInputTokens = cached
+	}
+	return out
+}
+
+// ChatToAnthropicResponse 将 Chat Completions 非流式响应转换为 Messages 响应
+// model 为客户端请求的模型名，保证响应与请求一致。
+func ChatToAnthropicResponse(body []byte, model string) ([]byte, error) {
+	var resp ChatCompletion
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse chat completion: %w", err)
+	}
+	if model == "" {
+		model = resp.Model
+	}
+
+	var content []AnthropicContentBlock
+	finishReason := ""
+	hasToolUse := false
+	if len(resp.Choices) > 0 {
+		choice := resp.Choices[0]
+		finishReason = choice.FinishReason
+		reasoning := choice.Message.ReasoningContent
+		if reasoning == "" {
+			reasoning = choice.Message.Reasoning
+		}
+		if reasoning != "" {
+			content = append(content, AnthropicContentBlock{Type: "thinking", Thinking: reasoning})
+		}
+		if choice.Message.Content != nil && *choice.Message.Content != "" {
+			content = append(content, AnthropicContentBlock{Type: "text", Text: *choice.Message.Content})
+		}
+		for _, call := range choice.Message.ToolCalls {
+			hasToolUse = true
+			content = append(content, AnthropicContentBlock{
+				Type:  "tool_use",
+				ID:    chatToolCallID(call.ID),
+				Name:  call.Function.Name,
+				Input: normalizeToolArguments(call.Function.Arguments),
+			})
+		}
+	}
+	if content == nil {
+		content = []AnthropicContentBlock{}
+	}
+
+	return json.Marshal(AnthropicResponse{
+		ID:         NewAnthropicMessageID(),
+		Type:       "message",
+		Role:       "assistant",
+		Model:      model,
+		Content:    content,
+		StopReason: chatFinishReasonToAnthropic(finishReason, hasToolUse),
+		Usage:      ChatUsageToAnthropic(resp.Usage),
+	})
+}
+
+// chatToolCallID 部分自建上游（如 vLLM 旧版本）不返回工具调用 ID，补一个以满足 tool_use.id 必填
+func chatToolCallID(id string) string {
+	if strings.TrimSpace(id) != "" {
+		return id
+	}
+	return "toolu_" + strings.TrimPrefix(NewAnthropicMessageID(), "msg_")
+}
+
+// ChatToAnthropicStream 将 chat.completion.chunk 事件流转换为 Messages SSE 事件流
+//
+// finish_reason 之后上游可能还会单独下发 usage 分片，因此 message_delta/message_stop
+// 在 [DONE] 或上游流结束时才输出。
+type ChatToAnthropicStream struct {
+	id    string
+	model string
+
+	nextIndex int
+	openIndex int
+	openKind  string // text, thinking, tool_use；空表示没有打开的块
+
+	toolBlocks map[int]int // tool_calls index -> content block index
+	hasToolUse bool
+
+	usage        AnthropicUsage
+	finishReason string
+
+	started  bool
+	finished bool
+	errored  bool
+}
+
+// NewChatToAnthropicStream 创建流式转换器
+func NewChatToAnthropicStream(model string) *ChatToAnthropicStream {
+	return &ChatToAnthropicStream{
+		id:         NewAnthropicMessageID(),
+		model:      model,
+		toolBlocks: make(map[int]int),
+	}
+}
+
+// Usage 返回上游累计用量（流结束后有效）
+func (p *ChatToAnthropicStream) Usage() AnthropicUsage {
+	return p.usage
+}
+
+// ProcessLine 处理一行 Chat Completions SSE
+func (p *ChatToAnthropicStream) ProcessLine(line string) []byte {
+	data, ok := sseData(line)
+	if !ok || p.finished || p.errored {
+		return nil
+	}
+	if data == "[DONE]" {
+		return p.finish()
+	}
+
+	var chunk struct {
+		ChatCompletionChunk
+		Error json.RawMessage `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+		return nil
+	}
+	if len(chunk.Error) > 0 && string(chunk.Error) != "null" {
+		p.errored = true
+		errType, message := parseErrorField(chunk.Error)
+		if message == "" {
+			message = "Upstream stream error"
+		}
+		if errType == "" || errType == "upstream_error" {
+			errType = "api_error"
+		}
+		return anthropicErrorEvent(errType, message)
+	}
+	if chunk.Usage != nil {
+		p.usage = ChatUsageToAnthropic(chunk.Usage)
+	}
+
+	out := p.ensureStart()
+	for _, choice := range chunk.Choices {
+		if choice.Index != 0 {
+			continue
+		}
+		delta := choice.Delta
+		reasoning := delta.ReasoningContent
+		if reasoning == nil {
+			reasoning = delta.Reasoning
+		}
+		if reasoning != nil && *reasoning != "" {
+			out = append(out, p.ensureBlock("thinking")...)
+			out = append(out, p.delta("thinking_delta", "thinking", *reasoning)...)
+		}
+		if delta.Content != nil && *delta.Content != "" {
+			out = append(out, p.ensureBlock("text")...)
+			out = append(out, p.delta("text_delta", "text", *delta.Content)...)
+		}
+		for _, call := range delta.ToolCalls {
+			out = append(out, p.toolCallDelta(call)...)
+		}
+		if choice.FinishReason != nil && *choice.FinishReason != "" {
+			p.finishReason = *choice.FinishReason
+		}
+	}
+	return out
+}
+
+// Finish 上游未发送 [DONE] 但已给出 finish_reason 时补齐收尾事件
+func (p *ChatToAnthropicStream) Finish() []byte {
+	if p.finished || p.errored || p.finishReason == "" {
+		return nil
+	}
+	return p.finish()
+}
+
+func (p *ChatToAnthropicStream) finish() []byte {
+	p.finished = true
+	out := p.ensureStart()
+	out = append(out, p.closeBlock()...)
+	out = append(out, formatAnthropicSSE("message_delta", map[string]any{
+		"type": "message_delta",
+		"delta": map[string]any{
+			"stop_reason":   chatFinishReasonToAnthropic(p.finishReason, p.hasToolUse),
+			"stop_sequence": nil,
+		},
+		"usage": p.usage,
+	})...)
+	return append(out, formatAnthropicSSE("message_stop", map[string]any{"type": "message_stop"})...)
+}
+
+// toolCallDelta 首个分片携带 id/name 时开启 tool_use 块，后续分片按 index 追加参数。
+// 上游按顺序逐个输出工具调用，已关闭块的迟到参数无法再追加，直接丢弃。
+func (p *ChatToAnthropicStream) toolCallDelta(call ChatToolCall) []byte {
+	toolIndex := 0
+	if call.Index != nil {
+		toolIndex = *call.Index
+	}
+	var out []byte
+	index, ok := p.toolBlocks[toolIndex]
+	if !ok {
+		if call.Function.Name == "" {
+			return nil
+		}
+		out = append(out, p.closeBlock()...)
+		index = p.openBlock("tool_use")
+		p.toolBlocks[toolIndex] = index
+		p.hasToolUse = true
+		out = append(out, formatAnthropicSSE("content_block_start", map[string]any{
+			"type":  "content_block_start",
+			"index": index,
+			"content_block": map[string]any{
+				"type":  "tool_use",
+				"id":    chatToolCallID(call.ID),
+				"name":  call.Function.Name,
+				"input": map[string]any{},
+			},
+		})...)
+	}
+	if call.Function.Arguments == "" || p.openKind != "tool_use" || p.openIndex != index {
+		return out
+	}
+	return append(out, formatAnthropicSSE("content_block_delta", map[string]any{
+		"type":  "content_block_delta",
+		"index": index,
+		"delta": map[string]any{"type": "input_json_delta", "partial_json": call.Function.Arguments},
+	})...)
+}
+
+func (p *ChatToAnthropicStream) ensureStart() []byte {
+	if p.started {
+		return nil
+	}
+	p.started = true
+	return formatAnthropicSSE("message_start", map[string]any{
+		"type": "message_start",
+		"message": map[string]any{
+			"id":            p.id,
+			"type":          "message",
+			"role":          "assistant",
+			"model":         p.model,
+			"content":       []any{},
+			"stop_reason":   nil,
+			"stop_sequence": nil,
+			"usage":         AnthropicUsage{},
+		},
+	})
+}
+
+// ensureBlock 确保当前打开的是指定类型的块，否则关闭旧块并新开一个
+func (p *ChatToAnthropicStream) ensureBlock(kind string) []byte {
+	if p.openKind == kind {
+		return nil
+	}
+	out := p.closeBlock()
+	index := p.openBlock(kind)
+	block := map[string]any{"type": kind}
+	if kind == "thinking" {
+		block["thinking"] = ""
+		block["signature"] = ""
+	} else {
+		block["text"] = ""
+	}
+	return append(out, formatAnthropicSSE("content_block_start", map[string]any{
+		"type":          "content_block_start",
+		"index":         index,
+		"content_block": block,
+	})...)
+}
+
+func (p *ChatToAnthropicStream) openBlock(kind string) int {
+	index := p.nextIndex
+	p.nextIndex++
+	p.openIndex = index
+	p.openKind = kind
+	return index
+}
+
+func (p *ChatToAnthropicStream) closeBlock() []byte {
+	if p.openKind == "" {
+		return nil
+	}
+	p.openKind = ""
+	return formatAnthropicSSE("content_block_stop", map[string]any{
+		"type":  "content_block_stop",
+		"index": p.openIndex,
+	})
+}
+
+func (p *ChatToAnthropicStream) delta(deltaType, field, value string) []byte {
+	return formatAnthropicSSE("content_block_delta", map[string]any{
+		"type":  "content_block_delta",
+		"index": p.openIndex,
+		"delta": map[string]any{"type": deltaType, field: value},
+	})
+}
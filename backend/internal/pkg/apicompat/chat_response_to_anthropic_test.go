@@ -0,0 +1,128 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatToAnthropicResponse(t *testing.T) {
+	body := `{
+		"id": "chatcmpl-1", "object": "chat.completion", "model": "deepseek-reasoner",
+		"choices": [{"index": 0, "finish_reason": "tool_calls", "message": {
+			"role": "assistant", "content": "Let me check.", "reasoning_content": "hmm",
+			"tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "lookup", "arguments": "{\"q\":\"x\"}"}}]
+		}}],
+		"usage": {"prompt_tokens": 30, "completion_tokens": 5, "total_tokens": 35, "prompt_cache_hit_tokens": 20}
+	}`
+	out, err := ChatToAnthropicResponse([]byte(body), "claude-sonnet-4-5")
+	require.NoError(t, err)
+
+	var resp AnthropicResponse
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "claude-sonnet-4-5", resp.Model)
+	require.Equal(t, "tool_use", resp.StopReason)
+	require.Len(t, resp.Content, 3)
+	require.Equal(t, "thinking", resp.Content[0].Type)
+	require.Equal(t, "Let me check.", resp.Content[1].Text)
+	require.Equal(t, "call_1", resp.Content[2].ID)
+	require.JSONEq(t, `{"q":"x"}`, string(resp.Content[2].Input))
+	require.Equal(t, AnthropicUsage{InputTokens: 10, OutputTokens: 5, CacheReadInputTokens: 20}, resp.Usage)
+}
+
+func TestChatToAnthropicStream(t *testing.T) {
+	lines := []string{
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"role":"assistant","content":""},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"reasoning_content":"think"},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"content":"Hi"},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"lookup","arguments":""}}]},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"q\":"}}]},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"x\"}"}}]},"finish_reason":null}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[{"index":0,"delta":{},"finish_reason":"tool_calls"}]}`,
+		`data: {"id":"c1","object":"chat.completion.chunk","choices":[],"usage":{"prompt_tokens":12,"completion_tokens":7,"total_tokens":19,"prompt_tokens_details":{"cached_tokens":4}}}`,
+		`data: [DONE]`,
+	}
+	p := NewChatToAnthropicStream("claude-sonnet-4-5")
+	var out strings.Builder
+	for _, line := range lines {
+		_, _ = out.Write(p.ProcessLine(line))
+	}
+	_, _ = out.Write(p.Finish())
+
+	types, events := collectAnthropicEvents(t, out.String())
+	require.Equal(t, []string{
+		"message_start",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_delta", "content_block_stop",
+		"message_delta", "message_stop",
+	}, types)
+	require.Equal(t, "thinking", events[1]["content_block"].(map[string]any)["type"])
+	require.Equal(t, "call_1", events[7]["content_block"].(map[string]any)["id"])
+	require.Equal(t, "tool_use", events[11]["delta"].(map[string]any)["stop_reason"])
+	require.Equal(t, AnthropicUsage{InputTokens: 8, OutputTokens: 7, CacheReadInputTokens: 4}, p.Usage())
+}
+
+func TestChatToAnthropicStream_FinishWithoutDone(t *testing.T) {
+	p := NewChatToAnthropicStream("m")
+	out := string(p.ProcessLine(`data: {"choices":[{"index":0,"delta":{"content":"ok"},"finish_reason":"length"}]}`))
+	out += string(p.Finish())
+	_, events := collectAnthropicEvents(t, out)
+	require.Equal(t, "message_stop", events[len(events)-1]["type"])
+	require.Equal(t, "max_tokens", events[len(events)-2]["delta"].(map[string]any)["stop_reason"])
+
+	// 上游中途断开（没有 finish_reason）时不伪造正常结束
+	p = NewChatToAnthropicStream("m")
+	_ = p.ProcessLine(`data: {"choices":[{"index":0,"delta":{"content":"partial"},"finish_reason":null}]}`)
+	require.Empty(t, p.Finish())
+}
+
+func TestChatToResponsesStream(t *testing.T) {
+	p := NewChatToResponsesStream("gpt-5")
+	var out strings.Builder
+	for _, line := range []string{
+		`data: {"choices":[{"index":0,"delta":{"content":"Hello"},"finish_reason":null}]}`,
+		`data: {"choices":[{"index":0,"delta":{},"finish_reason":"stop"}],"usage":{"prompt_tokens":3,"completion_tokens":1,"total_tokens":4}}`,
+		`data: [DONE]`,
+	} {
+		_, _ = out.Write(p.ProcessLine(line))
+	}
+	_, _ = out.Write(p.Finish())
+
+	names, events := collectAnthropicEvents(t, out.String())
+	require.Equal(t, "response.created", names[0])
+	last := events[len(events)-1]
+	require.Equal(t, "response.completed", last["type"])
+	usage := last["response"].(map[string]any)["usage"].(map[string]any)
+	require.EqualValues(t, 3, usage["input_tokens"])
+	require.EqualValues(t, 1, usage["output_tokens"])
+	require.Equal(t, 3, p.Usage().InputTokens)
+}
+
+func TestResponsesToChatRequest(t *testing.T) {
+	var req ResponsesRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"model": "gpt-5-codex",
+		"instructions": "You are Codex.",
+		"stream": true,
+		"input": [
+			{"type": "message", "role": "user", "content": [{"type": "input_text", "text": "ls"}]},
+			{"type": "function_call", "call_id": "call_1", "name": "shell", "arguments": "{\"cmd\":\"ls\"}"},
+			{"type": "function_call_output", "call_id": "call_1", "output": "a.go"}
+		],
+		"tools": [{"type": "function", "name": "shell", "parameters": {"type": "object"}}]
+	}`), &req))
+
+	out, err := ResponsesToChatRequest(&req)
+	require.NoError(t, err)
+	require.True(t, out.StreamOptions.IncludeUsage)
+	roles := make([]string, 0, len(out.Messages))
+	for _, msg := range out.Messages {
+		roles = append(roles, msg.Role)
+	}
+	require.Equal(t, []string{"system", "user", "assistant", "tool"}, roles)
+	require.Equal(t, "shell", out.Messages[2].ToolCalls[0].Function.Name)
+	require.Equal(t, "call_1", out.Messages[3].ToolCallID)
+}
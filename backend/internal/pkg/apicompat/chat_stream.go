@@ -0,0 +1,184 @@
+package apicompat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StreamConverter 将上游 SSE 行转换为目标协议的 SSE 输出
+type StreamConverter interface {
+	// ProcessLine 处理一行上游 SSE，返回需要写给客户端的 SSE 字节（可能为空）
+	ProcessLine(line string) []byte
+	// Finish 上游流结束时调用，返回收尾输出（如 data: [DONE]、message_stop）
+	Finish() []byte
+}
+
+// chatChunkWriter 组装 chat.completion.chunk 事件的公共状态
+type chatChunkWriter struct {
+	id           string
+	model        string
+	created      int64
+	includeUsage bool
+}
+
+func newChatChunkWriter(model string, includeUsage bool) chatChunkWriter {
+	return chatChunkWriter{
+		id:           NewChatCompletionID(),
+		model:        model,
+		created:      time.Now().Unix(),
+		includeUsage: includeUsage,
+	}
+}
+
+func (w *chatChunkWriter) chunk(delta ChatDelta, finishReason *string) []byte {
+	return formatChatSSE(ChatCompletionChunk{
+		ID:      w.id,
+		Object:  "chat.completion.chunk",
+		Created: w.created,
+		Model:   w.model,
+		Choices: []ChatChunkChoice{{Index: 0, Delta: delta, FinishReason: finishReason}},
+	})
+}
+
+func (w *chatChunkWriter) usageChunk(usage *ChatUsage) []byte {
+	if !w.includeUsage || usage == nil {
+		return nil
+	}
+	return formatChatSSE(ChatCompletionChunk{
+		ID:      w.id,
+		Object:  "chat.completion.chunk",
+		Created: w.created,
+		Model:   w.model,
+		Choices: []ChatChunkChoice{},
+		Usage:   usage,
+	})
+}
+
+// NewChatCompletionID 生成 chatcmpl- 前缀的响应 ID
+func NewChatCompletionID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("chatcmpl-%d", time.Now().UnixNano())
+	}
+	return "chatcmpl-" + hex.EncodeToString(b)
+}
+
+// ChatDoneEvent Chat Completions 流结束标记
+const ChatDoneEvent = "data: [DONE]\n\n"
+
+// chatPingEvent SSE 注释形式的心跳，兼容 OpenAI SDK
+const chatPingEvent = ": ping\n\n"
+
+func formatChatSSE(v any) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	out := make([]byte, 0, len(b)+8)
+	out = append(out, "data: "...)
+	out = append(out, b...)
+	out = append(out, "\n\n"...)
+	return out
+}
+
+// sseData 提取 SSE data 行负载；非 data 行返回 false
+func sseData(line string) (string, bool) {
+	line = strings.TrimRight(line, "\r\n")
+	if !strings.HasPrefix(line, "data:") {
+		return "", false
+	}
+	data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	if data == "" {
+		return "", false
+	}
+	return data, true
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// ChatErrorBody 将上游（Anthropic/OpenAI/Gemini 风格）错误体转换为 Chat Completions 错误体
+func ChatErrorBody(statusCode int, body []byte) []byte {
+	errType, message := extractErrorTypeMessage(body)
+	if errType == "" {
+		errType = defaultErrorType(statusCode)
+	}
+	if message == "" {
+		message = strings.TrimSpace(string(body))
+	}
+	if message == "" {
+		message = "Upstream request failed"
+	}
+	out, _ := json.Marshal(ChatErrorResponse{Error: ChatErrorDetail{Message: message, Type: errType}})
+	return out
+}
+
+// chatErrorEvent 流式过程中出现的错误以 data: {"error":{...}} 形式下发
+func chatErrorEvent(errType, message string) []byte {
+	if errType == "" {
+		errType = "upstream_error"
+	}
+	return formatChatSSE(ChatErrorResponse{Error: ChatErrorDetail{Message: message, Type: errType}})
+}
+
+// extractErrorTypeMessage 兼容以下错误格式：
+//   - {"type":"error","error":{"type":"...","message":"..."}}（Anthropic）
+//   - {"error":{"type":"...","message":"...","code":"..."}}（OpenAI）
+//   - {"error":{"status":"...","message":"..."}}（Gemini）
+//   - {"error":"reason"}
+func extractErrorTypeMessage(body []byte) (string, string) {
+	var envelope struct {
+		Error   json.RawMessage `json:"error"`
+		Message string          `json:"message"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return "", ""
+	}
+	if len(envelope.Error) == 0 {
+		return "", envelope.Message
+	}
+	return parseErrorField(envelope.Error)
+}
+
+func parseErrorField(raw json.RawMessage) (string, string) {
+	var reason string
+	if err := json.Unmarshal(raw, &reason); err == nil {
+		return "upstream_error", reason
+	}
+	var detail struct {
+		Type    string `json:"type"`
+		Code    any    `json:"code"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &detail); err != nil {
+		return "", ""
+	}
+	errType := detail.Type
+	if errType == "" && detail.Status != "" {
+		errType = strings.ToLower(detail.Status)
+	}
+	return errType, detail.Message
+}
+
+func defaultErrorType(statusCode int) string {
+	switch {
+	case statusCode == 400:
+		return "invalid_request_error"
+	case statusCode == 401:
+		return "authentication_error"
+	case statusCode == 403:
+		return "permission_error"
+	case statusCode == 404:
+		return "not_found_error"
+	case statusCode == 429:
+		return "rate_limit_error"
+	default:
+		return "api_error"
+	}
+}
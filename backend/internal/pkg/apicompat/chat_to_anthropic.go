@@ -0,0 +1,386 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// anthropicDefaultMaxTokens Chat 请求未指定 max_tokens 时的默认值（Messages API 要求必填）
+	anthropicDefaultMaxTokens = 8192
+)
+
+// reasoningEffortBudgets reasoning_effort 到 thinking budget_tokens 的映射
+var reasoningEffortBudgets = map[string]int{
+	"minimal": 1024,
+	"low":     2048,
+	"medium":  8192,
+	"high":    24576,
+	"xhigh":   32768,
+}
+
+// ChatToAnthropicRequest 将 Chat Completions 请求转换为 Anthropic Messages 请求
+func ChatToAnthropicRequest(req *ChatRequest) (*AnthropicRequest, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	out := &AnthropicRequest{
+		Model:       req.Model,
+		Stream:      req.Stream,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+	}
+
+	// max_tokens：max_completion_tokens 优先
+	switch {
+	case req.MaxCompletionTokens != nil && *req.MaxCompletionTokens > 0:
+		out.MaxTokens = *req.MaxCompletionTokens
+	case req.MaxTokens != nil && *req.MaxTokens > 0:
+		out.MaxTokens = *req.MaxTokens
+	default:
+		out.MaxTokens = anthropicDefaultMaxTokens
+	}
+
+	stops, err := parseStopSequences(req.Stop)
+	if err != nil {
+		return nil, err
+	}
+	out.StopSequences = stops
+
+	var systemBlocks []AnthropicContentBlock
+	var messages []AnthropicMessage
+	var pendingRole string
+	var pending []AnthropicContentBlock
+
+	flush := func() error {
+		if pendingRole == "" || len(pending) == 0 {
+			pendingRole = ""
+			pending = nil
+			return nil
+		}
+		content, err := json.Marshal(pending)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, AnthropicMessage{Role: pendingRole, Content: content})
+		pendingRole = ""
+		pending = nil
+		return nil
+	}
+	// Anthropic 要求 user/assistant 交替出现：相邻同角色消息合并为一条
+	appendBlocks := func(role string, blocks []AnthropicContentBlock) error {
+		if len(blocks) == 0 {
+			return nil
+		}
+		if pendingRole != role {
+			if err := flush(); err != nil {
+				return err
+			}
+			pendingRole = role
+		}
+		pending = append(pending, blocks...)
+		return nil
+	}
+
+	for i, msg := range req.Messages {
+		switch msg.Role {
+		case "system", "developer":
+			parts, err := parseChatContent(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			for _, part := range parts {
+				if part.Type == "text" && part.Text != "" {
+					systemBlocks = append(systemBlocks, AnthropicContentBlock{Type: "text", Text: part.Text})
+				}
+			}
+		case "user":
+			blocks, err := chatPartsToAnthropicBlocks(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			if err := appendBlocks("user", blocks); err != nil {
+				return nil, err
+			}
+		case "assistant":
+			blocks, err := chatPartsToAnthropicBlocks(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			for _, call := range msg.ToolCalls {
+				blocks = append(blocks, AnthropicContentBlock{
+					Type:  "tool_use",
+					ID:    call.ID,
+					Name:  call.Function.Name,
+					Input: normalizeToolArguments(call.Function.Arguments),
+				})
+			}
+			if err := appendBlocks("assistant", blocks); err != nil {
+				return nil, err
+			}
+		case "tool":
+			result, err := chatToolResultContent(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			if err := appendBlocks("user", []AnthropicContentBlock{{
+				Type:      "tool_result",
+				ToolUseID: msg.ToolCallID,
+				Content:   result,
+			}}); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("messages[%d]: unsupported role %q", i, msg.Role)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("messages must contain at least one user or assistant message")
+	}
+	out.Messages = messages
+
+	if hint := responseFormatInstruction(req.ResponseFormat); hint != "" {
+		systemBlocks = append(systemBlocks, AnthropicContentBlock{Type: "text", Text: hint})
+	}
+	if len(systemBlocks) > 0 {
+		system, err := json.Marshal(systemBlocks)
+		if err != nil {
+			return nil, err
+		}
+		out.System = system
+	}
+
+	for _, tool := range req.Tools {
+		if tool.Type != "function" || tool.Function == nil || tool.Function.Name == "" {
+			continue
+		}
+		schema := tool.Function.Parameters
+		if len(schema) == 0 || string(schema) == "null" {
+			schema = json.RawMessage(`{"type":"object","properties":{}}`)
+		}
+		out.Tools = append(out.Tools, AnthropicTool{
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			InputSchema: schema,
+		})
+	}
+
+	toolChoice, err := chatToolChoiceToAnthropic(req.ToolChoice)
+	if err != nil {
+		return nil, err
+	}
+	if req.ParallelToolCalls != nil && !*req.ParallelToolCalls && len(out.Tools) > 0 {
+		if toolChoice == nil {
+			toolChoice = &AnthropicToolChoice{Type: "auto"}
+		}
+		if toolChoice.Type != "none" {
+			toolChoice.DisableParallelToolUse = true
+		}
+	}
+	out.ToolChoice = toolChoice
+
+	if budget, ok := reasoningEffortBudgets[strings.ToLower(strings.TrimSpace(req.ReasoningEffort))]; ok {
+		out.Thinking = &AnthropicThinking{Type: "enabled", BudgetTokens: budget}
+		if out.MaxTokens <= budget {
+			out.MaxTokens = budget + anthropicDefaultMaxTokens
+		}
+		// thinking 模式下上游不接受自定义 temperature/top_p
+		out.Temperature = nil
+		out.TopP = nil
+	}
+
+	if req.User != "" {
+		out.Metadata = &AnthropicMetadata{UserID: req.User}
+	}
+
+	return out, nil
+}
+
+// parseChatContent 将 content 统一解析为片段列表（string 视为单个 text 片段）
+func parseChatContent(raw json.RawMessage) ([]ChatContentPart, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return nil, nil
+		}
+		return []ChatContentPart{{Type: "text", Text: text}}, nil
+	}
+	var parts []ChatContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+	return parts, nil
+}
+
+// chatContentText 拼接 content 中的全部文本片段
+func chatContentText(raw json.RawMessage) (string, error) {
+	parts, err := parseChatContent(raw)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, part := range parts {
+		if part.Type == "text" {
+			_, _ = sb.WriteString(part.Text)
+		}
+	}
+	return sb.String(), nil
+}
+
+func chatPartsToAnthropicBlocks(raw json.RawMessage) ([]AnthropicContentBlock, error) {
+	parts, err := parseChatContent(raw)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]AnthropicContentBlock, 0, len(parts))
+	for _, part := range parts {
+		switch part.Type {
+		case "text":
+			if part.Text != "" {
+				blocks = append(blocks, AnthropicContentBlock{Type: "text", Text: part.Text})
+			}
+		case "image_url":
+			if part.ImageURL == nil || part.ImageURL.URL == "" {
+				continue
+			}
+			blocks = append(blocks, AnthropicContentBlock{Type: "image", Source: imageURLToAnthropicSource(part.ImageURL.URL)})
+		}
+	}
+	return blocks, nil
+}
+
+// chatToolResultContent tool 消息内容转换为 tool_result.content
+func chatToolResultContent(raw json.RawMessage) (json.RawMessage, error) {
+	blocks, err := chatPartsToAnthropicBlocks(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return json.Marshal("")
+	}
+	if len(blocks) == 1 && blocks[0].Type == "text" {
+		return json.Marshal(blocks[0].Text)
+	}
+	return json.Marshal(blocks)
+}
+
+// imageURLToAnthropicSource data URL 转为 base64 来源，其他按 url 来源透传
+func imageURLToAnthropicSource(url string) *AnthropicSource {
+	if mediaType, data, ok := parseDataURL(url); ok {
+		return &AnthropicSource{Type: "base64", MediaType: mediaType, Data: data}
+	}
+	return &AnthropicSource{Type: "url", URL: url}
+}
+
+// parseDataURL 解析 data:<media_type>;base64,<data>
+func parseDataURL(url string) (mediaType, data string, ok bool) {
+	if !strings.HasPrefix(url, "data:") {
+		return "", "", false
+	}
+	header, payload, found := strings.Cut(strings.TrimPrefix(url, "data:"), ",")
+	if !found || !strings.HasSuffix(header, ";base64") {
+		return "", "", false
+	}
+	return strings.TrimSuffix(header, ";base64"), payload, true
+}
+
+// normalizeToolArguments 确保工具参数是合法的 JSON 对象
+func normalizeToolArguments(arguments string) json.RawMessage {
+	arguments = strings.TrimSpace(arguments)
+	if arguments == "" {
+		return json.RawMessage("{}")
+	}
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(arguments), &obj); err != nil || obj == nil {
+		return json.RawMessage("{}")
+	}
+	return json.RawMessage(arguments)
+}
+
+func parseStopSequences(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		if single == "" {
+			return nil, nil
+		}
+		return []string{single}, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, fmt.Errorf("invalid stop: %w", err)
+	}
+	return list, nil
+}
+
+func chatToolChoiceToAnthropic(raw json.RawMessage) (*AnthropicToolChoice, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var mode string
+	if err := json.Unmarshal(raw, &mode); err == nil {
+		switch mode {
+		case "auto":
+			return &AnthropicToolChoice{Type: "auto"}, nil
+		case "none":
+			return &AnthropicToolChoice{Type: "none"}, nil
+		case "required":
+			return &AnthropicToolChoice{Type: "any"}, nil
+		default:
+			return nil, fmt.Errorf("invalid tool_choice: %q", mode)
+		}
+	}
+	var named struct {
+		Type     string `json:"type"`
+		Function struct {
+			Name string `json:"name"`
+		} `json:"function"`
+	}
+	if err := json.Unmarshal(raw, &named); err != nil {
+		return nil, fmt.Errorf("invalid tool_choice: %w", err)
+	}
+	if named.Function.Name == "" {
+		return nil, errors.New("invalid tool_choice: function name is required")
+	}
+	return &AnthropicToolChoice{Type: "tool", Name: named.Function.Name}, nil
+}
+
+// responseFormatInstruction Anthropic 无原生 response_format，以 system 指令近似 JSON 输出约束
+func responseFormatInstruction(format *ChatResponseFormat) string {
+	if format == nil {
+		return ""
+	}
+	var spec struct {
+		Schema json.RawMessage `json:"schema"`
+	}
+	if format.Type == "json_schema" && len(format.JSONSchema) > 0 {
+		_ = json.Unmarshal(format.JSONSchema, &spec)
+	}
+	return jsonFormatInstruction(format.Type, spec.Schema)
+}
+
+// jsonFormatInstruction 按输出格式类型生成 JSON 约束指令；text 等其他类型返回空
+func jsonFormatInstruction(formatType string, schema json.RawMessage) string {
+	switch formatType {
+	case "json_object":
+		return "Respond only with a valid JSON object. Do not include any text outside the JSON."
+	case "json_schema":
+		if len(schema) == 0 || string(schema) == "null" {
+			return "Respond only with valid JSON. Do not include any text outside the JSON."
+		}
+		return "Respond only with valid JSON that conforms to the following JSON Schema. Do not include any text outside the JSON.\n" + string(schema)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,133 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatToAnthropicRequest_MessagesAndTools(t *testing.T) {
+	body := `{
+		"model": "claude-sonnet-4-5",
+		"stream": true,
+		"max_tokens": 512,
+		"stop": "END",
+		"messages": [
+			{"role": "system", "content": "You are helpful."},
+			{"role": "user", "content": [
+				{"type": "text", "text": "What is in this image?"},
+				{"type": "image_url", "image_url": {"url": "data:image/png;base64,aGVsbG8="}}
+			]},
+			{"role": "assistant", "content": null, "tool_calls": [
+				{"id": "call_1", "type": "function", "function": {"name": "lookup", "arguments": "{\"q\":\"cat\"}"}}
+			]},
+			{"role": "tool", "tool_call_id": "call_1", "content": "a cat"},
+			{"role": "user", "content": "thanks"}
+		],
+		"tools": [{"type": "function", "function": {"name": "lookup", "description": "search", "parameters": {"type": "object"}}}],
+		"tool_choice": "required"
+	}`
+	var req ChatRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+
+	out, err := ChatToAnthropicRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, 512, out.MaxTokens)
+	require.True(t, out.Stream)
+	require.Equal(t, []string{"END"}, out.StopSequences)
+	require.JSONEq(t, `[{"type":"text","text":"You are helpful."}]`, string(out.System))
+	require.Len(t, out.Tools, 1)
+	require.Equal(t, "lookup", out.Tools[0].Name)
+	require.Equal(t, &AnthropicToolChoice{Type: "any"}, out.ToolChoice)
+
+	// tool 消息与随后的 user 消息合并为同一条 user 消息
+	require.Len(t, out.Messages, 3)
+	require.Equal(t, "user", out.Messages[0].Role)
+	require.JSONEq(t, `[
+		{"type":"text","text":"What is in this image?"},
+		{"type":"image","source":{"type":"base64","media_type":"image/png","data":"aGVsbG8="}}
+	]`, string(out.Messages[0].Content))
+	require.Equal(t, "assistant", out.Messages[1].Role)
+	require.JSONEq(t, `[{"type":"tool_use","id":"call_1","name":"lookup","input":{"q":"cat"}}]`, string(out.Messages[1].Content))
+	require.Equal(t, "user", out.Messages[2].Role)
+	require.JSONEq(t, `[
+		{"type":"tool_result","tool_use_id":"call_1","content":"a cat"},
+		{"type":"text","text":"thanks"}
+	]`, string(out.Messages[2].Content))
+}
+
+func TestChatToAnthropicRequest_ReasoningEffortEnablesThinking(t *testing.T) {
+	temperature := 0.2
+	maxTokens := 1000
+	req := &ChatRequest{
+		Model:           "claude-sonnet-4-5",
+		Messages:        []ChatMessage{{Role: "user", Content: json.RawMessage(`"hi"`)}},
+		MaxTokens:       &maxTokens,
+		Temperature:     &temperature,
+		ReasoningEffort: "medium",
+	}
+
+	out, err := ChatToAnthropicRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, &AnthropicThinking{Type: "enabled", BudgetTokens: 8192}, out.Thinking)
+	require.Greater(t, out.MaxTokens, 8192)
+	require.Nil(t, out.Temperature)
+}
+
+func TestChatToAnthropicRequest_DefaultsAndErrors(t *testing.T) {
+	out, err := ChatToAnthropicRequest(&ChatRequest{
+		Model:    "claude-haiku-4-5",
+		Messages: []ChatMessage{{Role: "user", Content: json.RawMessage(`"hi"`)}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, anthropicDefaultMaxTokens, out.MaxTokens)
+	require.Empty(t, out.System)
+
+	_, err = ChatToAnthropicRequest(&ChatRequest{
+		Model:    "claude-haiku-4-5",
+		Messages: []ChatMessage{{Role: "system", Content: json.RawMessage(`"only system"`)}},
+	})
+	require.Error(t, err)
+
+	_, err = ChatToAnthropicRequest(&ChatRequest{
+		Model:      "claude-haiku-4-5",
+		Messages:   []ChatMessage{{Role: "user", Content: json.RawMessage(`"hi"`)}},
+		ToolChoice: json.RawMessage(`"sometimes"`),
+	})
+	require.Error(t, err)
+}
+
+func TestChatToResponsesRequest(t *testing.T) {
+	body := `{
+		"model": "gpt-5.1",
+		"max_completion_tokens": 256,
+		"reasoning_effort": "high",
+		"messages": [
+			{"role": "system", "content": "Be terse."},
+			{"role": "user", "content": "weather?"},
+			{"role": "assistant", "tool_calls": [{"id": "call_9", "type": "function", "function": {"name": "weather", "arguments": "{}"}}]},
+			{"role": "tool", "tool_call_id": "call_9", "content": "sunny"}
+		],
+		"tools": [{"type": "function", "function": {"name": "weather", "parameters": {"type": "object"}}}],
+		"tool_choice": {"type": "function", "function": {"name": "weather"}},
+		"response_format": {"type": "json_object"}
+	}`
+	var req ChatRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+
+	out, err := ChatToResponsesRequest(&req)
+	require.NoError(t, err)
+	require.NotNil(t, out.Store)
+	require.False(t, *out.Store)
+	require.Equal(t, 256, *out.MaxOutputTokens)
+	require.Equal(t, &ResponsesReasoning{Effort: "high", Summary: "auto"}, out.Reasoning)
+	require.JSONEq(t, `{"type":"function","name":"weather"}`, string(out.ToolChoice))
+	require.Equal(t, "json_object", out.Text.Format.Type)
+	require.JSONEq(t, `[
+		{"type":"message","role":"developer","content":[{"type":"input_text","text":"Be terse."}]},
+		{"type":"message","role":"user","content":[{"type":"input_text","text":"weather?"}]},
+		{"type":"function_call","call_id":"call_9","name":"weather","arguments":"{}"},
+		{"type":"function_call_output","call_id":"call_9","output":"sunny"}
+	]`, string(out.Input))
+}
@@ -0,0 +1,219 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ChatToResponsesRequest 将 Chat Completions 请求转换为 OpenAI Responses 请求
+func ChatToResponsesRequest(req *ChatRequest) (*ResponsesRequest, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	store := false
+	out := &ResponsesRequest{
+		Model:             req.Model,
+		Stream:            req.Stream,
+		Store:             &store,
+		Temperature:       req.Temperature,
+		TopP:              req.TopP,
+		ParallelToolCalls: req.ParallelToolCalls,
+		User:              req.User,
+	}
+
+	switch {
+	case req.MaxCompletionTokens != nil && *req.MaxCompletionTokens > 0:
+		out.MaxOutputTokens = req.MaxCompletionTokens
+	case req.MaxTokens != nil && *req.MaxTokens > 0:
+		out.MaxOutputTokens = req.MaxTokens
+	}
+
+	items := make([]ResponsesItem, 0, len(req.Messages))
+	for i, msg := range req.Messages {
+		switch msg.Role {
+		case "system", "developer":
+			// OAuth 账号会覆盖 instructions，system 提示以 developer 消息保留在 input 中
+			text, err := chatContentText(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			if text == "" {
+				continue
+			}
+			item, err := newResponsesMessage("developer", []ResponsesContentPart{{Type: "input_text", Text: text}})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		case "user":
+			parts, err := parseChatContent(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			converted := make([]ResponsesContentPart, 0, len(parts))
+			for _, part := range parts {
+				switch part.Type {
+				case "text":
+					converted = append(converted, ResponsesContentPart{Type: "input_text", Text: part.Text})
+				case "image_url":
+					if part.ImageURL == nil || part.ImageURL.URL == "" {
+						continue
+					}
+					converted = append(converted, ResponsesContentPart{Type: "input_image", ImageURL: part.ImageURL.URL, Detail: part.ImageURL.Detail})
+				}
+			}
+			if len(converted) == 0 {
+				continue
+			}
+			item, err := newResponsesMessage("user", converted)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		case "assistant":
+			text, err := chatContentText(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			if text != "" {
+				item, err := newResponsesMessage("assistant", []ResponsesContentPart{{Type: "output_text", Text: text}})
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			for _, call := range msg.ToolCalls {
+				args := call.Function.Arguments
+				if strings.TrimSpace(args) == "" {
+					args = "{}"
+				}
+				items = append(items, ResponsesItem{
+					Type:      "function_call",
+					CallID:    call.ID,
+					Name:      call.Function.Name,
+					Arguments: args,
+				})
+			}
+		case "tool":
+			text, err := chatContentText(msg.Content)
+			if err != nil {
+				return nil, fmt.Errorf("messages[%d]: %w", i, err)
+			}
+			items = append(items, ResponsesItem{
+				Type:   "function_call_output",
+				CallID: msg.ToolCallID,
+				Output: text,
+			})
+		default:
+			return nil, fmt.Errorf("messages[%d]: unsupported role %q", i, msg.Role)
+		}
+	}
+	if len(items) == 0 {
+		return nil, errors.New("messages must contain at least one message")
+	}
+	input, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	out.Input = input
+
+	for _, tool := range req.Tools {
+		if tool.Type != "function" || tool.Function == nil || tool.Function.Name == "" {
+			continue
+		}
+		out.Tools = append(out.Tools, ResponsesTool{
+			Type:        "function",
+			Name:        tool.Function.Name,
+			Description: tool.Function.Description,
+			Parameters:  tool.Function.Parameters,
+			Strict:      tool.Function.Strict,
+		})
+	}
+
+	toolChoice, err := chatToolChoiceToResponses(req.ToolChoice)
+	if err != nil {
+		return nil, err
+	}
+	out.ToolChoice = toolChoice
+
+	if effort := strings.ToLower(strings.TrimSpace(req.ReasoningEffort)); effort != "" {
+		out.Reasoning = &ResponsesReasoning{Effort: effort, Summary: "auto"}
+	}
+
+	format, err := chatResponseFormatToResponses(req.ResponseFormat)
+	if err != nil {
+		return nil, err
+	}
+	if format != nil {
+		out.Text = &ResponsesText{Format: format}
+	}
+
+	return out, nil
+}
+
+func newResponsesMessage(role string, parts []ResponsesContentPart) (ResponsesItem, error) {
+	content, err := json.Marshal(parts)
+	if err != nil {
+		return ResponsesItem{}, err
+	}
+	return ResponsesItem{Type: "message", Role: role, Content: content}, nil
+}
+
+func chatToolChoiceToResponses(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var mode string
+	if err := json.Unmarshal(raw, &mode); err == nil {
+		switch mode {
+		case "auto", "none", "required":
+			return raw, nil
+		default:
+			return nil, fmt.Errorf("invalid tool_choice: %q", mode)
+		}
+	}
+	var named struct {
+		Function struct {
+			Name string `json:"name"`
+		} `json:"function"`
+	}
+	if err := json.Unmarshal(raw, &named); err != nil {
+		return nil, fmt.Errorf("invalid tool_choice: %w", err)
+	}
+	if named.Function.Name == "" {
+		return nil, errors.New("invalid tool_choice: function name is required")
+	}
+	return json.Marshal(map[string]string{"type": "function", "name": named.Function.Name})
+}
+
+func chatResponseFormatToResponses(format *ChatResponseFormat) (*ResponsesTextFormat, error) {
+	if format == nil {
+		return nil, nil
+	}
+	switch format.Type {
+	case "", "text":
+		return nil, nil
+	case "json_object":
+		return &ResponsesTextFormat{Type: "json_object"}, nil
+	case "json_schema":
+		var spec struct {
+			Name   string          `json:"name"`
+			Schema json.RawMessage `json:"schema"`
+			Strict *bool           `json:"strict"`
+		}
+		if len(format.JSONSchema) > 0 {
+			if err := json.Unmarshal(format.JSONSchema, &spec); err != nil {
+				return nil, fmt.Errorf("invalid response_format.json_schema: %w", err)
+			}
+		}
+		if spec.Name == "" {
+			spec.Name = "response"
+		}
+		return &ResponsesTextFormat{Type: "json_schema", Name: spec.Name, Schema: spec.Schema, Strict: spec.Strict}, nil
+	default:
+		return nil, fmt.Errorf("unsupported response_format type: %q", format.Type)
+	}
+}
@@ -0,0 +1,168 @@
+// Package apicompat 提供 OpenAI Chat Completions、OpenAI Responses 与 Anthropic Messages
+// 三种协议之间的请求/响应转换，供网关在不同平台账号之间复用既有转发链路。
+package apicompat
+
+import "encoding/json"
+
+// OpenAI Chat Completions 请求/响应类型定义
+
+// ChatRequest Chat Completions 请求
+type ChatRequest struct {
+	Model               string              `json:"model"`
+	Messages            []ChatMessage       `json:"messages"`
+	Stream              bool                `json:"stream,omitempty"`
+	StreamOptions       *ChatStreamOptions  `json:"stream_options,omitempty"`
+	MaxTokens           *int                `json:"max_tokens,omitempty"`
+	MaxCompletionTokens *int                `json:"max_completion_tokens,omitempty"`
+	Temperature         *float64            `json:"temperature,omitempty"`
+	TopP                *float64            `json:"top_p,omitempty"`
+	Stop                json.RawMessage     `json:"stop,omitempty"` // string 或 []string
+	Tools               []ChatTool          `json:"tools,omitempty"`
+	ToolChoice          json.RawMessage     `json:"tool_choice,omitempty"` // string 或 {"type":"function","function":{"name":...}}
+	ParallelToolCalls   *bool               `json:"parallel_tool_calls,omitempty"`
+	ReasoningEffort     string              `json:"reasoning_effort,omitempty"`
+	ResponseFormat      *ChatResponseFormat `json:"response_format,omitempty"`
+	User                string              `json:"user,omitempty"`
+}
+
+// ChatStreamOptions 流式选项
+type ChatStreamOptions struct {
+	IncludeUsage bool `json:"include_usage,omitempty"`
+}
+
+// ChatMessage Chat 消息
+type ChatMessage struct {
+	Role             string          `json:"role"`              // system, developer, user, assistant, tool
+	Content          json.RawMessage `json:"content,omitempty"` // string、[]ChatContentPart 或 null
+	Name             string          `json:"name,omitempty"`
+	ReasoningContent string          `json:"reasoning_content,omitempty"`
+	ToolCalls        []ChatToolCall  `json:"tool_calls,omitempty"`
+	ToolCallID       string          `json:"tool_call_id,omitempty"`
+}
+
+// ChatContentPart 多模态消息内容片段
+type ChatContentPart struct {
+	Type     string        `json:"type"` // text, image_url
+	Text     string        `json:"text,omitempty"`
+	ImageURL *ChatImageURL `json:"image_url,omitempty"`
+}
+
+// ChatImageURL 图片地址（http(s) URL 或 data URL）
+type ChatImageURL struct {
+	URL    string `json:"url"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// ChatTool 工具定义
+type ChatTool struct {
+	Type     string        `json:"type"` // function
+	Function *ChatFunction `json:"function,omitempty"`
+}
+
+// ChatFunction 函数工具规格
+type ChatFunction struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+	Strict      *bool           `json:"strict,omitempty"`
+}
+
+// ChatToolCall 助手发起的工具调用
+type ChatToolCall struct {
+	Index    *int             `json:"index,omitempty"` // 仅流式 delta 使用
+	ID       string           `json:"id,omitempty"`
+	Type     string           `json:"type,omitempty"`
+	Function ChatFunctionCall `json:"function"`
+}
+
+// ChatFunctionCall 工具调用的函数名与参数（JSON 字符串）
+type ChatFunctionCall struct {
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments"`
+}
+
+// ChatResponseFormat 结构化输出格式
+type ChatResponseFormat struct {
+	Type       string          `json:"type"` // text, json_object, json_schema
+	JSONSchema json.RawMessage `json:"json_schema,omitempty"`
+}
+
+// ChatCompletion 非流式响应
+type ChatCompletion struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"` // chat.completion
+	Created int64        `json:"created"`
+	Model   string       `json:"model"`
+	Choices []ChatChoice `json:"choices"`
+	Usage   *ChatUsage   `json:"usage,omitempty"`
+}
+
+// ChatChoice 非流式响应选项
+type ChatChoice struct {
+	Index        int                 `json:"index"`
+	Message      ChatResponseMessage `json:"message"`
+	FinishReason string              `json:"finish_reason"`
+}
+
+// ChatResponseMessage 非流式响应消息
+type ChatResponseMessage struct {
+	Role             string         `json:"role"`
+	Content          *string        `json:"content"`
+	ReasoningContent string         `json:"reasoning_content,omitempty"`
+	Reasoning        string         `json:"reasoning,omitempty"` // OpenRouter 等上游的推理字段
+	ToolCalls        []ChatToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatCompletionChunk 流式响应分片
+type ChatCompletionChunk struct {
+	ID      string            `json:"id"`
+	Object  string            `json:"object"` // chat.completion.chunk
+	Created int64             `json:"created"`
+	Model   string            `json:"model"`
+	Choices []ChatChunkChoice `json:"choices"`
+	Usage   *ChatUsage        `json:"usage,omitempty"`
+}
+
+// ChatChunkChoice 流式响应选项
+type ChatChunkChoice struct {
+	Index        int       `json:"index"`
+	Delta        ChatDelta `json:"delta"`
+	FinishReason *string   `json:"finish_reason"`
+}
+
+// ChatDelta 流式增量
+type ChatDelta struct {
+	Role             string         `json:"role,omitempty"`
+	Content          *string        `json:"content,omitempty"`
+	ReasoningContent *string        `json:"reasoning_content,omitempty"`
+	Reasoning        *string        `json:"reasoning,omitempty"` // OpenRouter 等上游的推理字段
+	ToolCalls        []ChatToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatUsage 用量统计
+type ChatUsage struct {
+	PromptTokens        int                      `json:"prompt_tokens"`
+	CompletionTokens    int                      `json:"completion_tokens"`
+	TotalTokens         int                      `json:"total_tokens"`
+	PromptTokensDetails *ChatPromptTokensDetails `json:"prompt_tokens_details,omitempty"`
+	// PromptCacheHitTokens DeepSeek 上游的缓存命中 token 数（等价于 prompt_tokens_details.cached_tokens）
+	PromptCacheHitTokens int `json:"prompt_cache_hit_tokens,omitempty"`
+}
+
+// ChatPromptTokensDetails 输入 token 明细
+type ChatPromptTokensDetails struct {
+	CachedTokens int `json:"cached_tokens"`
+}
+
+// ChatErrorResponse OpenAI 风格错误响应
+type ChatErrorResponse struct {
+	Error ChatErrorDetail `json:"error"`
+}
+
+// ChatErrorDetail 错误详情
+type ChatErrorDetail struct {
+	Message string  `json:"message"`
+	Type    string  `json:"type"`
+	Param   *string `json:"param"`
+	Code    *string `json:"code"`
+}
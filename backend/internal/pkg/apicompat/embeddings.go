@@ -0,0 +1,156 @@
+package apicompat
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// OpenAI Embeddings 请求/响应类型定义
+
+// EmbeddingRequest Embeddings 请求
+type EmbeddingRequest struct {
+	Model          string          `json:"model"`
+	Input          json.RawMessage `json:"input"`                     // string、[]string、[]int 或 [][]int
+	EncodingFormat string          `json:"encoding_format,omitempty"` // float（默认）或 base64
+	Dimensions     *int            `json:"dimensions,omitempty"`
+	User           string          `json:"user,omitempty"`
+}
+
+// EmbeddingResponse Embeddings 响应
+type EmbeddingResponse struct {
+	Object string          `json:"object"` // list
+	Data   []EmbeddingData `json:"data"`
+	Model  string          `json:"model"`
+	Usage  EmbeddingUsage  `json:"usage"`
+}
+
+// EmbeddingData 单条向量；Embedding 为 []float64 或 base64 字符串
+type EmbeddingData struct {
+	Object    string `json:"object"` // embedding
+	Index     int    `json:"index"`
+	Embedding any    `json:"embedding"`
+}
+
+// EmbeddingUsage Embeddings 用量（仅输入 token）
+type EmbeddingUsage struct {
+	PromptTokens int `json:"prompt_tokens"`
+	TotalTokens  int `json:"total_tokens"`
+}
+
+// Texts 解析 input 为文本列表；token 数组形式无法转换到非 OpenAI 上游，返回错误
+func (r *EmbeddingRequest) Texts() ([]string, error) {
+	if len(r.Input) == 0 || string(r.Input) == "null" {
+		return nil, errors.New("input is required")
+	}
+	var text string
+	if err := json.Unmarshal(r.Input, &text); err == nil {
+		if text == "" {
+			return nil, errors.New("input must not be empty")
+		}
+		return []string{text}, nil
+	}
+	var texts []string
+	if err := json.Unmarshal(r.Input, &texts); err != nil {
+		return nil, errors.New("input must be a string or an array of strings for this group; token arrays are not supported")
+	}
+	if len(texts) == 0 {
+		return nil, errors.New("input must not be empty")
+	}
+	for i, t := range texts {
+		if t == "" {
+			return nil, fmt.Errorf("input[%d] must not be empty", i)
+		}
+	}
+	return texts, nil
+}
+
+// geminiEmbedContentRequest Gemini embedContent 请求（batchEmbedContents 的单项）
+type geminiEmbedContentRequest struct {
+	Model                string             `json:"model"`
+	Content              geminiEmbedContent `json:"content"`
+	OutputDimensionality *int               `json:"outputDimensionality,omitempty"`
+}
+
+type geminiEmbedContent struct {
+	Parts []geminiEmbedPart `json:"parts"`
+}
+
+type geminiEmbedPart struct {
+	Text string `json:"text"`
+}
+
+// EmbeddingToGeminiBatchRequest 将 OpenAI Embeddings 请求转换为 Gemini batchEmbedContents 请求体
+func EmbeddingToGeminiBatchRequest(req *EmbeddingRequest) ([]byte, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+	texts, err := req.Texts()
+	if err != nil {
+		return nil, err
+	}
+	model := strings.TrimPrefix(strings.TrimSpace(req.Model), "models/")
+	if model == "" {
+		return nil, errors.New("model is required")
+	}
+	var dimensions *int
+	if req.Dimensions != nil && *req.Dimensions > 0 {
+		dimensions = req.Dimensions
+	}
+
+	requests := make([]geminiEmbedContentRequest, 0, len(texts))
+	for _, text := range texts {
+		requests = append(requests, geminiEmbedContentRequest{
+			Model:                "models/" + model,
+			Content:              geminiEmbedContent{Parts: []geminiEmbedPart{{Text: text}}},
+			OutputDimensionality: dimensions,
+		})
+	}
+	return json.Marshal(map[string]any{"requests": requests})
+}
+
+// GeminiBatchEmbedToEmbeddingResponse 将 Gemini batchEmbedContents 响应转换为 OpenAI Embeddings 响应
+//
+// Gemini 响应不含用量，promptTokens 由调用方按请求文本估算后传入。
+func GeminiBatchEmbedToEmbeddingResponse(body []byte, model string, promptTokens int, encodingFormat string) ([]byte, error) {
+	var resp struct {
+		Embeddings []struct {
+			Values []float64 `json:"values"`
+		} `json:"embeddings"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse gemini embeddings: %w", err)
+	}
+
+	out := EmbeddingResponse{
+		Object: "list",
+		Data:   make([]EmbeddingData, 0, len(resp.Embeddings)),
+		Model:  model,
+		Usage:  EmbeddingUsage{PromptTokens: promptTokens, TotalTokens: promptTokens},
+	}
+	for i, e := range resp.Embeddings {
+		values := e.Values
+		if values == nil {
+			values = []float64{}
+		}
+		var embedding any = values
+		if encodingFormat == "base64" {
+			embedding = encodeEmbeddingBase64(values)
+		}
+		out.Data = append(out.Data, EmbeddingData{Object: "embedding", Index: i, Embedding: embedding})
+	}
+	return json.Marshal(out)
+}
+
+// encodeEmbeddingBase64 与 OpenAI 一致：float32 小端序字节流再做 base64
+func encodeEmbeddingBase64(values []float64) string {
+	buf := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(float32(v)))
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
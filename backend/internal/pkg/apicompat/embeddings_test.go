@@ -0,0 +1,80 @@
+package apicompat
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddingRequestTexts(t *testing.T) {
+	req := EmbeddingRequest{Input: json.RawMessage(`"hello"`)}
+	texts, err := req.Texts()
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello"}, texts)
+
+	req.Input = json.RawMessage(`["a","b"]`)
+	texts, err = req.Texts()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, texts)
+
+	for _, input := range []string{``, `""`, `[]`, `["a",""]`, `[1,2,3]`, `[[1,2]]`} {
+		req.Input = json.RawMessage(input)
+		_, err = req.Texts()
+		require.Error(t, err, input)
+	}
+}
+
+func TestEmbeddingToGeminiBatchRequest(t *testing.T) {
+	dims := 256
+	out, err := EmbeddingToGeminiBatchRequest(&EmbeddingRequest{
+		Model:      "gemini-embedding-001",
+		Input:      json.RawMessage(`["a","b"]`),
+		Dimensions: &dims,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"requests":[
+		{"model":"models/gemini-embedding-001","content":{"parts":[{"text":"a"}]},"outputDimensionality":256},
+		{"model":"models/gemini-embedding-001","content":{"parts":[{"text":"b"}]},"outputDimensionality":256}
+	]}`, string(out))
+
+	out, err = EmbeddingToGeminiBatchRequest(&EmbeddingRequest{Model: "models/text-embedding-004", Input: json.RawMessage(`"x"`)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"requests":[{"model":"models/text-embedding-004","content":{"parts":[{"text":"x"}]}}]}`, string(out))
+}
+
+func TestGeminiBatchEmbedToEmbeddingResponse(t *testing.T) {
+	body := []byte(`{"embeddings":[{"values":[0.5,-1]},{"values":[0.25]}]}`)
+
+	out, err := GeminiBatchEmbedToEmbeddingResponse(body, "gemini-embedding-001", 7, "")
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"object":"list",
+		"model":"gemini-embedding-001",
+		"data":[
+			{"object":"embedding","index":0,"embedding":[0.5,-1]},
+			{"object":"embedding","index":1,"embedding":[0.25]}
+		],
+		"usage":{"prompt_tokens":7,"total_tokens":7}
+	}`, string(out))
+
+	out, err = GeminiBatchEmbedToEmbeddingResponse(body, "gemini-embedding-001", 7, "base64")
+	require.NoError(t, err)
+	var resp struct {
+		Data []struct {
+			Embedding string `json:"embedding"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(out, &resp))
+	raw, err := base64.StdEncoding.DecodeString(resp.Data[0].Embedding)
+	require.NoError(t, err)
+	require.Len(t, raw, 8)
+	require.Equal(t, float32(0.5), math.Float32frombits(binary.LittleEndian.Uint32(raw[0:])))
+	require.Equal(t, float32(-1), math.Float32frombits(binary.LittleEndian.Uint32(raw[4:])))
+
+	_, err = GeminiBatchEmbedToEmbeddingResponse([]byte(`not json`), "m", 0, "")
+	require.Error(t, err)
+}
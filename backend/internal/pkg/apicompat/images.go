@@ -0,0 +1,236 @@
+package apicompat
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// OpenAI Images 请求/响应类型定义
+
+// ImageRequest Images generations/edits 请求（edits 的 multipart 字段解析到同一结构）
+type ImageRequest struct {
+	Model          string `json:"model"`
+	Prompt         string `json:"prompt"`
+	N              *int   `json:"n,omitempty"`
+	Size           string `json:"size,omitempty"`            // WxH、auto，或 Gemini 的 1K/2K/4K
+	ResponseFormat string `json:"response_format,omitempty"` // b64_json（默认）或 url
+	User           string `json:"user,omitempty"`
+}
+
+// ImageInput edits 请求上传的图片
+type ImageInput struct {
+	MimeType string
+	Data     []byte
+}
+
+// ImageResponse Images 响应
+type ImageResponse struct {
+	Created int64       `json:"created"`
+	Data    []ImageData `json:"data"`
+}
+
+// ImageData 单张图片；url 格式返回 data URL（上游不提供可访问的图片地址）
+type ImageData struct {
+	B64JSON       string `json:"b64_json,omitempty"`
+	URL           string `json:"url,omitempty"`
+	RevisedPrompt string `json:"revised_prompt,omitempty"`
+}
+
+// ImageMaxN 单次请求最多生成的图片数（Gemini 每次调用只产出一张，n 张需串行调用 n 次）
+const ImageMaxN = 4
+
+// Count 返回请求的图片数量（默认 1）
+func (r *ImageRequest) Count() (int, error) {
+	if r.N == nil {
+		return 1, nil
+	}
+	if *r.N < 1 || *r.N > ImageMaxN {
+		return 0, fmt.Errorf("n must be between 1 and %d", ImageMaxN)
+	}
+	return *r.N, nil
+}
+
+// geminiAspectRatios Gemini imageConfig.aspectRatio 支持的宽高比
+var geminiAspectRatios = []struct {
+	name  string
+	ratio float64
+}{
+	{"1:1", 1}, {"2:3", 2.0 / 3}, {"3:2", 3.0 / 2}, {"3:4", 3.0 / 4}, {"4:3", 4.0 / 3},
+	{"4:5", 4.0 / 5}, {"5:4", 5.0 / 4}, {"9:16", 9.0 / 16}, {"16:9", 16.0 / 9}, {"21:9", 21.0 / 9},
+}
+
+// OpenAIImageSizeToGemini 将 OpenAI size 映射为 Gemini 的 aspectRatio 与 imageSize（1K/2K/4K）
+//
+// WxH 取最接近的宽高比，按长边确定档位；auto/空值使用上游默认比例与 1K 档位。
+func OpenAIImageSizeToGemini(size string) (aspectRatio, imageSize string, err error) {
+	size = strings.ToLower(strings.TrimSpace(size))
+	switch size {
+	case "", "auto":
+		return "", "1K", nil
+	case "1k", "2k", "4k":
+		return "", strings.ToUpper(size), nil
+	}
+
+	w, h, ok := strings.Cut(size, "x")
+	width, errW := strconv.Atoi(w)
+	height, errH := strconv.Atoi(h)
+	if !ok || errW != nil || errH != nil || width <= 0 || height <= 0 {
+		return "", "", fmt.Errorf("invalid size %q: expected WIDTHxHEIGHT, auto, 1K, 2K or 4K", size)
+	}
+
+	target := math.Log(float64(width) / float64(height))
+	best := geminiAspectRatios[0]
+	for _, candidate := range geminiAspectRatios[1:] {
+		if math.Abs(math.Log(candidate.ratio)-target) < math.Abs(math.Log(best.ratio)-target) {
+			best = candidate
+		}
+	}
+
+	longSide := max(width, height)
+	switch {
+	case longSide <= 1024:
+		imageSize = "1K"
+	case longSide <= 2048:
+		imageSize = "2K"
+	default:
+		imageSize = "4K"
+	}
+	return best.name, imageSize, nil
+}
+
+// geminiModelSupportsImageSize gemini-2.5-flash-image 仅输出 1K 且不接受 imageSize 参数
+func geminiModelSupportsImageSize(model string) bool {
+	model = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(model)), "models/")
+	return !strings.HasPrefix(model, "gemini-2.5-flash-image")
+}
+
+// ImageToGeminiRequest 将 Images 请求转换为 Gemini generateContent 请求体
+//
+// images 为 edits 上传的原图；mask 作为附加图片并在提示词中说明其含义（Gemini 无原生蒙版参数）。
+func ImageToGeminiRequest(req *ImageRequest, images []ImageInput, mask *ImageInput) ([]byte, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+	prompt := strings.TrimSpace(req.Prompt)
+	if prompt == "" {
+		return nil, errors.New("prompt is required")
+	}
+	aspectRatio, imageSize, err := OpenAIImageSizeToGemini(req.Size)
+	if err != nil {
+		return nil, err
+	}
+	switch req.ResponseFormat {
+	case "", "b64_json", "url":
+	default:
+		return nil, fmt.Errorf("invalid response_format %q: expected b64_json or url", req.ResponseFormat)
+	}
+
+	parts := make([]map[string]any, 0, len(images)+2)
+	for _, img := range images {
+		parts = append(parts, geminiInlineImagePart(img))
+	}
+	if mask != nil {
+		parts = append(parts, geminiInlineImagePart(*mask))
+		prompt += "\n\nThe last image is a mask: only modify the regions that are transparent in the mask and keep everything else unchanged."
+	}
+	parts = append(parts, map[string]any{"text": prompt})
+
+	imageConfig := map[string]any{}
+	if aspectRatio != "" {
+		imageConfig["aspectRatio"] = aspectRatio
+	}
+	if geminiModelSupportsImageSize(req.Model) {
+		imageConfig["imageSize"] = imageSize
+	}
+	generationConfig := map[string]any{"responseModalities": []string{"TEXT", "IMAGE"}}
+	if len(imageConfig) > 0 {
+		generationConfig["imageConfig"] = imageConfig
+	}
+
+	return json.Marshal(map[string]any{
+		"contents":         []map[string]any{{"role": "user", "parts": parts}},
+		"generationConfig": generationConfig,
+	})
+}
+
+func geminiInlineImagePart(img ImageInput) map[string]any {
+	mimeType := img.MimeType
+	if mimeType == "" {
+		mimeType = "image/png"
+	}
+	return map[string]any{"inlineData": map[string]any{
+		"mimeType": mimeType,
+		"data":     base64.StdEncoding.EncodeToString(img.Data),
+	}}
+}
+
+// GeminiToImageData 从 Gemini generateContent 响应中提取图片；模型附带的文本作为 revised_prompt
+func GeminiToImageData(body []byte, responseFormat string) ([]ImageData, error) {
+	var resp struct {
+		Candidates []struct {
+			Content struct {
+				Parts []struct {
+					Text       string `json:"text"`
+					Thought    bool   `json:"thought"`
+					InlineData *struct {
+						MimeType string `json:"mimeType"`
+						Data     string `json:"data"`
+					} `json:"inlineData"`
+				} `json:"parts"`
+			} `json:"content"`
+			FinishReason string `json:"finishReason"`
+		} `json:"candidates"`
+		PromptFeedback *struct {
+			BlockReason string `json:"blockReason"`
+		} `json:"promptFeedback"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("parse gemini response: %w", err)
+	}
+
+	var data []ImageData
+	var texts []string
+	finishReason := ""
+	for _, cand := range resp.Candidates {
+		if cand.FinishReason != "" {
+			finishReason = cand.FinishReason
+		}
+		for _, part := range cand.Content.Parts {
+			switch {
+			case part.InlineData != nil && part.InlineData.Data != "" && !part.Thought:
+				item := ImageData{B64JSON: part.InlineData.Data}
+				if responseFormat == "url" {
+					mimeType := part.InlineData.MimeType
+					if mimeType == "" {
+						mimeType = "image/png"
+					}
+					item = ImageData{URL: "data:" + mimeType + ";base64," + part.InlineData.Data}
+				}
+				data = append(data, item)
+			case part.Text != "" && !part.Thought:
+				texts = append(texts, part.Text)
+			}
+		}
+	}
+	if len(data) == 0 {
+		reason := finishReason
+		if resp.PromptFeedback != nil && resp.PromptFeedback.BlockReason != "" {
+			reason = resp.PromptFeedback.BlockReason
+		}
+		if reason == "" {
+			reason = "no image in response"
+		}
+		return nil, fmt.Errorf("upstream returned no image (%s)", reason)
+	}
+	if revised := strings.TrimSpace(strings.Join(texts, "")); revised != "" {
+		for i := range data {
+			data[i].RevisedPrompt = revised
+		}
+	}
+	return data, nil
+}
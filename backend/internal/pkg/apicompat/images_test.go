@@ -0,0 +1,100 @@
+package apicompat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIImageSizeToGemini(t *testing.T) {
+	cases := []struct {
+		size, ratio, imageSize string
+	}{
+		{"", "", "1K"},
+		{"auto", "", "1K"},
+		{"2K", "", "2K"},
+		{"1024x1024", "1:1", "1K"},
+		{"1536x1024", "3:2", "2K"},
+		{"1024x1536", "2:3", "2K"},
+		{"1792x1024", "16:9", "2K"},
+		{"1024x1792", "9:16", "2K"},
+		{"512x512", "1:1", "1K"},
+		{"4096x1755", "21:9", "4K"},
+	}
+	for _, tc := range cases {
+		ratio, imageSize, err := OpenAIImageSizeToGemini(tc.size)
+		require.NoError(t, err, tc.size)
+		require.Equal(t, tc.ratio, ratio, tc.size)
+		require.Equal(t, tc.imageSize, imageSize, tc.size)
+	}
+
+	for _, size := range []string{"big", "1024", "0x100", "x"} {
+		_, _, err := OpenAIImageSizeToGemini(size)
+		require.Error(t, err, size)
+	}
+}
+
+func TestImageRequestCount(t *testing.T) {
+	n, err := (&ImageRequest{}).Count()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	three := 3
+	n, err = (&ImageRequest{N: &three}).Count()
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	for _, bad := range []int{0, ImageMaxN + 1} {
+		v := bad
+		_, err = (&ImageRequest{N: &v}).Count()
+		require.Error(t, err)
+	}
+}
+
+func TestImageToGeminiRequest(t *testing.T) {
+	out, err := ImageToGeminiRequest(&ImageRequest{Model: "gemini-3-pro-image-preview", Prompt: "a cat", Size: "1792x1024"}, nil, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"contents":[{"role":"user","parts":[{"text":"a cat"}]}],
+		"generationConfig":{"responseModalities":["TEXT","IMAGE"],"imageConfig":{"aspectRatio":"16:9","imageSize":"2K"}}
+	}`, string(out))
+
+	// gemini-2.5-flash-image 不接受 imageSize；edits 的原图与蒙版在提示词之前
+	out, err = ImageToGeminiRequest(
+		&ImageRequest{Model: "gemini-2.5-flash-image", Prompt: "add a hat"},
+		[]ImageInput{{MimeType: "image/jpeg", Data: []byte("ABC")}},
+		&ImageInput{Data: []byte("M")},
+	)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"generationConfig":{"responseModalities":["TEXT","IMAGE"]}`)
+	require.Contains(t, string(out), `{"inlineData":{"data":"QUJD","mimeType":"image/jpeg"}}`)
+	require.Contains(t, string(out), `{"inlineData":{"data":"TQ==","mimeType":"image/png"}}`)
+	require.Contains(t, string(out), "The last image is a mask")
+
+	_, err = ImageToGeminiRequest(&ImageRequest{Model: "m"}, nil, nil)
+	require.ErrorContains(t, err, "prompt")
+	_, err = ImageToGeminiRequest(&ImageRequest{Model: "m", Prompt: "x", ResponseFormat: "png"}, nil, nil)
+	require.ErrorContains(t, err, "response_format")
+}
+
+func TestGeminiToImageData(t *testing.T) {
+	body := []byte(`{"candidates":[{"content":{"parts":[
+		{"text":"thinking","thought":true},
+		{"text":"Here is your cat."},
+		{"inlineData":{"mimeType":"image/jpeg","data":"QUJD"}}
+	]},"finishReason":"STOP"}]}`)
+
+	data, err := GeminiToImageData(body, "")
+	require.NoError(t, err)
+	require.Equal(t, []ImageData{{B64JSON: "QUJD", RevisedPrompt: "Here is your cat."}}, data)
+
+	data, err = GeminiToImageData(body, "url")
+	require.NoError(t, err)
+	require.Equal(t, "data:image/jpeg;base64,QUJD", data[0].URL)
+	require.Empty(t, data[0].B64JSON)
+
+	_, err = GeminiToImageData([]byte(`{"candidates":[{"content":{"parts":[{"text":"no"}]},"finishReason":"IMAGE_SAFETY"}]}`), "")
+	require.ErrorContains(t, err, "IMAGE_SAFETY")
+	_, err = GeminiToImageData([]byte(`{"promptFeedback":{"blockReason":"SAFETY"}}`), "")
+	require.ErrorContains(t, err, "SAFETY")
+}
@@ -0,0 +1,292 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ResponsesToAnthropicRequest 将 OpenAI Responses 请求转换为 Anthropic Messages 请求
+//
+// 说明：
+//   - instructions 与 system/developer 消息合并为 system
+//   - function_call/function_call_output 分别转换为 tool_use/tool_result
+//   - reasoning 输入项不回传上游（加密内容与签名无法跨协议复用）
+//   - 不支持 previous_response_id：非 OpenAI 上游不保存会话状态，需由客户端携带完整上下文
+func ResponsesToAnthropicRequest(req *ResponsesRequest) (*AnthropicRequest, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+	if strings.TrimSpace(req.PreviousResponseID) != "" {
+		return nil, errors.New("previous_response_id is not supported for this group; send the full conversation in input instead")
+	}
+
+	out := &AnthropicRequest{
+		Model:       req.Model,
+		Stream:      req.Stream,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		MaxTokens:   anthropicDefaultMaxTokens,
+	}
+	if req.MaxOutputTokens != nil && *req.MaxOutputTokens > 0 {
+		out.MaxTokens = *req.MaxOutputTokens
+	}
+
+	var systemBlocks []AnthropicContentBlock
+	if strings.TrimSpace(req.Instructions) != "" {
+		systemBlocks = append(systemBlocks, AnthropicContentBlock{Type: "text", Text: req.Instructions})
+	}
+
+	items, err := parseResponsesInput(req.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []AnthropicMessage
+	var pendingRole string
+	var pending []AnthropicContentBlock
+
+	flush := func() error {
+		if pendingRole == "" || len(pending) == 0 {
+			pendingRole = ""
+			pending = nil
+			return nil
+		}
+		content, err := json.Marshal(pending)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, AnthropicMessage{Role: pendingRole, Content: content})
+		pendingRole = ""
+		pending = nil
+		return nil
+	}
+	// Anthropic 要求 user/assistant 交替出现：相邻同角色内容合并为一条消息
+	appendBlocks := func(role string, blocks []AnthropicContentBlock) error {
+		if len(blocks) == 0 {
+			return nil
+		}
+		if pendingRole != role {
+			if err := flush(); err != nil {
+				return err
+			}
+			pendingRole = role
+		}
+		pending = append(pending, blocks...)
+		return nil
+	}
+
+	for i, item := range items {
+		itemType := item.Type
+		if itemType == "" && item.Role != "" {
+			itemType = "message"
+		}
+		switch itemType {
+		case "message":
+			blocks, err := responsesContentToAnthropicBlocks(item.Content)
+			if err != nil {
+				return nil, fmt.Errorf("input[%d]: %w", i, err)
+			}
+			switch item.Role {
+			case "system", "developer":
+				for _, block := range blocks {
+					if block.Type == "text" {
+						systemBlocks = append(systemBlocks, block)
+					}
+				}
+			case "user":
+				err = appendBlocks("user", blocks)
+			case "assistant":
+				err = appendBlocks("assistant", blocks)
+			default:
+				return nil, fmt.Errorf("input[%d]: unsupported role %q", i, item.Role)
+			}
+			if err != nil {
+				return nil, err
+			}
+		case "function_call":
+			if err := appendBlocks("assistant", []AnthropicContentBlock{{
+				Type:  "tool_use",
+				ID:    item.CallID,
+				Name:  item.Name,
+				Input: normalizeToolArguments(item.Arguments),
+			}}); err != nil {
+				return nil, err
+			}
+		case "function_call_output":
+			content, err := json.Marshal(item.Output)
+			if err != nil {
+				return nil, err
+			}
+			if err := appendBlocks("user", []AnthropicContentBlock{{
+				Type:      "tool_result",
+				ToolUseID: item.CallID,
+				Content:   content,
+			}}); err != nil {
+				return nil, err
+			}
+		case "reasoning":
+			continue
+		default:
+			return nil, fmt.Errorf("input[%d]: unsupported item type %q", i, item.Type)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("input must contain at least one user or assistant message")
+	}
+	out.Messages = messages
+
+	if req.Text != nil && req.Text.Format != nil {
+		if hint := jsonFormatInstruction(req.Text.Format.Type, req.Text.Format.Schema); hint != "" {
+			systemBlocks = append(systemBlocks, AnthropicContentBlock{Type: "text", Text: hint})
+		}
+	}
+	if len(systemBlocks) > 0 {
+		system, err := json.Marshal(systemBlocks)
+		if err != nil {
+			return nil, err
+		}
+		out.System = system
+	}
+
+	for _, tool := range req.Tools {
+		// 仅转换 function 工具；web_search、file_search 等内置工具在 Messages 侧没有等价定义
+		if tool.Type != "function" || tool.Name == "" {
+			continue
+		}
+		schema := tool.Parameters
+		if len(schema) == 0 || string(schema) == "null" {
+			schema = json.RawMessage(`{"type":"object","properties":{}}`)
+		}
+		out.Tools = append(out.Tools, AnthropicTool{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: schema,
+		})
+	}
+
+	toolChoice, err := responsesToolChoiceToAnthropic(req.ToolChoice)
+	if err != nil {
+		return nil, err
+	}
+	if req.ParallelToolCalls != nil && !*req.ParallelToolCalls && len(out.Tools) > 0 {
+		if toolChoice == nil {
+			toolChoice = &AnthropicToolChoice{Type: "auto"}
+		}
+		if toolChoice.Type != "none" {
+			toolChoice.DisableParallelToolUse = true
+		}
+	}
+	out.ToolChoice = toolChoice
+
+	if req.Reasoning != nil {
+		if budget, ok := reasoningEffortBudgets[strings.ToLower(strings.TrimSpace(req.Reasoning.Effort))]; ok {
+			out.Thinking = &AnthropicThinking{Type: "enabled", BudgetTokens: budget}
+			if out.MaxTokens <= budget {
+				out.MaxTokens = budget + anthropicDefaultMaxTokens
+			}
+			// thinking 模式下上游不接受自定义 temperature/top_p
+			out.Temperature = nil
+			out.TopP = nil
+		}
+	}
+
+	if req.User != "" {
+		out.Metadata = &AnthropicMetadata{UserID: req.User}
+	}
+
+	return out, nil
+}
+
+// parseResponsesInput 解析 string 或 item 数组形式的 input（string 视为单条 user 消息）
+func parseResponsesInput(raw json.RawMessage) ([]ResponsesItem, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return nil, nil
+		}
+		content, err := json.Marshal(text)
+		if err != nil {
+			return nil, err
+		}
+		return []ResponsesItem{{Type: "message", Role: "user", Content: content}}, nil
+	}
+	var items []ResponsesItem
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+	return items, nil
+}
+
+// responsesContentToAnthropicBlocks 将 message.content（string 或片段数组）转换为 content block
+func responsesContentToAnthropicBlocks(raw json.RawMessage) ([]AnthropicContentBlock, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return nil, nil
+		}
+		return []AnthropicContentBlock{{Type: "text", Text: text}}, nil
+	}
+	var parts []ResponsesContentPart
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+	blocks := make([]AnthropicContentBlock, 0, len(parts))
+	for _, part := range parts {
+		switch part.Type {
+		case "input_text", "output_text", "text":
+			if part.Text != "" {
+				blocks = append(blocks, AnthropicContentBlock{Type: "text", Text: part.Text})
+			}
+		case "refusal":
+			if part.Refusal != "" {
+				blocks = append(blocks, AnthropicContentBlock{Type: "text", Text: part.Refusal})
+			}
+		case "input_image":
+			if part.ImageURL != "" {
+				blocks = append(blocks, AnthropicContentBlock{Type: "image", Source: imageURLToAnthropicSource(part.ImageURL)})
+			}
+		}
+	}
+	return blocks, nil
+}
+
+func responsesToolChoiceToAnthropic(raw json.RawMessage) (*AnthropicToolChoice, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var mode string
+	if err := json.Unmarshal(raw, &mode); err == nil {
+		switch mode {
+		case "auto":
+			return &AnthropicToolChoice{Type: "auto"}, nil
+		case "none":
+			return &AnthropicToolChoice{Type: "none"}, nil
+		case "required":
+			return &AnthropicToolChoice{Type: "any"}, nil
+		default:
+			return nil, fmt.Errorf("invalid tool_choice: %q", mode)
+		}
+	}
+	var named struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &named); err != nil {
+		return nil, fmt.Errorf("invalid tool_choice: %w", err)
+	}
+	if named.Type != "function" || named.Name == "" {
+		return nil, errors.New("invalid tool_choice: function name is required")
+	}
+	return &AnthropicToolChoice{Type: "tool", Name: named.Name}, nil
+}
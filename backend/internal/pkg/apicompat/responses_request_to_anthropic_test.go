@@ -0,0 +1,116 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponsesToAnthropicRequest_ItemsAndTools(t *testing.T) {
+	body := `{
+		"model": "claude-sonnet-4-5",
+		"instructions": "Be brief.",
+		"input": [
+			{"role": "developer", "content": "Use tools when needed."},
+			{"type": "message", "role": "user", "content": [
+				{"type": "input_text", "text": "What is in this image?"},
+				{"type": "input_image", "image_url": "data:image/jpeg;base64,QUJD"}
+			]},
+			{"type": "reasoning", "id": "rs_1", "summary": [{"type": "summary_text", "text": "thinking"}], "encrypted_content": "xxx"},
+			{"type": "message", "role": "assistant", "content": [{"type": "output_text", "text": "Let me look."}]},
+			{"type": "function_call", "call_id": "call_1", "name": "lookup", "arguments": "{\"q\":\"x\"}"},
+			{"type": "function_call_output", "call_id": "call_1", "output": "42"},
+			{"role": "user", "content": "Thanks"}
+		],
+		"tools": [
+			{"type": "function", "name": "lookup", "description": "Look up", "parameters": {"type": "object"}},
+			{"type": "web_search"}
+		],
+		"tool_choice": {"type": "function", "name": "lookup"},
+		"parallel_tool_calls": false,
+		"max_output_tokens": 512,
+		"text": {"format": {"type": "json_object"}},
+		"user": "u-1"
+	}`
+	var req ResponsesRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+
+	out, err := ResponsesToAnthropicRequest(&req)
+	require.NoError(t, err)
+	require.Equal(t, 512, out.MaxTokens)
+	require.Equal(t, "u-1", out.Metadata.UserID)
+
+	var system []AnthropicContentBlock
+	require.NoError(t, json.Unmarshal(out.System, &system))
+	require.Len(t, system, 3)
+	require.Equal(t, "Be brief.", system[0].Text)
+	require.Equal(t, "Use tools when needed.", system[1].Text)
+	require.Contains(t, system[2].Text, "JSON object")
+
+	// reasoning 项被丢弃，相邻同角色内容合并
+	require.Len(t, out.Messages, 3)
+	require.Equal(t, "user", out.Messages[0].Role)
+	require.Equal(t, "assistant", out.Messages[1].Role)
+	require.Equal(t, "user", out.Messages[2].Role)
+
+	var first []AnthropicContentBlock
+	require.NoError(t, json.Unmarshal(out.Messages[0].Content, &first))
+	require.Len(t, first, 2)
+	require.Equal(t, "image", first[1].Type)
+	require.Equal(t, "base64", first[1].Source.Type)
+	require.Equal(t, "image/jpeg", first[1].Source.MediaType)
+
+	var assistant []AnthropicContentBlock
+	require.NoError(t, json.Unmarshal(out.Messages[1].Content, &assistant))
+	require.Len(t, assistant, 2)
+	require.Equal(t, "text", assistant[0].Type)
+	require.Equal(t, "tool_use", assistant[1].Type)
+	require.Equal(t, "call_1", assistant[1].ID)
+	require.JSONEq(t, `{"q":"x"}`, string(assistant[1].Input))
+
+	var toolResult []AnthropicContentBlock
+	require.NoError(t, json.Unmarshal(out.Messages[2].Content, &toolResult))
+	require.Len(t, toolResult, 2)
+	require.Equal(t, "tool_result", toolResult[0].Type)
+	require.Equal(t, "call_1", toolResult[0].ToolUseID)
+	require.JSONEq(t, `"42"`, string(toolResult[0].Content))
+	require.Equal(t, "Thanks", toolResult[1].Text)
+
+	require.Len(t, out.Tools, 1)
+	require.Equal(t, "lookup", out.Tools[0].Name)
+	require.Equal(t, "tool", out.ToolChoice.Type)
+	require.Equal(t, "lookup", out.ToolChoice.Name)
+	require.True(t, out.ToolChoice.DisableParallelToolUse)
+}
+
+func TestResponsesToAnthropicRequest_ReasoningAndDefaults(t *testing.T) {
+	temperature := 0.3
+	out, err := ResponsesToAnthropicRequest(&ResponsesRequest{
+		Model:       "gemini-2.5-pro",
+		Input:       json.RawMessage(`"hello"`),
+		Temperature: &temperature,
+		Reasoning:   &ResponsesReasoning{Effort: "high", Summary: "auto"},
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Messages, 1)
+	require.JSONEq(t, `[{"type":"text","text":"hello"}]`, string(out.Messages[0].Content))
+	require.Equal(t, "enabled", out.Thinking.Type)
+	require.Equal(t, 24576, out.Thinking.BudgetTokens)
+	require.Greater(t, out.MaxTokens, out.Thinking.BudgetTokens)
+	require.Nil(t, out.Temperature)
+
+	out, err = ResponsesToAnthropicRequest(&ResponsesRequest{Model: "m", Input: json.RawMessage(`"hi"`)})
+	require.NoError(t, err)
+	require.Equal(t, anthropicDefaultMaxTokens, out.MaxTokens)
+	require.Nil(t, out.Thinking)
+
+	_, err = ResponsesToAnthropicRequest(&ResponsesRequest{Model: "m", Input: json.RawMessage(`"hi"`), PreviousResponseID: "resp_1"})
+	require.ErrorContains(t, err, "previous_response_id")
+
+	_, err = ResponsesToAnthropicRequest(&ResponsesRequest{Model: "m", Input: json.RawMessage(`[{"type":"item_reference","id":"fc_1"}]`)})
+	require.ErrorContains(t, err, "unsupported item type")
+
+	_, err = ResponsesToAnthropicRequest(&ResponsesRequest{Model: "m", Instructions: "only system"})
+	require.Error(t, err)
+}
@@ -0,0 +1,405 @@
+package apicompat
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NewAnthropicMessageID 生成 msg_ 前缀的消息 ID
+func NewAnthropicMessageID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("msg_%d", time.Now().UnixNano())
+	}
+	return "msg_" + hex.EncodeToString(b)
+}
+
+// responsesStopReason 根据响应状态推导 Anthropic stop_reason
+func responsesStopReason(resp *ResponsesResponse, hasToolUse bool) string {
+	if hasToolUse {
+		return "tool_use"
+	}
+	if resp != nil && resp.IncompleteDetails != nil {
+		switch resp.IncompleteDetails.Reason {
+		case "max_output_tokens":
+			return "max_tokens"
+		case "content_filter":
+			return "refusal"
+		}
+	}
+	return "end_turn"
+}
+
+// responsesUsageToAnthropic Responses 的 input_tokens 含缓存命中部分，Anthropic 需拆分为 cache_read_input_tokens
+func responsesUsageToAnthropic(usage *ResponsesUsage) AnthropicUsage {
+	if usage == nil {
+		return AnthropicUsage{}
+	}
+	out := AnthropicUsage{
+		InputTokens:  usage.InputTokens,
+		OutputTokens: usage.OutputTokens,
+	}
+	if usage.InputTokensDetails != nil && usage.InputTokensDetails.CachedTokens > 0 {
+		cached := usage.InputTokensDetails.CachedTokens
+		if cached > out.InputTokens {
+			cached = out.InputTokens
+		}
+		out.InputTokens -= cached
+		out.CacheReadInputTokens = cached
+	}
+	return out
+}
+
+// ResponsesToAnthropicResponse 将 Responses 非流式响应转换为 Messages 响应
+// 兼容 OAuth 上游未能折叠为 JSON 时直接返回的 SSE 文本。
+func ResponsesToAnthropicResponse(body []byte, model string) ([]byte, error) {
+	resp, err := parseResponsesBody(body)
+	if err != nil {
+		return nil, err
+	}
+	if model == "" {
+		model = resp.Model
+	}
+
+	content := make([]AnthropicContentBlock, 0, len(resp.Output))
+	hasToolUse := false
+	for _, item := range resp.Output {
+		switch item.Type {
+		case "reasoning":
+			var thinking strings.Builder
+			for _, summary := range item.Summary {
+				if thinking.Len() > 0 {
+					_, _ = thinking.WriteString("\n\n")
+				}
+				_, _ = thinking.WriteString(summary.Text)
+			}
+			if thinking.Len() > 0 {
+				content = append(content, AnthropicContentBlock{Type: "thinking", Thinking: thinking.String()})
+			}
+		case "message":
+			var parts []ResponsesContentPart
+			if err := json.Unmarshal(item.Content, &parts); err != nil {
+				continue
+			}
+			for _, part := range parts {
+				text := part.Text
+				if part.Type == "refusal" {
+					text = part.Refusal
+				}
+				if text != "" {
+					content = append(content, AnthropicContentBlock{Type: "text", Text: text})
+				}
+			}
+		case "function_call":
+			hasToolUse = true
+			content = append(content, AnthropicContentBlock{
+				Type:  "tool_use",
+				ID:    item.CallID,
+				Name:  item.Name,
+				Input: normalizeToolArguments(item.Arguments),
+			})
+		}
+	}
+
+	return json.Marshal(AnthropicResponse{
+		ID:         NewAnthropicMessageID(),
+		Type:       "message",
+		Role:       "assistant",
+		Model:      model,
+		Content:    content,
+		StopReason: responsesStopReason(resp, hasToolUse),
+		Usage:      responsesUsageToAnthropic(resp.Usage),
+	})
+}
+
+// AnthropicErrorBody 将上游（OpenAI/Gemini 风格）错误体转换为 Anthropic 错误体
+func AnthropicErrorBody(statusCode int, body []byte) []byte {
+	errType, message := extractErrorTypeMessage(body)
+	if errType == "" {
+		errType = defaultErrorType(statusCode)
+	}
+	if message == "" {
+		message = strings.TrimSpace(string(body))
+	}
+	if message == "" {
+		message = "Upstream request failed"
+	}
+	out, _ := json.Marshal(AnthropicErrorResponse{Type: "error", Error: AnthropicErrorDetail{Type: errType, Message: message}})
+	return out
+}
+
+// formatAnthropicSSE 以 event/data 两行格式输出 Messages SSE 事件
+func formatAnthropicSSE(eventType string, v any) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	out := make([]byte, 0, len(b)+len(eventType)+16)
+	out = append(out, "event: "...)
+	out = append(out, eventType...)
+	out = append(out, "\ndata: "...)
+	out = append(out, b...)
+	out = append(out, "\n\n"...)
+	return out
+}
+
+func anthropicErrorEvent(errType, message string) []byte {
+	if errType == "" {
+		errType = "api_error"
+	}
+	return formatAnthropicSSE("error", AnthropicErrorResponse{Type: "error", Error: AnthropicErrorDetail{Type: errType, Message: message}})
+}
+
+// ResponsesToAnthropicStream 将 Responses SSE 事件流转换为 Messages SSE 事件流
+type ResponsesToAnthropicStream struct {
+	id    string
+	model string
+
+	nextIndex       int
+	openIndex       int
+	openKind        string // text, thinking, tool_use；空表示没有打开的块
+	openOutputIndex int
+
+	toolBlocks   map[int]int // output_index -> content block index
+	argsStreamed map[int]bool
+	hasToolUse   bool
+
+	started  bool
+	finished bool
+	errored  bool
+}
+
+// NewResponsesToAnthropicStream 创建流式转换器
+func NewResponsesToAnthropicStream(model string) *ResponsesToAnthropicStream {
+	return &ResponsesToAnthropicStream{
+		id:           NewAnthropicMessageID(),
+		model:        model,
+		toolBlocks:   make(map[int]int),
+		argsStreamed: make(map[int]bool),
+	}
+}
+
+// ProcessLine 处理一行 Responses SSE
+func (p *ResponsesToAnthropicStream) ProcessLine(line string) []byte {
+	data, ok := sseData(line)
+	if !ok || data == "[DONE]" || p.finished || p.errored {
+		return nil
+	}
+
+	var event ResponsesStreamEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil
+	}
+
+	// 网关自身注入的错误事件可能不带 type 字段
+	if event.Type == "" && len(event.Error) > 0 {
+		event.Type = "error"
+	}
+
+	outputIndex := -1
+	if event.OutputIndex != nil {
+		outputIndex = *event.OutputIndex
+	}
+
+	switch event.Type {
+	case "response.created", "response.in_progress":
+		return p.ensureStart()
+
+	case "response.output_item.added":
+		if event.Item == nil || event.Item.Type != "function_call" {
+			return nil
+		}
+		out := p.ensureStart()
+		out = append(out, p.closeBlock()...)
+		index := p.openBlock("tool_use", outputIndex)
+		p.toolBlocks[outputIndex] = index
+		p.hasToolUse = true
+		out = append(out, formatAnthropicSSE("content_block_start", map[string]any{
+			"type":  "content_block_start",
+			"index": index,
+			"content_block": map[string]any{
+				"type":  "tool_use",
+				"id":    event.Item.CallID,
+				"name":  event.Item.Name,
+				"input": map[string]any{},
+			},
+		})...)
+		return out
+
+	case "response.function_call_arguments.delta":
+		index, ok := p.toolBlocks[outputIndex]
+		if !ok || event.Delta == "" || p.openKind != "tool_use" || p.openIndex != index {
+			return nil
+		}
+		p.argsStreamed[outputIndex] = true
+		return p.inputJSONDelta(index, event.Delta)
+
+	case "response.output_item.done":
+		if event.Item == nil {
+			return nil
+		}
+		var out []byte
+		if event.Item.Type == "function_call" {
+			// 部分上游不发送 arguments.delta，仅在 done 事件中给出完整参数
+			index, ok := p.toolBlocks[outputIndex]
+			if ok && !p.argsStreamed[outputIndex] && event.Item.Arguments != "" && p.openKind == "tool_use" && p.openIndex == index {
+				p.argsStreamed[outputIndex] = true
+				out = append(out, p.inputJSONDelta(index, event.Item.Arguments)...)
+			}
+		}
+		if p.openKind != "" && p.openOutputIndex == outputIndex {
+			out = append(out, p.closeBlock()...)
+		}
+		return out
+
+	case "response.reasoning_summary_part.added":
+		// 多段推理摘要合并到同一个 thinking 块，段落之间补空行
+		if p.openKind != "thinking" || p.openOutputIndex != outputIndex || event.SummaryIndex == nil || *event.SummaryIndex == 0 {
+			return nil
+		}
+		return p.delta("thinking_delta", "thinking", "\n\n")
+
+	case "response.reasoning_summary_text.delta", "response.reasoning_text.delta":
+		if event.Delta == "" {
+			return nil
+		}
+		out := p.ensureBlock("thinking", outputIndex)
+		return append(out, p.delta("thinking_delta", "thinking", event.Delta)...)
+
+	case "response.output_text.delta", "response.refusal.delta":
+		if event.Delta == "" {
+			return nil
+		}
+		out := p.ensureBlock("text", outputIndex)
+		return append(out, p.delta("text_delta", "text", event.Delta)...)
+
+	case "response.completed", "response.done", "response.incomplete":
+		p.finished = true
+		out := p.ensureStart()
+		out = append(out, p.closeBlock()...)
+		var usage AnthropicUsage
+		if event.Response != nil {
+			usage = responsesUsageToAnthropic(event.Response.Usage)
+		}
+		out = append(out, formatAnthropicSSE("message_delta", map[string]any{
+			"type": "message_delta",
+			"delta": map[string]any{
+				"stop_reason":   responsesStopReason(event.Response, p.hasToolUse),
+				"stop_sequence": nil,
+			},
+			"usage": usage,
+		})...)
+		out = append(out, formatAnthropicSSE("message_stop", map[string]any{"type": "message_stop"})...)
+		return out
+
+	case "response.failed":
+		p.errored = true
+		message := "Upstream response failed"
+		if event.Response != nil && event.Response.Error != nil && event.Response.Error.Message != "" {
+			message = event.Response.Error.Message
+		}
+		return anthropicErrorEvent("api_error", message)
+
+	case "error":
+		p.errored = true
+		errType, message := parseErrorField(event.Error)
+		if message == "" {
+			message = event.Message
+		}
+		if message == "" {
+			message = "Upstream stream error"
+		}
+		if errType == "" || errType == "upstream_error" {
+			errType = "api_error"
+		}
+		return anthropicErrorEvent(errType, message)
+	}
+	return nil
+}
+
+// Finish Messages 流以 message_stop 结束，无需额外收尾
+func (p *ResponsesToAnthropicStream) Finish() []byte {
+	return nil
+}
+
+func (p *ResponsesToAnthropicStream) ensureStart() []byte {
+	if p.started {
+		return nil
+	}
+	p.started = true
+	return formatAnthropicSSE("message_start", map[string]any{
+		"type": "message_start",
+		"message": map[string]any{
+			"id":            p.id,
+			"type":          "message",
+			"role":          "assistant",
+			"model":         p.model,
+			"content":       []any{},
+			"stop_reason":   nil,
+			"stop_sequence": nil,
+			"usage":         AnthropicUsage{},
+		},
+	})
+}
+
+// ensureBlock 确保当前打开的是指定类型的块，否则关闭旧块并新开一个
+func (p *ResponsesToAnthropicStream) ensureBlock(kind string, outputIndex int) []byte {
+	out := p.ensureStart()
+	if p.openKind == kind && p.openOutputIndex == outputIndex {
+		return out
+	}
+	out = append(out, p.closeBlock()...)
+	index := p.openBlock(kind, outputIndex)
+	block := map[string]any{"type": kind}
+	if kind == "thinking" {
+		block["thinking"] = ""
+		block["signature"] = ""
+	} else {
+		block["text"] = ""
+	}
+	return append(out, formatAnthropicSSE("content_block_start", map[string]any{
+		"type":          "content_block_start",
+		"index":         index,
+		"content_block": block,
+	})...)
+}
+
+func (p *ResponsesToAnthropicStream) openBlock(kind string, outputIndex int) int {
+	index := p.nextIndex
+	p.nextIndex++
+	p.openIndex = index
+	p.openKind = kind
+	p.openOutputIndex = outputIndex
+	return index
+}
+
+func (p *ResponsesToAnthropicStream) closeBlock() []byte {
+	if p.openKind == "" {
+		return nil
+	}
+	p.openKind = ""
+	return formatAnthropicSSE("content_block_stop", map[string]any{
+		"type":  "content_block_stop",
+		"index": p.openIndex,
+	})
+}
+
+func (p *ResponsesToAnthropicStream) delta(deltaType, field, value string) []byte {
+	return formatAnthropicSSE("content_block_delta", map[string]any{
+		"type":  "content_block_delta",
+		"index": p.openIndex,
+		"delta": map[string]any{"type": deltaType, field: value},
+	})
+}
+
+func (p *ResponsesToAnthropicStream) inputJSONDelta(index int, partial string) []byte {
+	return formatAnthropicSSE("content_block_delta", map[string]any{
+		"type":  "content_block_delta",
+		"index": index,
+		"delta": map[string]any{"type": "input_json_delta", "partial_json": partial},
+	})
+}
@@ -0,0 +1,120 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// collectAnthropicEvents 解析转换器输出的 event/data 对
+func collectAnthropicEvents(t *testing.T, out string) ([]string, []map[string]any) {
+	t.Helper()
+	var names []string
+	var payloads []map[string]any
+	for _, line := range strings.Split(out, "\n") {
+		if name, ok := strings.CutPrefix(line, "event: "); ok {
+			names = append(names, name)
+			continue
+		}
+		data, ok := sseData(line)
+		if !ok {
+			continue
+		}
+		var payload map[string]any
+		require.NoError(t, json.Unmarshal([]byte(data), &payload))
+		payloads = append(payloads, payload)
+	}
+	require.Len(t, payloads, len(names))
+	return names, payloads
+}
+
+func TestResponsesToAnthropicResponse(t *testing.T) {
+	body := `{
+		"id": "resp_1", "object": "response", "model": "gpt-5.1", "status": "completed",
+		"output": [
+			{"type": "reasoning", "summary": [{"type": "summary_text", "text": "step 1"}, {"type": "summary_text", "text": "step 2"}]},
+			{"type": "message", "role": "assistant", "content": [{"type": "output_text", "text": "Running it."}]},
+			{"type": "function_call", "call_id": "call_1", "name": "Bash", "arguments": "{\"command\":\"ls\"}"}
+		],
+		"usage": {"input_tokens": 100, "output_tokens": 20, "input_tokens_details": {"cached_tokens": 60}}
+	}`
+
+	out, err := ResponsesToAnthropicResponse([]byte(body), "claude-sonnet-4-5")
+	require.NoError(t, err)
+
+	var resp AnthropicResponse
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "message", resp.Type)
+	require.Equal(t, "claude-sonnet-4-5", resp.Model)
+	require.Equal(t, "tool_use", resp.StopReason)
+	require.Len(t, resp.Content, 3)
+	require.Equal(t, "step 1\n\nstep 2", resp.Content[0].Thinking)
+	require.Equal(t, "Running it.", resp.Content[1].Text)
+	require.Equal(t, "call_1", resp.Content[2].ID)
+	require.JSONEq(t, `{"command":"ls"}`, string(resp.Content[2].Input))
+	require.Equal(t, AnthropicUsage{InputTokens: 40, OutputTokens: 20, CacheReadInputTokens: 60}, resp.Usage)
+}
+
+func TestResponsesToAnthropicStream(t *testing.T) {
+	lines := []string{
+		`event: response.created`,
+		`data: {"type":"response.created","response":{"id":"resp_1","status":"in_progress","output":[]}}`,
+		`data: {"type":"response.output_item.added","output_index":0,"item":{"type":"reasoning"}}`,
+		`data: {"type":"response.reasoning_summary_text.delta","output_index":0,"summary_index":0,"delta":"think"}`,
+		`data: {"type":"response.output_item.done","output_index":0,"item":{"type":"reasoning"}}`,
+		`data: {"type":"response.output_text.delta","output_index":1,"content_index":0,"delta":"Hello"}`,
+		`data: {"type":"response.output_item.done","output_index":1,"item":{"type":"message"}}`,
+		`data: {"type":"response.output_item.added","output_index":2,"item":{"type":"function_call","call_id":"call_1","name":"Bash","arguments":""}}`,
+		`data: {"type":"response.output_item.done","output_index":2,"item":{"type":"function_call","call_id":"call_1","name":"Bash","arguments":"{\"command\":\"ls\"}"}}`,
+		`data: {"type":"response.completed","response":{"id":"resp_1","status":"completed","output":[],"usage":{"input_tokens":10,"output_tokens":4}}}`,
+	}
+
+	p := NewResponsesToAnthropicStream("claude-sonnet-4-5")
+	var sb strings.Builder
+	for _, line := range lines {
+		_, _ = sb.Write(p.ProcessLine(line))
+	}
+	_, _ = sb.Write(p.Finish())
+
+	names, payloads := collectAnthropicEvents(t, sb.String())
+	require.Equal(t, []string{
+		"message_start",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"message_delta", "message_stop",
+	}, names)
+
+	require.Equal(t, "claude-sonnet-4-5", payloads[0]["message"].(map[string]any)["model"])
+	require.Equal(t, map[string]any{"type": "thinking", "thinking": "", "signature": ""}, payloads[1]["content_block"])
+	require.Equal(t, "think", payloads[2]["delta"].(map[string]any)["thinking"])
+	require.Equal(t, map[string]any{"type": "text", "text": ""}, payloads[4]["content_block"])
+	require.EqualValues(t, 1, payloads[4]["index"])
+	require.Equal(t, "Hello", payloads[5]["delta"].(map[string]any)["text"])
+
+	toolStart := payloads[7]["content_block"].(map[string]any)
+	require.Equal(t, "tool_use", toolStart["type"])
+	require.Equal(t, "call_1", toolStart["id"])
+	require.Equal(t, `{"command":"ls"}`, payloads[8]["delta"].(map[string]any)["partial_json"])
+	require.EqualValues(t, 2, payloads[9]["index"])
+
+	require.Equal(t, "tool_use", payloads[10]["delta"].(map[string]any)["stop_reason"])
+	require.EqualValues(t, 10, payloads[10]["usage"].(map[string]any)["input_tokens"])
+}
+
+func TestResponsesToAnthropicStream_ErrorEvent(t *testing.T) {
+	p := NewResponsesToAnthropicStream("claude-sonnet-4-5")
+	_ = p.ProcessLine(`data: {"type":"response.created","response":{"id":"resp_1"}}`)
+	out := string(p.ProcessLine(`data: {"type":"error","sequence_number":0,"error":{"type":"upstream_error","message":"stream data interval timeout","code":"stream data interval timeout"}}`))
+	names, payloads := collectAnthropicEvents(t, out)
+	require.Equal(t, []string{"error"}, names)
+	require.Equal(t, map[string]any{"type": "api_error", "message": "stream data interval timeout"}, payloads[0]["error"])
+	require.Nil(t, p.ProcessLine(`data: {"type":"response.output_text.delta","delta":"late"}`))
+}
+
+func TestAnthropicErrorBody(t *testing.T) {
+	out := AnthropicErrorBody(429, []byte(`{"error":{"type":"rate_limit_error","message":"Upstream rate limit exceeded"}}`))
+	require.JSONEq(t, `{"type":"error","error":{"type":"rate_limit_error","message":"Upstream rate limit exceeded"}}`, string(out))
+}
@@ -0,0 +1,301 @@
+package apicompat
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func responsesUsageToChat(usage *ResponsesUsage) *ChatUsage {
+	if usage == nil {
+		return nil
+	}
+	out := &ChatUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.InputTokens + usage.OutputTokens,
+	}
+	if usage.InputTokensDetails != nil && usage.InputTokensDetails.CachedTokens > 0 {
+		out.PromptTokensDetails = &ChatPromptTokensDetails{CachedTokens: usage.InputTokensDetails.CachedTokens}
+	}
+	return out
+}
+
+// responsesFinishReason 根据响应状态推导 finish_reason
+func responsesFinishReason(resp *ResponsesResponse, hasToolCalls bool) string {
+	if hasToolCalls {
+		return "tool_calls"
+	}
+	if resp != nil && resp.IncompleteDetails != nil {
+		switch resp.IncompleteDetails.Reason {
+		case "max_output_tokens":
+			return "length"
+		case "content_filter":
+			return "content_filter"
+		}
+	}
+	return "stop"
+}
+
+// ResponsesToChatResponse 将 Responses 非流式响应转换为 Chat Completions 响应
+// 兼容 OAuth 上游未能折叠为 JSON 时直接返回的 SSE 文本。
+func ResponsesToChatResponse(body []byte, model string) ([]byte, error) {
+	resp, err := parseResponsesBody(body)
+	if err != nil {
+		return nil, err
+	}
+	if model == "" {
+		model = resp.Model
+	}
+
+	var text, reasoning strings.Builder
+	var toolCalls []ChatToolCall
+	for _, item := range resp.Output {
+		switch item.Type {
+		case "message":
+			var parts []ResponsesContentPart
+			if err := json.Unmarshal(item.Content, &parts); err == nil {
+				for _, part := range parts {
+					switch part.Type {
+					case "output_text":
+						_, _ = text.WriteString(part.Text)
+					case "refusal":
+						_, _ = text.WriteString(part.Refusal)
+					}
+				}
+			}
+		case "reasoning":
+			for _, summary := range item.Summary {
+				_, _ = reasoning.WriteString(summary.Text)
+			}
+		case "function_call":
+			args := item.Arguments
+			if args == "" {
+				args = "{}"
+			}
+			toolCalls = append(toolCalls, ChatToolCall{
+				ID:       item.CallID,
+				Type:     "function",
+				Function: ChatFunctionCall{Name: item.Name, Arguments: args},
+			})
+		}
+	}
+
+	message := ChatResponseMessage{
+		Role:             "assistant",
+		ReasoningContent: reasoning.String(),
+		ToolCalls:        toolCalls,
+	}
+	if text.Len() > 0 || len(toolCalls) == 0 {
+		message.Content = strPtr(text.String())
+	}
+
+	return json.Marshal(ChatCompletion{
+		ID:      NewChatCompletionID(),
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   model,
+		Choices: []ChatChoice{{
+			Index:        0,
+			Message:      message,
+			FinishReason: responsesFinishReason(resp, len(toolCalls) > 0),
+		}},
+		Usage: responsesUsageToChat(resp.Usage),
+	})
+}
+
+func parseResponsesBody(body []byte) (*ResponsesResponse, error) {
+	trimmed := bytes.TrimSpace(body)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		var resp ResponsesResponse
+		if err := json.Unmarshal(trimmed, &resp); err != nil {
+			return nil, fmt.Errorf("parse responses response: %w", err)
+		}
+		return &resp, nil
+	}
+
+	// SSE 文本：取最后一个终态事件中的 response
+	var final *ResponsesResponse
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	scanner.Buffer(make([]byte, 64*1024), len(trimmed)+1)
+	for scanner.Scan() {
+		data, ok := sseData(scanner.Text())
+		if !ok || data == "[DONE]" {
+			continue
+		}
+		var event ResponsesStreamEvent
+		if json.Unmarshal([]byte(data), &event) != nil || event.Response == nil {
+			continue
+		}
+		switch event.Type {
+		case "response.completed", "response.done", "response.incomplete":
+			final = event.Response
+		}
+	}
+	if final == nil {
+		return nil, errors.New("parse responses response: no terminal event found")
+	}
+	return final, nil
+}
+
+// ResponsesToChatStream 将 Responses SSE 事件流转换为 chat.completion.chunk 事件流
+type ResponsesToChatStream struct {
+	chatChunkWriter
+
+	toolIndexes   map[int]int // output_index -> tool_calls index
+	argsStreamed  map[int]bool
+	nextToolIndex int
+	roleSent      bool
+	finished      bool
+	errored       bool
+}
+
+// NewResponsesToChatStream 创建流式转换器
+func NewResponsesToChatStream(model string, includeUsage bool) *ResponsesToChatStream {
+	return &ResponsesToChatStream{
+		chatChunkWriter: newChatChunkWriter(model, includeUsage),
+		toolIndexes:     make(map[int]int),
+		argsStreamed:    make(map[int]bool),
+	}
+}
+
+// ProcessLine 处理一行 Responses SSE
+func (p *ResponsesToChatStream) ProcessLine(line string) []byte {
+	data, ok := sseData(line)
+	if !ok || data == "[DONE]" || p.finished || p.errored {
+		return nil
+	}
+
+	var event ResponsesStreamEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil
+	}
+
+	// 网关自身注入的错误事件可能不带 type 字段
+	if event.Type == "" && len(event.Error) > 0 {
+		event.Type = "error"
+	}
+
+	switch event.Type {
+	case "response.created", "response.in_progress":
+		return p.ensureRole()
+
+	case "response.output_item.added":
+		if event.Item == nil || event.Item.Type != "function_call" || event.OutputIndex == nil {
+			return nil
+		}
+		out := p.ensureRole()
+		toolIndex := p.nextToolIndex
+		p.nextToolIndex++
+		p.toolIndexes[*event.OutputIndex] = toolIndex
+		out = append(out, p.chunk(ChatDelta{ToolCalls: []ChatToolCall{{
+			Index:    &toolIndex,
+			ID:       event.Item.CallID,
+			Type:     "function",
+			Function: ChatFunctionCall{Name: event.Item.Name, Arguments: ""},
+		}}}, nil)...)
+		return out
+
+	case "response.function_call_arguments.delta":
+		if event.OutputIndex == nil || event.Delta == "" {
+			return nil
+		}
+		toolIndex, ok := p.toolIndexes[*event.OutputIndex]
+		if !ok {
+			return nil
+		}
+		p.argsStreamed[*event.OutputIndex] = true
+		return p.chunk(ChatDelta{ToolCalls: []ChatToolCall{{
+			Index:    &toolIndex,
+			Function: ChatFunctionCall{Arguments: event.Delta},
+		}}}, nil)
+
+	case "response.output_item.done":
+		// 部分上游不发送 arguments.delta，仅在 done 事件中给出完整参数
+		if event.Item == nil || event.Item.Type != "function_call" || event.OutputIndex == nil {
+			return nil
+		}
+		toolIndex, ok := p.toolIndexes[*event.OutputIndex]
+		if !ok || p.argsStreamed[*event.OutputIndex] || event.Item.Arguments == "" {
+			return nil
+		}
+		p.argsStreamed[*event.OutputIndex] = true
+		return p.chunk(ChatDelta{ToolCalls: []ChatToolCall{{
+			Index:    &toolIndex,
+			Function: ChatFunctionCall{Arguments: event.Item.Arguments},
+		}}}, nil)
+
+	case "response.output_text.delta", "response.refusal.delta":
+		if event.Delta == "" {
+			return nil
+		}
+		out := p.ensureRole()
+		return append(out, p.chunk(ChatDelta{Content: strPtr(event.Delta)}, nil)...)
+
+	case "response.reasoning_summary_text.delta", "response.reasoning_text.delta":
+		if event.Delta == "" {
+			return nil
+		}
+		out := p.ensureRole()
+		return append(out, p.chunk(ChatDelta{ReasoningContent: strPtr(event.Delta)}, nil)...)
+
+	case "response.completed", "response.done", "response.incomplete":
+		p.finished = true
+		out := p.ensureRole()
+		finishReason := responsesFinishReason(event.Response, p.nextToolIndex > 0)
+		out = append(out, p.chunk(ChatDelta{}, &finishReason)...)
+		if event.Response != nil {
+			out = append(out, p.usageChunk(responsesUsageToChat(event.Response.Usage))...)
+		}
+		return out
+
+	case "response.failed":
+		p.errored = true
+		message := "Upstream response failed"
+		errType := "upstream_error"
+		if event.Response != nil && event.Response.Error != nil {
+			if event.Response.Error.Message != "" {
+				message = event.Response.Error.Message
+			}
+			if event.Response.Error.Code != "" {
+				errType = event.Response.Error.Code
+			}
+		}
+		return chatErrorEvent(errType, message)
+
+	case "error":
+		p.errored = true
+		errType, message := parseErrorField(event.Error)
+		if message == "" {
+			message = event.Message
+		}
+		if errType == "" {
+			errType = event.Code
+		}
+		if message == "" {
+			message = "Upstream stream error"
+		}
+		return chatErrorEvent(errType, message)
+	}
+	return nil
+}
+
+// Finish 上游流正常结束时补发 [DONE]
+func (p *ResponsesToChatStream) Finish() []byte {
+	if !p.finished {
+		return nil
+	}
+	return []byte(ChatDoneEvent)
+}
+
+func (p *ResponsesToChatStream) ensureRole() []byte {
+	if p.roleSent {
+		return nil
+	}
+	p.roleSent = true
+	return p.chunk(ChatDelta{Role: "assistant", Content: strPtr("")}, nil)
+}
@@ -0,0 +1,87 @@
+package apicompat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponsesToChatResponse(t *testing.T) {
+	body := `{
+		"id": "resp_1", "object": "response", "model": "gpt-5.1", "status": "completed",
+		"output": [
+			{"type": "reasoning", "id": "rs_1", "summary": [{"type": "summary_text", "text": "thinking"}]},
+			{"type": "message", "id": "msg_1", "role": "assistant", "content": [{"type": "output_text", "text": "Hello"}]}
+		],
+		"usage": {"input_tokens": 9, "output_tokens": 3, "input_tokens_details": {"cached_tokens": 4}}
+	}`
+
+	out, err := ResponsesToChatResponse([]byte(body), "gpt-5.1")
+	require.NoError(t, err)
+
+	var resp ChatCompletion
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "stop", resp.Choices[0].FinishReason)
+	require.Equal(t, "Hello", *resp.Choices[0].Message.Content)
+	require.Equal(t, "thinking", resp.Choices[0].Message.ReasoningContent)
+	require.Equal(t, 12, resp.Usage.TotalTokens)
+	require.Equal(t, 4, resp.Usage.PromptTokensDetails.CachedTokens)
+}
+
+func TestResponsesToChatResponse_SSEBody(t *testing.T) {
+	body := "event: response.created\n" +
+		`data: {"type":"response.created","response":{"id":"resp_1","status":"in_progress","output":[]}}` + "\n\n" +
+		"event: response.completed\n" +
+		`data: {"type":"response.completed","response":{"id":"resp_1","status":"incomplete","incomplete_details":{"reason":"max_output_tokens"},"output":[{"type":"message","role":"assistant","content":[{"type":"output_text","text":"partial"}]}]}}` + "\n\n"
+
+	out, err := ResponsesToChatResponse([]byte(body), "gpt-5.1")
+	require.NoError(t, err)
+
+	var resp ChatCompletion
+	require.NoError(t, json.Unmarshal(out, &resp))
+	require.Equal(t, "length", resp.Choices[0].FinishReason)
+	require.Equal(t, "partial", *resp.Choices[0].Message.Content)
+}
+
+func TestResponsesToChatStream(t *testing.T) {
+	lines := []string{
+		`event: response.created`,
+		`data: {"type":"response.created","response":{"id":"resp_1","status":"in_progress","output":[]}}`,
+		`data: {"type":"response.reasoning_summary_text.delta","output_index":0,"delta":"plan"}`,
+		`data: {"type":"response.output_text.delta","output_index":1,"delta":"Sure"}`,
+		`data: {"type":"response.output_item.added","output_index":2,"item":{"type":"function_call","call_id":"call_1","name":"run","arguments":""}}`,
+		`data: {"type":"response.function_call_arguments.delta","output_index":2,"delta":"{}"}`,
+		`data: {"type":"response.output_item.done","output_index":2,"item":{"type":"function_call","call_id":"call_1","name":"run","arguments":"{}"}}`,
+		`data: {"type":"response.completed","response":{"id":"resp_1","status":"completed","output":[],"usage":{"input_tokens":5,"output_tokens":2}}}`,
+	}
+
+	p := NewResponsesToChatStream("gpt-5.1", true)
+	var sb strings.Builder
+	for _, line := range lines {
+		_, _ = sb.Write(p.ProcessLine(line))
+	}
+	_, _ = sb.Write(p.Finish())
+	out := sb.String()
+	require.True(t, strings.HasSuffix(out, ChatDoneEvent))
+
+	chunks := collectChatChunks(t, out)
+	require.Len(t, chunks, 7)
+	delta := func(i int) map[string]any {
+		return chunks[i]["choices"].([]any)[0].(map[string]any)["delta"].(map[string]any)
+	}
+	require.Equal(t, "assistant", delta(0)["role"])
+	require.Equal(t, "plan", delta(1)["reasoning_content"])
+	require.Equal(t, "Sure", delta(2)["content"])
+	require.Equal(t, "call_1", delta(3)["tool_calls"].([]any)[0].(map[string]any)["id"])
+	require.Equal(t, "tool_calls", chunks[5]["choices"].([]any)[0].(map[string]any)["finish_reason"])
+	require.EqualValues(t, 7, chunks[6]["usage"].(map[string]any)["total_tokens"])
+}
+
+func TestResponsesToChatStream_GatewayErrorEvent(t *testing.T) {
+	p := NewResponsesToChatStream("gpt-5.1", false)
+	out := string(p.ProcessLine(`data: {"error": {"type": "rate_limit_error", "message": "Too many pending requests"}}`))
+	require.Contains(t, out, `"type":"rate_limit_error"`)
+	require.Nil(t, p.Finish())
+}
@@ -0,0 +1,150 @@
+package apicompat
+
+import "encoding/json"
+
+// OpenAI Responses 请求/响应类型定义
+
+// ResponsesRequest Responses API 请求
+type ResponsesRequest struct {
+	Model             string              `json:"model"`
+	Instructions      string              `json:"instructions,omitempty"`
+	Input             json.RawMessage     `json:"input,omitempty"` // string 或 []ResponsesItem
+	Tools             []ResponsesTool     `json:"tools,omitempty"`
+	ToolChoice        json.RawMessage     `json:"tool_choice,omitempty"`
+	ParallelToolCalls *bool               `json:"parallel_tool_calls,omitempty"`
+	MaxOutputTokens   *int                `json:"max_output_tokens,omitempty"`
+	Temperature       *float64            `json:"temperature,omitempty"`
+	TopP              *float64            `json:"top_p,omitempty"`
+	Stream            bool                `json:"stream,omitempty"`
+	Store             *bool               `json:"store,omitempty"`
+	Reasoning         *ResponsesReasoning `json:"reasoning,omitempty"`
+	Text              *ResponsesText      `json:"text,omitempty"`
+	Include           []string            `json:"include,omitempty"`
+	User              string              `json:"user,omitempty"`
+	PromptCacheKey    string              `json:"prompt_cache_key,omitempty"`
+
+	PreviousResponseID string `json:"previous_response_id,omitempty"`
+}
+
+// ResponsesItem input/output 数组元素（message、function_call、function_call_output、reasoning 等）
+type ResponsesItem struct {
+	Type   string `json:"type"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+	// message
+	Role    string          `json:"role,omitempty"`
+	Content json.RawMessage `json:"content,omitempty"` // string 或 []ResponsesContentPart
+	// function_call / function_call_output
+	CallID    string `json:"call_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments,omitempty"`
+	Output    string `json:"output,omitempty"`
+	// reasoning
+	Summary          []ResponsesSummaryPart `json:"summary,omitempty"`
+	EncryptedContent string                 `json:"encrypted_content,omitempty"`
+}
+
+// ResponsesContentPart 消息内容片段
+type ResponsesContentPart struct {
+	Type     string `json:"type"` // input_text, output_text, input_image, refusal
+	Text     string `json:"text,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Refusal  string `json:"refusal,omitempty"`
+}
+
+// ResponsesSummaryPart 推理摘要片段
+type ResponsesSummaryPart struct {
+	Type string `json:"type"` // summary_text
+	Text string `json:"text"`
+}
+
+// ResponsesTool 工具定义
+type ResponsesTool struct {
+	Type        string          `json:"type"` // function
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+	Strict      *bool           `json:"strict,omitempty"`
+}
+
+// ResponsesReasoning 推理配置
+type ResponsesReasoning struct {
+	Effort  string `json:"effort,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// ResponsesText 文本输出配置
+type ResponsesText struct {
+	Format *ResponsesTextFormat `json:"format,omitempty"`
+}
+
+// ResponsesTextFormat 结构化输出格式
+type ResponsesTextFormat struct {
+	Type   string          `json:"type"` // text, json_object, json_schema
+	Name   string          `json:"name,omitempty"`
+	Schema json.RawMessage `json:"schema,omitempty"`
+	Strict *bool           `json:"strict,omitempty"`
+}
+
+// ResponsesResponse Responses API 响应对象
+type ResponsesResponse struct {
+	ID                string                      `json:"id"`
+	Object            string                      `json:"object"` // response
+	CreatedAt         int64                       `json:"created_at"`
+	Model             string                      `json:"model"`
+	Status            string                      `json:"status"` // completed, incomplete, failed, in_progress
+	Output            []ResponsesItem             `json:"output"`
+	Usage             *ResponsesUsage             `json:"usage,omitempty"`
+	IncompleteDetails *ResponsesIncompleteDetails `json:"incomplete_details,omitempty"`
+	Error             *ResponsesError             `json:"error,omitempty"`
+}
+
+// ResponsesUsage 用量统计
+type ResponsesUsage struct {
+	InputTokens         int                           `json:"input_tokens"`
+	OutputTokens        int                           `json:"output_tokens"`
+	TotalTokens         int                           `json:"total_tokens"`
+	InputTokensDetails  *ResponsesInputTokensDetails  `json:"input_tokens_details,omitempty"`
+	OutputTokensDetails *ResponsesOutputTokensDetails `json:"output_tokens_details,omitempty"`
+}
+
+// ResponsesInputTokensDetails 输入 token 明细
+type ResponsesInputTokensDetails struct {
+	CachedTokens int `json:"cached_tokens"`
+}
+
+// ResponsesOutputTokensDetails 输出 token 明细
+type ResponsesOutputTokensDetails struct {
+	ReasoningTokens int `json:"reasoning_tokens"`
+}
+
+// ResponsesIncompleteDetails 未完成原因
+type ResponsesIncompleteDetails struct {
+	Reason string `json:"reason"` // max_output_tokens, content_filter
+}
+
+// ResponsesError 响应错误
+type ResponsesError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// ResponsesStreamEvent SSE 事件负载（response.created、response.output_text.delta 等）
+type ResponsesStreamEvent struct {
+	Type           string             `json:"type"`
+	SequenceNumber int                `json:"sequence_number"`
+	Response       *ResponsesResponse `json:"response,omitempty"`
+	OutputIndex    *int               `json:"output_index,omitempty"`
+	ContentIndex   *int               `json:"content_index,omitempty"`
+	SummaryIndex   *int               `json:"summary_index,omitempty"`
+	ItemID         string             `json:"item_id,omitempty"`
+	Item           *ResponsesItem     `json:"item,omitempty"`
+	Part           json.RawMessage    `json:"part,omitempty"`
+	Delta          string             `json:"delta,omitempty"`
+	Text           string             `json:"text,omitempty"`
+	Arguments      string             `json:"arguments,omitempty"`
+	Code           string             `json:"code,omitempty"`
+	Message        string             `json:"message,omitempty"`
+	Error          json.RawMessage    `json:"error,omitempty"`
+}
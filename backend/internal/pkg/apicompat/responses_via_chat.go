@@ -0,0 +1,66 @@
+package apicompat
+
+import "strings"
+
+// Responses ↔ Chat Completions 转换以 Anthropic Messages 为中间格式，
+// 复用 Responses ↔ Messages 与 Messages ↔ Chat 两组转换规则，避免维护第三套工具调用/推理映射。
+
+// ResponsesToChatRequest 将 OpenAI Responses 请求转换为 Chat Completions 请求
+func ResponsesToChatRequest(req *ResponsesRequest) (*ChatRequest, error) {
+	anthropicReq, err := ResponsesToAnthropicRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return AnthropicToChatRequest(anthropicReq)
+}
+
+// ChatToResponsesResponse 将 Chat Completions 非流式响应转换为 Responses 响应
+func ChatToResponsesResponse(body []byte, model string) ([]byte, error) {
+	anthropicBody, err := ChatToAnthropicResponse(body, model)
+	if err != nil {
+		return nil, err
+	}
+	return AnthropicToResponsesResponse(anthropicBody, model)
+}
+
+// ChatToResponsesStream 将 chat.completion.chunk 事件流转换为 Responses SSE 事件流
+type ChatToResponsesStream struct {
+	chat      *ChatToAnthropicStream
+	responses *AnthropicToResponsesStream
+}
+
+// NewChatToResponsesStream 创建流式转换器
+func NewChatToResponsesStream(model string) *ChatToResponsesStream {
+	return &ChatToResponsesStream{
+		chat:      NewChatToAnthropicStream(model),
+		responses: NewAnthropicToResponsesStream(model),
+	}
+}
+
+// Usage 返回上游累计用量（流结束后有效）
+func (p *ChatToResponsesStream) Usage() AnthropicUsage {
+	return p.chat.Usage()
+}
+
+// ProcessLine 处理一行 Chat Completions SSE
+func (p *ChatToResponsesStream) ProcessLine(line string) []byte {
+	return p.relay(p.chat.ProcessLine(line))
+}
+
+// Finish 上游流结束时补齐收尾事件
+func (p *ChatToResponsesStream) Finish() []byte {
+	out := p.relay(p.chat.Finish())
+	return append(out, p.responses.Finish()...)
+}
+
+// relay 将中间 Messages SSE 逐行交给 Responses 转换器
+func (p *ChatToResponsesStream) relay(messages []byte) []byte {
+	if len(messages) == 0 {
+		return nil
+	}
+	var out []byte
+	for _, line := range strings.Split(string(messages), "\n") {
+		out = append(out, p.responses.ProcessLine(line)...)
+	}
+	return out
+}
@@ -11,6 +11,7 @@ const (
 	BetaFineGrainedToolStreaming = "fine-grained-tool-streaming-2025-05-14"
 	BetaTokenCounting            = "token-counting-2024-11-01"
 	BetaContext1M                = "context-1m-2025-08-07"
+	BetaFilesAPI                 = "files-api-2025-04-14"
 )
 
 // DefaultBetaHeader Claude Code 客户端默认的 anthropic-beta header
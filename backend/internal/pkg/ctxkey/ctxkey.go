@@ -32,4 +32,11 @@ const (
 	// SingleAccountRetry 标识当前请求处于单账号 503 退避重试模式。
 	// 在此模式下，Service 层的模型限流预检查将等待限流过期而非直接切换账号。
 	SingleAccountRetry Key = "ctx_single_account_retry"
+
+	// PinnedAccountID 请求必须由指定账号处理（int64），例如引用了 Files API 文件的请求
+	// 只能发往持有该文件的上游账号。调度时跳过负载均衡与粘性会话。
+	PinnedAccountID Key = "ctx_pinned_account_id"
+
+	// PromptCachePrefixes 请求各 cache_control 断点的前缀哈希，用于 prompt cache 亲和调度
+	PromptCachePrefixes Key = "ctx_prompt_cache_prefixes"
 )
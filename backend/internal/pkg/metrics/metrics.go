@@ -0,0 +1,368 @@
+// Package metrics 提供轻量的 Prometheus 文本格式指标导出（counter/gauge/histogram）
+//
+// 仅实现网关需要的最小子集，避免引入 client_golang 依赖：
+// 1. 指标按名称注册到 Registry，重复注册返回已存在的实例
+// 2. 标签值按注册时声明的顺序传入
+// 3. 采集前可注册 OnCollect 钩子，用于刷新按需计算的 gauge（如账号并发）
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefBuckets 默认延迟分桶（秒）
+var DefBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+type collector interface {
+	name() string
+	write(w io.Writer)
+}
+
+// Registry 保存所有已注册指标
+type Registry struct {
+	mu         sync.RWMutex
+	collectors map[string]collector
+	hooks      []func()
+}
+
+// NewRegistry 创建空的指标注册表
+func NewRegistry() *Registry {
+	return &Registry{collectors: map[string]collector{}}
+}
+
+// Default 进程级默认注册表
+var Default = NewRegistry()
+
+func (r *Registry) register(c collector) collector {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.collectors[c.name()]; ok {
+		return existing
+	}
+	r.collectors[c.name()] = c
+	return c
+}
+
+// OnCollect 注册采集前回调（在每次导出前同步执行）
+func (r *Registry) OnCollect(fn func()) {
+	if fn == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, fn)
+}
+
+// WriteText 以 Prometheus 文本格式（0.0.4）导出所有指标
+func (r *Registry) WriteText(w io.Writer) {
+	r.mu.RLock()
+	hooks := append([]func(){}, r.hooks...)
+	r.mu.RUnlock()
+	for _, fn := range hooks {
+		fn()
+	}
+
+	r.mu.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	collectors := make([]collector, 0, len(names))
+	for _, name := range names {
+		collectors = append(collectors, r.collectors[name])
+	}
+	r.mu.RUnlock()
+
+	for _, c := range collectors {
+		c.write(w)
+	}
+}
+
+// Handler 返回导出指标的 HTTP handler
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.WriteText(w)
+	})
+}
+
+// ---------------------------------------------------------------------------
+// 标签序列
+// ---------------------------------------------------------------------------
+
+type series[T any] struct {
+	mu     sync.RWMutex
+	labels []string
+	values map[string]*seriesEntry[T]
+	newFn  func() T
+}
+
+type seriesEntry[T any] struct {
+	labelValues []string
+	value       T
+}
+
+func newSeries[T any](labels []string, newFn func() T) *series[T] {
+	return &series[T]{labels: labels, values: map[string]*seriesEntry[T]{}, newFn: newFn}
+}
+
+func (s *series[T]) get(labelValues []string) T {
+	if len(labelValues) != len(s.labels) {
+		panic(fmt.Sprintf("metrics: expected %d label values, got %d", len(s.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s.mu.RLock()
+	entry, ok := s.values[key]
+	s.mu.RUnlock()
+	if ok {
+		return entry.value
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.values[key]; ok {
+		return entry.value
+	}
+	entry = &seriesEntry[T]{labelValues: append([]string(nil), labelValues...), value: s.newFn()}
+	s.values[key] = entry
+	return entry.value
+}
+
+func (s *series[T]) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = map[string]*seriesEntry[T]{}
+}
+
+func (s *series[T]) snapshot() []*seriesEntry[T] {
+	s.mu.RLock()
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]*seriesEntry[T], 0, len(keys))
+	for _, k := range keys {
+		out = append(out, s.values[k])
+	}
+	s.mu.RUnlock()
+	return out
+}
+
+// ---------------------------------------------------------------------------
+// Counter / Gauge
+// ---------------------------------------------------------------------------
+
+// Value 是并发安全的 float64 值
+type Value struct {
+	mu sync.Mutex
+	v  float64
+}
+
+// Add 增加指定值
+func (v *Value) Add(delta float64) {
+	v.mu.Lock()
+	v.v += delta
+	v.mu.Unlock()
+}
+
+// Inc 加一
+func (v *Value) Inc() { v.Add(1) }
+
+// Set 设置为指定值（仅 gauge 使用）
+func (v *Value) Set(val float64) {
+	v.mu.Lock()
+	v.v = val
+	v.mu.Unlock()
+}
+
+// Get 返回当前值
+func (v *Value) Get() float64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.v
+}
+
+type valueVec struct {
+	metricName string
+	help       string
+	kind       string
+	series     *series[*Value]
+}
+
+func (v *valueVec) name() string { return v.metricName }
+
+func (v *valueVec) write(w io.Writer) {
+	writeHeader(w, v.metricName, v.help, v.kind)
+	for _, e := range v.series.snapshot() {
+		_, _ = fmt.Fprintf(w, "%s%s %s\n", v.metricName, formatLabels(v.series.labels, e.labelValues, "", ""), formatFloat(e.value.Get()))
+	}
+}
+
+// CounterVec 带标签的单调递增计数器
+type CounterVec struct{ *valueVec }
+
+// NewCounterVec 在注册表中创建（或取回）计数器
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := r.register(&valueVec{metricName: name, help: help, kind: "counter", series: newSeries(labels, func() *Value { return &Value{} })})
+	return &CounterVec{c.(*valueVec)}
+}
+
+// WithLabelValues 返回对应标签组合的计数器
+func (c *CounterVec) WithLabelValues(labelValues ...string) *Value {
+	return c.series.get(labelValues)
+}
+
+// GaugeVec 带标签的瞬时值
+type GaugeVec struct{ *valueVec }
+
+// NewGaugeVec 在注册表中创建（或取回）gauge
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := r.register(&valueVec{metricName: name, help: help, kind: "gauge", series: newSeries(labels, func() *Value { return &Value{} })})
+	return &GaugeVec{g.(*valueVec)}
+}
+
+// WithLabelValues 返回对应标签组合的 gauge
+func (g *GaugeVec) WithLabelValues(labelValues ...string) *Value {
+	return g.series.get(labelValues)
+}
+
+// Reset 清空所有标签组合（用于采集前整体刷新）
+func (g *GaugeVec) Reset() {
+	g.series.reset()
+}
+
+// ---------------------------------------------------------------------------
+// Histogram
+// ---------------------------------------------------------------------------
+
+type histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+// Observe 记录一次观测值
+func (h *histogram) Observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// Observer 是 histogram 的观测接口
+type Observer interface {
+	Observe(v float64)
+}
+
+// HistogramVec 带标签的直方图
+type HistogramVec struct {
+	metricName string
+	help       string
+	buckets    []float64
+	series     *series[*histogram]
+}
+
+// NewHistogramVec 在注册表中创建（或取回）直方图；buckets 为空时使用 DefBuckets
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	h := &HistogramVec{metricName: name, help: help, buckets: buckets}
+	h.series = newSeries(labels, func() *histogram {
+		return &histogram{buckets: h.buckets, counts: make([]uint64, len(h.buckets))}
+	})
+	return r.register(h).(*HistogramVec)
+}
+
+func (h *HistogramVec) name() string { return h.metricName }
+
+// WithLabelValues 返回对应标签组合的直方图
+func (h *HistogramVec) WithLabelValues(labelValues ...string) Observer {
+	return h.series.get(labelValues)
+}
+
+func (h *HistogramVec) write(w io.Writer) {
+	writeHeader(w, h.metricName, h.help, "histogram")
+	for _, e := range h.series.snapshot() {
+		e.value.mu.Lock()
+		counts := append([]uint64(nil), e.value.counts...)
+		sum, count := e.value.sum, e.value.count
+		e.value.mu.Unlock()
+
+		for i, upper := range h.buckets {
+			_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, formatLabels(h.series.labels, e.labelValues, "le", formatFloat(upper)), counts[i])
+		}
+		_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, formatLabels(h.series.labels, e.labelValues, "le", "+Inf"), count)
+		_, _ = fmt.Fprintf(w, "%s_sum%s %s\n", h.metricName, formatLabels(h.series.labels, e.labelValues, "", ""), formatFloat(sum))
+		_, _ = fmt.Fprintf(w, "%s_count%s %d\n", h.metricName, formatLabels(h.series.labels, e.labelValues, "", ""), count)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// 文本格式
+// ---------------------------------------------------------------------------
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func writeHeader(w io.Writer, name, help, kind string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, helpEscaper.Replace(help), name, kind)
+}
+
+func formatLabels(names, values []string, extraName, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, n := range names {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(n)
+		sb.WriteString(`="`)
+		sb.WriteString(labelEscaper.Replace(values[i]))
+		sb.WriteByte('"')
+	}
+	if extraName != "" {
+		if len(names) > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(extraName)
+		sb.WriteString(`="`)
+		sb.WriteString(extraValue)
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
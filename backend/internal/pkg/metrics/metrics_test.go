@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryWriteText(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounterVec("test_requests_total", "Total requests.", "platform", "status")
+	requests.WithLabelValues("openai", "200").Inc()
+	requests.WithLabelValues("openai", "200").Add(2)
+	requests.WithLabelValues(`an"thropic`, "500").Inc()
+
+	// 重复注册返回同一实例
+	require.Same(t, requests.valueVec, r.NewCounterVec("test_requests_total", "ignored", "platform", "status").valueVec)
+
+	latency := r.NewHistogramVec("test_latency_seconds", "Latency.", []float64{1, 0.1}, "platform")
+	latency.WithLabelValues("openai").Observe(0.05)
+	latency.WithLabelValues("openai").Observe(0.5)
+	latency.WithLabelValues("openai").Observe(3)
+
+	inflight := r.NewGaugeVec("test_inflight", "In flight.", "account_id")
+	r.OnCollect(func() {
+		inflight.Reset()
+		inflight.WithLabelValues("7").Set(4)
+	})
+	inflight.WithLabelValues("stale").Set(1)
+
+	var sb strings.Builder
+	r.WriteText(&sb)
+	require.Equal(t, `# HELP test_inflight In flight.
+# TYPE test_inflight gauge
+test_inflight{account_id="7"} 4
+# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{platform="openai",le="0.1"} 1
+test_latency_seconds_bucket{platform="openai",le="1"} 2
+test_latency_seconds_bucket{platform="openai",le="+Inf"} 3
+test_latency_seconds_sum{platform="openai"} 3.55
+test_latency_seconds_count{platform="openai"} 3
+# HELP test_requests_total Total requests.
+# TYPE test_requests_total counter
+test_requests_total{platform="an\"thropic",status="500"} 1
+test_requests_total{platform="openai",status="200"} 3
+`, sb.String())
+}
+
+func TestCounterVec_LabelCountMismatchPanics(t *testing.T) {
+	c := NewRegistry().NewCounterVec("x_total", "x", "a", "b")
+	require.Panics(t, func() { c.WithLabelValues("only-one") })
+}
@@ -78,6 +78,17 @@ type ModelStat struct {
 	ActualCost   float64 `json:"actual_cost"` // 实际扣除
 }
 
+// GroupCacheStat represents prompt cache usage statistics for a single group
+type GroupCacheStat struct {
+	GroupID             int64   `json:"group_id"`
+	GroupName           string  `json:"group_name"`
+	Requests            int64   `json:"requests"`
+	InputTokens         int64   `json:"input_tokens"`
+	CacheCreationTokens int64   `json:"cache_creation_tokens"`
+	CacheReadTokens     int64   `json:"cache_read_tokens"`
+	CacheHitRate        float64 `json:"cache_hit_rate"` // cache_read / (input + cache_creation + cache_read)
+}
+
 // UserUsageTrendPoint represents user usage trend data point
 type UserUsageTrendPoint struct {
 	Date       string  `json:"date"`
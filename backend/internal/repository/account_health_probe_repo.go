@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/accounthealthprobe"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type accountHealthProbeRepository struct {
+	client *dbent.Client
+}
+
+func NewAccountHealthProbeRepository(client *dbent.Client) service.AccountHealthProbeRepository {
+	return &accountHealthProbeRepository{client: client}
+}
+
+func (r *accountHealthProbeRepository) Create(ctx context.Context, probe *service.AccountHealthProbe) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.AccountHealthProbe.Create().
+		SetAccountID(probe.AccountID).
+		SetPlatform(probe.Platform).
+		SetModel(probe.Model).
+		SetResult(probe.Result).
+		SetStatusCode(probe.StatusCode).
+		SetLatencyMs(probe.LatencyMs).
+		SetNillableErrorMessage(probe.ErrorMessage).
+		SetAction(probe.Action)
+	if !probe.CreatedAt.IsZero() {
+		builder.SetCreatedAt(probe.CreatedAt)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	probe.ID = created.ID
+	probe.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *accountHealthProbeRepository) ListByAccount(ctx context.Context, accountID int64, page, pageSize int) ([]*service.AccountHealthProbe, int64, error) {
+	q := r.client.AccountHealthProbe.Query().
+		Where(accounthealthprobe.AccountIDEQ(accountID))
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	items, err := q.
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Order(dbent.Desc(accounthealthprobe.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return accountHealthProbesToService(items), int64(total), nil
+}
+
+func (r *accountHealthProbeRepository) ListRecent(ctx context.Context, accountID int64, limit int) ([]*service.AccountHealthProbe, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	items, err := r.client.AccountHealthProbe.Query().
+		Where(accounthealthprobe.AccountIDEQ(accountID)).
+		Order(dbent.Desc(accounthealthprobe.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return accountHealthProbesToService(items), nil
+}
+
+func (r *accountHealthProbeRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	n, err := r.client.AccountHealthProbe.Delete().
+		Where(accounthealthprobe.CreatedAtLT(cutoff)).
+		Exec(ctx)
+	return int64(n), err
+}
+
+func accountHealthProbesToService(items []*dbent.AccountHealthProbe) []*service.AccountHealthProbe {
+	out := make([]*service.AccountHealthProbe, 0, len(items))
+	for _, m := range items {
+		out = append(out, &service.AccountHealthProbe{
+			ID:           m.ID,
+			AccountID:    m.AccountID,
+			Platform:     m.Platform,
+			Model:        m.Model,
+			Result:       m.Result,
+			StatusCode:   m.StatusCode,
+			LatencyMs:    m.LatencyMs,
+			ErrorMessage: m.ErrorMessage,
+			Action:       m.Action,
+			CreatedAt:    m.CreatedAt,
+		})
+	}
+	return out
+}
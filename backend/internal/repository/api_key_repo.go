@@ -36,7 +36,10 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 		SetNillableGroupID(key.GroupID).
 		SetQuota(key.Quota).
 		SetQuotaUsed(key.QuotaUsed).
-		SetNillableExpiresAt(key.ExpiresAt)
+		SetNillableExpiresAt(key.ExpiresAt).
+		SetRateLimitRpm(key.RateLimitRPM).
+		SetRateLimitTpm(key.RateLimitTPM).
+		SetDailyRequestLimit(key.DailyRequestLimit)
 
 	if len(key.IPWhitelist) > 0 {
 		builder.SetIPWhitelist(key.IPWhitelist)
@@ -44,6 +47,9 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 	if len(key.IPBlacklist) > 0 {
 		builder.SetIPBlacklist(key.IPBlacklist)
 	}
+	if len(key.AllowedModels) > 0 {
+		builder.SetAllowedModels(key.AllowedModels)
+	}
 
 	created, err := builder.Save(ctx)
 	if err == nil {
@@ -116,6 +122,10 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 			apikey.FieldQuota,
 			apikey.FieldQuotaUsed,
 			apikey.FieldExpiresAt,
+			apikey.FieldAllowedModels,
+			apikey.FieldRateLimitRpm,
+			apikey.FieldRateLimitTpm,
+			apikey.FieldDailyRequestLimit,
 		).
 		WithUser(func(q *dbent.UserQuery) {
 			q.Select(
@@ -147,6 +157,13 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				group.FieldModelRouting,
 				group.FieldMcpXMLInject,
 				group.FieldSupportedModelScopes,
+				group.FieldResponseCacheEnabled,
+				group.FieldResponseCacheTTLSeconds,
+				group.FieldBatchDiscountMultiplier,
+				group.FieldHedgeEnabled,
+				group.FieldHedgeDelayMs,
+				group.FieldPromptCacheRoutingEnabled,
+				group.FieldAutoCacheControlEnabled,
 			)
 		}).
 		Only(ctx)
@@ -172,6 +189,9 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		SetStatus(key.Status).
 		SetQuota(key.Quota).
 		SetQuotaUsed(key.QuotaUsed).
+		SetRateLimitRpm(key.RateLimitRPM).
+		SetRateLimitTpm(key.RateLimitTPM).
+		SetDailyRequestLimit(key.DailyRequestLimit).
 		SetUpdatedAt(now)
 	if key.GroupID != nil {
 		builder.SetGroupID(*key.GroupID)
@@ -198,6 +218,13 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		builder.ClearIPBlacklist()
 	}
 
+	// 模型白名单
+	if len(key.AllowedModels) > 0 {
+		builder.SetAllowedModels(key.AllowedModels)
+	} else {
+		builder.ClearAllowedModels()
+	}
+
 	affected, err := builder.Save(ctx)
 	if err != nil {
 		return err
@@ -425,6 +452,11 @@ func apiKeyEntityToService(m *dbent.APIKey) *service.APIKey {
 		Quota:       m.Quota,
 		QuotaUsed:   m.QuotaUsed,
 		ExpiresAt:   m.ExpiresAt,
+
+		AllowedModels:     m.AllowedModels,
+		RateLimitRPM:      m.RateLimitRpm,
+		RateLimitTPM:      m.RateLimitTpm,
+		DailyRequestLimit: m.DailyRequestLimit,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
@@ -486,6 +518,13 @@ func groupEntityToService(g *dbent.Group) *service.Group {
 		MCPXMLInject:                    g.McpXMLInject,
 		SupportedModelScopes:            g.SupportedModelScopes,
 		SortOrder:                       g.SortOrder,
+		ResponseCacheEnabled:            g.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds:         g.ResponseCacheTTLSeconds,
+		BatchDiscountMultiplier:         g.BatchDiscountMultiplier,
+		HedgeEnabled:                    g.HedgeEnabled,
+		HedgeDelayMs:                    g.HedgeDelayMs,
+		PromptCacheRoutingEnabled:       g.PromptCacheRoutingEnabled,
+		AutoCacheControlEnabled:         g.AutoCacheControlEnabled,
 		CreatedAt:                       g.CreatedAt,
 		UpdatedAt:                       g.UpdatedAt,
 	}
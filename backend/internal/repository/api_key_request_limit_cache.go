@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// API Key 请求限制缓存
+//
+// RPM 与 TPM 使用 Redis 有序集合实现滑动窗口：
+//   - RPM：成员为请求 ID，分数为请求时间（毫秒）
+//   - TPM：成员为 "{id}:{tokens}"，分数为记录时间（毫秒），窗口内 token 数为成员后缀之和
+//
+// 每日请求数使用按日期分键的计数器，在当天结束后过期。
+const (
+	// 格式: apikey:limit:rpm:{apiKeyID}
+	apiKeyRPMKeyPrefix = "apikey:limit:rpm:"
+	// 格式: apikey:limit:tpm:{apiKeyID}
+	apiKeyTPMKeyPrefix = "apikey:limit:tpm:"
+	// 格式: apikey:limit:daily:{apiKeyID}:{yyyymmdd}
+	apiKeyDailyKeyPrefix = "apikey:limit:daily:"
+)
+
+var (
+	// acquireRequestScript 依次检查 TPM、RPM、每日请求数，全部未超限时记录本次请求
+	// 使用 Redis TIME 命令获取服务器时间，避免多实例时钟不同步问题
+	// KEYS[1] = RPM 有序集合键
+	// KEYS[2] = TPM 有序集合键
+	// KEYS[3] = 每日计数键
+	// ARGV[1] = rpm limit（0 = 不限制）
+	// ARGV[2] = tpm limit（0 = 不限制）
+	// ARGV[3] = daily limit（0 = 不限制）
+	// ARGV[4] = 窗口长度（毫秒）
+	// ARGV[5] = 每日计数 TTL（秒）
+	// ARGV[6] = 请求 ID
+	// 返回 {limit, retryAfterMs}，limit 为 0 表示放行，1=rpm 2=tpm 3=daily
+	acquireRequestScript = redis.NewScript(`
+		local rpmLimit = tonumber(ARGV[1])
+		local tpmLimit = tonumber(ARGV[2])
+		local dailyLimit = tonumber(ARGV[3])
+		local window = tonumber(ARGV[4])
+		local dailyTTL = tonumber(ARGV[5])
+
+		local t = redis.call('TIME')
+		local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+		local expireBefore = now - window
+
+		if tpmLimit > 0 then
+			redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', expireBefore)
+			local members = redis.call('ZRANGE', KEYS[2], 0, -1, 'WITHSCORES')
+			local used = 0
+			for i = 1, #members, 2 do
+				local tokens = tonumber(string.match(members[i], ':(%d+)$'))
+				if tokens then
+					used = used + tokens
+				end
+			end
+			if used >= tpmLimit then
+				return {2, tonumber(members[2]) + window - now}
+			end
+		end
+
+		if rpmLimit > 0 then
+			redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', expireBefore)
+			if redis.call('ZCARD', KEYS[1]) >= rpmLimit then
+				local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+				return {1, tonumber(oldest[2]) + window - now}
+			end
+		end
+
+		if dailyLimit > 0 then
+			local current = tonumber(redis.call('GET', KEYS[3]) or '0')
+			if current >= dailyLimit then
+				return {3, redis.call('PTTL', KEYS[3])}
+			end
+			redis.call('INCR', KEYS[3])
+			redis.call('EXPIRE', KEYS[3], dailyTTL)
+		end
+
+		if rpmLimit > 0 then
+			redis.call('ZADD', KEYS[1], now, ARGV[6])
+			redis.call('PEXPIRE', KEYS[1], window)
+		end
+
+		return {0, 0}
+	`)
+
+	// addTokensScript 将 token 数计入 TPM 滑动窗口
+	// KEYS[1] = TPM 有序集合键
+	// ARGV[1] = 成员（{id}:{tokens}）
+	// ARGV[2] = 窗口长度（毫秒）
+	addTokensScript = redis.NewScript(`
+		local t = redis.call('TIME')
+		local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+		local window = tonumber(ARGV[2])
+		redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+		redis.call('ZADD', KEYS[1], now, ARGV[1])
+		redis.call('PEXPIRE', KEYS[1], window)
+		return 1
+	`)
+)
+
+var apiKeyLimitCodes = map[int64]string{
+	1: service.APIKeyLimitRPM,
+	2: service.APIKeyLimitTPM,
+	3: service.APIKeyLimitDaily,
+}
+
+func apiKeyRPMKey(apiKeyID int64) string {
+	return fmt.Sprintf("%s%d", apiKeyRPMKeyPrefix, apiKeyID)
+}
+
+func apiKeyTPMKey(apiKeyID int64) string {
+	return fmt.Sprintf("%s%d", apiKeyTPMKeyPrefix, apiKeyID)
+}
+
+func apiKeyDailyKey(apiKeyID int64, day string) string {
+	return fmt.Sprintf("%s%d:%s", apiKeyDailyKeyPrefix, apiKeyID, day)
+}
+
+type apiKeyRequestLimitCache struct {
+	rdb *redis.Client
+}
+
+func NewAPIKeyRequestLimitCache(rdb *redis.Client) service.APIKeyRequestLimitCache {
+	return &apiKeyRequestLimitCache{rdb: rdb}
+}
+
+func (c *apiKeyRequestLimitCache) AcquireRequest(ctx context.Context, apiKeyID int64, check service.APIKeyRequestLimitCheck) (string, time.Duration, error) {
+	keys := []string{apiKeyRPMKey(apiKeyID), apiKeyTPMKey(apiKeyID), apiKeyDailyKey(apiKeyID, check.Day)}
+	dailyTTL := int64(check.DayTTL / time.Second)
+	if dailyTTL <= 0 {
+		dailyTTL = 1
+	}
+	res, err := acquireRequestScript.Run(ctx, c.rdb, keys,
+		check.RPM, check.TPM, check.Daily, check.Window.Milliseconds(), dailyTTL, uuid.NewString(),
+	).Int64Slice()
+	if err != nil {
+		return "", 0, err
+	}
+	if len(res) != 2 || res[0] == 0 {
+		return "", 0, nil
+	}
+	retryAfter := time.Duration(res[1]) * time.Millisecond
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	return apiKeyLimitCodes[res[0]], retryAfter, nil
+}
+
+func (c *apiKeyRequestLimitCache) AddTokens(ctx context.Context, apiKeyID int64, tokens int, window time.Duration) error {
+	member := uuid.NewString() + ":" + strconv.Itoa(tokens)
+	return addTokensScript.Run(ctx, c.rdb, []string{apiKeyTPMKey(apiKeyID)}, member, window.Milliseconds()).Err()
+}
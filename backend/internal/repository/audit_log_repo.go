@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/auditlog"
+	"github.com/Wei-Shaw/sub2api/ent/user"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type auditLogRepository struct {
+	client *dbent.Client
+}
+
+func NewAuditLogRepository(client *dbent.Client) service.AuditLogRepository {
+	return &auditLogRepository{client: client}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *service.AuditLog) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.AuditLog.Create().
+		SetAuthMethod(entry.AuthMethod).
+		SetIPAddress(entry.IPAddress).
+		SetUserAgent(entry.UserAgent).
+		SetMethod(entry.Method).
+		SetRoute(entry.Route).
+		SetPath(entry.Path).
+		SetStatusCode(entry.StatusCode).
+		SetTargetType(entry.TargetType).
+		SetTargetID(entry.TargetID).
+		SetNillableActorUserID(entry.ActorUserID).
+		SetNillableRequestBody(entry.RequestBody)
+
+	if len(entry.Changes) > 0 {
+		builder.SetChanges(auditChangesToEntity(entry.Changes))
+	}
+	if !entry.CreatedAt.IsZero() {
+		builder.SetCreatedAt(entry.CreatedAt)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	entry.ID = created.ID
+	entry.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *auditLogRepository) List(ctx context.Context, filters service.AuditLogFilters, page, pageSize int) ([]*service.AuditLog, int64, error) {
+	q := r.client.AuditLog.Query()
+
+	if filters.ActorUserID > 0 {
+		q = q.Where(auditlog.ActorUserIDEQ(filters.ActorUserID))
+	}
+	if filters.TargetType != "" {
+		q = q.Where(auditlog.TargetTypeEQ(filters.TargetType))
+	}
+	if filters.TargetID != "" {
+		q = q.Where(auditlog.TargetIDEQ(filters.TargetID))
+	}
+	if filters.Method != "" {
+		q = q.Where(auditlog.MethodEQ(filters.Method))
+	}
+	if filters.Route != "" {
+		q = q.Where(auditlog.RouteContains(filters.Route))
+	}
+	if !filters.StartTime.IsZero() {
+		q = q.Where(auditlog.CreatedAtGTE(filters.StartTime))
+	}
+	if !filters.EndTime.IsZero() {
+		q = q.Where(auditlog.CreatedAtLTE(filters.EndTime))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	items, err := q.
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Order(dbent.Desc(auditlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	out := make([]*service.AuditLog, 0, len(items))
+	actorIDs := make([]int64, 0, len(items))
+	seen := make(map[int64]struct{}, len(items))
+	for _, m := range items {
+		out = append(out, auditLogEntityToService(m))
+		if m.ActorUserID != nil {
+			if _, ok := seen[*m.ActorUserID]; !ok {
+				seen[*m.ActorUserID] = struct{}{}
+				actorIDs = append(actorIDs, *m.ActorUserID)
+			}
+		}
+	}
+
+	// 填充操作者邮箱（用户可能已被删除，查不到时留空）
+	if len(actorIDs) > 0 {
+		users, err := r.client.User.Query().
+			Where(user.IDIn(actorIDs...)).
+			Select(user.FieldID, user.FieldEmail).
+			All(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		emails := make(map[int64]string, len(users))
+		for _, u := range users {
+			emails[u.ID] = u.Email
+		}
+		for _, entry := range out {
+			if entry.ActorUserID != nil {
+				entry.ActorEmail = emails[*entry.ActorUserID]
+			}
+		}
+	}
+
+	return out, int64(total), nil
+}
+
+func auditLogEntityToService(m *dbent.AuditLog) *service.AuditLog {
+	if m == nil {
+		return nil
+	}
+	return &service.AuditLog{
+		ID:          m.ID,
+		ActorUserID: m.ActorUserID,
+		AuthMethod:  m.AuthMethod,
+		IPAddress:   m.IPAddress,
+		UserAgent:   m.UserAgent,
+		Method:      m.Method,
+		Route:       m.Route,
+		Path:        m.Path,
+		StatusCode:  m.StatusCode,
+		TargetType:  m.TargetType,
+		TargetID:    m.TargetID,
+		Changes:     auditChangesFromEntity(m.Changes),
+		RequestBody: m.RequestBody,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func auditChangesToEntity(changes map[string]service.AuditFieldChange) map[string]any {
+	out := make(map[string]any, len(changes))
+	for path, change := range changes {
+		out[path] = map[string]any{
+			"before": change.Before,
+			"after":  change.After,
+		}
+	}
+	return out
+}
+
+func auditChangesFromEntity(raw map[string]any) map[string]service.AuditFieldChange {
+	if len(raw) == 0 {
+		return nil
+	}
+	out := make(map[string]service.AuditFieldChange, len(raw))
+	for path, v := range raw {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		out[path] = service.AuditFieldChange{Before: m["before"], After: m["after"]}
+	}
+	return out
+}
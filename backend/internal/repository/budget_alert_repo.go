@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/budgetalert"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type budgetAlertRepository struct {
+	client *dbent.Client
+}
+
+func NewBudgetAlertRepository(client *dbent.Client) service.BudgetAlertRepository {
+	return &budgetAlertRepository{client: client}
+}
+
+func (r *budgetAlertRepository) Create(ctx context.Context, a *service.BudgetAlert) error {
+	client := clientFromContext(ctx, r.client)
+	created, err := client.BudgetAlert.Create().
+		SetUserID(a.UserID).
+		SetName(a.Name).
+		SetMetric(a.Metric).
+		SetThresholdType(a.ThresholdType).
+		SetThreshold(a.Threshold).
+		SetNillableAPIKeyID(a.APIKeyID).
+		SetNillableSubscriptionID(a.SubscriptionID).
+		SetNotifyEmail(a.NotifyEmail).
+		SetNillableWebhookURL(a.WebhookURL).
+		SetAutoDisableKey(a.AutoDisableKey).
+		SetEnabled(a.Enabled).
+		Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, nil, nil)
+	}
+	a.ID = created.ID
+	a.CreatedAt = created.CreatedAt
+	a.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *budgetAlertRepository) GetByID(ctx context.Context, id int64) (*service.BudgetAlert, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.BudgetAlert.Get(ctx, id)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrBudgetAlertNotFound, nil)
+	}
+	return budgetAlertEntityToService(m), nil
+}
+
+func (r *budgetAlertRepository) Update(ctx context.Context, a *service.BudgetAlert) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.BudgetAlert.UpdateOneID(a.ID).
+		SetName(a.Name).
+		SetMetric(a.Metric).
+		SetThresholdType(a.ThresholdType).
+		SetThreshold(a.Threshold).
+		SetNotifyEmail(a.NotifyEmail).
+		SetAutoDisableKey(a.AutoDisableKey).
+		SetEnabled(a.Enabled).
+		ClearLastTriggeredWindow().
+		ClearLastTriggeredAt()
+
+	if a.APIKeyID != nil {
+		builder.SetAPIKeyID(*a.APIKeyID)
+	} else {
+		builder.ClearAPIKeyID()
+	}
+	if a.SubscriptionID != nil {
+		builder.SetSubscriptionID(*a.SubscriptionID)
+	} else {
+		builder.ClearSubscriptionID()
+	}
+	if a.WebhookURL != nil {
+		builder.SetWebhookURL(*a.WebhookURL)
+	} else {
+		builder.ClearWebhookURL()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrBudgetAlertNotFound, nil)
+	}
+	a.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *budgetAlertRepository) Delete(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	if err := client.BudgetAlert.DeleteOneID(id).Exec(ctx); err != nil {
+		return translatePersistenceError(err, service.ErrBudgetAlertNotFound, nil)
+	}
+	return nil
+}
+
+func (r *budgetAlertRepository) ListByUserID(ctx context.Context, userID int64) ([]service.BudgetAlert, error) {
+	client := clientFromContext(ctx, r.client)
+	items, err := client.BudgetAlert.Query().
+		Where(budgetalert.UserIDEQ(userID)).
+		Order(dbent.Asc(budgetalert.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return budgetAlertEntitiesToService(items), nil
+}
+
+func (r *budgetAlertRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	client := clientFromContext(ctx, r.client)
+	return client.BudgetAlert.Query().
+		Where(budgetalert.UserIDEQ(userID)).
+		Count(ctx)
+}
+
+func (r *budgetAlertRepository) ListEnabled(ctx context.Context) ([]service.BudgetAlert, error) {
+	client := clientFromContext(ctx, r.client)
+	items, err := client.BudgetAlert.Query().
+		Where(budgetalert.EnabledEQ(true)).
+		Order(dbent.Asc(budgetalert.FieldUserID), dbent.Asc(budgetalert.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return budgetAlertEntitiesToService(items), nil
+}
+
+func (r *budgetAlertRepository) ClaimWindow(ctx context.Context, id int64, window string, triggeredAt time.Time) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.BudgetAlert.Update().
+		Where(
+			budgetalert.IDEQ(id),
+			budgetalert.Or(
+				budgetalert.LastTriggeredWindowIsNil(),
+				budgetalert.LastTriggeredWindowNEQ(window),
+			),
+		).
+		SetLastTriggeredWindow(window).
+		SetLastTriggeredAt(triggeredAt).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *budgetAlertRepository) ClearWindow(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.BudgetAlert.Update().
+		Where(budgetalert.IDEQ(id)).
+		ClearLastTriggeredWindow().
+		Save(ctx)
+	return err
+}
+
+func budgetAlertEntitiesToService(items []*dbent.BudgetAlert) []service.BudgetAlert {
+	out := make([]service.BudgetAlert, 0, len(items))
+	for _, m := range items {
+		out = append(out, *budgetAlertEntityToService(m))
+	}
+	return out
+}
+
+func budgetAlertEntityToService(m *dbent.BudgetAlert) *service.BudgetAlert {
+	if m == nil {
+		return nil
+	}
+	return &service.BudgetAlert{
+		ID:                  m.ID,
+		UserID:              m.UserID,
+		Name:                m.Name,
+		Metric:              m.Metric,
+		ThresholdType:       m.ThresholdType,
+		Threshold:           m.Threshold,
+		APIKeyID:            m.APIKeyID,
+		SubscriptionID:      m.SubscriptionID,
+		NotifyEmail:         m.NotifyEmail,
+		WebhookURL:          m.WebhookURL,
+		AutoDisableKey:      m.AutoDisableKey,
+		Enabled:             m.Enabled,
+		LastTriggeredWindow: m.LastTriggeredWindow,
+		LastTriggeredAt:     m.LastTriggeredAt,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+func newBudgetAlertRepoSQLite(t *testing.T) service.BudgetAlertRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(drv)))
+	t.Cleanup(func() { _ = client.Close() })
+	return NewBudgetAlertRepository(client)
+}
+
+func TestBudgetAlertRepository_ClaimWindow(t *testing.T) {
+	ctx := context.Background()
+	repo := newBudgetAlertRepoSQLite(t)
+
+	alert := &service.BudgetAlert{
+		UserID:        1,
+		Metric:        service.BudgetAlertMetricSubscriptionDaily,
+		ThresholdType: service.BudgetAlertThresholdPercentUsed,
+		Threshold:     80,
+		NotifyEmail:   true,
+		Enabled:       true,
+	}
+	require.NoError(t, repo.Create(ctx, alert))
+
+	now := time.Now()
+	claimed, err := repo.ClaimWindow(ctx, alert.ID, "2026-01-01T00:00:00Z", now)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// 同一窗口只能抢占一次
+	claimed, err = repo.ClaimWindow(ctx, alert.ID, "2026-01-01T00:00:00Z", now)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	// 新窗口可再次抢占
+	claimed, err = repo.ClaimWindow(ctx, alert.ID, "2026-01-02T00:00:00Z", now)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, repo.ClearWindow(ctx, alert.ID))
+	got, err := repo.GetByID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Nil(t, got.LastTriggeredWindow)
+	require.NotNil(t, got.LastTriggeredAt)
+
+	// 更新配置会重置去重状态
+	_, err = repo.ClaimWindow(ctx, alert.ID, "2026-01-03T00:00:00Z", now)
+	require.NoError(t, err)
+	got.Threshold = 90
+	require.NoError(t, repo.Update(ctx, got))
+	got, err = repo.GetByID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Nil(t, got.LastTriggeredWindow)
+	require.Equal(t, float64(90), got.Threshold)
+
+	enabled, err := repo.ListEnabled(ctx)
+	require.NoError(t, err)
+	require.Len(t, enabled, 1)
+
+	_, err = repo.GetByID(ctx, alert.ID+100)
+	require.ErrorIs(t, err, service.ErrBudgetAlertNotFound)
+}
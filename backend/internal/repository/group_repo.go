@@ -52,7 +52,14 @@ func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) er
 		SetNillableFallbackGroupID(groupIn.FallbackGroupID).
 		SetNillableFallbackGroupIDOnInvalidRequest(groupIn.FallbackGroupIDOnInvalidRequest).
 		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
-		SetMcpXMLInject(groupIn.MCPXMLInject)
+		SetMcpXMLInject(groupIn.MCPXMLInject).
+		SetResponseCacheEnabled(groupIn.ResponseCacheEnabled).
+		SetResponseCacheTTLSeconds(groupIn.ResponseCacheTTLSeconds).
+		SetBatchDiscountMultiplier(groupIn.BatchDiscountMultiplier).
+		SetHedgeEnabled(groupIn.HedgeEnabled).
+		SetHedgeDelayMs(groupIn.HedgeDelayMs).
+		SetPromptCacheRoutingEnabled(groupIn.PromptCacheRoutingEnabled).
+		SetAutoCacheControlEnabled(groupIn.AutoCacheControlEnabled)
 
 	// 设置模型路由配置
 	if groupIn.ModelRouting != nil {
@@ -113,7 +120,14 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		SetDefaultValidityDays(groupIn.DefaultValidityDays).
 		SetClaudeCodeOnly(groupIn.ClaudeCodeOnly).
 		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
-		SetMcpXMLInject(groupIn.MCPXMLInject)
+		SetMcpXMLInject(groupIn.MCPXMLInject).
+		SetResponseCacheEnabled(groupIn.ResponseCacheEnabled).
+		SetResponseCacheTTLSeconds(groupIn.ResponseCacheTTLSeconds).
+		SetBatchDiscountMultiplier(groupIn.BatchDiscountMultiplier).
+		SetHedgeEnabled(groupIn.HedgeEnabled).
+		SetHedgeDelayMs(groupIn.HedgeDelayMs).
+		SetPromptCacheRoutingEnabled(groupIn.PromptCacheRoutingEnabled).
+		SetAutoCacheControlEnabled(groupIn.AutoCacheControlEnabled)
 
 	// 处理 FallbackGroupID：nil 时清除，否则设置
 	if groupIn.FallbackGroupID != nil {
@@ -16,8 +16,12 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/proxyutil"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/tlsfingerprint"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // 默认配置常量
@@ -134,19 +138,23 @@ func (s *httpUpstreamService) Do(req *http.Request, proxyURL string, accountID i
 	}
 
 	// 执行请求
+	span := startUpstreamAttemptSpan(req, accountID, false)
 	resp, err := entry.client.Do(req)
 	if err != nil {
 		// 请求失败，立即减少计数
 		atomic.AddInt64(&entry.inFlight, -1)
 		atomic.StoreInt64(&entry.lastUsed, time.Now().UnixNano())
+		tracing.End(span, err)
 		return nil, err
 	}
+	recordUpstreamAttemptResponse(span, resp)
 
 	// 包装响应体，在关闭时自动减少计数并更新时间戳
 	// 这确保了流式响应（如 SSE）在完全读取前不会被淘汰
 	resp.Body = wrapTrackedBody(resp.Body, func() {
 		atomic.AddInt64(&entry.inFlight, -1)
 		atomic.StoreInt64(&entry.lastUsed, time.Now().UnixNano())
+		span.End()
 	})
 
 	return resp, nil
@@ -206,14 +214,17 @@ func (s *httpUpstreamService) DoWithTLS(req *http.Request, proxyURL string, acco
 	}
 
 	// 执行请求
+	span := startUpstreamAttemptSpan(req, accountID, true)
 	resp, err := entry.client.Do(req)
 	if err != nil {
 		// 请求失败，立即减少计数
 		atomic.AddInt64(&entry.inFlight, -1)
 		atomic.StoreInt64(&entry.lastUsed, time.Now().UnixNano())
+		tracing.End(span, err)
 		slog.Debug("tls_fingerprint_request_failed", "account_id", accountID, "error", err)
 		return nil, err
 	}
+	recordUpstreamAttemptResponse(span, resp)
 
 	slog.Debug("tls_fingerprint_request_success", "account_id", accountID, "status", resp.StatusCode)
 
@@ -221,6 +232,7 @@ func (s *httpUpstreamService) DoWithTLS(req *http.Request, proxyURL string, acco
 	resp.Body = wrapTrackedBody(resp.Body, func() {
 		atomic.AddInt64(&entry.inFlight, -1)
 		atomic.StoreInt64(&entry.lastUsed, time.Now().UnixNano())
+		span.End()
 	})
 
 	return resp, nil
@@ -862,6 +874,37 @@ func (b *trackedBody) Close() error {
 	return err
 }
 
+// startUpstreamAttemptSpan 为单次上游请求创建 client span，span 在响应体关闭时结束（覆盖流式读取）
+// 注意：不向上游注入 traceparent，避免泄露内部链路信息
+func startUpstreamAttemptSpan(req *http.Request, accountID int64, tlsFingerprint bool) trace.Span {
+	attrs := []attribute.KeyValue{
+		attribute.Int64("account_id", accountID),
+		attribute.String("http.request.method", req.Method),
+		attribute.Bool("tls_fingerprint", tlsFingerprint),
+	}
+	if req.URL != nil {
+		attrs = append(attrs, attribute.String("server.address", req.URL.Host))
+	}
+	_, span := tracing.Tracer().Start(req.Context(), "upstream.attempt",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return span
+}
+
+func recordUpstreamAttemptResponse(span trace.Span, resp *http.Response) {
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	for _, key := range []string{"x-request-id", "request-id"} {
+		if v := resp.Header.Get(key); v != "" {
+			span.SetAttributes(attribute.String("upstream.request_id", v))
+			break
+		}
+	}
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("upstream HTTP %d", resp.StatusCode))
+	}
+}
+
 // wrapTrackedBody 包装响应体以跟踪关闭事件
 // 用于在响应体关闭时更新 inFlight 计数
 //
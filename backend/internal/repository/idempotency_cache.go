@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// Idempotency-Key 记录缓存
+//
+// 每个幂等键对应一个 Hash（执行状态、请求指纹、响应状态码与 Content-Type）
+// 和一个 List（按写出顺序保存的响应体分片，供重复请求回放或接入执行中的流）。
+const (
+	// 格式: idempotency:{apiKeyID}:{keyHash}
+	idempotencyKeyPrefix = "idempotency:"
+	// 格式: idempotency:{apiKeyID}:{keyHash}:body
+	idempotencyBodySuffix = ":body"
+)
+
+var (
+	// acquireIdempotencyScript 记录不存在时创建执行中记录
+	// KEYS[1] = 记录 Hash 键
+	// KEYS[2] = 响应体 List 键
+	// ARGV[1] = 请求指纹
+	// ARGV[2] = 占位 TTL（毫秒）
+	// 返回 1 表示获得执行权
+	acquireIdempotencyScript = redis.NewScript(`
+		if redis.call('EXISTS', KEYS[1]) == 1 then
+			return 0
+		end
+		redis.call('DEL', KEYS[2])
+		redis.call('HSET', KEYS[1], 'state', 'in_progress', 'fingerprint', ARGV[1])
+		redis.call('PEXPIRE', KEYS[1], ARGV[2])
+		return 1
+	`)
+)
+
+func idempotencyRecordRedisKey(key string) string {
+	return idempotencyKeyPrefix + key
+}
+
+func idempotencyBodyRedisKey(key string) string {
+	return idempotencyKeyPrefix + key + idempotencyBodySuffix
+}
+
+type idempotencyCache struct {
+	rdb *redis.Client
+}
+
+func NewIdempotencyCache(rdb *redis.Client) service.IdempotencyCache {
+	return &idempotencyCache{rdb: rdb}
+}
+
+func (c *idempotencyCache) Acquire(ctx context.Context, key, fingerprint string, ttl time.Duration) (bool, error) {
+	res, err := acquireIdempotencyScript.Run(ctx, c.rdb,
+		[]string{idempotencyRecordRedisKey(key), idempotencyBodyRedisKey(key)},
+		fingerprint, ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+func (c *idempotencyCache) Get(ctx context.Context, key string) (*service.IdempotencyRecord, error) {
+	fields, err := c.rdb.HGetAll(ctx, idempotencyRecordRedisKey(key)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	statusCode, _ := strconv.Atoi(fields["status_code"])
+	return &service.IdempotencyRecord{
+		State:       fields["state"],
+		Fingerprint: fields["fingerprint"],
+		StatusCode:  statusCode,
+		ContentType: fields["content_type"],
+		Truncated:   fields["truncated"] == "1",
+	}, nil
+}
+
+func (c *idempotencyCache) SetResponse(ctx context.Context, key string, statusCode int, contentType string) error {
+	return c.rdb.HSet(ctx, idempotencyRecordRedisKey(key), "status_code", statusCode, "content_type", contentType).Err()
+}
+
+func (c *idempotencyCache) AppendChunk(ctx context.Context, key string, chunk []byte, ttl time.Duration) error {
+	bodyKey := idempotencyBodyRedisKey(key)
+	pipe := c.rdb.Pipeline()
+	pipe.RPush(ctx, bodyKey, chunk)
+	pipe.PExpire(ctx, bodyKey, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *idempotencyCache) GetChunks(ctx context.Context, key string, start, count int64) ([][]byte, error) {
+	values, err := c.rdb.LRange(ctx, idempotencyBodyRedisKey(key), start, start+count-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	chunks := make([][]byte, len(values))
+	for i, v := range values {
+		chunks[i] = []byte(v)
+	}
+	return chunks, nil
+}
+
+func (c *idempotencyCache) MarkTruncated(ctx context.Context, key string) error {
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, idempotencyRecordRedisKey(key), "truncated", "1")
+	pipe.Del(ctx, idempotencyBodyRedisKey(key))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *idempotencyCache) Complete(ctx context.Context, key string, ttl time.Duration) error {
+	recordKey := idempotencyRecordRedisKey(key)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, recordKey, "state", service.IdempotencyStateCompleted)
+	pipe.PExpire(ctx, recordKey, ttl)
+	pipe.PExpire(ctx, idempotencyBodyRedisKey(key), ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *idempotencyCache) Delete(ctx context.Context, key string) error {
+	return c.rdb.Del(ctx, idempotencyRecordRedisKey(key), idempotencyBodyRedisKey(key)).Err()
+}
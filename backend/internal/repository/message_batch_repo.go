@@ -0,0 +1,409 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatch"
+	"github.com/Wei-Shaw/sub2api/ent/messagebatchitem"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// messageBatchInsertChunk 批量插入请求时每条 INSERT 的行数
+const messageBatchInsertChunk = 500
+
+// messageBatchClaimCandidates 每次抢占时读取的候选请求数（并发 worker 抢占失败时依次尝试下一条）
+const messageBatchClaimCandidates = 8
+
+type messageBatchRepository struct {
+	client *dbent.Client
+}
+
+func NewMessageBatchRepository(client *dbent.Client) service.MessageBatchRepository {
+	return &messageBatchRepository{client: client}
+}
+
+func (r *messageBatchRepository) Create(ctx context.Context, batch *service.MessageBatch, items []service.MessageBatchItem) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return err
+	}
+	var txClient *dbent.Client
+	if err == nil {
+		defer func() { _ = tx.Rollback() }()
+		txClient = tx.Client()
+	} else {
+		// 已处于外部事务中（ErrTxStarted），复用当前 client 并由调用方负责提交/回滚。
+		txClient = r.client
+	}
+
+	created, err := txClient.MessageBatch.Create().
+		SetBatchID(batch.BatchID).
+		SetUserID(batch.UserID).
+		SetAPIKeyID(batch.APIKeyID).
+		SetNillableGroupID(batch.GroupID).
+		SetProcessingStatus(batch.ProcessingStatus).
+		SetRequestCount(batch.RequestCount).
+		SetExpiresAt(batch.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, nil, nil)
+	}
+
+	for start := 0; start < len(items); start += messageBatchInsertChunk {
+		end := min(start+messageBatchInsertChunk, len(items))
+		builders := make([]*dbent.MessageBatchItemCreate, 0, end-start)
+		for _, item := range items[start:end] {
+			builders = append(builders, txClient.MessageBatchItem.Create().
+				SetBatchID(created.ID).
+				SetCustomID(item.CustomID).
+				SetParams(item.Params).
+				SetStatus(item.Status).
+				SetNextAttemptAt(item.NextAttemptAt))
+		}
+		if err := txClient.MessageBatchItem.CreateBulk(builders...).Exec(ctx); err != nil {
+			return translatePersistenceError(err, nil, nil)
+		}
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	batch.ID = created.ID
+	batch.CreatedAt = created.CreatedAt
+	batch.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *messageBatchRepository) GetByID(ctx context.Context, id int64) (*service.MessageBatch, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.MessageBatch.Get(ctx, id)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrMessageBatchNotFound, nil)
+	}
+	return messageBatchEntityToService(m), nil
+}
+
+func (r *messageBatchRepository) GetByBatchID(ctx context.Context, batchID string) (*service.MessageBatch, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.MessageBatch.Query().
+		Where(messagebatch.BatchIDEQ(batchID)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrMessageBatchNotFound, nil)
+	}
+	return messageBatchEntityToService(m), nil
+}
+
+func (r *messageBatchRepository) ListByUserID(ctx context.Context, userID int64, beforeID, afterID int64, limit int) ([]service.MessageBatch, bool, error) {
+	client := clientFromContext(ctx, r.client)
+	q := client.MessageBatch.Query().Where(messagebatch.UserIDEQ(userID))
+	if afterID > 0 {
+		q = q.Where(messagebatch.IDLT(afterID))
+	}
+	// before_id 取游标之前（更新）的一页：按 id 升序取最接近游标的记录后再反转
+	if beforeID > 0 && afterID == 0 {
+		q = q.Where(messagebatch.IDGT(beforeID)).Order(dbent.Asc(messagebatch.FieldID))
+	} else {
+		if beforeID > 0 {
+			q = q.Where(messagebatch.IDGT(beforeID))
+		}
+		q = q.Order(dbent.Desc(messagebatch.FieldID))
+	}
+
+	items, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+	if beforeID > 0 && afterID == 0 {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	out := make([]service.MessageBatch, 0, len(items))
+	for _, m := range items {
+		out = append(out, *messageBatchEntityToService(m))
+	}
+	return out, hasMore, nil
+}
+
+func (r *messageBatchRepository) CountItemsByStatus(ctx context.Context, batchIDs []int64) (map[int64]service.MessageBatchRequestCounts, error) {
+	out := make(map[int64]service.MessageBatchRequestCounts, len(batchIDs))
+	if len(batchIDs) == 0 {
+		return out, nil
+	}
+	client := clientFromContext(ctx, r.client)
+	var rows []struct {
+		BatchID int64  `json:"batch_id"`
+		Status  string `json:"status"`
+		Count   int    `json:"count"`
+	}
+	err := client.MessageBatchItem.Query().
+		Where(messagebatchitem.BatchIDIn(batchIDs...)).
+		GroupBy(messagebatchitem.FieldBatchID, messagebatchitem.FieldStatus).
+		Aggregate(dbent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts := out[row.BatchID]
+		counts.Add(row.Status, row.Count)
+		out[row.BatchID] = counts
+	}
+	return out, nil
+}
+
+func (r *messageBatchRepository) ListItems(ctx context.Context, batchID int64, afterItemID int64, limit int) ([]service.MessageBatchItem, error) {
+	client := clientFromContext(ctx, r.client)
+	items, err := client.MessageBatchItem.Query().
+		Where(
+			messagebatchitem.BatchIDEQ(batchID),
+			messagebatchitem.IDGT(afterItemID),
+		).
+		Order(dbent.Asc(messagebatchitem.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.MessageBatchItem, 0, len(items))
+	for _, m := range items {
+		out = append(out, *messageBatchItemEntityToService(m))
+	}
+	return out, nil
+}
+
+func (r *messageBatchRepository) Cancel(ctx context.Context, id int64, now time.Time) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return err
+	}
+	var txClient *dbent.Client
+	if err == nil {
+		defer func() { _ = tx.Rollback() }()
+		txClient = tx.Client()
+	} else {
+		txClient = r.client
+	}
+
+	if _, err := txClient.MessageBatch.Update().
+		Where(
+			messagebatch.IDEQ(id),
+			messagebatch.ProcessingStatusEQ(service.MessageBatchStatusInProgress),
+		).
+		SetProcessingStatus(service.MessageBatchStatusCanceling).
+		SetCancelInitiatedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+	if _, err := txClient.MessageBatchItem.Update().
+		Where(
+			messagebatchitem.BatchIDEQ(id),
+			messagebatchitem.StatusEQ(service.MessageBatchItemPending),
+		).
+		SetStatus(service.MessageBatchItemCanceled).
+		SetCompletedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	if tx != nil {
+		return tx.Commit()
+	}
+	return nil
+}
+
+func (r *messageBatchRepository) ClaimNext(ctx context.Context, now time.Time) (*service.MessageBatchItem, error) {
+	client := clientFromContext(ctx, r.client)
+	candidates, err := client.MessageBatchItem.Query().
+		Where(
+			messagebatchitem.StatusEQ(service.MessageBatchItemPending),
+			messagebatchitem.NextAttemptAtLTE(now),
+		).
+		Order(dbent.Asc(messagebatchitem.FieldNextAttemptAt), dbent.Asc(messagebatchitem.FieldID)).
+		Limit(messageBatchClaimCandidates).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 条件更新抢占：多个 worker/实例竞争同一条时只有一个能更新成功
+	for _, m := range candidates {
+		n, err := client.MessageBatchItem.Update().
+			Where(
+				messagebatchitem.IDEQ(m.ID),
+				messagebatchitem.StatusEQ(service.MessageBatchItemPending),
+			).
+			SetStatus(service.MessageBatchItemProcessing).
+			SetStartedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		item := messageBatchItemEntityToService(m)
+		item.Status = service.MessageBatchItemProcessing
+		item.StartedAt = &now
+		return item, nil
+	}
+	return nil, nil
+}
+
+func (r *messageBatchRepository) Requeue(ctx context.Context, itemID int64, nextAttemptAt time.Time, countAttempt bool) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.MessageBatchItem.Update().
+		Where(
+			messagebatchitem.IDEQ(itemID),
+			messagebatchitem.StatusEQ(service.MessageBatchItemProcessing),
+		).
+		SetStatus(service.MessageBatchItemPending).
+		SetNextAttemptAt(nextAttemptAt).
+		ClearStartedAt()
+	if countAttempt {
+		builder.AddAttempts(1)
+	}
+	_, err := builder.Save(ctx)
+	return err
+}
+
+func (r *messageBatchRepository) Complete(ctx context.Context, itemID int64, status string, result *string, now time.Time) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.MessageBatchItem.Update().
+		Where(
+			messagebatchitem.IDEQ(itemID),
+			messagebatchitem.StatusEQ(service.MessageBatchItemProcessing),
+		).
+		SetStatus(status).
+		SetNillableResult(result).
+		SetCompletedAt(now).
+		AddAttempts(1).
+		Save(ctx)
+	return err
+}
+
+func (r *messageBatchRepository) ExpireItems(ctx context.Context, now time.Time) (int, error) {
+	client := clientFromContext(ctx, r.client)
+	ids, err := client.MessageBatch.Query().
+		Where(
+			messagebatch.ProcessingStatusNEQ(service.MessageBatchStatusEnded),
+			messagebatch.ExpiresAtLT(now),
+		).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return client.MessageBatchItem.Update().
+		Where(
+			messagebatchitem.BatchIDIn(ids...),
+			messagebatchitem.StatusEQ(service.MessageBatchItemPending),
+		).
+		SetStatus(service.MessageBatchItemExpired).
+		SetCompletedAt(now).
+		Save(ctx)
+}
+
+func (r *messageBatchRepository) RequeueStale(ctx context.Context, before time.Time, now time.Time) (int, error) {
+	client := clientFromContext(ctx, r.client)
+	return client.MessageBatchItem.Update().
+		Where(
+			messagebatchitem.StatusEQ(service.MessageBatchItemProcessing),
+			messagebatchitem.StartedAtLT(before),
+		).
+		SetStatus(service.MessageBatchItemPending).
+		SetNextAttemptAt(now).
+		ClearStartedAt().
+		Save(ctx)
+}
+
+func (r *messageBatchRepository) FinalizeEnded(ctx context.Context, now time.Time) (int, error) {
+	client := clientFromContext(ctx, r.client)
+	active, err := client.MessageBatch.Query().
+		Where(messagebatch.ProcessingStatusNEQ(service.MessageBatchStatusEnded)).
+		IDs(ctx)
+	if err != nil || len(active) == 0 {
+		return 0, err
+	}
+	open, err := client.MessageBatchItem.Query().
+		Where(
+			messagebatchitem.BatchIDIn(active...),
+			messagebatchitem.StatusIn(service.MessageBatchItemPending, service.MessageBatchItemProcessing),
+		).
+		Unique(true).
+		Select(messagebatchitem.FieldBatchID).
+		Ints(ctx)
+	if err != nil {
+		return 0, err
+	}
+	openSet := make(map[int64]struct{}, len(open))
+	for _, id := range open {
+		openSet[int64(id)] = struct{}{}
+	}
+	done := make([]int64, 0, len(active))
+	for _, id := range active {
+		if _, ok := openSet[id]; !ok {
+			done = append(done, id)
+		}
+	}
+	if len(done) == 0 {
+		return 0, nil
+	}
+	return client.MessageBatch.Update().
+		Where(
+			messagebatch.IDIn(done...),
+			messagebatch.ProcessingStatusNEQ(service.MessageBatchStatusEnded),
+		).
+		SetProcessingStatus(service.MessageBatchStatusEnded).
+		SetEndedAt(now).
+		Save(ctx)
+}
+
+func messageBatchEntityToService(m *dbent.MessageBatch) *service.MessageBatch {
+	if m == nil {
+		return nil
+	}
+	return &service.MessageBatch{
+		ID:                m.ID,
+		BatchID:           m.BatchID,
+		UserID:            m.UserID,
+		APIKeyID:          m.APIKeyID,
+		GroupID:           m.GroupID,
+		ProcessingStatus:  m.ProcessingStatus,
+		RequestCount:      m.RequestCount,
+		ExpiresAt:         m.ExpiresAt,
+		CancelInitiatedAt: m.CancelInitiatedAt,
+		EndedAt:           m.EndedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func messageBatchItemEntityToService(m *dbent.MessageBatchItem) *service.MessageBatchItem {
+	if m == nil {
+		return nil
+	}
+	return &service.MessageBatchItem{
+		ID:            m.ID,
+		BatchID:       m.BatchID,
+		CustomID:      m.CustomID,
+		Params:        m.Params,
+		Status:        m.Status,
+		Result:        m.Result,
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		StartedAt:     m.StartedAt,
+		CompletedAt:   m.CompletedAt,
+	}
+}
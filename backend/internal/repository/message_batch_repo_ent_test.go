@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+func newMessageBatchRepoSQLite(t *testing.T) service.MessageBatchRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(drv)))
+	t.Cleanup(func() { _ = client.Close() })
+	return NewMessageBatchRepository(client)
+}
+
+func createTestMessageBatch(t *testing.T, repo service.MessageBatchRepository, batchID string, userID int64, customIDs ...string) *service.MessageBatch {
+	t.Helper()
+	now := time.Now()
+	batch := &service.MessageBatch{
+		BatchID:          batchID,
+		UserID:           userID,
+		APIKeyID:         1,
+		ProcessingStatus: service.MessageBatchStatusInProgress,
+		RequestCount:     len(customIDs),
+		ExpiresAt:        now.Add(24 * time.Hour),
+	}
+	items := make([]service.MessageBatchItem, 0, len(customIDs))
+	for _, id := range customIDs {
+		items = append(items, service.MessageBatchItem{
+			CustomID:      id,
+			Params:        `{"model":"claude-sonnet-4-5","max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`,
+			Status:        service.MessageBatchItemPending,
+			NextAttemptAt: now.Add(-time.Second),
+		})
+	}
+	require.NoError(t, repo.Create(context.Background(), batch, items))
+	return batch
+}
+
+func TestMessageBatchRepository_ClaimAndFinalize(t *testing.T) {
+	ctx := context.Background()
+	repo := newMessageBatchRepoSQLite(t)
+	batch := createTestMessageBatch(t, repo, "msgbatch_a", 1, "first", "second")
+
+	now := time.Now()
+	first, err := repo.ClaimNext(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, "first", first.CustomID)
+	require.Equal(t, service.MessageBatchItemProcessing, first.Status)
+
+	// 延后的请求在到期前不会被再次抢占
+	require.NoError(t, repo.Requeue(ctx, first.ID, now.Add(time.Hour), true))
+	second, err := repo.ClaimNext(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, "second", second.CustomID)
+	none, err := repo.ClaimNext(ctx, now)
+	require.NoError(t, err)
+	require.Nil(t, none)
+
+	result := `{"type":"succeeded","message":{"id":"msg_1"}}`
+	require.NoError(t, repo.Complete(ctx, second.ID, service.MessageBatchItemSucceeded, &result, now))
+
+	counts, err := repo.CountItemsByStatus(ctx, []int64{batch.ID})
+	require.NoError(t, err)
+	require.Equal(t, service.MessageBatchRequestCounts{Processing: 1, Succeeded: 1}, counts[batch.ID])
+
+	n, err := repo.FinalizeEnded(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	again, err := repo.ClaimNext(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, first.ID, again.ID)
+	require.Equal(t, 1, again.Attempts)
+	require.NoError(t, repo.Complete(ctx, again.ID, service.MessageBatchItemErrored, nil, now))
+
+	n, err = repo.FinalizeEnded(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	got, err := repo.GetByBatchID(ctx, "msgbatch_a")
+	require.NoError(t, err)
+	require.Equal(t, service.MessageBatchStatusEnded, got.ProcessingStatus)
+	require.NotNil(t, got.EndedAt)
+
+	items, err := repo.ListItems(ctx, batch.ID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, result, *items[1].Result)
+}
+
+func TestMessageBatchRepository_CancelExpireAndRequeueStale(t *testing.T) {
+	ctx := context.Background()
+	repo := newMessageBatchRepoSQLite(t)
+	now := time.Now()
+
+	canceled := createTestMessageBatch(t, repo, "msgbatch_cancel", 1, "a", "b")
+	inFlight, err := repo.ClaimNext(ctx, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Cancel(ctx, canceled.ID, now))
+
+	counts, err := repo.CountItemsByStatus(ctx, []int64{canceled.ID})
+	require.NoError(t, err)
+	require.Equal(t, service.MessageBatchRequestCounts{Processing: 1, Canceled: 1}, counts[canceled.ID])
+	got, err := repo.GetByID(ctx, canceled.ID)
+	require.NoError(t, err)
+	require.Equal(t, service.MessageBatchStatusCanceling, got.ProcessingStatus)
+
+	// worker 中断后，超时的 processing 请求重新入队
+	n, err := repo.RequeueStale(ctx, now.Add(time.Minute), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	reclaimed, err := repo.ClaimNext(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, inFlight.ID, reclaimed.ID)
+
+	expiring := createTestMessageBatch(t, repo, "msgbatch_expire", 1, "c")
+	n, err = repo.ExpireItems(ctx, now.Add(25*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	counts, err = repo.CountItemsByStatus(ctx, []int64{expiring.ID})
+	require.NoError(t, err)
+	require.Equal(t, service.MessageBatchRequestCounts{Expired: 1}, counts[expiring.ID])
+}
+
+func TestMessageBatchRepository_ListByUserID(t *testing.T) {
+	ctx := context.Background()
+	repo := newMessageBatchRepoSQLite(t)
+	var ids []int64
+	for _, batchID := range []string{"msgbatch_1", "msgbatch_2", "msgbatch_3", "msgbatch_4"} {
+		ids = append(ids, createTestMessageBatch(t, repo, batchID, 1, "x").ID)
+	}
+	createTestMessageBatch(t, repo, "msgbatch_other", 2, "x")
+
+	page, hasMore, err := repo.ListByUserID(ctx, 1, 0, 0, 2)
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Equal(t, []string{"msgbatch_4", "msgbatch_3"}, messageBatchIDs(page))
+
+	page, hasMore, err = repo.ListByUserID(ctx, 1, 0, ids[2], 2)
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, []string{"msgbatch_2", "msgbatch_1"}, messageBatchIDs(page))
+
+	page, hasMore, err = repo.ListByUserID(ctx, 1, ids[0], 0, 2)
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Equal(t, []string{"msgbatch_3", "msgbatch_2"}, messageBatchIDs(page))
+}
+
+func messageBatchIDs(batches []service.MessageBatch) []string {
+	out := make([]string, 0, len(batches))
+	for _, b := range batches {
+		out = append(out, b.BatchID)
+	}
+	return out
+}
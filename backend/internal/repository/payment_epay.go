@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const epayTradeSuccess = "TRADE_SUCCESS"
+
+// epayCurrency 易支付协议只以人民币结算，回调中不携带币种
+const epayCurrency = "CNY"
+
+// epayProvider 易支付（彩虹易支付兼容协议）：
+//   - 下单：跳转 {gateway}/submit.php，参数 MD5 签名
+//   - 回调：GET/POST 异步通知，trade_status=TRADE_SUCCESS，应答 "success"
+//   - 退款：POST {gateway}/api.php?act=refund
+type epayProvider struct {
+	cfg        config.EPayConfig
+	httpClient *http.Client
+}
+
+func newEPayProvider(cfg config.EPayConfig) *epayProvider {
+	sharedClient, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            15 * time.Second,
+		ValidateResolvedIP: true,
+	})
+	if err != nil {
+		sharedClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &epayProvider{cfg: cfg, httpClient: sharedClient}
+}
+
+func (p *epayProvider) Name() string {
+	return service.PaymentProviderEPay
+}
+
+func (p *epayProvider) CallbackAck() string {
+	return "success"
+}
+
+func (p *epayProvider) CreateCheckout(_ context.Context, order *service.PaymentOrder, req *service.PaymentCheckoutRequest) (*service.PaymentCheckout, error) {
+	params := url.Values{}
+	params.Set("pid", p.cfg.PID)
+	params.Set("out_trade_no", order.OrderNo)
+	params.Set("notify_url", req.NotifyURL)
+	params.Set("name", req.Subject)
+	params.Set("money", formatEPayMoney(order.Amount))
+	if req.ReturnURL != "" {
+		params.Set("return_url", req.ReturnURL)
+	}
+	if p.cfg.PayType != "" {
+		params.Set("type", p.cfg.PayType)
+	}
+	params.Set("sign", epaySign(params, p.cfg.Key))
+	params.Set("sign_type", "MD5")
+
+	return &service.PaymentCheckout{
+		PayURL: strings.TrimRight(p.cfg.GatewayURL, "/") + "/submit.php?" + params.Encode(),
+	}, nil
+}
+
+func (p *epayProvider) VerifyCallback(_ context.Context, req *service.PaymentCallbackRequest) (*service.PaymentCallbackResult, error) {
+	params := req.Params
+	sign := params.Get("sign")
+	if sign == "" || params.Get("pid") != p.cfg.PID {
+		return nil, service.ErrPaymentInvalidSignature
+	}
+	expected := epaySign(params, p.cfg.Key)
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(sign)), []byte(expected)) != 1 {
+		return nil, service.ErrPaymentInvalidSignature
+	}
+
+	amount, _ := strconv.ParseFloat(params.Get("money"), 64)
+	return &service.PaymentCallbackResult{
+		OrderNo:  params.Get("out_trade_no"),
+		TradeNo:  params.Get("trade_no"),
+		Amount:   amount,
+		Currency: epayCurrency,
+		Paid:     params.Get("trade_status") == epayTradeSuccess,
+	}, nil
+}
+
+func (p *epayProvider) Refund(ctx context.Context, order *service.PaymentOrder) error {
+	form := url.Values{}
+	form.Set("pid", p.cfg.PID)
+	form.Set("key", p.cfg.Key)
+	form.Set("out_trade_no", order.OrderNo)
+	if order.ProviderTradeNo != nil {
+		form.Set("trade_no", *order.ProviderTradeNo)
+	}
+	form.Set("money", formatEPayMoney(order.Amount))
+
+	endpoint := strings.TrimRight(p.cfg.GatewayURL, "/") + "/api.php?act=refund"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("epay refund request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+
+	var result struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("epay refund: unexpected response (status=%d)", resp.StatusCode)
+	}
+	if result.Code != 1 {
+		return fmt.Errorf("epay refund failed: %s", result.Msg)
+	}
+	return nil
+}
+
+// epaySign 易支付签名：参数按 key 升序拼接（跳过 sign/sign_type/空值），末尾追加商户密钥后取 MD5
+func epaySign(params url.Values, key string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "sign" || k == "sign_type" || params.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(params.Get(k))
+	}
+	sb.WriteString(key)
+	sum := md5.Sum([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func formatEPayMoney(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// paymentProviderMockName 测试渠道名称（回调路径 /api/v1/payments/callback/mock）
+const paymentProviderMockName = "mock"
+
+// mockPaymentProvider 测试用支付渠道：不校验签名，回调参数直接决定支付结果。
+// 回调参数：order_no、trade_no、amount、currency、status=paid。
+type mockPaymentProvider struct {
+	mu sync.Mutex
+	// refundErr 非 nil 时 Refund 返回该错误（测试渠道退款失败）
+	refundErr error
+	// refunded 已退款的订单号
+	refunded []string
+}
+
+func newMockPaymentProvider() *mockPaymentProvider {
+	return &mockPaymentProvider{}
+}
+
+func (p *mockPaymentProvider) Name() string {
+	return paymentProviderMockName
+}
+
+func (p *mockPaymentProvider) CallbackAck() string {
+	return "success"
+}
+
+// CreateCheckout 返回可直接访问的回调地址，访问即视为支付成功
+func (p *mockPaymentProvider) CreateCheckout(_ context.Context, order *service.PaymentOrder, req *service.PaymentCheckoutRequest) (*service.PaymentCheckout, error) {
+	params := url.Values{}
+	params.Set("order_no", order.OrderNo)
+	params.Set("trade_no", "MOCK"+order.OrderNo)
+	params.Set("amount", strconv.FormatFloat(order.Amount, 'f', 2, 64))
+	params.Set("currency", order.Currency)
+	params.Set("status", "paid")
+
+	sep := "?"
+	if strings.Contains(req.NotifyURL, "?") {
+		sep = "&"
+	}
+	return &service.PaymentCheckout{PayURL: req.NotifyURL + sep + params.Encode()}, nil
+}
+
+func (p *mockPaymentProvider) VerifyCallback(_ context.Context, req *service.PaymentCallbackRequest) (*service.PaymentCallbackResult, error) {
+	orderNo := req.Params.Get("order_no")
+	if orderNo == "" {
+		return nil, nil
+	}
+	amount, _ := strconv.ParseFloat(req.Params.Get("amount"), 64)
+	return &service.PaymentCallbackResult{
+		OrderNo:  orderNo,
+		TradeNo:  req.Params.Get("trade_no"),
+		Amount:   amount,
+		Currency: strings.ToUpper(req.Params.Get("currency")),
+		Paid:     req.Params.Get("status") == "paid",
+	}, nil
+}
+
+func (p *mockPaymentProvider) Refund(_ context.Context, order *service.PaymentOrder) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.refundErr != nil {
+		return p.refundErr
+	}
+	p.refunded = append(p.refunded, order.OrderNo)
+	return nil
+}
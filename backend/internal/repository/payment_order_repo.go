@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type paymentOrderRepository struct {
+	client *dbent.Client
+}
+
+func NewPaymentOrderRepository(client *dbent.Client) service.PaymentOrderRepository {
+	return &paymentOrderRepository{client: client}
+}
+
+func (r *paymentOrderRepository) Create(ctx context.Context, o *service.PaymentOrder) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.PaymentOrder.Create().
+		SetOrderNo(o.OrderNo).
+		SetUserID(o.UserID).
+		SetType(o.Type).
+		SetProvider(o.Provider).
+		SetStatus(o.Status).
+		SetAmount(o.Amount).
+		SetCurrency(o.Currency).
+		SetCreditAmount(o.CreditAmount).
+		SetValidityDays(o.ValidityDays).
+		SetClientIP(o.ClientIP).
+		SetNillablePlanID(o.PlanID).
+		SetNillableGroupID(o.GroupID).
+		SetNillableNotes(o.Notes)
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, nil, nil)
+	}
+	o.ID = created.ID
+	o.CreatedAt = created.CreatedAt
+	o.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *paymentOrderRepository) GetByID(ctx context.Context, id int64) (*service.PaymentOrder, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.PaymentOrder.Get(ctx, id)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrPaymentOrderNotFound, nil)
+	}
+	return paymentOrderEntityToService(m), nil
+}
+
+func (r *paymentOrderRepository) GetByOrderNo(ctx context.Context, orderNo string) (*service.PaymentOrder, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.PaymentOrder.Query().
+		Where(paymentorder.OrderNoEQ(orderNo)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrPaymentOrderNotFound, nil)
+	}
+	return paymentOrderEntityToService(m), nil
+}
+
+func (r *paymentOrderRepository) UpdateCheckout(ctx context.Context, id int64, payURL, providerRef string) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.PaymentOrder.UpdateOneID(id).SetPayURL(payURL)
+	if providerRef != "" {
+		builder.SetProviderTradeNo(providerRef)
+	}
+	if _, err := builder.Save(ctx); err != nil {
+		return translatePersistenceError(err, service.ErrPaymentOrderNotFound, nil)
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) MarkPaid(ctx context.Context, id int64, tradeNo string, paidAt time.Time) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.PaymentOrder.Update().
+		Where(paymentorder.IDEQ(id), paymentorder.StatusEQ(service.PaymentOrderStatusPending)).
+		SetStatus(service.PaymentOrderStatusPaid).
+		SetPaidAt(paidAt)
+	if tradeNo != "" {
+		builder.SetProviderTradeNo(tradeNo)
+	}
+	n, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrPaymentOrderStatusChanged
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) SetSubscriptionID(ctx context.Context, id, subscriptionID int64) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.PaymentOrder.UpdateOneID(id).SetSubscriptionID(subscriptionID).Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrPaymentOrderNotFound, nil)
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) MarkRefunding(ctx context.Context, id int64) error {
+	return r.transitionStatus(ctx, id, service.PaymentOrderStatusPaid, service.PaymentOrderStatusRefunding)
+}
+
+func (r *paymentOrderRepository) RevertRefunding(ctx context.Context, id int64) error {
+	return r.transitionStatus(ctx, id, service.PaymentOrderStatusRefunding, service.PaymentOrderStatusPaid)
+}
+
+// transitionStatus 条件更新订单状态：仅当当前状态为 from 时更新为 to
+func (r *paymentOrderRepository) transitionStatus(ctx context.Context, id int64, from, to string) error {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.PaymentOrder.Update().
+		Where(paymentorder.IDEQ(id), paymentorder.StatusEQ(from)).
+		SetStatus(to).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrPaymentOrderStatusChanged
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) MarkRefunded(ctx context.Context, id int64, refundedAt time.Time, notes string) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.PaymentOrder.Update().
+		Where(paymentorder.IDEQ(id), paymentorder.StatusEQ(service.PaymentOrderStatusRefunding)).
+		SetStatus(service.PaymentOrderStatusRefunded).
+		SetRefundedAt(refundedAt)
+	if notes != "" {
+		builder.SetNotes(notes)
+	}
+	n, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return service.ErrPaymentOrderStatusChanged
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) MarkVoided(ctx context.Context, id int64, voidedAt time.Time, notes string) error {
+	client := clientFromContext(ctx, r.client)
+	builder := client.PaymentOrder.Update().
+		Where(paymentorder.IDEQ(id), paymentorder.StatusEQ(service.PaymentOrderStatusPending)).
+		SetStatus(service.PaymentOrderStatusVoided).
+		SetVoidedAt(voidedAt)
+	if notes != "" {
+		builder.SetNotes(notes)
+	}
+	n, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		exists, err := client.PaymentOrder.Query().Where(paymentorder.IDEQ(id)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return service.ErrPaymentOrderNotFound
+		}
+		return service.ErrPaymentOrderStatusChanged
+	}
+	return nil
+}
+
+func (r *paymentOrderRepository) List(
+	ctx context.Context,
+	params pagination.PaginationParams,
+	filters service.PaymentOrderFilters,
+) ([]service.PaymentOrder, *pagination.PaginationResult, error) {
+	q := r.client.PaymentOrder.Query()
+
+	if filters.UserID > 0 {
+		q = q.Where(paymentorder.UserIDEQ(filters.UserID))
+	}
+	if filters.Status != "" {
+		q = q.Where(paymentorder.StatusEQ(filters.Status))
+	}
+	if filters.Type != "" {
+		q = q.Where(paymentorder.TypeEQ(filters.Type))
+	}
+	if filters.Provider != "" {
+		q = q.Where(paymentorder.ProviderEQ(filters.Provider))
+	}
+	if filters.OrderNo != "" {
+		q = q.Where(paymentorder.OrderNoEQ(filters.OrderNo))
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(paymentorder.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]service.PaymentOrder, 0, len(items))
+	for _, m := range items {
+		out = append(out, *paymentOrderEntityToService(m))
+	}
+	return out, paginationResultFromTotal(int64(total), params), nil
+}
+
+func paymentOrderEntityToService(m *dbent.PaymentOrder) *service.PaymentOrder {
+	if m == nil {
+		return nil
+	}
+	return &service.PaymentOrder{
+		ID:              m.ID,
+		OrderNo:         m.OrderNo,
+		UserID:          m.UserID,
+		Type:            m.Type,
+		Provider:        m.Provider,
+		Status:          m.Status,
+		Amount:          m.Amount,
+		Currency:        m.Currency,
+		CreditAmount:    m.CreditAmount,
+		PlanID:          m.PlanID,
+		GroupID:         m.GroupID,
+		ValidityDays:    m.ValidityDays,
+		SubscriptionID:  m.SubscriptionID,
+		ProviderTradeNo: m.ProviderTradeNo,
+		PayURL:          m.PayURL,
+		ClientIP:        m.ClientIP,
+		Notes:           m.Notes,
+		PaidAt:          m.PaidAt,
+		RefundedAt:      m.RefundedAt,
+		VoidedAt:        m.VoidedAt,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"sync"
+	"testing"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+type paymentTestEnv struct {
+	client   *dbent.Client
+	svc      *service.PaymentService
+	mock     *mockPaymentProvider
+	userRepo service.UserRepository
+	userID   int64
+}
+
+func newPaymentTestEnv(t *testing.T) *paymentTestEnv {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(drv)))
+	t.Cleanup(func() { _ = client.Close() })
+
+	u, err := client.User.Create().
+		SetEmail("payer@example.com").
+		SetPasswordHash("x").
+		Save(context.Background())
+	require.NoError(t, err)
+
+	cfg := &config.Config{Payment: config.PaymentConfig{
+		Enabled:       true,
+		NotifyBaseURL: "https://example.com",
+		Currency:      "CNY",
+		CreditRate:    2,
+		MinAmount:     1,
+		MaxAmount:     1000,
+	}}
+	mock := newMockPaymentProvider()
+	userRepo := NewUserRepository(client, db)
+	svc := service.NewPaymentService(
+		NewPaymentOrderRepository(client),
+		userRepo,
+		nil,
+		nil,
+		client,
+		nil,
+		service.NewPaymentProviderRegistry(mock),
+		cfg,
+	)
+	return &paymentTestEnv{client: client, svc: svc, mock: mock, userRepo: userRepo, userID: u.ID}
+}
+
+func (e *paymentTestEnv) balance(t *testing.T) float64 {
+	t.Helper()
+	u, err := e.userRepo.GetByID(context.Background(), e.userID)
+	require.NoError(t, err)
+	return u.Balance
+}
+
+func (e *paymentTestEnv) callback(order *service.PaymentOrder, amount string) (string, error) {
+	params := url.Values{}
+	params.Set("order_no", order.OrderNo)
+	params.Set("trade_no", "T"+order.OrderNo)
+	params.Set("amount", amount)
+	params.Set("currency", "CNY")
+	params.Set("status", "paid")
+	return e.svc.HandleCallback(context.Background(), paymentProviderMockName, &service.PaymentCallbackRequest{Params: params})
+}
+
+func TestPaymentServiceBalanceOrderCallbackIsIdempotent(t *testing.T) {
+	env := newPaymentTestEnv(t)
+	ctx := context.Background()
+
+	order, err := env.svc.CreateOrder(ctx, &service.CreatePaymentOrderInput{
+		UserID:   env.userID,
+		Type:     service.PaymentOrderTypeBalance,
+		Provider: paymentProviderMockName,
+		Amount:   10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, service.PaymentOrderStatusPending, order.Status)
+	require.NotNil(t, order.PayURL)
+	require.Contains(t, *order.PayURL, "/api/v1/payments/callback/mock?")
+
+	// 并发重复回调只发放一次
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = env.callback(order, "10.00")
+		}()
+	}
+	wg.Wait()
+	ack, err := env.callback(order, "10.00")
+	require.NoError(t, err)
+	require.Equal(t, "success", ack)
+	require.InDelta(t, 20.0, env.balance(t), 1e-9)
+
+	paid, err := env.svc.GetUserOrder(ctx, env.userID, order.OrderNo)
+	require.NoError(t, err)
+	require.Equal(t, service.PaymentOrderStatusPaid, paid.Status)
+	require.NotNil(t, paid.PaidAt)
+	require.Equal(t, "T"+order.OrderNo, *paid.ProviderTradeNo)
+
+	// 他人无法查看订单
+	_, err = env.svc.GetUserOrder(ctx, env.userID+1, order.OrderNo)
+	require.ErrorIs(t, err, service.ErrPaymentOrderNotFound)
+}
+
+func TestPaymentServiceRejectsAmountMismatch(t *testing.T) {
+	env := newPaymentTestEnv(t)
+
+	order, err := env.svc.CreateOrder(context.Background(), &service.CreatePaymentOrderInput{
+		UserID:   env.userID,
+		Type:     service.PaymentOrderTypeBalance,
+		Provider: paymentProviderMockName,
+		Amount:   10,
+	})
+	require.NoError(t, err)
+
+	_, err = env.callback(order, "1.00")
+	require.ErrorIs(t, err, service.ErrPaymentAmountMismatch)
+	require.Zero(t, env.balance(t))
+}
+
+func TestPaymentServiceRefundRevertsBalance(t *testing.T) {
+	env := newPaymentTestEnv(t)
+	ctx := context.Background()
+
+	order, err := env.svc.CreateOrder(ctx, &service.CreatePaymentOrderInput{
+		UserID:   env.userID,
+		Type:     service.PaymentOrderTypeBalance,
+		Provider: paymentProviderMockName,
+		Amount:   5,
+	})
+	require.NoError(t, err)
+	_, err = env.callback(order, "5.00")
+	require.NoError(t, err)
+	require.InDelta(t, 10.0, env.balance(t), 1e-9)
+
+	refunded, err := env.svc.Refund(ctx, order.ID, "duplicate payment")
+	require.NoError(t, err)
+	require.Equal(t, service.PaymentOrderStatusRefunded, refunded.Status)
+	require.NotNil(t, refunded.RefundedAt)
+	require.Equal(t, []string{order.OrderNo}, env.mock.refunded)
+	require.InDelta(t, 0.0, env.balance(t), 1e-9)
+
+	_, err = env.svc.Refund(ctx, order.ID, "")
+	require.ErrorIs(t, err, service.ErrPaymentOrderNotRefundable)
+
+	// 退款后的重复回调不再发放余额
+	_, err = env.callback(order, "5.00")
+	require.ErrorIs(t, err, service.ErrPaymentOrderNotPayable)
+	require.InDelta(t, 0.0, env.balance(t), 1e-9)
+}
+
+func TestPaymentServiceRefundRollsBackOnProviderFailure(t *testing.T) {
+	env := newPaymentTestEnv(t)
+	ctx := context.Background()
+
+	order, err := env.svc.CreateOrder(ctx, &service.CreatePaymentOrderInput{
+		UserID:   env.userID,
+		Type:     service.PaymentOrderTypeBalance,
+		Provider: paymentProviderMockName,
+		Amount:   5,
+	})
+	require.NoError(t, err)
+	_, err = env.callback(order, "5.00")
+	require.NoError(t, err)
+
+	env.mock.refundErr = context.DeadlineExceeded
+	_, err = env.svc.Refund(ctx, order.ID, "")
+	require.Error(t, err)
+
+	current, err := env.svc.GetByID(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, service.PaymentOrderStatusPaid, current.Status)
+	require.InDelta(t, 10.0, env.balance(t), 1e-9)
+}
+
+func TestPaymentServiceVoidedOrderRejectsCallback(t *testing.T) {
+	env := newPaymentTestEnv(t)
+	ctx := context.Background()
+
+	order, err := env.svc.CreateOrder(ctx, &service.CreatePaymentOrderInput{
+		UserID:   env.userID,
+		Type:     service.PaymentOrderTypeBalance,
+		Provider: paymentProviderMockName,
+		Amount:   3,
+	})
+	require.NoError(t, err)
+
+	voided, err := env.svc.Void(ctx, order.ID, "expired")
+	require.NoError(t, err)
+	require.Equal(t, service.PaymentOrderStatusVoided, voided.Status)
+	require.Equal(t, "expired", *voided.Notes)
+
+	_, err = env.svc.Void(ctx, order.ID, "")
+	require.ErrorIs(t, err, service.ErrPaymentOrderNotVoidable)
+
+	_, err = env.callback(order, "3.00")
+	require.ErrorIs(t, err, service.ErrPaymentOrderNotPayable)
+	require.Zero(t, env.balance(t))
+
+	orders, result, err := env.svc.ListUserOrders(ctx, env.userID, pagination.PaginationParams{Page: 1, PageSize: 10}, service.PaymentOrderStatusVoided)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, int64(1), result.Total)
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEPayProviderSignAndVerify(t *testing.T) {
+	p := newEPayProvider(config.EPayConfig{GatewayURL: "https://pay.example.com/", PID: "1001", Key: "secret", PayType: "alipay"})
+	order := &service.PaymentOrder{OrderNo: "20260101000000ABCDEF", Amount: 12.5}
+
+	checkout, err := p.CreateCheckout(context.Background(), order, &service.PaymentCheckoutRequest{
+		Subject:   "Balance top-up",
+		NotifyURL: "https://api.example.com/api/v1/payments/callback/epay",
+	})
+	require.NoError(t, err)
+	u, err := url.Parse(checkout.PayURL)
+	require.NoError(t, err)
+	require.Equal(t, "/submit.php", u.Path)
+	q := u.Query()
+	require.Equal(t, "12.50", q.Get("money"))
+	require.Equal(t, epaySign(q, "secret"), q.Get("sign"))
+
+	notify := url.Values{}
+	notify.Set("pid", "1001")
+	notify.Set("out_trade_no", order.OrderNo)
+	notify.Set("trade_no", "EP123")
+	notify.Set("money", "12.50")
+	notify.Set("trade_status", "TRADE_SUCCESS")
+	notify.Set("type", "alipay")
+	notify.Set("sign", epaySign(notify, "secret"))
+	notify.Set("sign_type", "MD5")
+
+	result, err := p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Params: notify})
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+	require.Equal(t, order.OrderNo, result.OrderNo)
+	require.Equal(t, "EP123", result.TradeNo)
+	require.InDelta(t, 12.5, result.Amount, 1e-9)
+	require.Equal(t, "CNY", result.Currency)
+
+	notify.Set("money", "0.01")
+	_, err = p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Params: notify})
+	require.ErrorIs(t, err, service.ErrPaymentInvalidSignature)
+}
+
+func TestStripeProviderVerifyCallback(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	p := newStripeProvider(config.StripeConfig{WebhookSecret: "whsec_test"})
+	p.now = func() time.Time { return now }
+
+	body := []byte(`{"type":"checkout.session.completed","data":{"object":{"client_reference_id":"ORD1","payment_intent":"pi_123","payment_status":"paid","amount_total":1250,"currency":"usd"}}}`)
+	sign := func(ts time.Time, secret string) http.Header {
+		timestamp := strconv.FormatInt(ts.Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "." + string(body)))
+		h := http.Header{}
+		h.Set("Stripe-Signature", "t="+timestamp+",v1="+hex.EncodeToString(mac.Sum(nil)))
+		return h
+	}
+
+	result, err := p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Header: sign(now, "whsec_test"), RawBody: body})
+	require.NoError(t, err)
+	require.Equal(t, "ORD1", result.OrderNo)
+	require.Equal(t, "pi_123", result.TradeNo)
+	require.True(t, result.Paid)
+	require.InDelta(t, 12.5, result.Amount, 1e-9)
+	require.Equal(t, "USD", result.Currency)
+
+	_, err = p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Header: sign(now, "wrong"), RawBody: body})
+	require.ErrorIs(t, err, service.ErrPaymentInvalidSignature)
+
+	_, err = p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Header: sign(now.Add(-time.Hour), "whsec_test"), RawBody: body})
+	require.ErrorIs(t, err, service.ErrPaymentInvalidSignature)
+
+	body = []byte(`{"type":"charge.refunded","data":{"object":{}}}`)
+	result, err = p.VerifyCallback(context.Background(), &service.PaymentCallbackRequest{Header: sign(now, "whsec_test"), RawBody: body})
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestStripeMinorUnits(t *testing.T) {
+	require.Equal(t, int64(1999), stripeMinorUnits(19.99, "USD"))
+	require.Equal(t, int64(500), stripeMinorUnits(500, "jpy"))
+	require.InDelta(t, 19.99, stripeMajorUnits(1999, "usd"), 1e-9)
+	require.InDelta(t, 500.0, stripeMajorUnits(500, "JPY"), 1e-9)
+}
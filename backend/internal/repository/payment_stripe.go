@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// stripeWebhookTolerance Stripe-Signature 时间戳允许的最大偏差（与官方 SDK 默认值一致）
+const stripeWebhookTolerance = 5 * time.Minute
+
+// stripeZeroDecimalCurrencies 无小数位的币种（金额不乘 100）
+var stripeZeroDecimalCurrencies = map[string]struct{}{
+	"bif": {}, "clp": {}, "djf": {}, "gnf": {}, "jpy": {}, "kmf": {}, "krw": {}, "mga": {},
+	"pyg": {}, "rwf": {}, "ugx": {}, "vnd": {}, "vuv": {}, "xaf": {}, "xof": {}, "xpf": {},
+}
+
+// stripeProvider Stripe Checkout：
+//   - 下单：创建 Checkout Session，跳转 session.url
+//   - 回调：Webhook（checkout.session.completed / async_payment_succeeded），校验 Stripe-Signature
+//   - 退款：按 payment_intent 创建 Refund
+type stripeProvider struct {
+	cfg        config.StripeConfig
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func newStripeProvider(cfg config.StripeConfig) *stripeProvider {
+	sharedClient, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            20 * time.Second,
+		ValidateResolvedIP: true,
+	})
+	if err != nil {
+		sharedClient = &http.Client{Timeout: 20 * time.Second}
+	}
+	return &stripeProvider{cfg: cfg, httpClient: sharedClient, now: time.Now}
+}
+
+func (p *stripeProvider) Name() string {
+	return service.PaymentProviderStripe
+}
+
+func (p *stripeProvider) CallbackAck() string {
+	return `{"received":true}`
+}
+
+func (p *stripeProvider) CreateCheckout(ctx context.Context, order *service.PaymentOrder, req *service.PaymentCheckoutRequest) (*service.PaymentCheckout, error) {
+	currency := strings.ToLower(order.Currency)
+	returnURL := req.ReturnURL
+	if returnURL == "" {
+		if u, err := url.Parse(req.NotifyURL); err == nil {
+			returnURL = u.Scheme + "://" + u.Host + "/"
+		}
+	}
+
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("success_url", returnURL)
+	form.Set("cancel_url", returnURL)
+	form.Set("client_reference_id", order.OrderNo)
+	form.Set("metadata[order_no]", order.OrderNo)
+	form.Set("payment_intent_data[metadata][order_no]", order.OrderNo)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(stripeMinorUnits(order.Amount, currency), 10))
+	form.Set("line_items[0][price_data][product_data][name]", req.Subject)
+
+	var session struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+	if err := p.post(ctx, "/v1/checkout/sessions", form, &session); err != nil {
+		return nil, err
+	}
+	if session.URL == "" {
+		return nil, fmt.Errorf("stripe checkout session has no url")
+	}
+	return &service.PaymentCheckout{PayURL: session.URL, ProviderRef: session.ID}, nil
+}
+
+func (p *stripeProvider) VerifyCallback(_ context.Context, req *service.PaymentCallbackRequest) (*service.PaymentCallbackResult, error) {
+	if err := p.verifySignature(req.Header.Get("Stripe-Signature"), req.RawBody); err != nil {
+		return nil, err
+	}
+
+	var event struct {
+		Type string `json:"type"`
+		Data struct {
+			Object struct {
+				ClientReferenceID string            `json:"client_reference_id"`
+				PaymentIntent     string            `json:"payment_intent"`
+				PaymentStatus     string            `json:"payment_status"`
+				AmountTotal       int64             `json:"amount_total"`
+				Currency          string            `json:"currency"`
+				Metadata          map[string]string `json:"metadata"`
+			} `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(req.RawBody, &event); err != nil {
+		return nil, fmt.Errorf("decode stripe event: %w", err)
+	}
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+	default:
+		return nil, nil
+	}
+
+	obj := event.Data.Object
+	orderNo := obj.ClientReferenceID
+	if orderNo == "" {
+		orderNo = obj.Metadata["order_no"]
+	}
+	return &service.PaymentCallbackResult{
+		OrderNo:  orderNo,
+		TradeNo:  obj.PaymentIntent,
+		Amount:   stripeMajorUnits(obj.AmountTotal, obj.Currency),
+		Currency: strings.ToUpper(obj.Currency),
+		Paid:     obj.PaymentStatus == "paid",
+	}, nil
+}
+
+// verifySignature 校验 Stripe-Signature: t=<ts>,v1=<hex>[,v1=<hex>]，签名内容为 "<ts>.<body>"
+func (p *stripeProvider) verifySignature(header string, body []byte) error {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return service.ErrPaymentInvalidSignature
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return service.ErrPaymentInvalidSignature
+	}
+	if math.Abs(p.now().Sub(time.Unix(ts, 0)).Seconds()) > stripeWebhookTolerance.Seconds() {
+		return service.ErrPaymentInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.cfg.WebhookSecret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+	for _, sig := range signatures {
+		decoded, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return service.ErrPaymentInvalidSignature
+}
+
+func (p *stripeProvider) Refund(ctx context.Context, order *service.PaymentOrder) error {
+	if order.ProviderTradeNo == nil || !strings.HasPrefix(*order.ProviderTradeNo, "pi_") {
+		return fmt.Errorf("stripe refund: order %s has no payment_intent", order.OrderNo)
+	}
+	form := url.Values{}
+	form.Set("payment_intent", *order.ProviderTradeNo)
+	form.Set("metadata[order_no]", order.OrderNo)
+	return p.post(ctx, "/v1/refunds", form, nil)
+}
+
+func (p *stripeProvider) post(ctx context.Context, path string, form url.Values, out any) error {
+	endpoint := strings.TrimRight(p.cfg.APIBaseURL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.cfg.SecretKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe request %s: %w", path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		return fmt.Errorf("stripe %s failed (status=%d): %s", path, resp.StatusCode, apiErr.Error.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode stripe response: %w", err)
+	}
+	return nil
+}
+
+func stripeMinorUnits(amount float64, currency string) int64 {
+	if _, ok := stripeZeroDecimalCurrencies[strings.ToLower(currency)]; ok {
+		return int64(math.Round(amount))
+	}
+	return int64(math.Round(amount * 100))
+}
+
+func stripeMajorUnits(amount int64, currency string) float64 {
+	if _, ok := stripeZeroDecimalCurrencies[strings.ToLower(currency)]; ok {
+		return float64(amount)
+	}
+	return float64(amount) / 100
+}
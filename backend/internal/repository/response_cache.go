@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	responseCacheKeyPrefix = "response_cache:entry:"
+	responseCacheStatsKey  = "response_cache:stats"
+)
+
+// responseCacheKey generates the Redis key for a cached response.
+func responseCacheKey(key string) string {
+	return responseCacheKeyPrefix + key
+}
+
+// responseCacheStatField generates the stats hash field: "{groupID}:{field}".
+func responseCacheStatField(groupID int64, field string) string {
+	return fmt.Sprintf("%d:%s", groupID, field)
+}
+
+type responseCache struct {
+	rdb *redis.Client
+}
+
+func NewResponseCache(rdb *redis.Client) service.ResponseCache {
+	return &responseCache{rdb: rdb}
+}
+
+func (c *responseCache) Get(ctx context.Context, key string) (*service.ResponseCacheEntry, error) {
+	raw, err := c.rdb.Get(ctx, responseCacheKey(key)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry service.ResponseCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (c *responseCache) Set(ctx context.Context, key string, entry *service.ResponseCacheEntry, ttl time.Duration) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, responseCacheKey(key), raw, ttl).Err()
+}
+
+func (c *responseCache) IncrStat(ctx context.Context, groupID int64, field string) error {
+	return c.rdb.HIncrBy(ctx, responseCacheStatsKey, responseCacheStatField(groupID, field), 1).Err()
+}
+
+func (c *responseCache) GetStats(ctx context.Context) (map[int64]map[string]int64, error) {
+	raw, err := c.rdb.HGetAll(ctx, responseCacheStatsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseResponseCacheStats(raw), nil
+}
+
+func (c *responseCache) ResetStats(ctx context.Context) error {
+	return c.rdb.Del(ctx, responseCacheStatsKey).Err()
+}
+
+func parseResponseCacheStats(raw map[string]string) map[int64]map[string]int64 {
+	out := make(map[int64]map[string]int64)
+	for k, v := range raw {
+		gid, field, ok := strings.Cut(k, ":")
+		if !ok {
+			continue
+		}
+		groupID, err := strconv.ParseInt(gid, 10, 64)
+		if err != nil {
+			continue
+		}
+		count, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+		if out[groupID] == nil {
+			out[groupID] = make(map[string]int64)
+		}
+		out[groupID][field] = count
+	}
+	return out
+}
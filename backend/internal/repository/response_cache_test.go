@@ -0,0 +1,31 @@
+//go:build unit
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCacheKey(t *testing.T) {
+	require.Equal(t, "response_cache:entry:abc", responseCacheKey("abc"))
+	require.Equal(t, "12:hits", responseCacheStatField(12, "hits"))
+}
+
+func TestParseResponseCacheStats(t *testing.T) {
+	got := parseResponseCacheStats(map[string]string{
+		"1:hits":    "3",
+		"1:misses":  "5",
+		"2:stores":  "1",
+		"bad":       "1",
+		"x:hits":    "1",
+		"3:hits":    "not-a-number",
+		"4:invalid": "2",
+	})
+	require.Equal(t, map[int64]map[string]int64{
+		1: {"hits": 3, "misses": 5},
+		2: {"stores": 1},
+		4: {"invalid": 2},
+	}, got)
+}
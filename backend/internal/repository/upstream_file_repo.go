@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/upstreamfile"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type upstreamFileRepository struct {
+	client *dbent.Client
+}
+
+func NewUpstreamFileRepository(client *dbent.Client) service.UpstreamFileRepository {
+	return &upstreamFileRepository{client: client}
+}
+
+func (r *upstreamFileRepository) Create(ctx context.Context, file *service.UpstreamFile) error {
+	client := clientFromContext(ctx, r.client)
+	created, err := client.UpstreamFile.Create().
+		SetFileID(file.FileID).
+		SetUserID(file.UserID).
+		SetAPIKeyID(file.APIKeyID).
+		SetAccountID(file.AccountID).
+		SetFilename(file.Filename).
+		SetMimeType(file.MimeType).
+		SetSizeBytes(file.SizeBytes).
+		SetDownloadable(file.Downloadable).
+		Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, nil, nil)
+	}
+	file.ID = created.ID
+	file.CreatedAt = created.CreatedAt
+	file.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *upstreamFileRepository) GetByFileID(ctx context.Context, fileID string) (*service.UpstreamFile, error) {
+	client := clientFromContext(ctx, r.client)
+	m, err := client.UpstreamFile.Query().
+		Where(upstreamfile.FileIDEQ(fileID)).
+		Only(ctx)
+	if err != nil {
+		return nil, translatePersistenceError(err, service.ErrUpstreamFileNotFound, nil)
+	}
+	return upstreamFileEntityToService(m), nil
+}
+
+func (r *upstreamFileRepository) ListByFileIDs(ctx context.Context, fileIDs []string) ([]service.UpstreamFile, error) {
+	if len(fileIDs) == 0 {
+		return nil, nil
+	}
+	client := clientFromContext(ctx, r.client)
+	items, err := client.UpstreamFile.Query().
+		Where(upstreamfile.FileIDIn(fileIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]service.UpstreamFile, 0, len(items))
+	for _, m := range items {
+		out = append(out, *upstreamFileEntityToService(m))
+	}
+	return out, nil
+}
+
+func (r *upstreamFileRepository) ListByUserID(ctx context.Context, userID int64, beforeID, afterID int64, limit int) ([]service.UpstreamFile, bool, error) {
+	client := clientFromContext(ctx, r.client)
+	q := client.UpstreamFile.Query().Where(upstreamfile.UserIDEQ(userID))
+	if afterID > 0 {
+		q = q.Where(upstreamfile.IDLT(afterID))
+	}
+	// before_id 取游标之前（更新）的一页：按 id 升序取最接近游标的记录后再反转
+	if beforeID > 0 && afterID == 0 {
+		q = q.Where(upstreamfile.IDGT(beforeID)).Order(dbent.Asc(upstreamfile.FieldID))
+	} else {
+		if beforeID > 0 {
+			q = q.Where(upstreamfile.IDGT(beforeID))
+		}
+		q = q.Order(dbent.Desc(upstreamfile.FieldID))
+	}
+
+	items, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+	if beforeID > 0 && afterID == 0 {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	out := make([]service.UpstreamFile, 0, len(items))
+	for _, m := range items {
+		out = append(out, *upstreamFileEntityToService(m))
+	}
+	return out, hasMore, nil
+}
+
+func (r *upstreamFileRepository) SumSizeByUserID(ctx context.Context, userID int64) (int64, error) {
+	var result []struct {
+		Sum *int64 `json:"sum"`
+	}
+	client := clientFromContext(ctx, r.client)
+	err := client.UpstreamFile.Query().
+		Where(upstreamfile.UserIDEQ(userID)).
+		Aggregate(dbent.As(dbent.Sum(upstreamfile.FieldSizeBytes), "sum")).
+		Scan(ctx, &result)
+	if err != nil {
+		return 0, err
+	}
+	if len(result) == 0 || result[0].Sum == nil {
+		return 0, nil
+	}
+	return *result[0].Sum, nil
+}
+
+func (r *upstreamFileRepository) Delete(ctx context.Context, id int64) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.UpstreamFile.Delete().Where(upstreamfile.IDEQ(id)).Exec(ctx)
+	return err
+}
+
+func upstreamFileEntityToService(m *dbent.UpstreamFile) *service.UpstreamFile {
+	if m == nil {
+		return nil
+	}
+	return &service.UpstreamFile{
+		ID:           m.ID,
+		FileID:       m.FileID,
+		UserID:       m.UserID,
+		APIKeyID:     m.APIKeyID,
+		AccountID:    m.AccountID,
+		Filename:     m.Filename,
+		MimeType:     m.MimeType,
+		SizeBytes:    m.SizeBytes,
+		Downloadable: m.Downloadable,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+func newUpstreamFileRepoSQLite(t *testing.T) service.UpstreamFileRepository {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	drv := entsql.OpenDB(dialect.SQLite, db)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(drv)))
+	t.Cleanup(func() { _ = client.Close() })
+	return NewUpstreamFileRepository(client)
+}
+
+func TestUpstreamFileRepository_CRUDAndQuota(t *testing.T) {
+	ctx := context.Background()
+	repo := newUpstreamFileRepoSQLite(t)
+
+	used, err := repo.SumSizeByUserID(ctx, 1)
+	require.NoError(t, err)
+	require.Zero(t, used)
+
+	for i, f := range []service.UpstreamFile{
+		{FileID: "file_a", UserID: 1, AccountID: 10, Filename: "a.pdf", MimeType: "application/pdf", SizeBytes: 100},
+		{FileID: "file_b", UserID: 1, AccountID: 11, Filename: "b.txt", MimeType: "text/plain", SizeBytes: 50, Downloadable: true},
+		{FileID: "file_c", UserID: 2, AccountID: 10, SizeBytes: 7},
+	} {
+		file := f
+		require.NoError(t, repo.Create(ctx, &file), i)
+		require.NotZero(t, file.ID)
+	}
+
+	used, err = repo.SumSizeByUserID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), used)
+
+	got, err := repo.GetByFileID(ctx, "file_b")
+	require.NoError(t, err)
+	require.Equal(t, int64(11), got.AccountID)
+	require.True(t, got.Downloadable)
+
+	_, err = repo.GetByFileID(ctx, "file_missing")
+	require.ErrorIs(t, err, service.ErrUpstreamFileNotFound)
+
+	files, err := repo.ListByFileIDs(ctx, []string{"file_a", "file_c", "file_missing"})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	require.NoError(t, repo.Delete(ctx, got.ID))
+	used, err = repo.SumSizeByUserID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), used)
+}
+
+func TestUpstreamFileRepository_ListByUserID(t *testing.T) {
+	ctx := context.Background()
+	repo := newUpstreamFileRepoSQLite(t)
+
+	var ids []int64
+	for _, fileID := range []string{"file_1", "file_2", "file_3", "file_4"} {
+		f := &service.UpstreamFile{FileID: fileID, UserID: 1, AccountID: 1}
+		require.NoError(t, repo.Create(ctx, f))
+		ids = append(ids, f.ID)
+	}
+	require.NoError(t, repo.Create(ctx, &service.UpstreamFile{FileID: "file_other", UserID: 2, AccountID: 1}))
+
+	page, hasMore, err := repo.ListByUserID(ctx, 1, 0, 0, 3)
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Equal(t, []string{"file_4", "file_3", "file_2"}, upstreamFileIDs(page))
+
+	page, hasMore, err = repo.ListByUserID(ctx, 1, 0, ids[1], 3)
+	require.NoError(t, err)
+	require.False(t, hasMore)
+	require.Equal(t, []string{"file_1"}, upstreamFileIDs(page))
+
+	page, hasMore, err = repo.ListByUserID(ctx, 1, ids[0], 0, 2)
+	require.NoError(t, err)
+	require.True(t, hasMore)
+	require.Equal(t, []string{"file_3", "file_2"}, upstreamFileIDs(page))
+}
+
+func upstreamFileIDs(files []service.UpstreamFile) []string {
+	out := make([]string, 0, len(files))
+	for _, f := range files {
+		out = append(out, f.FileID)
+	}
+	return out
+}
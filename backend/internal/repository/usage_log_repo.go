@@ -22,7 +22,7 @@ import (
 	"github.com/lib/pq"
 )
 
-const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, reasoning_effort, cache_ttl_overridden, created_at"
+const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, reasoning_effort, cache_ttl_overridden, response_cache_hit, request_type, created_at"
 
 type usageLogRepository struct {
 	client *dbent.Client
@@ -116,6 +116,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 				image_size,
 				reasoning_effort,
 				cache_ttl_overridden,
+				response_cache_hit,
+				request_type,
 				created_at
 			) VALUES (
 				$1, $2, $3, $4, $5,
@@ -123,7 +125,7 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 				$8, $9, $10, $11,
 				$12, $13,
 				$14, $15, $16, $17, $18, $19,
-				$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32
+				$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34
 			)
 			ON CONFLICT (request_id, api_key_id) DO NOTHING
 			RETURNING id, created_at
@@ -175,6 +177,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 		imageSize,
 		reasoningEffort,
 		log.CacheTTLOverridden,
+		log.ResponseCacheHit,
+		log.RequestType,
 		createdAt,
 	}
 	if err := scanSingleRow(ctx, sqlq, query, args, &log.ID, &log.CreatedAt); err != nil {
@@ -905,6 +909,9 @@ type ModelStat = usagestats.ModelStat
 // UserUsageTrendPoint represents user usage trend data point
 type UserUsageTrendPoint = usagestats.UserUsageTrendPoint
 
+// GroupCacheStat represents prompt cache usage statistics for a single group
+type GroupCacheStat = usagestats.GroupCacheStat
+
 // APIKeyUsageTrendPoint represents API key usage trend data point
 type APIKeyUsageTrendPoint = usagestats.APIKeyUsageTrendPoint
 
@@ -1656,6 +1663,50 @@ func (r *usageLogRepository) GetModelStatsWithFilters(ctx context.Context, start
 	return results, nil
 }
 
+// GetGroupCacheStats 按分组统计 prompt cache 读写量与命中率（输入侧 token 中缓存读取的占比）
+func (r *usageLogRepository) GetGroupCacheStats(ctx context.Context, startTime, endTime time.Time) (results []GroupCacheStat, err error) {
+	query := `
+		SELECT
+			ul.group_id,
+			COALESCE(MAX(g.name), '') as group_name,
+			COUNT(*) as requests,
+			COALESCE(SUM(ul.input_tokens), 0) as input_tokens,
+			COALESCE(SUM(ul.cache_creation_tokens), 0) as cache_creation_tokens,
+			COALESCE(SUM(ul.cache_read_tokens), 0) as cache_read_tokens
+		FROM usage_logs ul
+		LEFT JOIN groups g ON g.id = ul.group_id
+		WHERE ul.created_at >= $1 AND ul.created_at < $2 AND ul.group_id IS NOT NULL
+		GROUP BY ul.group_id
+		ORDER BY requests DESC
+	`
+	rows, err := r.sql.QueryContext(ctx, query, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			results = nil
+		}
+	}()
+
+	results = make([]GroupCacheStat, 0)
+	for rows.Next() {
+		var row GroupCacheStat
+		if err = rows.Scan(&row.GroupID, &row.GroupName, &row.Requests, &row.InputTokens, &row.CacheCreationTokens, &row.CacheReadTokens); err != nil {
+			return nil, err
+		}
+		if total := row.InputTokens + row.CacheCreationTokens + row.CacheReadTokens; total > 0 {
+			row.CacheHitRate = float64(row.CacheReadTokens) / float64(total)
+		}
+		results = append(results, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // GetGlobalStats gets usage statistics for all users within a time range
 func (r *usageLogRepository) GetGlobalStats(ctx context.Context, startTime, endTime time.Time) (*UsageStats, error) {
 	query := `
@@ -2198,6 +2249,8 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		imageSize             sql.NullString
 		reasoningEffort       sql.NullString
 		cacheTTLOverridden    bool
+		responseCacheHit      bool
+		requestType           string
 		createdAt             time.Time
 	)
 
@@ -2234,6 +2287,8 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		&imageSize,
 		&reasoningEffort,
 		&cacheTTLOverridden,
+		&responseCacheHit,
+		&requestType,
 		&createdAt,
 	); err != nil {
 		return nil, err
@@ -2263,6 +2318,8 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		Stream:                stream,
 		ImageCount:            imageCount,
 		CacheTTLOverridden:    cacheTTLOverridden,
+		ResponseCacheHit:      responseCacheHit,
+		RequestType:           requestType,
 		CreatedAt:             createdAt,
 	}
 
@@ -47,6 +47,21 @@ func ProvideSessionLimitCache(rdb *redis.Client, cfg *config.Config) service.Ses
 	return NewSessionLimitCache(rdb, defaultIdleTimeoutMinutes)
 }
 
+// ProvidePaymentProviderRegistry 按配置创建已启用的支付渠道
+func ProvidePaymentProviderRegistry(cfg *config.Config) *service.PaymentProviderRegistry {
+	if cfg == nil || !cfg.Payment.Enabled {
+		return service.NewPaymentProviderRegistry()
+	}
+	var providers []service.PaymentProvider
+	if cfg.Payment.EPay.Enabled {
+		providers = append(providers, newEPayProvider(cfg.Payment.EPay))
+	}
+	if cfg.Payment.Stripe.Enabled {
+		providers = append(providers, newStripeProvider(cfg.Payment.Stripe))
+	}
+	return service.NewPaymentProviderRegistry(providers...)
+}
+
 // ProviderSet is the Wire provider set for all repositories
 var ProviderSet = wire.NewSet(
 	NewUserRepository,
@@ -69,6 +84,12 @@ var ProviderSet = wire.NewSet(
 	NewUserGroupRateRepository,
 	NewErrorPassthroughRepository,
 	NewRequestContentLogRepository,
+	NewAuditLogRepository,
+	NewAccountHealthProbeRepository,
+	NewPaymentOrderRepository,
+	NewBudgetAlertRepository,
+	NewMessageBatchRepository,
+	NewUpstreamFileRepository,
 
 	// Cache implementations
 	NewGatewayCache,
@@ -82,6 +103,9 @@ var ProviderSet = wire.NewSet(
 	NewEmailCache,
 	NewIdentityCache,
 	NewRedeemCache,
+	NewResponseCache,
+	NewIdempotencyCache,
+	NewAPIKeyRequestLimitCache,
 	NewUpdateCache,
 	NewGeminiTokenCache,
 	NewSchedulerCache,
@@ -105,6 +129,7 @@ var ProviderSet = wire.NewSet(
 	NewOpenAIOAuthClient,
 	NewGeminiOAuthClient,
 	NewGeminiCliCodeAssistClient,
+	ProvidePaymentProviderRegistry,
 
 	ProvideEnt,
 	ProvideSQLDB,
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	adminAuditRoutePrefix = "/api/v1/admin/"
+
+	contextKeyAuditBefore = "audit_before"
+	contextKeyAuditAfter  = "audit_after"
+	contextKeyAuditTarget = "audit_target_id"
+)
+
+// SetAuditChange 由 handler 提供变更前后的实体快照，AdminAudit 据此计算脱敏后的字段差异。
+// 快照应是 handler 响应用的 DTO（或 service 模型），新增/删除场景可传 nil。
+func SetAuditChange(c *gin.Context, before, after any) {
+	if before != nil {
+		c.Set(contextKeyAuditBefore, before)
+	}
+	if after != nil {
+		c.Set(contextKeyAuditAfter, after)
+	}
+}
+
+// SetAuditTargetID 覆盖审计记录的目标实体 ID（默认取路由参数 :id）
+func SetAuditTargetID(c *gin.Context, targetID string) {
+	c.Set(contextKeyAuditTarget, targetID)
+}
+
+// limitedBodyCapture 在 handler 读取请求体时同步复制前 limit 字节
+type limitedBodyCapture struct {
+	io.ReadCloser
+	buf   bytes.Buffer
+	limit int
+}
+
+func (bc *limitedBodyCapture) Read(p []byte) (int, error) {
+	n, err := bc.ReadCloser.Read(p)
+	if n > 0 {
+		if remain := bc.limit - bc.buf.Len(); remain > 0 {
+			_, _ = bc.buf.Write(p[:min(n, remain)])
+		}
+	}
+	return n, err
+}
+
+// AdminAudit 管理后台审计中间件：记录每一次变更请求（非 GET/HEAD/OPTIONS）。
+// 需放在 adminAuth 之后，以便读取操作者身份。
+func AdminAudit(svc *service.AuditLogService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if svc == nil || !svc.IsEnabled() || !isMutatingMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		var capture *limitedBodyCapture
+		if c.Request.Body != nil && isJSONContentType(c.ContentType()) {
+			capture = &limitedBodyCapture{ReadCloser: c.Request.Body, limit: svc.MaxBodySize()}
+			c.Request.Body = capture
+		}
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			// 未匹配到路由（404），不记录
+			return
+		}
+
+		input := &service.AuditRecordInput{
+			AuthMethod: c.GetString("auth_method"),
+			IPAddress:  ip.GetClientIP(c),
+			UserAgent:  c.GetHeader("User-Agent"),
+			Method:     c.Request.Method,
+			Route:      route,
+			Path:       c.Request.URL.Path,
+			StatusCode: c.Writer.Status(),
+			TargetType: auditTargetType(route),
+			TargetID:   auditTargetID(c),
+		}
+		if subject, ok := GetAuthSubjectFromContext(c); ok {
+			input.ActorUserID = subject.UserID
+		}
+		if capture != nil && capture.buf.Len() > 0 {
+			input.RequestBody = capture.buf.Bytes()
+		}
+		input.Before, _ = c.Get(contextKeyAuditBefore)
+		input.After, _ = c.Get(contextKeyAuditAfter)
+
+		svc.RecordAsync(input)
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+func isJSONContentType(contentType string) bool {
+	return contentType == "" || strings.Contains(contentType, "json")
+}
+
+// auditTargetType 取路由模板中 /api/v1/admin/ 后的第一段作为目标类型（users、accounts、settings...）
+func auditTargetType(route string) string {
+	rest, ok := strings.CutPrefix(route, adminAuditRoutePrefix)
+	if !ok {
+		return ""
+	}
+	segment, _, _ := strings.Cut(rest, "/")
+	return segment
+}
+
+func auditTargetID(c *gin.Context) string {
+	if v := c.GetString(contextKeyAuditTarget); v != "" {
+		return v
+	}
+	if id := c.Param("id"); id != "" {
+		return id
+	}
+	if len(c.Params) > 0 {
+		return c.Params[0].Value
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type auditLogRepoStub struct {
+	created chan *service.AuditLog
+}
+
+func (r *auditLogRepoStub) Create(_ context.Context, entry *service.AuditLog) error {
+	r.created <- entry
+	return nil
+}
+
+func (r *auditLogRepoStub) List(context.Context, service.AuditLogFilters, int, int) ([]*service.AuditLog, int64, error) {
+	return nil, 0, nil
+}
+
+func newAuditTestRouter(repo *auditLogRepoStub) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{AuditLog: config.AuditLogConfig{Enabled: true, MaxBodySize: 1024}}
+	svc := service.NewAuditLogService(repo, cfg)
+
+	r := gin.New()
+	admin := r.Group("/api/v1/admin")
+	admin.Use(func(c *gin.Context) {
+		c.Set(string(ContextKeyUser), AuthSubject{UserID: 7})
+		c.Set("auth_method", "jwt")
+		c.Next()
+	})
+	admin.Use(AdminAudit(svc))
+	admin.PUT("/accounts/:id", func(c *gin.Context) {
+		_, _ = io.ReadAll(c.Request.Body)
+		SetAuditChange(c,
+			map[string]any{"name": "old", "credentials": map[string]any{"api_key": "sk-old"}},
+			map[string]any{"name": "new", "credentials": map[string]any{"api_key": "sk-new"}},
+		)
+		c.Status(http.StatusOK)
+	})
+	admin.GET("/accounts/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func TestAdminAudit_RecordsMutation(t *testing.T) {
+	repo := &auditLogRepoStub{created: make(chan *service.AuditLog, 1)}
+	r := newAuditTestRouter(repo)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/accounts/42", strings.NewReader(`{"name":"new","credentials":{"api_key":"sk-new"}}`))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry *service.AuditLog
+	select {
+	case entry = <-repo.created:
+	case <-time.After(2 * time.Second):
+		t.Fatal("audit log not recorded")
+	}
+
+	require.NotNil(t, entry.ActorUserID)
+	require.Equal(t, int64(7), *entry.ActorUserID)
+	require.Equal(t, "jwt", entry.AuthMethod)
+	require.Equal(t, "/api/v1/admin/accounts/:id", entry.Route)
+	require.Equal(t, "accounts", entry.TargetType)
+	require.Equal(t, "42", entry.TargetID)
+	require.Equal(t, http.StatusOK, entry.StatusCode)
+	require.Equal(t, service.AuditFieldChange{Before: "old", After: "new"}, entry.Changes["name"])
+	require.Equal(t, service.AuditFieldChange{Before: "***", After: "***"}, entry.Changes["credentials.api_key"])
+	require.NotNil(t, entry.RequestBody)
+	require.NotContains(t, *entry.RequestBody, "sk-new")
+}
+
+func TestAdminAudit_SkipsReads(t *testing.T) {
+	repo := &auditLogRepoStub{created: make(chan *service.AuditLog, 1)}
+	r := newAuditTestRouter(repo)
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/admin/accounts/42", nil))
+
+	select {
+	case <-repo.created:
+		t.Fatal("GET should not be audited")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
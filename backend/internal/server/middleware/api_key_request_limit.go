@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/googleapi"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// gatewayErrorFormat 网关各平台的错误响应格式
+type gatewayErrorFormat int
+
+const (
+	gatewayErrorFormatAnthropic gatewayErrorFormat = iota
+	gatewayErrorFormatOpenAI
+	gatewayErrorFormatGoogle
+)
+
+// gatewayErrorFormatForPath 根据请求路径判断客户端期望的错误格式
+func gatewayErrorFormatForPath(path string) gatewayErrorFormat {
+	if strings.HasSuffix(path, "/responses") ||
+		strings.HasSuffix(path, "/chat/completions") ||
+		strings.HasSuffix(path, "/embeddings") ||
+		strings.Contains(path, "/images/") {
+		return gatewayErrorFormatOpenAI
+	}
+	return gatewayErrorFormatAnthropic
+}
+
+// contextKeyDeferredRequestLimits 认证阶段推迟的请求数限制检查（func() bool），由 Idempotency 中间件执行
+const contextKeyDeferredRequestLimits ContextKey = "deferred_request_limits"
+
+// enforceAPIKeyRequestLimits 校验 API Key 的模型白名单与 RPM/TPM/每日请求数限制。
+// 仅对 POST 请求生效；未通过时按平台格式写出错误并中止，返回 false。
+//
+// 携带已有记录的 Idempotency-Key 的重复请求会被回放而不执行 handler，不占用请求数；
+// 此时检查推迟到 Idempotency 中间件，仅在首个请求失败、重复请求重新获得执行权时执行。
+func enforceAPIKeyRequestLimits(c *gin.Context, apiKeyService *service.APIKeyService, idempotencyService *service.IdempotencyService, apiKey *service.APIKey, format gatewayErrorFormat) bool {
+	if c.Request.Method != http.MethodPost {
+		return true
+	}
+
+	if len(apiKey.AllowedModels) > 0 && !skipsModelAllowlistCheck(c.FullPath()) {
+		model := requestModelForLimit(c)
+		switch err := apiKeyService.CheckModelAllowed(apiKey, model); {
+		case err == nil:
+		case errors.Is(err, service.ErrAPIKeyModelRequired):
+			abortWithGatewayError(c, format, http.StatusForbidden, "permission_error", "model_not_allowed",
+				"A model must be specified for this API key")
+			return false
+		default:
+			abortWithGatewayError(c, format, http.StatusForbidden, "permission_error", "model_not_allowed",
+				"Model "+model+" is not allowed for this API key")
+			return false
+		}
+	}
+
+	if !apiKey.HasRequestLimits() || !countsTowardRequestLimits(c) {
+		return true
+	}
+	if idempotencyKey := strings.TrimSpace(c.GetHeader(service.IdempotencyKeyHeader)); idempotencyKey != "" &&
+		idempotencyService.HasRecord(c, apiKey.ID, idempotencyKey) {
+		c.Set(string(contextKeyDeferredRequestLimits), func() bool {
+			return checkAPIKeyRequestLimits(c, apiKeyService, apiKey, format)
+		})
+		return true
+	}
+	return checkAPIKeyRequestLimits(c, apiKeyService, apiKey, format)
+}
+
+// runDeferredRequestLimits 执行认证阶段推迟的请求数限制检查；未通过时已写出错误并中止，返回 false
+func runDeferredRequestLimits(c *gin.Context) bool {
+	value, ok := c.Get(string(contextKeyDeferredRequestLimits))
+	if !ok {
+		return true
+	}
+	c.Set(string(contextKeyDeferredRequestLimits), nil)
+	check, _ := value.(func() bool)
+	return check == nil || check()
+}
+
+// checkAPIKeyRequestLimits 占用一次 RPM/TPM/每日请求数计数；超限时按平台格式写出错误并中止，返回 false
+func checkAPIKeyRequestLimits(c *gin.Context, apiKeyService *service.APIKeyService, apiKey *service.APIKey, format gatewayErrorFormat) bool {
+	err := apiKeyService.CheckRequestLimits(c.Request.Context(), apiKey)
+	if err == nil {
+		return true
+	}
+
+	var limitErr *service.APIKeyRequestLimitError
+	if errors.As(err, &limitErr) {
+		if limitErr.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(limitErr.RetryAfter.Seconds()))))
+		}
+		abortWithGatewayError(c, format, http.StatusTooManyRequests, "rate_limit_error", "rate_limit_exceeded", limitErr.Error())
+		return false
+	}
+	abortWithGatewayError(c, format, http.StatusInternalServerError, "api_error", "internal_error", "Failed to check API key limits")
+	return false
+}
+
+// skipsModelAllowlistCheck 没有顶层 model 字段、由 handler/service 自行校验白名单或与模型无关的路由：
+//   - 消息批次：创建时逐条校验 params.model；
+//   - 图片接口：edits 为 multipart 请求体，generations 可省略 model，handler 确定模型后校验；
+//   - 文件上传、批次取消：非推理请求。
+func skipsModelAllowlistCheck(route string) bool {
+	return strings.HasSuffix(route, "/messages/batches") ||
+		strings.HasSuffix(route, "/messages/batches/:id/cancel") ||
+		strings.HasSuffix(route, "/images/generations") ||
+		strings.HasSuffix(route, "/images/edits") ||
+		route == "/v1/files"
+}
+
+// requestLimitRouteSuffixes 计入 RPM/TPM/每日请求数的推理接口
+var requestLimitRouteSuffixes = []string{
+	"/messages",
+	"/responses",
+	"/chat/completions",
+	"/embeddings",
+	"/images/generations",
+	"/images/edits",
+}
+
+// countsTowardRequestLimits 是否计入 RPM/TPM/每日请求数：仅推理接口计数。
+// 文件上传、消息批次管理与 count_tokens / countTokens 不是推理请求，不计数；GET 请求在此之前已跳过。
+func countsTowardRequestLimits(c *gin.Context) bool {
+	if modelAction := c.Param("modelAction"); modelAction != "" {
+		return !strings.HasSuffix(modelAction, ":countTokens")
+	}
+	route := c.FullPath()
+	for _, suffix := range requestLimitRouteSuffixes {
+		if strings.HasSuffix(route, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestModelForLimit 提取请求的模型名：Gemini 原生接口取自路径，其余取自 JSON 请求体。
+// 读取请求体后会原样放回，供后续 handler 使用。
+func requestModelForLimit(c *gin.Context) string {
+	if modelAction := strings.TrimPrefix(c.Param("modelAction"), "/"); modelAction != "" {
+		model, _, _ := strings.Cut(modelAction, ":")
+		return model
+	}
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		// 保留读取错误（如请求体超限），交由 handler 按原逻辑处理
+		c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err: err}))
+		return ""
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return gjson.GetBytes(body, "model").String()
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// abortWithGatewayError 按平台格式写出错误：
+//   - Anthropic: {"type":"error","error":{"type":"...","message":"..."}}
+//   - OpenAI:    {"error":{"type":"...","code":"...","message":"..."}}
+//   - Google:    {"error":{"code":429,"message":"...","status":"RESOURCE_EXHAUSTED"}}
+func abortWithGatewayError(c *gin.Context, format gatewayErrorFormat, status int, errType, code, message string) {
+	switch format {
+	case gatewayErrorFormatOpenAI:
+		c.JSON(status, gin.H{
+			"error": gin.H{
+				"type":    errType,
+				"code":    code,
+				"message": message,
+			},
+		})
+	case gatewayErrorFormatGoogle:
+		c.JSON(status, gin.H{
+			"error": gin.H{
+				"code":    status,
+				"message": message,
+				"status":  googleapi.HTTPStatusToGoogleStatus(status),
+			},
+		})
+	default:
+		c.JSON(status, gin.H{
+			"type": "error",
+			"error": gin.H{
+				"type":    errType,
+				"message": message,
+			},
+		})
+	}
+	c.Abort()
+}
@@ -0,0 +1,288 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRequestLimitCache struct {
+	limit      string
+	retryAfter time.Duration
+	calls      int
+}
+
+func (s *stubRequestLimitCache) AcquireRequest(ctx context.Context, apiKeyID int64, check service.APIKeyRequestLimitCheck) (string, time.Duration, error) {
+	s.calls++
+	return s.limit, s.retryAfter, nil
+}
+
+func (s *stubRequestLimitCache) AddTokens(ctx context.Context, apiKeyID int64, tokens int, window time.Duration) error {
+	return nil
+}
+
+func newRequestLimitTestService(apiKey *service.APIKey, cache service.APIKeyRequestLimitCache, cfg *config.Config) *service.APIKeyService {
+	repo := &stubApiKeyRepo{
+		getByKey: func(ctx context.Context, key string) (*service.APIKey, error) {
+			if key != apiKey.Key {
+				return nil, service.ErrAPIKeyNotFound
+			}
+			clone := *apiKey
+			return &clone, nil
+		},
+	}
+	svc := service.NewAPIKeyService(repo, nil, nil, nil, nil, nil, cfg)
+	svc.SetRequestLimitCache(cache)
+	return svc
+}
+
+func newRequestLimitTestKey() *service.APIKey {
+	user := &service.User{ID: 7, Role: service.RoleUser, Status: service.StatusActive, Balance: 10, Concurrency: 3}
+	return &service.APIKey{ID: 100, UserID: user.ID, Key: "test-key", Status: service.StatusActive, User: user}
+}
+
+func TestAPIKeyAuthEnforcesModelAllowlist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKey := newRequestLimitTestKey()
+	apiKey.AllowedModels = []string{"claude-sonnet-*"}
+	svc := newRequestLimitTestService(apiKey, &stubRequestLimitCache{}, cfg)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(svc, nil, nil, cfg)))
+	router.POST("/v1/messages", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+	router.POST("/v1/responses", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	t.Run("allowed_model_passes_and_body_is_preserved", func(t *testing.T) {
+		body := `{"model":"claude-sonnet-4-5","messages":[]}`
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(body))
+		req.Header.Set("x-api-key", apiKey.Key)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, body, w.Body.String())
+	})
+
+	t.Run("anthropic_format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(`{"model":"claude-opus-4-1"}`))
+		req.Header.Set("x-api-key", apiKey.Key)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.JSONEq(t, `{"type":"error","error":{"type":"permission_error","message":"Model claude-opus-4-1 is not allowed for this API key"}}`, w.Body.String())
+	})
+
+	t.Run("openai_format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/responses", strings.NewReader(`{"model":"gpt-5"}`))
+		req.Header.Set("Authorization", "Bearer "+apiKey.Key)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), `"code":"model_not_allowed"`)
+	})
+}
+
+func TestAPIKeyAuthEnforcesRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKey := newRequestLimitTestKey()
+	apiKey.RateLimitRPM = 10
+	cache := &stubRequestLimitCache{limit: service.APIKeyLimitRPM, retryAfter: 1500 * time.Millisecond}
+	svc := newRequestLimitTestService(apiKey, cache, cfg)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(svc, nil, nil, cfg)))
+	router.POST("/v1/chat/completions", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/v1/models", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(`{"model":"gpt-5"}`))
+	req.Header.Set("Authorization", "Bearer "+apiKey.Key)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+	require.JSONEq(t, `{"error":{"type":"rate_limit_error","code":"rate_limit_exceeded","message":"API key rate limit exceeded: 10 requests per minute"}}`, w.Body.String())
+
+	// GET 请求不计入限制
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+	req.Header.Set("Authorization", "Bearer "+apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 1, cache.calls)
+}
+
+func TestAPIKeyAuthGoogleEnforcesLimits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeSimple}
+	apiKey := newRequestLimitTestKey()
+	apiKey.AllowedModels = []string{"gemini-2.5-*"}
+	apiKey.DailyRequestLimit = 100
+	cache := &stubRequestLimitCache{}
+	svc := newRequestLimitTestService(apiKey, cache, cfg)
+
+	router := gin.New()
+	router.Use(APIKeyAuthWithSubscriptionGoogle(svc, nil, nil, cfg))
+	router.POST("/v1beta/models/*modelAction", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-2.5-pro:generateContent", strings.NewReader(`{}`))
+	req.Header.Set("x-goog-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-1.5-pro:generateContent", strings.NewReader(`{}`))
+	req.Header.Set("x-goog-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), `"status":"PERMISSION_DENIED"`)
+
+	cache.limit = service.APIKeyLimitDaily
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-2.5-pro:generateContent", strings.NewReader(`{}`))
+	req.Header.Set("x-goog-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Contains(t, w.Body.String(), `"status":"RESOURCE_EXHAUSTED"`)
+	require.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
+func TestGatewayErrorFormatForPath(t *testing.T) {
+	cases := map[string]gatewayErrorFormat{
+		"/v1/messages":                     gatewayErrorFormatAnthropic,
+		"/v1/messages/count_tokens":        gatewayErrorFormatAnthropic,
+		"/v1/responses":                    gatewayErrorFormatOpenAI,
+		"/chat/completions":                gatewayErrorFormatOpenAI,
+		"/v1/embeddings":                   gatewayErrorFormatOpenAI,
+		"/embeddings":                      gatewayErrorFormatOpenAI,
+		"/v1/images/generations":           gatewayErrorFormatOpenAI,
+		"/antigravity/v1/images/edits":     gatewayErrorFormatOpenAI,
+		"/antigravity/v1/messages":         gatewayErrorFormatAnthropic,
+		"/antigravity/v1/chat/completions": gatewayErrorFormatOpenAI,
+	}
+	for path, want := range cases {
+		require.Equal(t, want, gatewayErrorFormatForPath(path), path)
+	}
+}
+
+func TestAPIKeyAuthModelAllowlistFailsClosed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKey := newRequestLimitTestKey()
+	apiKey.AllowedModels = []string{"claude-sonnet-*"}
+	svc := newRequestLimitTestService(apiKey, &stubRequestLimitCache{}, cfg)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(svc, nil, nil, cfg)))
+	router.POST("/v1/messages", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/v1/images/edits", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// 无法确定模型的请求被拒绝
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(`{"messages":[]}`))
+	req.Header.Set("x-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), "A model must be specified")
+
+	// multipart 图片编辑交由 handler 校验
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/v1/images/edits", strings.NewReader("--x--"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	req.Header.Set("Authorization", "Bearer "+apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAPIKeyAuthCountTokensSkipsRequestLimits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKey := newRequestLimitTestKey()
+	apiKey.AllowedModels = []string{"claude-sonnet-*"}
+	apiKey.RateLimitRPM = 1
+	cache := &stubRequestLimitCache{limit: service.APIKeyLimitRPM, retryAfter: time.Second}
+	svc := newRequestLimitTestService(apiKey, cache, cfg)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(svc, nil, nil, cfg)))
+	router.POST("/v1/messages/count_tokens", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages/count_tokens", strings.NewReader(`{"model":"claude-sonnet-4-5"}`))
+	req.Header.Set("x-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Zero(t, cache.calls)
+
+	// 白名单仍然生效
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/v1/messages/count_tokens", strings.NewReader(`{"model":"claude-opus-4-1"}`))
+	req.Header.Set("x-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestAPIKeyAuthManagementRoutesSkipRequestLimits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKey := newRequestLimitTestKey()
+	apiKey.RateLimitRPM = 1
+	cache := &stubRequestLimitCache{limit: service.APIKeyLimitRPM, retryAfter: time.Second}
+	svc := newRequestLimitTestService(apiKey, cache, cfg)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(svc, nil, nil, cfg)))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.POST("/v1/files", ok)
+	router.DELETE("/v1/files/:id", ok)
+	router.POST("/v1/messages/batches", ok)
+	router.GET("/v1/messages/batches/:id", ok)
+	router.POST("/v1/messages/batches/:id/cancel", ok)
+	router.GET("/v1/models", ok)
+	router.GET("/v1/usage", ok)
+	router.POST("/v1/messages", ok)
+
+	for _, tc := range []struct{ method, path string }{
+		{http.MethodPost, "/v1/files"},
+		{http.MethodDelete, "/v1/files/file_1"},
+		{http.MethodPost, "/v1/messages/batches"},
+		{http.MethodGet, "/v1/messages/batches/b_1"},
+		{http.MethodPost, "/v1/messages/batches/b_1/cancel"},
+		{http.MethodGet, "/v1/models"},
+		{http.MethodGet, "/v1/usage"},
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(`{}`))
+		req.Header.Set("x-api-key", apiKey.Key)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, tc.path)
+	}
+	require.Zero(t, cache.calls)
+
+	// 推理接口仍然计数
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(`{"model":"claude-sonnet-4-5"}`))
+	req.Header.Set("x-api-key", apiKey.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, 1, cache.calls)
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ClientRequestID ensures every request has a unique client_request_id in request.Context().
 //
 // This is used by the Ops monitoring module for end-to-end request correlation.
+// The id is always generated server-side (a client traceparent may span many requests);
+// when tracing is enabled it is recorded on the request span so ops logs can be matched to traces.
 func ClientRequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if c.Request == nil {
@@ -24,6 +29,9 @@ func ClientRequestID() gin.HandlerFunc {
 		}
 
 		id := uuid.New().String()
+		if tracing.Enabled() {
+			trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String("client_request_id", id))
+		}
 		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.ClientRequestID, id))
 		c.Next()
 	}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// Idempotency Idempotency-Key 去重中间件，需挂在 API Key 认证之后。
+// 首个请求正常执行并记录响应；重复请求直接回放记录的响应（或接入执行中的流），不进入 handler。
+// 认证阶段为重复请求推迟的请求数限制检查在这里、进入 handler 之前执行。
+func Idempotency(svc *service.IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		next := func() {
+			if runDeferredRequestLimits(c) {
+				c.Next()
+			}
+		}
+
+		if c.Request.Method != http.MethodPost || !svc.Enabled() {
+			next()
+			return
+		}
+		idempotencyKey := strings.TrimSpace(c.GetHeader(service.IdempotencyKeyHeader))
+		if idempotencyKey == "" {
+			next()
+			return
+		}
+		apiKey, ok := GetAPIKeyFromContext(c)
+		if !ok || apiKey == nil {
+			next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				// 保留读取错误（如请求体超限），交由 handler 按原逻辑处理
+				c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err: err}))
+				next()
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		lease, handled, err := svc.Begin(c, apiKey.ID, idempotencyKey, body)
+		if err != nil {
+			format := gatewayErrorFormatForPath(c.Request.URL.Path)
+			if c.Param("modelAction") != "" {
+				format = gatewayErrorFormatGoogle
+			}
+			switch {
+			case errors.Is(err, service.ErrIdempotencyKeyInvalid):
+				abortWithGatewayError(c, format, http.StatusBadRequest, "invalid_request_error", "invalid_idempotency_key", err.Error())
+			case errors.Is(err, service.ErrIdempotencyKeyMismatch):
+				abortWithGatewayError(c, format, http.StatusUnprocessableEntity, "invalid_request_error", "idempotency_key_reused", err.Error())
+			default:
+				abortWithGatewayError(c, format, http.StatusConflict, "invalid_request_error", "idempotency_conflict", err.Error())
+			}
+			return
+		}
+		if handled {
+			// 重复请求：已回放首个请求的响应
+			c.Abort()
+			return
+		}
+		if lease == nil {
+			// 幂等存储不可用，按普通请求处理
+			next()
+			return
+		}
+
+		defer lease.Finish()
+		next()
+	}
+}
@@ -0,0 +1,321 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryIdempotencyCache struct {
+	mu         sync.Mutex
+	records    map[string]*service.IdempotencyRecord
+	chunks     map[string][][]byte
+	failAppend bool
+}
+
+func newMemoryIdempotencyCache() *memoryIdempotencyCache {
+	return &memoryIdempotencyCache{records: map[string]*service.IdempotencyRecord{}, chunks: map[string][][]byte{}}
+}
+
+func (m *memoryIdempotencyCache) Acquire(_ context.Context, key, fingerprint string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.records[key]; ok {
+		return false, nil
+	}
+	m.records[key] = &service.IdempotencyRecord{State: service.IdempotencyStateInProgress, Fingerprint: fingerprint}
+	delete(m.chunks, key)
+	return true, nil
+}
+
+func (m *memoryIdempotencyCache) Get(_ context.Context, key string) (*service.IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.records[key]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *memoryIdempotencyCache) SetResponse(_ context.Context, key string, statusCode int, contentType string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[key].StatusCode = statusCode
+	m.records[key].ContentType = contentType
+	return nil
+}
+
+func (m *memoryIdempotencyCache) AppendChunk(_ context.Context, key string, chunk []byte, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failAppend {
+		return errors.New("redis unavailable")
+	}
+	m.chunks[key] = append(m.chunks[key], append([]byte(nil), chunk...))
+	return nil
+}
+
+func (m *memoryIdempotencyCache) GetChunks(_ context.Context, key string, start, count int64) ([][]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	all := m.chunks[key]
+	if start >= int64(len(all)) {
+		return nil, nil
+	}
+	end := start + count
+	if end > int64(len(all)) {
+		end = int64(len(all))
+	}
+	return all[start:end], nil
+}
+
+func (m *memoryIdempotencyCache) MarkTruncated(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[key].Truncated = true
+	delete(m.chunks, key)
+	return nil
+}
+
+func (m *memoryIdempotencyCache) Complete(_ context.Context, key string, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[key].State = service.IdempotencyStateCompleted
+	return nil
+}
+
+func (m *memoryIdempotencyCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, key)
+	delete(m.chunks, key)
+	return nil
+}
+
+func newIdempotencyTestRouter(handler gin.HandlerFunc) *gin.Engine {
+	return newIdempotencyTestRouterWithCache(newMemoryIdempotencyCache(), handler)
+}
+
+func newIdempotencyTestRouterWithCache(cache service.IdempotencyCache, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{}
+	cfg.Gateway.Idempotency = config.GatewayIdempotencyConfig{Enabled: true, TTLSeconds: 60, LockTTLSeconds: 60, MaxResponseBytes: 1 << 20}
+	svc := service.NewIdempotencyService(cache, cfg)
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(string(ContextKeyAPIKey), &service.APIKey{ID: 1})
+		c.Next()
+	})
+	r.POST("/v1/messages", Idempotency(svc), handler)
+	return r
+}
+
+func doIdempotentRequest(r http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(service.IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyReplaysCompletedResponse(t *testing.T) {
+	var calls atomic.Int32
+	r := newIdempotencyTestRouter(func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"id": "msg_1"})
+	})
+
+	first := doIdempotentRequest(r, "k1", `{"model":"m"}`)
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Empty(t, first.Header().Get(service.IdempotencyReplayedHeader))
+
+	second := doIdempotentRequest(r, "k1", `{"model":"m"}`)
+	require.Equal(t, http.StatusOK, second.Code)
+	require.Equal(t, "true", second.Header().Get(service.IdempotencyReplayedHeader))
+	require.Equal(t, first.Body.String(), second.Body.String())
+	require.Equal(t, "application/json; charset=utf-8", second.Header().Get("Content-Type"))
+	require.Equal(t, int32(1), calls.Load())
+
+	// 同一幂等键、不同请求体
+	mismatch := doIdempotentRequest(r, "k1", `{"model":"other"}`)
+	require.Equal(t, http.StatusUnprocessableEntity, mismatch.Code)
+	require.Contains(t, mismatch.Body.String(), "invalid_request_error")
+
+	// 未携带幂等键的请求照常执行
+	doIdempotentRequest(r, "", `{"model":"m"}`)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestIdempotencyFailedResponseAllowsRetry(t *testing.T) {
+	var calls atomic.Int32
+	r := newIdempotencyTestRouter(func(c *gin.Context) {
+		if calls.Add(1) == 1 {
+			c.JSON(http.StatusBadGateway, gin.H{"error": "upstream"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": "msg_2"})
+	})
+
+	require.Equal(t, http.StatusBadGateway, doIdempotentRequest(r, "k2", `{}`).Code)
+	retry := doIdempotentRequest(r, "k2", `{}`)
+	require.Equal(t, http.StatusOK, retry.Code)
+	require.Empty(t, retry.Header().Get(service.IdempotencyReplayedHeader))
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestIdempotencyRecordFailureAfterSuccessDoesNotReexecute(t *testing.T) {
+	var calls atomic.Int32
+	cache := newMemoryIdempotencyCache()
+	cache.failAppend = true
+	r := newIdempotencyTestRouterWithCache(cache, func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"id": "msg_4"})
+	})
+
+	require.Equal(t, http.StatusOK, doIdempotentRequest(r, "k4", `{}`).Code)
+
+	// 成功响应已计费但无法回放：重复请求返回冲突，不再次执行
+	retry := doIdempotentRequest(r, "k4", `{}`)
+	require.Equal(t, http.StatusConflict, retry.Code)
+	require.Contains(t, retry.Body.String(), "unavailable for replay")
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestIdempotencyAttachesToInFlightStream(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	r := newIdempotencyTestRouter(func(c *gin.Context) {
+		calls.Add(1)
+		c.Header("Content-Type", "text/event-stream")
+		c.Status(http.StatusOK)
+		_, _ = c.Writer.WriteString("event: message_start\n\n")
+		c.Writer.Flush()
+		close(started)
+		<-release
+		_, _ = c.Writer.WriteString("event: message_stop\n\n")
+	})
+
+	var wg sync.WaitGroup
+	var first, second *httptest.ResponseRecorder
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		first = doIdempotentRequest(r, "k3", `{"stream":true}`)
+	}()
+	<-started
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		second = doIdempotentRequest(r, "k3", `{"stream":true}`)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, "event: message_start\n\nevent: message_stop\n\n", first.Body.String())
+	require.Equal(t, first.Body.String(), second.Body.String())
+	require.Equal(t, "text/event-stream", second.Header().Get("Content-Type"))
+}
+
+func newIdempotencyLimitTestRouter(t *testing.T, apiKey *service.APIKey, limits *stubRequestLimitCache, handler gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	cfg.Gateway.Idempotency = config.GatewayIdempotencyConfig{Enabled: true, TTLSeconds: 60, LockTTLSeconds: 60, MaxResponseBytes: 1 << 20}
+	idempotencySvc := service.NewIdempotencyService(newMemoryIdempotencyCache(), cfg)
+
+	r := gin.New()
+	r.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(newRequestLimitTestService(apiKey, limits, cfg), nil, idempotencySvc, cfg)))
+	r.POST("/v1/messages", Idempotency(idempotencySvc), handler)
+	r.POST("/v1/chat/completions", handler)
+	return r
+}
+
+func doLimitedIdempotentRequest(r http.Handler, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("x-api-key", "test-key")
+	if key != "" {
+		req.Header.Set(service.IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyReplayDoesNotConsumeRequestLimits(t *testing.T) {
+	apiKey := newRequestLimitTestKey()
+	apiKey.RateLimitRPM = 1
+	limits := &stubRequestLimitCache{}
+	var calls atomic.Int32
+	r := newIdempotencyLimitTestRouter(t, apiKey, limits, func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"id": "msg_1"})
+	})
+
+	require.Equal(t, http.StatusOK, doLimitedIdempotentRequest(r, "/v1/messages", "k1", `{"model":"m"}`).Code)
+	require.Equal(t, 1, limits.calls)
+
+	// 已达上限：重复请求仍然回放，不计数
+	limits.limit = service.APIKeyLimitRPM
+	replay := doLimitedIdempotentRequest(r, "/v1/messages", "k1", `{"model":"m"}`)
+	require.Equal(t, http.StatusOK, replay.Code)
+	require.Equal(t, "true", replay.Header().Get(service.IdempotencyReplayedHeader))
+	require.Equal(t, 1, limits.calls)
+	require.Equal(t, int32(1), calls.Load())
+
+	// 新的幂等键、以及其他接口复用同一幂等键都会计数
+	require.Equal(t, http.StatusTooManyRequests, doLimitedIdempotentRequest(r, "/v1/messages", "k2", `{"model":"m"}`).Code)
+	require.Equal(t, http.StatusTooManyRequests, doLimitedIdempotentRequest(r, "/v1/chat/completions", "k1", `{"model":"m"}`).Code)
+	require.Equal(t, 3, limits.calls)
+}
+
+func TestIdempotencyRetryAfterFailureChecksRequestLimits(t *testing.T) {
+	apiKey := newRequestLimitTestKey()
+	apiKey.RateLimitRPM = 1
+	limits := &stubRequestLimitCache{}
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	r := newIdempotencyLimitTestRouter(t, apiKey, limits, func(c *gin.Context) {
+		calls.Add(1)
+		close(started)
+		<-release
+		c.JSON(http.StatusBadGateway, gin.H{"error": "upstream"})
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- doLimitedIdempotentRequest(r, "/v1/messages", "k1", `{}`) }()
+	<-started
+
+	// 重复请求到达时首个请求仍在执行：认证阶段推迟计数；首个请求失败后重新获得执行权时补做检查
+	limits.limit = service.APIKeyLimitRPM
+	retried := make(chan *httptest.ResponseRecorder)
+	go func() { retried <- doLimitedIdempotentRequest(r, "/v1/messages", "k1", `{}`) }()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	require.Equal(t, http.StatusBadGateway, (<-done).Code)
+	require.Equal(t, http.StatusTooManyRequests, (<-retried).Code)
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, 2, limits.calls)
+}
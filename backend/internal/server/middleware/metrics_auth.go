@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsAuth 校验 /metrics 抓取请求的 Bearer Token（Prometheus authorization.credentials）
+func MetricsAuth(token string) gin.HandlerFunc {
+	expected := []byte(strings.TrimSpace(token))
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		provided, ok := strings.CutPrefix(authHeader, "Bearer ")
+		if !ok || len(expected) == 0 || subtle.ConstantTimeCompare([]byte(strings.TrimSpace(provided)), expected) != 1 {
+			AbortWithError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid metrics token")
+			return
+		}
+		c.Next()
+	}
+}
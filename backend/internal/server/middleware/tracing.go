@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing 为网关请求创建服务端根 span（沿用客户端 traceparent），
+// 需放在 ClientRequestID 之前，以便 client_request_id 记录到该 span 上。
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request == nil || !tracing.Enabled() {
+			c.Next()
+			return
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, c.FullPath()),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", c.FullPath()),
+			),
+		)
+		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+	}
+}
+
+// startStageSpan 在中间件内为某个阶段创建 span，返回的 end 可重复调用（仅首次生效）。
+// 阶段内若请求被 Abort，span 标记为错误并记录响应状态码。
+func startStageSpan(c *gin.Context, name string) func() {
+	_, span := tracing.Start(c.Request.Context(), name)
+	ended := false
+	return func() {
+		if ended {
+			return
+		}
+		ended = true
+		if c.IsAborted() {
+			status := c.Writer.Status()
+			span.SetAttributes(attribute.Int("http.response.status_code", status))
+			span.SetStatus(codes.Error, fmt.Sprintf("aborted with HTTP %d", status))
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,66 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func serveClientRequestID(t *testing.T) (requestID, traceID string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/v1/messages", Tracing(), ClientRequestID(), func(c *gin.Context) {
+		requestID, _ = c.Request.Context().Value(ctxkey.ClientRequestID).(string)
+		traceID = tracing.TraceID(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+	req.Header.Set("traceparent", testTraceparent)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	return requestID, traceID
+}
+
+func TestClientRequestID_UUIDWhenTracingDisabled(t *testing.T) {
+	got, _ := serveClientRequestID(t)
+	_, err := uuid.Parse(got)
+	require.NoError(t, err)
+}
+
+func TestClientRequestID_UniqueUnderSharedTrace(t *testing.T) {
+	shutdown, err := tracing.Init(context.Background(), config.TracingConfig{
+		Enabled:     true,
+		Endpoint:    "127.0.0.1:1",
+		Insecure:    true,
+		SampleRatio: 1,
+	}, "test")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = shutdown(ctx)
+	})
+
+	// 同一客户端 trace 下的多个请求共享 trace id，但 client_request_id 各不相同
+	first, firstTrace := serveClientRequestID(t)
+	second, secondTrace := serveClientRequestID(t)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", firstTrace)
+	require.Equal(t, firstTrace, secondTrace)
+	_, err = uuid.Parse(first)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
@@ -25,6 +25,8 @@ func SetupRouter(
 	subscriptionService *service.SubscriptionService,
 	opsService *service.OpsService,
 	requestContentLogService *service.RequestContentLogService,
+	idempotencyService *service.IdempotencyService,
+	auditLogService *service.AuditLogService,
 	settingService *service.SettingService,
 	cfg *config.Config,
 	redisClient *redis.Client,
@@ -48,7 +50,7 @@ func SetupRouter(
 	}
 
 	// 注册路由
-	registerRoutes(r, handlers, jwtAuth, adminAuth, apiKeyAuth, apiKeyService, subscriptionService, opsService, requestContentLogService, cfg, redisClient)
+	registerRoutes(r, handlers, jwtAuth, adminAuth, apiKeyAuth, apiKeyService, subscriptionService, opsService, requestContentLogService, idempotencyService, auditLogService, cfg, redisClient)
 
 	return r
 }
@@ -64,11 +66,14 @@ func registerRoutes(
 	subscriptionService *service.SubscriptionService,
 	opsService *service.OpsService,
 	requestContentLogService *service.RequestContentLogService,
+	idempotencyService *service.IdempotencyService,
+	auditLogService *service.AuditLogService,
 	cfg *config.Config,
 	redisClient *redis.Client,
 ) {
 	// 通用路由（健康检查、状态等）
 	routes.RegisterCommonRoutes(r)
+	routes.RegisterMetricsRoutes(r, h, cfg)
 
 	// API v1
 	v1 := r.Group("/api/v1")
@@ -76,6 +81,7 @@ func registerRoutes(
 	// 注册各模块路由
 	routes.RegisterAuthRoutes(v1, h, jwtAuth, redisClient)
 	routes.RegisterUserRoutes(v1, h, jwtAuth)
-	routes.RegisterAdminRoutes(v1, h, adminAuth)
-	routes.RegisterGatewayRoutes(r, h, apiKeyAuth, apiKeyService, subscriptionService, opsService, requestContentLogService, cfg)
+	routes.RegisterPaymentRoutes(v1, h, jwtAuth)
+	routes.RegisterAdminRoutes(v1, h, adminAuth, auditLogService)
+	routes.RegisterGatewayRoutes(r, h, apiKeyAuth, apiKeyService, subscriptionService, opsService, requestContentLogService, idempotencyService, cfg)
 }
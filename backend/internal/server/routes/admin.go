@@ -4,6 +4,7 @@ package routes
 import (
 	"github.com/Wei-Shaw/sub2api/internal/handler"
 	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,9 +14,12 @@ func RegisterAdminRoutes(
 	v1 *gin.RouterGroup,
 	h *handler.Handlers,
 	adminAuth middleware.AdminAuthMiddleware,
+	auditLogService *service.AuditLogService,
 ) {
 	admin := v1.Group("/admin")
 	admin.Use(gin.HandlerFunc(adminAuth))
+	// 审计日志：记录所有变更操作（需在 adminAuth 之后以获取操作者）
+	admin.Use(middleware.AdminAudit(auditLogService))
 	{
 		// 仪表盘
 		registerDashboardRoutes(admin, h)
@@ -73,6 +77,15 @@ func RegisterAdminRoutes(
 
 		// 请求内容日志
 		registerRequestContentLogRoutes(admin, h)
+
+		// 管理员操作审计日志
+		registerAuditLogRoutes(admin, h)
+
+		// 在线支付订单
+		registerPaymentRoutes(admin, h)
+
+		// 响应缓存统计
+		registerResponseCacheRoutes(admin, h)
 	}
 }
 
@@ -99,6 +112,11 @@ func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		ops.GET("/email-notification/config", h.Admin.Ops.GetEmailNotificationConfig)
 		ops.PUT("/email-notification/config", h.Admin.Ops.UpdateEmailNotificationConfig)
 
+		// Notification channels: webhook/telegram/dingtalk/feishu (DB-backed)
+		ops.GET("/notification-channels", h.Admin.Ops.GetNotificationChannels)
+		ops.PUT("/notification-channels", h.Admin.Ops.UpdateNotificationChannels)
+		ops.POST("/notification-channels/:id/test", h.Admin.Ops.TestNotificationChannel)
+
 		// Runtime settings (DB-backed)
 		runtime := ops.Group("/runtime")
 		{
@@ -163,6 +181,7 @@ func registerDashboardRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		dashboard.GET("/realtime", h.Admin.Dashboard.GetRealtimeMetrics)
 		dashboard.GET("/trend", h.Admin.Dashboard.GetUsageTrend)
 		dashboard.GET("/models", h.Admin.Dashboard.GetModelStats)
+		dashboard.GET("/groups-cache", h.Admin.Dashboard.GetGroupCacheStats)
 		dashboard.GET("/api-keys-trend", h.Admin.Dashboard.GetAPIKeyUsageTrend)
 		dashboard.GET("/users-trend", h.Admin.Dashboard.GetUserUsageTrend)
 		dashboard.POST("/users-usage", h.Admin.Dashboard.GetBatchUsersUsage)
@@ -227,6 +246,7 @@ func registerAccountRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		accounts.DELETE("/:id/temp-unschedulable", h.Admin.Account.ClearTempUnschedulable)
 		accounts.POST("/:id/schedulable", h.Admin.Account.SetSchedulable)
 		accounts.GET("/:id/models", h.Admin.Account.GetAvailableModels)
+		accounts.GET("/:id/health-probes", h.Admin.AccountHealthProbe.List)
 		accounts.POST("/batch", h.Admin.Account.BatchCreate)
 		accounts.GET("/data", h.Admin.Account.ExportData)
 		accounts.POST("/data", h.Admin.Account.ImportData)
@@ -236,6 +256,8 @@ func registerAccountRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 
 		// Antigravity 默认模型映射
 		accounts.GET("/antigravity/default-model-mapping", h.Admin.Account.GetAntigravityDefaultModelMapping)
+		accounts.GET("/bedrock/default-model-mapping", h.Admin.Account.GetBedrockDefaultModelMapping)
+		accounts.GET("/vertex/default-model-mapping", h.Admin.Account.GetVertexDefaultModelMapping)
 
 		// Claude OAuth routes
 		accounts.POST("/generate-auth-url", h.Admin.OAuth.GenerateAuthURL)
@@ -424,3 +446,25 @@ func registerRequestContentLogRoutes(admin *gin.RouterGroup, h *handler.Handlers
 		logs.GET("/:id", h.Admin.RequestContentLog.GetByID)
 	}
 }
+
+func registerAuditLogRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	admin.GET("/audit-logs", h.Admin.AuditLog.List)
+}
+
+func registerPaymentRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	orders := admin.Group("/payments/orders")
+	{
+		orders.GET("", h.Admin.Payment.List)
+		orders.GET("/:id", h.Admin.Payment.GetByID)
+		orders.POST("/:id/refund", h.Admin.Payment.Refund)
+		orders.POST("/:id/void", h.Admin.Payment.Void)
+	}
+}
+
+func registerResponseCacheRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	responseCache := admin.Group("/response-cache")
+	{
+		responseCache.GET("/stats", h.Admin.ResponseCache.GetStats)
+		responseCache.DELETE("/stats", h.Admin.ResponseCache.ResetStats)
+	}
+}
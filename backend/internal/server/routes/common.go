@@ -3,6 +3,10 @@ package routes
 import (
 	"net/http"
 
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -30,3 +34,11 @@ func RegisterCommonRoutes(r *gin.Engine) {
 		})
 	})
 }
+
+// RegisterMetricsRoutes 注册 Prometheus 指标导出（需配置 metrics.enabled 与 Bearer Token）
+func RegisterMetricsRoutes(r *gin.Engine, h *handler.Handlers, cfg *config.Config) {
+	if cfg == nil || !cfg.Metrics.Enabled || h.Metrics == nil {
+		return
+	}
+	r.GET("/metrics", middleware.MetricsAuth(cfg.Metrics.AuthToken), h.Metrics.Metrics)
+}
@@ -18,45 +18,89 @@ func RegisterGatewayRoutes(
 	subscriptionService *service.SubscriptionService,
 	opsService *service.OpsService,
 	requestContentLogService *service.RequestContentLogService,
+	idempotencyService *service.IdempotencyService,
 	cfg *config.Config,
 ) {
 	bodyLimit := middleware.RequestBodyLimit(cfg.Gateway.MaxBodySize)
+	requestTracing := middleware.Tracing()
 	clientRequestID := middleware.ClientRequestID()
 	opsErrorLogger := handler.OpsErrorLoggerMiddleware(opsService)
+	gatewayMetrics := handler.GatewayMetricsMiddleware()
+	// Idempotency-Key 去重（需在 API Key 认证之后）
+	idempotency := middleware.Idempotency(idempotencyService)
 
 	// API网关（Claude API兼容）
 	gateway := r.Group("/v1")
 	gateway.Use(bodyLimit)
+	gateway.Use(requestTracing)
 	gateway.Use(clientRequestID)
 	gateway.Use(opsErrorLogger)
+	gateway.Use(gatewayMetrics)
 	gateway.Use(gin.HandlerFunc(apiKeyAuth))
 	gateway.Use(middleware.RequestContentLogger(requestContentLogService, "anthropic"))
 	{
-		gateway.POST("/messages", h.Gateway.Messages)
+		gateway.POST("/messages", idempotency, h.Gateway.Messages)
 		gateway.POST("/messages/count_tokens", h.Gateway.CountTokens)
+		// Message Batches API（本地 worker 池低优先级执行）
+		gateway.POST("/messages/batches", h.MessageBatch.Create)
+		gateway.GET("/messages/batches", h.MessageBatch.List)
+		gateway.GET("/messages/batches/:id", h.MessageBatch.Get)
+		gateway.POST("/messages/batches/:id/cancel", h.MessageBatch.Cancel)
+		gateway.GET("/messages/batches/:id/results", h.MessageBatch.Results)
 		gateway.GET("/models", h.Gateway.Models)
 		gateway.GET("/usage", h.Gateway.Usage)
 		// OpenAI Responses API
-		gateway.POST("/responses", h.OpenAIGateway.Responses)
+		gateway.POST("/responses", idempotency, h.OpenAIGateway.Responses)
+		// OpenAI Chat Completions API（按分组平台转换后转发）
+		gateway.POST("/chat/completions", idempotency, h.ChatCompletions.ChatCompletions)
+		// OpenAI Embeddings API（openai / gemini 分组）
+		gateway.POST("/embeddings", h.OpenAIGateway.Embeddings)
+		// OpenAI Images API（gemini / antigravity 分组的图片模型）
+		gateway.POST("/images/generations", h.OpenAIGateway.ImageGenerations)
+		gateway.POST("/images/edits", h.OpenAIGateway.ImageEdits)
+	}
+
+	// Anthropic Files API：文件体积较大，使用独立的请求体限制；不记录请求内容
+	files := r.Group("/v1/files")
+	files.Use(middleware.RequestBodyLimit(cfg.Gateway.Files.MaxFileSize))
+	files.Use(requestTracing)
+	files.Use(clientRequestID)
+	files.Use(opsErrorLogger)
+	files.Use(gatewayMetrics)
+	files.Use(gin.HandlerFunc(apiKeyAuth))
+	{
+		files.POST("", h.File.Upload)
+		files.GET("", h.File.List)
+		files.GET("/:id", h.File.Get)
+		files.GET("/:id/content", h.File.Content)
+		files.DELETE("/:id", h.File.Delete)
 	}
 
 	// Gemini 原生 API 兼容层（Gemini SDK/CLI 直连）
 	gemini := r.Group("/v1beta")
 	gemini.Use(bodyLimit)
+	gemini.Use(requestTracing)
 	gemini.Use(clientRequestID)
 	gemini.Use(opsErrorLogger)
-	gemini.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, cfg))
+	gemini.Use(gatewayMetrics)
+	gemini.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, idempotencyService, cfg))
 	gemini.Use(middleware.RequestContentLogger(requestContentLogService, "gemini"))
 	{
 		gemini.GET("/models", h.Gateway.GeminiV1BetaListModels)
 		gemini.GET("/models/:model", h.Gateway.GeminiV1BetaGetModel)
 		// Gin treats ":" as a param marker, but Gemini uses "{model}:{action}" in the same segment.
-		gemini.POST("/models/*modelAction", h.Gateway.GeminiV1BetaModels)
+		gemini.POST("/models/*modelAction", idempotency, h.Gateway.GeminiV1BetaModels)
 	}
 
 	// OpenAI Responses API（不带v1前缀的别名）
-	r.POST("/responses", bodyLimit, clientRequestID, opsErrorLogger, gin.HandlerFunc(apiKeyAuth),
-		middleware.RequestContentLogger(requestContentLogService, "openai"), h.OpenAIGateway.Responses)
+	r.POST("/responses", bodyLimit, requestTracing, clientRequestID, opsErrorLogger, gatewayMetrics, gin.HandlerFunc(apiKeyAuth),
+		middleware.RequestContentLogger(requestContentLogService, "openai"), idempotency, h.OpenAIGateway.Responses)
+	// OpenAI Chat Completions API（不带v1前缀的别名）
+	r.POST("/chat/completions", bodyLimit, requestTracing, clientRequestID, opsErrorLogger, gatewayMetrics, gin.HandlerFunc(apiKeyAuth),
+		middleware.RequestContentLogger(requestContentLogService, "openai"), idempotency, h.ChatCompletions.ChatCompletions)
+	// OpenAI Embeddings API（不带v1前缀的别名）
+	r.POST("/embeddings", bodyLimit, requestTracing, clientRequestID, opsErrorLogger, gatewayMetrics, gin.HandlerFunc(apiKeyAuth),
+		middleware.RequestContentLogger(requestContentLogService, "openai"), h.OpenAIGateway.Embeddings)
 
 	// Antigravity 模型列表
 	r.GET("/antigravity/models", gin.HandlerFunc(apiKeyAuth), h.Gateway.AntigravityModels)
@@ -64,28 +108,36 @@ func RegisterGatewayRoutes(
 	// Antigravity 专用路由（仅使用 antigravity 账户，不混合调度）
 	antigravityV1 := r.Group("/antigravity/v1")
 	antigravityV1.Use(bodyLimit)
+	antigravityV1.Use(requestTracing)
 	antigravityV1.Use(clientRequestID)
 	antigravityV1.Use(opsErrorLogger)
+	antigravityV1.Use(gatewayMetrics)
 	antigravityV1.Use(middleware.ForcePlatform(service.PlatformAntigravity))
 	antigravityV1.Use(gin.HandlerFunc(apiKeyAuth))
 	antigravityV1.Use(middleware.RequestContentLogger(requestContentLogService, "antigravity"))
 	{
-		antigravityV1.POST("/messages", h.Gateway.Messages)
+		antigravityV1.POST("/messages", idempotency, h.Gateway.Messages)
 		antigravityV1.POST("/messages/count_tokens", h.Gateway.CountTokens)
+		antigravityV1.POST("/chat/completions", idempotency, h.ChatCompletions.ChatCompletions)
+		antigravityV1.POST("/responses", idempotency, h.OpenAIGateway.Responses)
+		antigravityV1.POST("/images/generations", h.OpenAIGateway.ImageGenerations)
+		antigravityV1.POST("/images/edits", h.OpenAIGateway.ImageEdits)
 		antigravityV1.GET("/models", h.Gateway.AntigravityModels)
 		antigravityV1.GET("/usage", h.Gateway.Usage)
 	}
 
 	antigravityV1Beta := r.Group("/antigravity/v1beta")
 	antigravityV1Beta.Use(bodyLimit)
+	antigravityV1Beta.Use(requestTracing)
 	antigravityV1Beta.Use(clientRequestID)
 	antigravityV1Beta.Use(opsErrorLogger)
+	antigravityV1Beta.Use(gatewayMetrics)
 	antigravityV1Beta.Use(middleware.ForcePlatform(service.PlatformAntigravity))
-	antigravityV1Beta.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, cfg))
+	antigravityV1Beta.Use(middleware.APIKeyAuthWithSubscriptionGoogle(apiKeyService, subscriptionService, idempotencyService, cfg))
 	antigravityV1Beta.Use(middleware.RequestContentLogger(requestContentLogService, "antigravity"))
 	{
 		antigravityV1Beta.GET("/models", h.Gateway.GeminiV1BetaListModels)
 		antigravityV1Beta.GET("/models/:model", h.Gateway.GeminiV1BetaGetModel)
-		antigravityV1Beta.POST("/models/*modelAction", h.Gateway.GeminiV1BetaModels)
+		antigravityV1Beta.POST("/models/*modelAction", idempotency, h.Gateway.GeminiV1BetaModels)
 	}
 }
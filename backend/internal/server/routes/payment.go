@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/handler"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPaymentRoutes 注册在线支付路由
+func RegisterPaymentRoutes(
+	v1 *gin.RouterGroup,
+	h *handler.Handlers,
+	jwtAuth middleware.JWTAuthMiddleware,
+) {
+	payments := v1.Group("/payments")
+
+	// 渠道异步回调（公开，签名在 service 层校验）
+	payments.GET("/callback/:provider", h.Payment.Callback)
+	payments.POST("/callback/:provider", h.Payment.Callback)
+
+	authenticated := payments.Group("")
+	authenticated.Use(gin.HandlerFunc(jwtAuth))
+	{
+		authenticated.GET("/options", h.Payment.GetOptions)
+		authenticated.POST("/orders", h.Payment.CreateOrder)
+		authenticated.GET("/orders", h.Payment.ListOrders)
+		authenticated.GET("/orders/:order_no", h.Payment.GetOrder)
+	}
+}
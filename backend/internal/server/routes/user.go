@@ -87,5 +87,14 @@ func RegisterUserRoutes(
 			subscriptions.GET("/progress", h.Subscription.GetProgress)
 			subscriptions.GET("/summary", h.Subscription.GetSummary)
 		}
+
+		// 预算告警
+		budgetAlerts := authenticated.Group("/budget-alerts")
+		{
+			budgetAlerts.GET("", h.BudgetAlert.List)
+			budgetAlerts.POST("", h.BudgetAlert.Create)
+			budgetAlerts.PUT("/:id", h.BudgetAlert.Update)
+			budgetAlerts.DELETE("/:id", h.BudgetAlert.Delete)
+		}
 	}
 }
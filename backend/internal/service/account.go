@@ -146,6 +146,20 @@ func (a *Account) IsGeminiCodeAssist() bool {
 	return oauthType == "code_assist"
 }
 
+// SupportsEmbeddings 账号是否可承接向量（embeddings）请求：
+// OpenAI API Key / 上游透传 / Azure 账号走 /embeddings，Gemini API Key 与 AI Studio OAuth 走 batchEmbedContents。
+// ChatGPT OAuth、Code Assist / Google One OAuth 与 Antigravity 账号没有向量接口。
+func (a *Account) SupportsEmbeddings() bool {
+	switch a.Platform {
+	case PlatformOpenAI:
+		return a.Type == AccountTypeAPIKey || a.Type == AccountTypeUpstream || a.Type == AccountTypeAzure
+	case PlatformGemini:
+		return a.Type == AccountTypeAPIKey || a.GeminiOAuthType() == "ai_studio"
+	default:
+		return false
+	}
+}
+
 func (a *Account) CanGetUsage() bool {
 	return a.Type == AccountTypeOAuth
 }
@@ -350,19 +364,11 @@ func parseTempUnschedInt(value any) int {
 
 func (a *Account) GetModelMapping() map[string]string {
 	if a.Credentials == nil {
-		// Antigravity 平台使用默认映射
-		if a.Platform == domain.PlatformAntigravity {
-			return domain.DefaultAntigravityModelMapping
-		}
-		return nil
+		return a.defaultModelMapping()
 	}
 	raw, ok := a.Credentials["model_mapping"]
 	if !ok || raw == nil {
-		// Antigravity 平台使用默认映射
-		if a.Platform == domain.PlatformAntigravity {
-			return domain.DefaultAntigravityModelMapping
-		}
-		return nil
+		return a.defaultModelMapping()
 	}
 	if m, ok := raw.(map[string]any); ok {
 		result := make(map[string]string)
@@ -375,16 +381,31 @@ func (a *Account) GetModelMapping() map[string]string {
 			return result
 		}
 	}
-	// Antigravity 平台使用默认映射
+	return a.defaultModelMapping()
+}
+
+// defaultModelMapping 账号未配置 model_mapping 时的默认映射：
+// Antigravity 平台、Bedrock 账号与 anthropic 平台的 Vertex 账号使用内置映射，其余账号不限制模型
+func (a *Account) defaultModelMapping() map[string]string {
 	if a.Platform == domain.PlatformAntigravity {
 		return domain.DefaultAntigravityModelMapping
 	}
+	if a.Type == domain.AccountTypeBedrock {
+		return domain.DefaultBedrockModelMapping
+	}
+	if a.Type == domain.AccountTypeVertex && a.Platform == domain.PlatformAnthropic {
+		return domain.DefaultVertexClaudeModelMapping
+	}
 	return nil
 }
 
 // IsModelSupported 检查模型是否在 model_mapping 中（支持通配符）
 // 如果未配置 mapping，返回 true（允许所有模型）
+// Azure 账号配置了 deployment_mapping 时，映射后的模型还必须有对应的部署
 func (a *Account) IsModelSupported(requestedModel string) bool {
+	if a.IsAzureOpenAI() && !a.hasAzureDeployment(a.GetMappedModel(requestedModel)) {
+		return false
+	}
 	mapping := a.GetModelMapping()
 	if len(mapping) == 0 {
 		return true // 无映射 = 允许所有
@@ -444,6 +465,57 @@ func (a *Account) GetGeminiBaseURL(defaultBaseURL string) string {
 	return baseURL
 }
 
+// IsBedrock 是否为 AWS Bedrock 账号
+func (a *Account) IsBedrock() bool {
+	return a.Platform == PlatformAnthropic && a.Type == AccountTypeBedrock
+}
+
+// GetBedrockRegion 返回 Bedrock 账号的 AWS 区域
+func (a *Account) GetBedrockRegion() string {
+	return strings.TrimSpace(a.GetCredential("aws_region"))
+}
+
+// GetBedrockModelID 将请求模型映射为 Bedrock 模型 ID。
+// 映射结果为基础模型 ID（anthropic.*）时追加跨区域推理配置文件前缀：
+// 优先使用 credentials.inference_profile_prefix（"none" 表示不追加），否则按区域推导（us/eu/apac）。
+func (a *Account) GetBedrockModelID(requestedModel string) string {
+	modelID := a.GetMappedModel(requestedModel)
+	if !strings.HasPrefix(modelID, "anthropic.") {
+		return modelID
+	}
+	prefix := strings.TrimSpace(a.GetCredential("inference_profile_prefix"))
+	if prefix == "" {
+		region := a.GetBedrockRegion()
+		switch {
+		case strings.HasPrefix(region, "us-gov-"):
+			prefix = "us-gov"
+		case strings.HasPrefix(region, "us-"):
+			prefix = "us"
+		case strings.HasPrefix(region, "eu-"):
+			prefix = "eu"
+		case strings.HasPrefix(region, "ap-"):
+			prefix = "apac"
+		}
+	}
+	if prefix == "" || prefix == "none" {
+		return modelID
+	}
+	return prefix + "." + modelID
+}
+
+// IsVertex 是否为 Google Vertex AI 账号（gemini 平台走 Gemini 模型，anthropic 平台走 Vertex 上的 Claude 模型）
+func (a *Account) IsVertex() bool {
+	return a.Type == AccountTypeVertex
+}
+
+// GetVertexLocation 返回 Vertex 账号的区域，未配置时使用 global 端点
+func (a *Account) GetVertexLocation() string {
+	if location := strings.TrimSpace(a.GetCredential("location")); location != "" {
+		return location
+	}
+	return "global"
+}
+
 func (a *Account) GetExtraString(key string) string {
 	if a.Extra == nil {
 		return ""
@@ -608,6 +680,130 @@ func (a *Account) GetOpenAIBaseURL() string {
 	return "https://api.openai.com"
 }
 
+// IsAzureOpenAI 是否为 Azure OpenAI 账号
+func (a *Account) IsAzureOpenAI() bool {
+	return a.IsOpenAI() && a.Type == AccountTypeAzure
+}
+
+// GetAzureEndpoint 返回 Azure OpenAI 资源端点（如 https://my-resource.openai.azure.com）
+func (a *Account) GetAzureEndpoint() string {
+	endpoint := strings.TrimRight(strings.TrimSpace(a.GetCredential("azure_endpoint")), "/")
+	endpoint = strings.TrimSuffix(endpoint, "/openai/v1")
+	return strings.TrimSuffix(endpoint, "/openai")
+}
+
+// GetAzureAPIVersion 返回 Azure OpenAI 的 api-version，"v1" 表示使用 /openai/v1 GA 接口
+func (a *Account) GetAzureAPIVersion() string {
+	if version := strings.TrimSpace(a.GetCredential("api_version")); version != "" {
+		return version
+	}
+	return azureOpenAIDefaultAPIVersion
+}
+
+// GetAzureDeploymentMapping 返回模型 → 部署名映射（credentials.deployment_mapping，支持通配符）
+func (a *Account) GetAzureDeploymentMapping() map[string]string {
+	if a.Credentials == nil {
+		return nil
+	}
+	m, ok := a.Credentials["deployment_mapping"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+			result[k] = strings.TrimSpace(s)
+		}
+	}
+	return result
+}
+
+// GetAzureDeployment 返回模型对应的 Azure 部署名；未配置映射时部署名与模型名相同
+func (a *Account) GetAzureDeployment(model string) string {
+	mapping := a.GetAzureDeploymentMapping()
+	if len(mapping) == 0 {
+		return model
+	}
+	if deployment, ok := mapping[model]; ok {
+		return deployment
+	}
+	return matchWildcardMapping(mapping, model)
+}
+
+func (a *Account) hasAzureDeployment(model string) bool {
+	mapping := a.GetAzureDeploymentMapping()
+	if len(mapping) == 0 {
+		return true
+	}
+	if _, ok := mapping[model]; ok {
+		return true
+	}
+	for pattern := range mapping {
+		if matchWildcard(pattern, model) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpenAICompat 是否为 OpenAI 兼容 Chat Completions 上游账号（DeepSeek、Qwen、OpenRouter、vLLM 等）
+func (a *Account) IsOpenAICompat() bool {
+	return a.Type == AccountTypeOpenAICompat && (a.Platform == PlatformAnthropic || a.Platform == PlatformOpenAI)
+}
+
+// GetOpenAICompatBaseURL 返回兼容上游的 API 前缀（如 https://api.deepseek.com/v1），请求发往 {base_url}/chat/completions
+func (a *Account) GetOpenAICompatBaseURL() string {
+	baseURL := strings.TrimRight(strings.TrimSpace(a.GetCredential("base_url")), "/")
+	return strings.TrimSuffix(baseURL, "/chat/completions")
+}
+
+// GetModelPricingOverride 返回账号级模型价格覆盖（extra.model_pricing，单位 USD / 百万 token，支持通配符）：
+//
+//	{"deepseek-*": {"input": 0.27, "output": 1.1, "cache_read": 0.07, "cache_creation": 0}}
+//
+// 依次按请求模型与映射后的上游模型匹配；未配置时返回 nil，按全局价格表计费。
+func (a *Account) GetModelPricingOverride(model string) *ModelPricing {
+	if a.Extra == nil {
+		return nil
+	}
+	raw, ok := a.Extra["model_pricing"].(map[string]any)
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	lookup := func(model string) map[string]any {
+		if entry, ok := raw[model].(map[string]any); ok {
+			return entry
+		}
+		matched := ""
+		for pattern := range raw {
+			if matchWildcard(pattern, model) && len(pattern) > len(matched) {
+				matched = pattern
+			}
+		}
+		if matched == "" {
+			return nil
+		}
+		entry, _ := raw[matched].(map[string]any)
+		return entry
+	}
+	entry := lookup(model)
+	if entry == nil {
+		if mapped := a.GetMappedModel(model); mapped != model {
+			entry = lookup(mapped)
+		}
+	}
+	if entry == nil {
+		return nil
+	}
+	const perMillion = 1e-6
+	return &ModelPricing{
+		InputPricePerToken:         parseExtraFloat64(entry["input"]) * perMillion,
+		OutputPricePerToken:        parseExtraFloat64(entry["output"]) * perMillion,
+		CacheReadPricePerToken:     parseExtraFloat64(entry["cache_read"]) * perMillion,
+		CacheCreationPricePerToken: parseExtraFloat64(entry["cache_creation"]) * perMillion,
+	}
+}
+
 func (a *Account) GetOpenAIAccessToken() string {
 	if !a.IsOpenAI() {
 		return ""
@@ -0,0 +1,512 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	accountHealthProbeJobName = "account_health_probe"
+
+	// accountHealthProbeTickInterval 检查各平台是否到达探测时间的频率
+	accountHealthProbeTickInterval = 30 * time.Second
+	// accountHealthProbeRoundLockPrefix 每个平台每轮探测的集群锁；锁不主动释放，TTL 即探测间隔，保证多实例下每个间隔只探测一轮
+	accountHealthProbeRoundLockPrefix = "account_health_probe:round:"
+	accountHealthProbeCleanupInterval = time.Hour
+	accountHealthProbeListPageSize    = 100
+	accountHealthProbeMaxErrorLength  = 2048
+	accountHealthProbeCaptureBytes    = 64 << 10
+)
+
+// 健康探测结果
+const (
+	AccountHealthProbeSuccess     = "success"
+	AccountHealthProbeFailure     = "failure"
+	AccountHealthProbeRateLimited = "rate_limited"
+)
+
+// 健康探测触发的账号状态变更
+const (
+	AccountHealthProbeActionQuarantined = "quarantined"
+	AccountHealthProbeActionRecovered   = "recovered"
+)
+
+// accountHealthProbeStatusPattern 从账号测试错误信息（"API returned 401: ..."）中提取上游状态码
+var accountHealthProbeStatusPattern = regexp.MustCompile(`API returned (\d{3})`)
+
+// AccountHealthProbe 账号健康探测记录
+type AccountHealthProbe struct {
+	ID           int64     `json:"id"`
+	AccountID    int64     `json:"account_id"`
+	Platform     string    `json:"platform"`
+	Model        string    `json:"model"`
+	Result       string    `json:"result"`
+	StatusCode   int       `json:"status_code"`
+	LatencyMs    int64     `json:"latency_ms"`
+	ErrorMessage *string   `json:"error_message,omitempty"`
+	Action       string    `json:"action,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// AccountHealthProbeRepository 健康探测记录仓储接口
+type AccountHealthProbeRepository interface {
+	Create(ctx context.Context, probe *AccountHealthProbe) error
+	ListByAccount(ctx context.Context, accountID int64, page, pageSize int) ([]*AccountHealthProbe, int64, error)
+	// ListRecent 返回账号最近 limit 条探测记录（按时间倒序）
+	ListRecent(ctx context.Context, accountID int64, limit int) ([]*AccountHealthProbe, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// accountConnectionTester 执行一次账号连通性测试（由 AccountTestService 实现）
+type accountConnectionTester interface {
+	TestAccountConnection(c *gin.Context, accountID int64, modelID string) error
+}
+
+// AccountHealthProbeService 后台定时健康探测
+//
+// 按平台配置的间隔对正常与 error 状态的账号发送最小测试请求（复用管理后台的账号测试），记录探测历史：
+//   - 正常账号连续失败 failure_threshold 次后置为 error（隔离），不再参与调度；
+//   - error 账号连续成功 recovery_threshold 次后自动恢复为正常。
+//
+// 连续次数由探测历史推导，不依赖实例内存；上游限流（429/529）只记录不计入连续次数。
+type AccountHealthProbeService struct {
+	accountRepo AccountRepository
+	probeRepo   AccountHealthProbeRepository
+	tester      accountConnectionTester
+	opsRepo     OpsRepository
+	redisClient *redis.Client
+	cfg         *config.Config
+
+	instanceID string
+
+	stopCh    chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+
+	mu            sync.Mutex
+	nextRun       map[string]time.Time
+	lastCleanupAt time.Time
+
+	warnNoRedisOnce sync.Once
+}
+
+func NewAccountHealthProbeService(
+	accountRepo AccountRepository,
+	probeRepo AccountHealthProbeRepository,
+	accountTestService *AccountTestService,
+	opsRepo OpsRepository,
+	redisClient *redis.Client,
+	cfg *config.Config,
+) *AccountHealthProbeService {
+	s := &AccountHealthProbeService{
+		accountRepo: accountRepo,
+		probeRepo:   probeRepo,
+		opsRepo:     opsRepo,
+		redisClient: redisClient,
+		cfg:         cfg,
+		instanceID:  uuid.NewString(),
+		stopCh:      make(chan struct{}),
+		nextRun:     map[string]time.Time{},
+	}
+	if accountTestService != nil {
+		s.tester = accountTestService
+	}
+	return s
+}
+
+func (s *AccountHealthProbeService) enabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.HealthProbe.Enabled
+}
+
+func (s *AccountHealthProbeService) Start() {
+	if !s.enabled() {
+		return
+	}
+	if s.accountRepo == nil || s.probeRepo == nil || s.tester == nil {
+		log.Printf("[AccountHealthProbe] not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.wg.Add(1)
+		go s.run()
+		log.Printf("[AccountHealthProbe] started (failure_threshold=%d recovery_threshold=%d concurrency=%d)",
+			s.cfg.HealthProbe.FailureThreshold, s.cfg.HealthProbe.RecoveryThreshold, s.cfg.HealthProbe.Concurrency)
+	})
+}
+
+func (s *AccountHealthProbeService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *AccountHealthProbeService) run() {
+	defer s.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// 启动后先等待一个周期，避免与启动期的令牌刷新等任务同时压向上游
+	ticker := time.NewTicker(accountHealthProbeTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+// tick 对到达探测时间的平台执行一轮探测
+func (s *AccountHealthProbeService) tick(ctx context.Context) {
+	now := time.Now()
+	for _, platform := range s.duePlatforms(now) {
+		if ctx.Err() != nil {
+			return
+		}
+		probeCfg := s.cfg.HealthProbe.Platforms[platform]
+		interval := time.Duration(probeCfg.IntervalSeconds) * time.Second
+		if !s.tryAcquireRoundLock(ctx, platform, interval) {
+			continue
+		}
+		s.runRound(ctx, platform, probeCfg.Model)
+	}
+	s.maybeCleanup(ctx, now)
+}
+
+// duePlatforms 返回本实例到达探测时间的平台，并推进下次探测时间
+func (s *AccountHealthProbeService) duePlatforms(now time.Time) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []string
+	for platform, probeCfg := range s.cfg.HealthProbe.Platforms {
+		if probeCfg.IntervalSeconds <= 0 {
+			continue
+		}
+		if next, ok := s.nextRun[platform]; ok && now.Before(next) {
+			continue
+		}
+		s.nextRun[platform] = now.Add(time.Duration(probeCfg.IntervalSeconds) * time.Second)
+		due = append(due, platform)
+	}
+	sort.Strings(due)
+	return due
+}
+
+// tryAcquireRoundLock 多实例部署时只有抢到锁的实例执行本轮探测
+func (s *AccountHealthProbeService) tryAcquireRoundLock(ctx context.Context, platform string, interval time.Duration) bool {
+	// In simple run mode, assume single instance.
+	if s.cfg.RunMode == config.RunModeSimple {
+		return true
+	}
+	if s.redisClient == nil {
+		s.warnNoRedisOnce.Do(func() {
+			log.Printf("[AccountHealthProbe] redis not configured; running without distributed lock")
+		})
+		return true
+	}
+	// 预留一个检查周期，避免各实例计时偏差导致整轮被跳过
+	ttl := interval - accountHealthProbeTickInterval
+	if ttl < accountHealthProbeTickInterval {
+		ttl = accountHealthProbeTickInterval
+	}
+	ok, err := s.redisClient.SetNX(ctx, accountHealthProbeRoundLockPrefix+platform, s.instanceID, ttl).Result()
+	if err != nil {
+		// Prefer fail-closed to avoid every instance probing the same accounts when Redis is flaky.
+		log.Printf("[AccountHealthProbe] round lock SetNX failed; skipping platform=%s: %v", platform, err)
+		return false
+	}
+	return ok
+}
+
+// runRound 对平台下所有候选账号执行一轮探测
+func (s *AccountHealthProbeService) runRound(ctx context.Context, platform, model string) {
+	startedAt := time.Now().UTC()
+
+	accounts, err := s.listCandidates(ctx, platform)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[AccountHealthProbe] list accounts failed: platform=%s err=%v", platform, err)
+			s.recordHeartbeat(startedAt, time.Since(startedAt), "", err)
+		}
+		return
+	}
+
+	var (
+		mu    sync.Mutex
+		stats = map[string]int{}
+		wg    sync.WaitGroup
+	)
+	sem := make(chan struct{}, s.cfg.HealthProbe.Concurrency)
+	for i := range accounts {
+		account := &accounts[i]
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				probe := s.probeAccount(ctx, account, model)
+				if probe == nil {
+					return
+				}
+				mu.Lock()
+				stats[probe.Result]++
+				if probe.Action != "" {
+					stats[probe.Action]++
+				}
+				mu.Unlock()
+			}()
+		}
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	result := fmt.Sprintf("platform=%s accounts=%d success=%d failure=%d rate_limited=%d quarantined=%d recovered=%d",
+		platform, len(accounts), stats[AccountHealthProbeSuccess], stats[AccountHealthProbeFailure], stats[AccountHealthProbeRateLimited],
+		stats[AccountHealthProbeActionQuarantined], stats[AccountHealthProbeActionRecovered])
+	s.recordHeartbeat(startedAt, time.Since(startedAt), result, nil)
+	log.Printf("[AccountHealthProbe] round complete: %s", result)
+}
+
+// listCandidates 返回需要探测的账号：可调度的正常账号，以及未被手动暂停的 error 账号。
+// 限流、过载、临时不可调度中的账号本就不接流量，跳过以免把限流误判为故障。
+func (s *AccountHealthProbeService) listCandidates(ctx context.Context, platform string) ([]Account, error) {
+	active, err := s.accountRepo.ListByPlatform(ctx, platform)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]Account, 0, len(active))
+	for i := range active {
+		if active[i].IsSchedulable() {
+			candidates = append(candidates, active[i])
+		}
+	}
+
+	for page := 1; ; page++ {
+		params := pagination.PaginationParams{Page: page, PageSize: accountHealthProbeListPageSize}
+		errored, result, err := s.accountRepo.ListWithFilters(ctx, params, platform, "", StatusError, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range errored {
+			if errored[i].Schedulable {
+				candidates = append(candidates, errored[i])
+			}
+		}
+		if result == nil || page >= result.Pages || len(errored) == 0 {
+			break
+		}
+	}
+	return candidates, nil
+}
+
+// probeAccount 探测单个账号、记录结果并按连续次数隔离或恢复；服务停止导致的中断不记录
+func (s *AccountHealthProbeService) probeAccount(ctx context.Context, account *Account, model string) *AccountHealthProbe {
+	probeCtx, cancel := context.WithTimeout(ctx, time.Duration(s.cfg.HealthProbe.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	startedAt := time.Now()
+	testErr := s.runConnectionTest(probeCtx, account.ID, strings.TrimSpace(model))
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	probe := &AccountHealthProbe{
+		AccountID: account.ID,
+		Platform:  account.Platform,
+		Model:     strings.TrimSpace(model),
+		Result:    AccountHealthProbeSuccess,
+		LatencyMs: time.Since(startedAt).Milliseconds(),
+	}
+	if testErr != nil {
+		probe.Result, probe.StatusCode = classifyAccountHealthProbeError(testErr)
+		msg := truncateString(sanitizeUpstreamErrorMessage(testErr.Error()), accountHealthProbeMaxErrorLength)
+		probe.ErrorMessage = &msg
+	}
+
+	probe.Action = s.applyTransition(ctx, account, probe)
+	if err := s.probeRepo.Create(ctx, probe); err != nil {
+		log.Printf("[AccountHealthProbe] save probe failed: account=%d err=%v", account.ID, err)
+	}
+	return probe
+}
+
+// runConnectionTest 通过合成的 gin.Context 复用账号测试逻辑；测试输出的 SSE 事件直接丢弃
+func (s *AccountHealthProbeService) runConnectionTest(ctx context.Context, accountID int64, model string) error {
+	w := newLimitedResponseWriter(accountHealthProbeCaptureBytes)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost/health-probe", bytes.NewReader(nil))
+	if err != nil {
+		return err
+	}
+	return s.tester.TestAccountConnection(newBackgroundGinContext(req, w, nil), accountID, model)
+}
+
+// classifyAccountHealthProbeError 上游限流/过载说明账号本身可用，不计入连续失败
+func classifyAccountHealthProbeError(err error) (string, int) {
+	statusCode := 0
+	if m := accountHealthProbeStatusPattern.FindStringSubmatch(err.Error()); len(m) == 2 {
+		statusCode, _ = strconv.Atoi(m[1])
+	}
+	if statusCode == http.StatusTooManyRequests || statusCode == 529 {
+		return AccountHealthProbeRateLimited, statusCode
+	}
+	return AccountHealthProbeFailure, statusCode
+}
+
+// applyTransition 根据本次结果与历史记录决定是否隔离或恢复账号，返回执行的动作
+func (s *AccountHealthProbeService) applyTransition(ctx context.Context, account *Account, probe *AccountHealthProbe) string {
+	var want, action string
+	var threshold int
+	switch {
+	case account.Status == StatusActive && probe.Result == AccountHealthProbeFailure:
+		want, action, threshold = AccountHealthProbeFailure, AccountHealthProbeActionQuarantined, s.cfg.HealthProbe.FailureThreshold
+	case account.Status == StatusError && probe.Result == AccountHealthProbeSuccess:
+		want, action, threshold = AccountHealthProbeSuccess, AccountHealthProbeActionRecovered, s.cfg.HealthProbe.RecoveryThreshold
+	default:
+		return ""
+	}
+
+	consecutive := 1
+	if threshold > 1 {
+		// 限流记录不计数但会占用条数，多取一些历史
+		recent, err := s.probeRepo.ListRecent(ctx, account.ID, threshold*3)
+		if err != nil {
+			log.Printf("[AccountHealthProbe] load probe history failed: account=%d err=%v", account.ID, err)
+			return ""
+		}
+		consecutive += countConsecutiveProbeResults(recent, want)
+	}
+	if consecutive < threshold {
+		return ""
+	}
+
+	if action == AccountHealthProbeActionQuarantined {
+		reason := ""
+		if probe.ErrorMessage != nil {
+			reason = *probe.ErrorMessage
+		}
+		msg := fmt.Sprintf("Health probe failed %d consecutive times: %s", consecutive, reason)
+		if err := s.accountRepo.SetError(ctx, account.ID, truncateString(msg, accountHealthProbeMaxErrorLength)); err != nil {
+			log.Printf("[AccountHealthProbe] quarantine account failed: account=%d err=%v", account.ID, err)
+			return ""
+		}
+		log.Printf("[AccountHealthProbe] account quarantined: account=%d(%s) consecutive_failures=%d", account.ID, account.Name, consecutive)
+		return action
+	}
+
+	if err := s.accountRepo.ClearError(ctx, account.ID); err != nil {
+		log.Printf("[AccountHealthProbe] recover account failed: account=%d err=%v", account.ID, err)
+		return ""
+	}
+	log.Printf("[AccountHealthProbe] account recovered: account=%d(%s) consecutive_successes=%d", account.ID, account.Name, consecutive)
+	return action
+}
+
+// countConsecutiveProbeResults 统计最近记录中连续为 want 的次数（跳过限流记录）。
+// 已触发状态变更的记录截断计数，避免恢复后立刻因旧的失败记录再次被隔离。
+func countConsecutiveProbeResults(recent []*AccountHealthProbe, want string) int {
+	count := 0
+	for _, probe := range recent {
+		if probe == nil || probe.Result == AccountHealthProbeRateLimited {
+			continue
+		}
+		if probe.Result != want || probe.Action != "" {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// maybeCleanup 按保留期删除过期探测记录（每小时最多一次）
+func (s *AccountHealthProbeService) maybeCleanup(ctx context.Context, now time.Time) {
+	days := s.cfg.HealthProbe.RetentionDays
+	if days <= 0 {
+		return
+	}
+	s.mu.Lock()
+	if !s.lastCleanupAt.IsZero() && now.Sub(s.lastCleanupAt) < accountHealthProbeCleanupInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastCleanupAt = now
+	s.mu.Unlock()
+
+	deleted, err := s.probeRepo.DeleteBefore(ctx, now.UTC().AddDate(0, 0, -days))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[AccountHealthProbe] cleanup failed: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		log.Printf("[AccountHealthProbe] cleaned up %d probe records", deleted)
+	}
+}
+
+// recordHeartbeat 复用运维监控的任务心跳表，便于在 Ops 面板中观察探测任务状态
+func (s *AccountHealthProbeService) recordHeartbeat(runAt time.Time, duration time.Duration, result string, runErr error) {
+	if s == nil || s.opsRepo == nil {
+		return
+	}
+	now := time.Now().UTC()
+	durMs := duration.Milliseconds()
+	input := &OpsUpsertJobHeartbeatInput{
+		JobName:        accountHealthProbeJobName,
+		LastRunAt:      &runAt,
+		LastDurationMs: &durMs,
+	}
+	if runErr != nil {
+		msg := truncateString(runErr.Error(), 2048)
+		input.LastErrorAt = &now
+		input.LastError = &msg
+	} else {
+		input.LastSuccessAt = &now
+		input.LastResult = &result
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_ = s.opsRepo.UpsertJobHeartbeat(ctx, input)
+}
+
+// ListProbes 分页查询账号的探测历史
+func (s *AccountHealthProbeService) ListProbes(ctx context.Context, accountID int64, page, pageSize int) ([]*AccountHealthProbe, int64, error) {
+	if s == nil || s.probeRepo == nil {
+		return []*AccountHealthProbe{}, 0, nil
+	}
+	return s.probeRepo.ListByAccount(ctx, accountID, page, pageSize)
+}
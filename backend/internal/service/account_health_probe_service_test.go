@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type healthProbeAccountRepo struct {
+	AccountRepository
+	mu       sync.Mutex
+	accounts []Account
+	errored  map[int64]string
+	cleared  []int64
+}
+
+func (r *healthProbeAccountRepo) ListByPlatform(ctx context.Context, platform string) ([]Account, error) {
+	var out []Account
+	for _, acc := range r.accounts {
+		if acc.Platform == platform && acc.Status == StatusActive {
+			out = append(out, acc)
+		}
+	}
+	return out, nil
+}
+
+func (r *healthProbeAccountRepo) ListWithFilters(ctx context.Context, params pagination.PaginationParams, platform, accountType, status, search string, groupID int64) ([]Account, *pagination.PaginationResult, error) {
+	var out []Account
+	for _, acc := range r.accounts {
+		if acc.Platform == platform && acc.Status == status {
+			out = append(out, acc)
+		}
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out)), Page: 1, PageSize: params.PageSize, Pages: 1}, nil
+}
+
+func (r *healthProbeAccountRepo) SetError(ctx context.Context, id int64, errorMsg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errored[id] = errorMsg
+	return nil
+}
+
+func (r *healthProbeAccountRepo) ClearError(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleared = append(r.cleared, id)
+	return nil
+}
+
+type healthProbeMemoryRepo struct {
+	mu     sync.Mutex
+	probes []*AccountHealthProbe
+}
+
+func (r *healthProbeMemoryRepo) Create(ctx context.Context, probe *AccountHealthProbe) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	probe.ID = int64(len(r.probes) + 1)
+	r.probes = append(r.probes, probe)
+	return nil
+}
+
+func (r *healthProbeMemoryRepo) ListByAccount(ctx context.Context, accountID int64, page, pageSize int) ([]*AccountHealthProbe, int64, error) {
+	recent, err := r.ListRecent(ctx, accountID, len(r.probes))
+	return recent, int64(len(recent)), err
+}
+
+func (r *healthProbeMemoryRepo) ListRecent(ctx context.Context, accountID int64, limit int) ([]*AccountHealthProbe, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*AccountHealthProbe
+	for i := len(r.probes) - 1; i >= 0 && len(out) < limit; i-- {
+		if r.probes[i].AccountID == accountID {
+			out = append(out, r.probes[i])
+		}
+	}
+	return out, nil
+}
+
+func (r *healthProbeMemoryRepo) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (r *healthProbeMemoryRepo) latest(accountID int64) *AccountHealthProbe {
+	recent, _ := r.ListRecent(context.Background(), accountID, 1)
+	if len(recent) == 0 {
+		return nil
+	}
+	return recent[0]
+}
+
+type healthProbeTester struct {
+	mu     sync.Mutex
+	errs   map[int64]error
+	tested []int64
+	models []string
+}
+
+func (t *healthProbeTester) TestAccountConnection(c *gin.Context, accountID int64, modelID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tested = append(t.tested, accountID)
+	t.models = append(t.models, modelID)
+	return t.errs[accountID]
+}
+
+func TestClassifyAccountHealthProbeError(t *testing.T) {
+	result, status := classifyAccountHealthProbeError(errors.New("API returned 401: invalid x-api-key"))
+	require.Equal(t, AccountHealthProbeFailure, result)
+	require.Equal(t, 401, status)
+
+	result, status = classifyAccountHealthProbeError(errors.New("API returned 429: rate limited"))
+	require.Equal(t, AccountHealthProbeRateLimited, result)
+	require.Equal(t, 429, status)
+
+	result, status = classifyAccountHealthProbeError(errors.New("Request failed: dial tcp: i/o timeout"))
+	require.Equal(t, AccountHealthProbeFailure, result)
+	require.Equal(t, 0, status)
+}
+
+func TestCountConsecutiveProbeResults(t *testing.T) {
+	recent := []*AccountHealthProbe{
+		{Result: AccountHealthProbeFailure},
+		{Result: AccountHealthProbeRateLimited},
+		{Result: AccountHealthProbeFailure},
+		{Result: AccountHealthProbeSuccess},
+		{Result: AccountHealthProbeFailure},
+	}
+	require.Equal(t, 2, countConsecutiveProbeResults(recent, AccountHealthProbeFailure))
+	require.Equal(t, 0, countConsecutiveProbeResults(recent, AccountHealthProbeSuccess))
+
+	// 已触发隔离的记录截断计数：管理员手动恢复后需要重新累计
+	recent = []*AccountHealthProbe{
+		{Result: AccountHealthProbeFailure},
+		{Result: AccountHealthProbeFailure, Action: AccountHealthProbeActionQuarantined},
+		{Result: AccountHealthProbeFailure},
+	}
+	require.Equal(t, 1, countConsecutiveProbeResults(recent, AccountHealthProbeFailure))
+}
+
+func TestAccountHealthProbeService_RunRound(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	accountRepo := &healthProbeAccountRepo{
+		accounts: []Account{
+			{ID: 1, Name: "dead", Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true},
+			{ID: 2, Name: "revived", Platform: PlatformAnthropic, Status: StatusError, Schedulable: true},
+			{ID: 3, Name: "busy", Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true},
+			{ID: 4, Name: "limited", Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true, RateLimitResetAt: &future},
+			{ID: 5, Name: "paused", Platform: PlatformAnthropic, Status: StatusError, Schedulable: false},
+			{ID: 6, Name: "openai", Platform: PlatformOpenAI, Status: StatusActive, Schedulable: true},
+		},
+		errored: map[int64]string{},
+	}
+	probeRepo := &healthProbeMemoryRepo{probes: []*AccountHealthProbe{
+		{AccountID: 1, Result: AccountHealthProbeFailure},
+		{AccountID: 1, Result: AccountHealthProbeRateLimited},
+		{AccountID: 1, Result: AccountHealthProbeFailure},
+		{AccountID: 2, Result: AccountHealthProbeSuccess},
+	}}
+	tester := &healthProbeTester{errs: map[int64]error{
+		1: errors.New("API returned 401: invalid x-api-key"),
+		3: errors.New("API returned 529: overloaded"),
+	}}
+	cfg := &config.Config{HealthProbe: config.HealthProbeConfig{
+		Enabled:           true,
+		FailureThreshold:  3,
+		RecoveryThreshold: 2,
+		Concurrency:       2,
+		TimeoutSeconds:    5,
+	}}
+	svc := NewAccountHealthProbeService(accountRepo, probeRepo, nil, nil, nil, cfg)
+	svc.tester = tester
+
+	svc.runRound(context.Background(), PlatformAnthropic, "claude-haiku-4-5")
+
+	require.ElementsMatch(t, []int64{1, 2, 3}, tester.tested)
+	require.Equal(t, []string{"claude-haiku-4-5", "claude-haiku-4-5", "claude-haiku-4-5"}, tester.models)
+
+	require.Contains(t, accountRepo.errored, int64(1))
+	require.Contains(t, accountRepo.errored[1], "Health probe failed 3 consecutive times")
+	require.Equal(t, AccountHealthProbeActionQuarantined, probeRepo.latest(1).Action)
+	require.Equal(t, 401, probeRepo.latest(1).StatusCode)
+
+	require.Equal(t, []int64{2}, accountRepo.cleared)
+	require.Equal(t, AccountHealthProbeActionRecovered, probeRepo.latest(2).Action)
+
+	require.NotContains(t, accountRepo.errored, int64(3))
+	require.Equal(t, AccountHealthProbeRateLimited, probeRepo.latest(3).Result)
+	require.Empty(t, probeRepo.latest(3).Action)
+}
+
+func TestAccountHealthProbeService_DuePlatforms(t *testing.T) {
+	cfg := &config.Config{HealthProbe: config.HealthProbeConfig{
+		Enabled: true,
+		Platforms: map[string]config.HealthProbePlatformConfig{
+			PlatformAnthropic: {IntervalSeconds: 600},
+			PlatformOpenAI:    {IntervalSeconds: 60},
+			PlatformGemini:    {IntervalSeconds: 0},
+		},
+	}}
+	svc := NewAccountHealthProbeService(nil, nil, nil, nil, nil, cfg)
+
+	now := time.Now()
+	require.Equal(t, []string{PlatformAnthropic, PlatformOpenAI}, svc.duePlatforms(now))
+	require.Empty(t, svc.duePlatforms(now.Add(30*time.Second)))
+	require.Equal(t, []string{PlatformOpenAI}, svc.duePlatforms(now.Add(90*time.Second)))
+}
@@ -48,6 +48,8 @@ type AccountTestService struct {
 	geminiTokenProvider       *GeminiTokenProvider
 	antigravityGatewayService *AntigravityGatewayService
 	httpUpstream              HTTPUpstream
+	bedrockCredentials        *BedrockCredentialProvider
+	vertexTokenProvider       *VertexTokenProvider
 	cfg                       *config.Config
 }
 
@@ -57,6 +59,8 @@ func NewAccountTestService(
 	geminiTokenProvider *GeminiTokenProvider,
 	antigravityGatewayService *AntigravityGatewayService,
 	httpUpstream HTTPUpstream,
+	bedrockCredentials *BedrockCredentialProvider,
+	vertexTokenProvider *VertexTokenProvider,
 	cfg *config.Config,
 ) *AccountTestService {
 	return &AccountTestService{
@@ -64,6 +68,8 @@ func NewAccountTestService(
 		geminiTokenProvider:       geminiTokenProvider,
 		antigravityGatewayService: antigravityGatewayService,
 		httpUpstream:              httpUpstream,
+		bedrockCredentials:        bedrockCredentials,
+		vertexTokenProvider:       vertexTokenProvider,
 		cfg:                       cfg,
 	}
 }
@@ -151,6 +157,10 @@ func (s *AccountTestService) TestAccountConnection(c *gin.Context, accountID int
 	}
 
 	// Route to platform-specific test method
+	if account.IsOpenAICompat() {
+		return s.testOpenAICompatAccountConnection(c, account, modelID)
+	}
+
 	if account.IsOpenAI() {
 		return s.testOpenAIAccountConnection(c, account, modelID)
 	}
@@ -163,9 +173,204 @@ func (s *AccountTestService) TestAccountConnection(c *gin.Context, accountID int
 		return s.testAntigravityAccountConnection(c, account, modelID)
 	}
 
+	if account.IsBedrock() {
+		return s.testBedrockAccountConnection(c, account, modelID)
+	}
+
+	if account.IsVertex() {
+		return s.testVertexClaudeAccountConnection(c, account, modelID)
+	}
+
 	return s.testClaudeAccountConnection(c, account, modelID)
 }
 
+// testVertexClaudeAccountConnection tests a Vertex AI account serving Claude models
+func (s *AccountTestService) testVertexClaudeAccountConnection(c *gin.Context, account *Account, modelID string) error {
+	ctx := c.Request.Context()
+
+	testModelID := modelID
+	if testModelID == "" {
+		testModelID = claude.DefaultTestModel
+	}
+	vertexModelID := account.GetMappedModel(testModelID)
+
+	payload, err := createTestPayload(testModelID)
+	if err != nil {
+		return s.sendErrorAndEnd(c, "Failed to create test payload")
+	}
+	payloadBytes, _ := json.Marshal(payload)
+	body, err := buildVertexClaudeRequestBody(payloadBytes)
+	if err != nil {
+		return s.sendErrorAndEnd(c, "Failed to create test payload")
+	}
+
+	if s.vertexTokenProvider == nil {
+		return s.sendErrorAndEnd(c, "Vertex token provider not configured")
+	}
+	token, err := s.vertexTokenProvider.GetAccessToken(ctx, account)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Failed to get access token: %s", err.Error()))
+	}
+	targetURL, err := vertexModelURL(account, vertexPublisherAnthropic, vertexModelID, "streamRawPredict")
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Failed to create request: %s", err.Error()))
+	}
+	req, err := newVertexRequest(ctx, targetURL, token, body)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Failed to create request: %s", err.Error()))
+	}
+
+	// Set SSE headers
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no")
+	c.Writer.Flush()
+
+	s.sendEvent(c, TestEvent{Type: "test_start", Model: vertexModelID})
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+
+	resp, err := s.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Request failed: %s", err.Error()))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(errBody)))
+	}
+
+	return s.processClaudeStream(c, resp.Body)
+}
+
+// testBedrockAccountConnection tests an AWS Bedrock account's connection
+func (s *AccountTestService) testBedrockAccountConnection(c *gin.Context, account *Account, modelID string) error {
+	ctx := c.Request.Context()
+
+	testModelID := modelID
+	if testModelID == "" {
+		testModelID = claude.DefaultTestModel
+	}
+	bedrockModelID := account.GetBedrockModelID(testModelID)
+
+	payload, err := createTestPayload(testModelID)
+	if err != nil {
+		return s.sendErrorAndEnd(c, "Failed to create test payload")
+	}
+	payloadBytes, _ := json.Marshal(payload)
+	body, err := buildBedrockRequestBody(payloadBytes, "")
+	if err != nil {
+		return s.sendErrorAndEnd(c, "Failed to create test payload")
+	}
+
+	req, err := newBedrockRuntimeRequest(ctx, s.bedrockCredentials, account, bedrockRuntimeURL(account, bedrockModelID, "invoke-with-response-stream"), body, "application/vnd.amazon.eventstream")
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Failed to create request: %s", err.Error()))
+	}
+
+	// Set SSE headers
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no")
+	c.Writer.Flush()
+
+	s.sendEvent(c, TestEvent{Type: "test_start", Model: bedrockModelID})
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+
+	resp, err := s.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Request failed: %s", err.Error()))
+	}
+	adaptBedrockResponse(resp, true)
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(errBody)))
+	}
+
+	return s.processClaudeStream(c, resp.Body)
+}
+
+// testOpenAICompatAccountConnection tests an OpenAI-compatible chat completions account.
+// 兼容上游没有统一的默认模型：未指定模型时使用平台默认测试模型经账号映射后的结果。
+func (s *AccountTestService) testOpenAICompatAccountConnection(c *gin.Context, account *Account, modelID string) error {
+	ctx := c.Request.Context()
+
+	testModelID := modelID
+	if testModelID == "" {
+		defaultModel := claude.DefaultTestModel
+		if account.IsOpenAI() {
+			defaultModel = openai.DefaultTestModel
+		}
+		if mapped := account.GetMappedModel(defaultModel); mapped != defaultModel {
+			testModelID = mapped
+		}
+	} else {
+		testModelID = account.GetMappedModel(testModelID)
+	}
+	if testModelID == "" {
+		return s.sendErrorAndEnd(c, "Model is required for OpenAI-compatible accounts")
+	}
+
+	baseURL := account.GetOpenAICompatBaseURL()
+	if baseURL == "" {
+		return s.sendErrorAndEnd(c, "No base URL available")
+	}
+	validatedURL, err := s.validateUpstreamBaseURL(baseURL)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Invalid base URL: %s", err.Error()))
+	}
+
+	payload, _ := json.Marshal(map[string]any{
+		"model":      testModelID,
+		"messages":   []map[string]string{{"role": "user", "content": "hi"}},
+		"max_tokens": 32,
+		"stream":     true,
+	})
+	req, err := newOpenAICompatRequest(ctx, strings.TrimRight(validatedURL, "/")+"/chat/completions", strings.TrimSpace(account.GetCredential("api_key")), payload, true)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Failed to create request: %s", err.Error()))
+	}
+
+	// Set SSE headers
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("X-Accel-Buffering", "no")
+	c.Writer.Flush()
+
+	s.sendEvent(c, TestEvent{Type: "test_start", Model: testModelID})
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+
+	resp, err := s.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		return s.sendErrorAndEnd(c, fmt.Sprintf("Request failed: %s", err.Error()))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		errBody, _ := io.ReadAll(resp.Body)
+		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(errBody)))
+	}
+
+	return s.processChatCompletionsStream(c, resp.Body)
+}
+
 // testClaudeAccountConnection tests an Anthropic Claude account's connection
 func (s *AccountTestService) testClaudeAccountConnection(c *gin.Context, account *Account, modelID string) error {
 	ctx := c.Request.Context()
@@ -334,6 +539,22 @@ func (s *AccountTestService) testOpenAIAccountConnection(c *gin.Context, account
 			return s.sendErrorAndEnd(c, fmt.Sprintf("Invalid base URL: %s", err.Error()))
 		}
 		apiURL = strings.TrimSuffix(normalizedBaseURL, "/") + "/responses"
+	} else if account.IsAzureOpenAI() {
+		// Azure OpenAI - use api-key header, model in payload is the deployment name
+		authToken = strings.TrimSpace(account.GetCredential("api_key"))
+		if authToken == "" {
+			return s.sendErrorAndEnd(c, "No API key available")
+		}
+		endpoint := account.GetAzureEndpoint()
+		if endpoint == "" {
+			return s.sendErrorAndEnd(c, "No Azure endpoint configured")
+		}
+		normalizedBaseURL, err := s.validateUpstreamBaseURL(endpoint)
+		if err != nil {
+			return s.sendErrorAndEnd(c, fmt.Sprintf("Invalid base URL: %s", err.Error()))
+		}
+		testModelID = account.GetAzureDeployment(account.GetMappedModel(testModelID))
+		apiURL = azureOpenAIURL(normalizedBaseURL, account.GetAzureAPIVersion(), testModelID, "responses")
 	} else {
 		return s.sendErrorAndEnd(c, fmt.Sprintf("Unsupported account type: %s", account.Type))
 	}
@@ -359,7 +580,11 @@ func (s *AccountTestService) testOpenAIAccountConnection(c *gin.Context, account
 
 	// Set common headers
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+authToken)
+	if account.IsAzureOpenAI() {
+		req.Header.Set(azureAPIKeyHeader, authToken)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
 
 	// Set OAuth-specific headers for ChatGPT internal API
 	if isOAuth {
@@ -401,8 +626,8 @@ func (s *AccountTestService) testGeminiAccountConnection(c *gin.Context, account
 		testModelID = geminicli.DefaultTestModel
 	}
 
-	// For API Key accounts with model mapping, map the model
-	if account.Type == AccountTypeAPIKey {
+	// For API Key / Vertex accounts with model mapping, map the model
+	if account.Type == AccountTypeAPIKey || account.IsVertex() {
 		mapping := account.GetModelMapping()
 		if len(mapping) > 0 {
 			if mappedModel, exists := mapping[testModelID]; exists {
@@ -430,6 +655,8 @@ func (s *AccountTestService) testGeminiAccountConnection(c *gin.Context, account
 		req, err = s.buildGeminiAPIKeyRequest(ctx, account, testModelID, payload)
 	case AccountTypeOAuth:
 		req, err = s.buildGeminiOAuthRequest(ctx, account, testModelID, payload)
+	case AccountTypeVertex:
+		req, err = buildVertexGeminiRequest(ctx, s.vertexTokenProvider, account, testModelID, "streamGenerateContent", true, payload)
 	default:
 		return s.sendErrorAndEnd(c, fmt.Sprintf("Unsupported account type: %s", account.Type))
 	}
@@ -830,6 +1057,59 @@ func (s *AccountTestService) processOpenAIStream(c *gin.Context, body io.Reader)
 	}
 }
 
+// processChatCompletionsStream processes the SSE stream from a Chat Completions API
+func (s *AccountTestService) processChatCompletionsStream(c *gin.Context, body io.Reader) error {
+	reader := bufio.NewReader(body)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				s.sendEvent(c, TestEvent{Type: "test_complete", Success: true})
+				return nil
+			}
+			return s.sendErrorAndEnd(c, fmt.Sprintf("Stream read error: %s", err.Error()))
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" || !sseDataPrefix.MatchString(line) {
+			continue
+		}
+
+		jsonStr := sseDataPrefix.ReplaceAllString(line, "")
+		if jsonStr == "[DONE]" {
+			s.sendEvent(c, TestEvent{Type: "test_complete", Success: true})
+			return nil
+		}
+
+		var data struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+			continue
+		}
+		if data.Error != nil {
+			errorMsg := data.Error.Message
+			if errorMsg == "" {
+				errorMsg = "Unknown error"
+			}
+			return s.sendErrorAndEnd(c, errorMsg)
+		}
+		for _, choice := range data.Choices {
+			if choice.Delta.Content != "" {
+				s.sendEvent(c, TestEvent{Type: "content", Text: choice.Delta.Content})
+			}
+		}
+	}
+}
+
 // sendEvent sends a SSE event to the client
 func (s *AccountTestService) sendEvent(c *gin.Context, event TestEvent) {
 	eventJSON, _ := json.Marshal(event)
@@ -124,6 +124,18 @@ type CreateGroupInput struct {
 	MCPXMLInject        *bool
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string
+	// 精确响应缓存
+	ResponseCacheEnabled    bool
+	ResponseCacheTTLSeconds int
+	// 批处理计费折扣（nil 表示不打折）
+	BatchDiscountMultiplier *float64
+	// 对冲请求
+	HedgeEnabled bool
+	HedgeDelayMs int
+	// Prompt Cache 前缀路由
+	PromptCacheRoutingEnabled bool
+	// 自动插入 cache_control 断点
+	AutoCacheControlEnabled bool
 	// 从指定分组复制账号（创建分组后在同一事务内绑定）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -153,6 +165,18 @@ type UpdateGroupInput struct {
 	MCPXMLInject        *bool
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes *[]string
+	// 精确响应缓存
+	ResponseCacheEnabled    *bool
+	ResponseCacheTTLSeconds *int
+	// 批处理计费折扣
+	BatchDiscountMultiplier *float64
+	// 对冲请求
+	HedgeEnabled *bool
+	HedgeDelayMs *int
+	// Prompt Cache 前缀路由
+	PromptCacheRoutingEnabled *bool
+	// 自动插入 cache_control 断点
+	AutoCacheControlEnabled *bool
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -657,6 +681,15 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		}
 	}
 
+	// 批处理计费折扣：默认不打折
+	batchDiscount := 1.0
+	if input.BatchDiscountMultiplier != nil {
+		if err := validateBatchDiscountMultiplier(*input.BatchDiscountMultiplier); err != nil {
+			return nil, err
+		}
+		batchDiscount = *input.BatchDiscountMultiplier
+	}
+
 	// MCPXMLInject：默认为 true，仅当显式传入 false 时关闭
 	mcpXMLInject := true
 	if input.MCPXMLInject != nil {
@@ -715,6 +748,13 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		ModelRouting:                    input.ModelRouting,
 		MCPXMLInject:                    mcpXMLInject,
 		SupportedModelScopes:            input.SupportedModelScopes,
+		ResponseCacheEnabled:            input.ResponseCacheEnabled,
+		ResponseCacheTTLSeconds:         input.ResponseCacheTTLSeconds,
+		BatchDiscountMultiplier:         batchDiscount,
+		HedgeEnabled:                    input.HedgeEnabled,
+		HedgeDelayMs:                    input.HedgeDelayMs,
+		PromptCacheRoutingEnabled:       input.PromptCacheRoutingEnabled,
+		AutoCacheControlEnabled:         input.AutoCacheControlEnabled,
 	}
 	if err := s.groupRepo.Create(ctx, group); err != nil {
 		return nil, err
@@ -913,6 +953,32 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 		group.SupportedModelScopes = *input.SupportedModelScopes
 	}
 
+	// 精确响应缓存
+	if input.ResponseCacheEnabled != nil {
+		group.ResponseCacheEnabled = *input.ResponseCacheEnabled
+	}
+	if input.ResponseCacheTTLSeconds != nil {
+		group.ResponseCacheTTLSeconds = *input.ResponseCacheTTLSeconds
+	}
+	if input.BatchDiscountMultiplier != nil {
+		if err := validateBatchDiscountMultiplier(*input.BatchDiscountMultiplier); err != nil {
+			return nil, err
+		}
+		group.BatchDiscountMultiplier = *input.BatchDiscountMultiplier
+	}
+	if input.HedgeEnabled != nil {
+		group.HedgeEnabled = *input.HedgeEnabled
+	}
+	if input.HedgeDelayMs != nil {
+		group.HedgeDelayMs = *input.HedgeDelayMs
+	}
+	if input.PromptCacheRoutingEnabled != nil {
+		group.PromptCacheRoutingEnabled = *input.PromptCacheRoutingEnabled
+	}
+	if input.AutoCacheControlEnabled != nil {
+		group.AutoCacheControlEnabled = *input.AutoCacheControlEnabled
+	}
+
 	if err := s.groupRepo.Update(ctx, group); err != nil {
 		return nil, err
 	}
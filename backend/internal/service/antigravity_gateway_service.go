@@ -2191,7 +2191,7 @@ handleSuccess:
 
 	// 判断是否为图片生成模型
 	imageCount := 0
-	if isImageGenerationModel(mappedModel) {
+	if IsImageGenerationModel(mappedModel) {
 		// Gemini 图片生成 API 每次请求只生成一张图片（API 限制）
 		imageCount = 1
 	}
@@ -3732,9 +3732,9 @@ func (s *AntigravityGatewayService) extractImageSize(body []byte) string {
 	return "2K" // 默认 2K
 }
 
-// isImageGenerationModel 判断模型是否为图片生成模型
+// IsImageGenerationModel 判断模型是否为图片生成模型
 // 支持的模型：gemini-3-pro-image, gemini-3-pro-image-preview, gemini-2.5-flash-image 等
-func isImageGenerationModel(model string) bool {
+func IsImageGenerationModel(model string) bool {
 	modelLower := strings.ToLower(model)
 	// 移除 models/ 前缀
 	modelLower = strings.TrimPrefix(modelLower, "models/")
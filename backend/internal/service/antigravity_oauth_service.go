@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/antigravity"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 )
 
 type AntigravityOAuthService struct {
@@ -246,7 +247,10 @@ func isNonRetryableAntigravityOAuthError(err error) bool {
 }
 
 // RefreshAccountToken 刷新账户的 token
-func (s *AntigravityOAuthService) RefreshAccountToken(ctx context.Context, account *Account) (*AntigravityTokenInfo, error) {
+func (s *AntigravityOAuthService) RefreshAccountToken(ctx context.Context, account *Account) (_ *AntigravityTokenInfo, err error) {
+	ctx, span := startTokenRefreshSpan(ctx, account)
+	defer func() { tracing.End(span, err) }()
+
 	if account.Platform != PlatformAntigravity || account.Type != AccountTypeOAuth {
 		return nil, fmt.Errorf("非 Antigravity OAuth 账户")
 	}
@@ -28,6 +28,12 @@ type APIKey struct {
 	Quota     float64    // Quota limit in USD (0 = unlimited)
 	QuotaUsed float64    // Used quota amount
 	ExpiresAt *time.Time // Expiration time (nil = never expires)
+
+	// Request limit fields
+	AllowedModels     []string // Allowed model glob patterns (empty = all models)
+	RateLimitRPM      int      // Max requests per minute (0 = unlimited)
+	RateLimitTPM      int      // Max tokens per minute (0 = unlimited)
+	DailyRequestLimit int      // Max requests per day (0 = unlimited)
 }
 
 func (k *APIKey) IsActive() bool {
@@ -19,6 +19,12 @@ type APIKeyAuthSnapshot struct {
 
 	// Expiration field for API Key expiration feature
 	ExpiresAt *time.Time `json:"expires_at,omitempty"` // Expiration time (nil = never expires)
+
+	// Request limit fields are enforced by the auth middleware
+	AllowedModels     []string `json:"allowed_models,omitempty"`
+	RateLimitRPM      int      `json:"rate_limit_rpm,omitempty"`
+	RateLimitTPM      int      `json:"rate_limit_tpm,omitempty"`
+	DailyRequestLimit int      `json:"daily_request_limit,omitempty"`
 }
 
 // APIKeyAuthUserSnapshot 用户快照
@@ -56,6 +62,15 @@ type APIKeyAuthGroupSnapshot struct {
 
 	// 支持的模型系列（仅 antigravity 平台使用）
 	SupportedModelScopes []string `json:"supported_model_scopes,omitempty"`
+
+	// 精确响应缓存在网关转发时读取，必须进入认证缓存快照
+	ResponseCacheEnabled    bool    `json:"response_cache_enabled,omitempty"`
+	ResponseCacheTTLSeconds int     `json:"response_cache_ttl_seconds,omitempty"`
+	BatchDiscountMultiplier float64 `json:"batch_discount_multiplier,omitempty"`
+	HedgeEnabled            bool    `json:"hedge_enabled,omitempty"`
+	HedgeDelayMs            int     `json:"hedge_delay_ms,omitempty"`
+	PromptCacheRouting      bool    `json:"prompt_cache_routing_enabled,omitempty"`
+	AutoCacheControl        bool    `json:"auto_cache_control_enabled,omitempty"`
 }
 
 // APIKeyAuthCacheEntry 缓存条目，支持负缓存
@@ -216,6 +216,11 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 		Quota:       apiKey.Quota,
 		QuotaUsed:   apiKey.QuotaUsed,
 		ExpiresAt:   apiKey.ExpiresAt,
+
+		AllowedModels:     apiKey.AllowedModels,
+		RateLimitRPM:      apiKey.RateLimitRPM,
+		RateLimitTPM:      apiKey.RateLimitTPM,
+		DailyRequestLimit: apiKey.DailyRequestLimit,
 		User: APIKeyAuthUserSnapshot{
 			ID:          apiKey.User.ID,
 			Status:      apiKey.User.Status,
@@ -245,6 +250,13 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 			ModelRoutingEnabled:             apiKey.Group.ModelRoutingEnabled,
 			MCPXMLInject:                    apiKey.Group.MCPXMLInject,
 			SupportedModelScopes:            apiKey.Group.SupportedModelScopes,
+			ResponseCacheEnabled:            apiKey.Group.ResponseCacheEnabled,
+			ResponseCacheTTLSeconds:         apiKey.Group.ResponseCacheTTLSeconds,
+			BatchDiscountMultiplier:         apiKey.Group.BatchDiscountMultiplier,
+			HedgeEnabled:                    apiKey.Group.HedgeEnabled,
+			HedgeDelayMs:                    apiKey.Group.HedgeDelayMs,
+			PromptCacheRouting:              apiKey.Group.PromptCacheRoutingEnabled,
+			AutoCacheControl:                apiKey.Group.AutoCacheControlEnabled,
 		}
 	}
 	return snapshot
@@ -265,6 +277,11 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 		Quota:       snapshot.Quota,
 		QuotaUsed:   snapshot.QuotaUsed,
 		ExpiresAt:   snapshot.ExpiresAt,
+
+		AllowedModels:     snapshot.AllowedModels,
+		RateLimitRPM:      snapshot.RateLimitRPM,
+		RateLimitTPM:      snapshot.RateLimitTPM,
+		DailyRequestLimit: snapshot.DailyRequestLimit,
 		User: &User{
 			ID:          snapshot.User.ID,
 			Status:      snapshot.User.Status,
@@ -295,6 +312,13 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 			ModelRoutingEnabled:             snapshot.Group.ModelRoutingEnabled,
 			MCPXMLInject:                    snapshot.Group.MCPXMLInject,
 			SupportedModelScopes:            snapshot.Group.SupportedModelScopes,
+			ResponseCacheEnabled:            snapshot.Group.ResponseCacheEnabled,
+			ResponseCacheTTLSeconds:         snapshot.Group.ResponseCacheTTLSeconds,
+			BatchDiscountMultiplier:         snapshot.Group.BatchDiscountMultiplier,
+			HedgeEnabled:                    snapshot.Group.HedgeEnabled,
+			HedgeDelayMs:                    snapshot.Group.HedgeDelayMs,
+			PromptCacheRoutingEnabled:       snapshot.Group.PromptCacheRouting,
+			AutoCacheControlEnabled:         snapshot.Group.AutoCacheControl,
 		}
 	}
 	return apiKey
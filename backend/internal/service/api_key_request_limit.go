@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// API Key 请求限制类型
+const (
+	APIKeyLimitRPM   = "rpm"
+	APIKeyLimitTPM   = "tpm"
+	APIKeyLimitDaily = "daily"
+)
+
+const (
+	apiKeyRateLimitWindow      = time.Minute
+	apiKeyMaxAllowedModels     = 50
+	apiKeyMaxModelPatternChars = 100
+)
+
+var (
+	ErrAPIKeyModelNotAllowed = infraerrors.Forbidden("API_KEY_MODEL_NOT_ALLOWED", "model is not allowed for this api key")
+	ErrAPIKeyModelRequired   = infraerrors.Forbidden("API_KEY_MODEL_REQUIRED", "model is required for api keys with a model allowlist")
+	ErrInvalidModelPattern   = infraerrors.BadRequest("INVALID_MODEL_PATTERN", "invalid model pattern")
+	ErrInvalidRequestLimit   = infraerrors.BadRequest("INVALID_REQUEST_LIMIT", "request limits must be non-negative")
+)
+
+// APIKeyRequestLimitCheck 单次请求的限制参数
+type APIKeyRequestLimitCheck struct {
+	RPM    int
+	TPM    int
+	Daily  int
+	Window time.Duration // RPM/TPM 滑动窗口长度
+	Day    string        // 每日计数所属日期（按配置时区），如 20260101
+	DayTTL time.Duration // 每日计数的过期时间
+}
+
+// APIKeyRequestLimitCache API Key 请求限制计数（Redis 滑动窗口）
+type APIKeyRequestLimitCache interface {
+	// AcquireRequest 原子地检查 TPM/RPM/每日请求数，全部未超限时记录本次请求。
+	// 返回触发的限制类型（空字符串表示放行）及建议的重试间隔。
+	AcquireRequest(ctx context.Context, apiKeyID int64, check APIKeyRequestLimitCheck) (limit string, retryAfter time.Duration, err error)
+	// AddTokens 将请求消耗的 token 计入 TPM 滑动窗口
+	AddTokens(ctx context.Context, apiKeyID int64, tokens int, window time.Duration) error
+}
+
+// APIKeyRequestLimitError API Key 请求频率超限
+type APIKeyRequestLimitError struct {
+	Limit      string        // rpm / tpm / daily
+	Value      int           // 配置的上限
+	RetryAfter time.Duration // 建议重试间隔
+}
+
+func (e *APIKeyRequestLimitError) Error() string {
+	switch e.Limit {
+	case APIKeyLimitRPM:
+		return fmt.Sprintf("API key rate limit exceeded: %d requests per minute", e.Value)
+	case APIKeyLimitTPM:
+		return fmt.Sprintf("API key rate limit exceeded: %d tokens per minute", e.Value)
+	default:
+		return fmt.Sprintf("API key daily request limit exceeded: %d requests per day", e.Value)
+	}
+}
+
+// HasRequestLimits 是否配置了 RPM/TPM/每日请求数限制
+func (k *APIKey) HasRequestLimits() bool {
+	return k.RateLimitRPM > 0 || k.RateLimitTPM > 0 || k.DailyRequestLimit > 0
+}
+
+// IsModelAllowed 检查模型是否在白名单内（白名单为空表示不限制）
+func (k *APIKey) IsModelAllowed(model string) bool {
+	if len(k.AllowedModels) == 0 {
+		return true
+	}
+	model = strings.ToLower(strings.TrimSpace(model))
+	for _, pattern := range k.AllowedModels {
+		if matchModelGlob(strings.ToLower(pattern), model) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchModelGlob glob 匹配：* 匹配任意字符序列（包括 /），? 匹配单个字符
+func matchModelGlob(pattern, name string) bool {
+	p, n := 0, 0
+	star, mark := -1, 0
+	for n < len(name) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == name[n]):
+			p++
+			n++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, n
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			n = mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// normalizeAllowedModels 去除空白与重复项并校验长度
+func normalizeAllowedModels(patterns []string) ([]string, error) {
+	if len(patterns) > apiKeyMaxAllowedModels {
+		return nil, ErrInvalidModelPattern.WithMetadata(map[string]string{"reason": "too many patterns"})
+	}
+	out := make([]string, 0, len(patterns))
+	seen := make(map[string]struct{}, len(patterns))
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if len(p) > apiKeyMaxModelPatternChars {
+			return nil, ErrInvalidModelPattern.WithMetadata(map[string]string{"pattern": p})
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func validateRequestLimits(values ...*int) error {
+	for _, v := range values {
+		if v != nil && *v < 0 {
+			return ErrInvalidRequestLimit
+		}
+	}
+	return nil
+}
+
+// SetRequestLimitCache 注入 RPM/TPM/每日请求数计数缓存
+func (s *APIKeyService) SetRequestLimitCache(cache APIKeyRequestLimitCache) {
+	s.requestLimitCache = cache
+}
+
+// CheckModelAllowed 校验模型白名单。配置了白名单但无法确定请求模型时拒绝（fail-closed），
+// 避免缺少顶层 model 字段的请求绕过白名单。
+func (s *APIKeyService) CheckModelAllowed(apiKey *APIKey, model string) error {
+	if apiKey == nil || len(apiKey.AllowedModels) == 0 {
+		return nil
+	}
+	if strings.TrimSpace(model) == "" {
+		return ErrAPIKeyModelRequired
+	}
+	if !apiKey.IsModelAllowed(model) {
+		return ErrAPIKeyModelNotAllowed
+	}
+	return nil
+}
+
+// CheckRequestLimits 校验 RPM/TPM/每日请求数限制，通过时记录本次请求。
+// 计数缓存不可用时放行（fail-open），避免 Redis 故障阻断全部流量。
+func (s *APIKeyService) CheckRequestLimits(ctx context.Context, apiKey *APIKey) error {
+	if apiKey == nil || !apiKey.HasRequestLimits() || s.requestLimitCache == nil {
+		return nil
+	}
+
+	now := timezone.Now()
+	check := APIKeyRequestLimitCheck{
+		RPM:    apiKey.RateLimitRPM,
+		TPM:    apiKey.RateLimitTPM,
+		Daily:  apiKey.DailyRequestLimit,
+		Window: apiKeyRateLimitWindow,
+		Day:    now.Format("20060102"),
+		DayTTL: time.Until(timezone.EndOfDay(now)) + time.Minute,
+	}
+	limit, retryAfter, err := s.requestLimitCache.AcquireRequest(ctx, apiKey.ID, check)
+	if err != nil {
+		log.Printf("[APIKeyLimit] acquire failed: api_key=%d err=%v", apiKey.ID, err)
+		return nil
+	}
+
+	switch limit {
+	case "":
+		return nil
+	case APIKeyLimitRPM:
+		return &APIKeyRequestLimitError{Limit: limit, Value: apiKey.RateLimitRPM, RetryAfter: retryAfter}
+	case APIKeyLimitTPM:
+		return &APIKeyRequestLimitError{Limit: limit, Value: apiKey.RateLimitTPM, RetryAfter: retryAfter}
+	default:
+		if retryAfter <= 0 {
+			retryAfter = time.Until(timezone.EndOfDay(now))
+		}
+		return &APIKeyRequestLimitError{Limit: APIKeyLimitDaily, Value: apiKey.DailyRequestLimit, RetryAfter: retryAfter}
+	}
+}
+
+// RecordTokenUsage 将请求消耗的 token 计入 TPM 窗口（仅配置了 TPM 的 Key）
+func (s *APIKeyService) RecordTokenUsage(ctx context.Context, apiKey *APIKey, tokens int) {
+	if apiKey == nil || apiKey.RateLimitTPM <= 0 || tokens <= 0 || s.requestLimitCache == nil {
+		return
+	}
+	if err := s.requestLimitCache.AddTokens(ctx, apiKey.ID, tokens, apiKeyRateLimitWindow); err != nil {
+		log.Printf("[APIKeyLimit] add tokens failed: api_key=%d err=%v", apiKey.ID, err)
+	}
+}
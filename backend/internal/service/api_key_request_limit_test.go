@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchModelGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"claude-sonnet-4-5", "claude-sonnet-4-5", true},
+		{"claude-sonnet-*", "claude-sonnet-4-5-20250929", true},
+		{"claude-*-4-5", "claude-opus-4-5", true},
+		{"claude-*-4-5", "claude-opus-4-1", false},
+		{"gpt-5?", "gpt-5o", true},
+		{"gpt-5?", "gpt-5", false},
+		{"*", "anything/with/slash", true},
+		{"openrouter/*", "openrouter/anthropic/claude", true},
+		{"gemini-*", "claude-sonnet-4-5", false},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.want, matchModelGlob(tc.pattern, tc.name), "%s vs %s", tc.pattern, tc.name)
+	}
+}
+
+func TestAPIKeyIsModelAllowed(t *testing.T) {
+	key := &APIKey{}
+	require.True(t, key.IsModelAllowed("any-model"))
+
+	key.AllowedModels = []string{"Claude-Sonnet-*", "gpt-5"}
+	require.True(t, key.IsModelAllowed("claude-sonnet-4-5"))
+	require.True(t, key.IsModelAllowed("GPT-5"))
+	require.False(t, key.IsModelAllowed("gpt-5-codex"))
+}
+
+func TestNormalizeAllowedModels(t *testing.T) {
+	out, err := normalizeAllowedModels([]string{" claude-* ", "", "claude-*", "gpt-5"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"claude-*", "gpt-5"}, out)
+
+	_, err = normalizeAllowedModels(make([]string, apiKeyMaxAllowedModels+1))
+	require.ErrorIs(t, err, ErrInvalidModelPattern)
+}
+
+type apiKeyRequestLimitCacheStub struct {
+	limit      string
+	retryAfter time.Duration
+	err        error
+	checks     []APIKeyRequestLimitCheck
+	tokens     int
+}
+
+func (s *apiKeyRequestLimitCacheStub) AcquireRequest(_ context.Context, _ int64, check APIKeyRequestLimitCheck) (string, time.Duration, error) {
+	s.checks = append(s.checks, check)
+	return s.limit, s.retryAfter, s.err
+}
+
+func (s *apiKeyRequestLimitCacheStub) AddTokens(_ context.Context, _ int64, tokens int, _ time.Duration) error {
+	s.tokens += tokens
+	return nil
+}
+
+func TestCheckRequestLimits(t *testing.T) {
+	ctx := context.Background()
+	cache := &apiKeyRequestLimitCacheStub{}
+	svc := &APIKeyService{requestLimitCache: cache}
+
+	// 无限制时不访问缓存
+	require.NoError(t, svc.CheckRequestLimits(ctx, &APIKey{ID: 1}))
+	require.Empty(t, cache.checks)
+
+	key := &APIKey{ID: 1, RateLimitRPM: 5, RateLimitTPM: 1000, DailyRequestLimit: 100, AllowedModels: []string{"m*"}}
+	require.NoError(t, svc.CheckRequestLimits(ctx, key))
+	require.Len(t, cache.checks, 1)
+	require.Equal(t, 5, cache.checks[0].RPM)
+	require.Equal(t, 1000, cache.checks[0].TPM)
+	require.Equal(t, 100, cache.checks[0].Daily)
+	require.Equal(t, time.Minute, cache.checks[0].Window)
+	require.Len(t, cache.checks[0].Day, 8)
+
+	cache.limit, cache.retryAfter = APIKeyLimitTPM, 3*time.Second
+	err := svc.CheckRequestLimits(ctx, key)
+	var limitErr *APIKeyRequestLimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, APIKeyLimitTPM, limitErr.Limit)
+	require.Equal(t, 1000, limitErr.Value)
+	require.Equal(t, 3*time.Second, limitErr.RetryAfter)
+
+	// 每日限制未返回重试间隔时使用到当天结束的时长
+	cache.limit, cache.retryAfter = APIKeyLimitDaily, 0
+	err = svc.CheckRequestLimits(ctx, key)
+	require.True(t, errors.As(err, &limitErr))
+	require.Positive(t, limitErr.RetryAfter)
+
+	// 缓存故障时放行
+	cache.limit, cache.err = "", errors.New("redis down")
+	require.NoError(t, svc.CheckRequestLimits(ctx, key))
+}
+
+func TestCheckModelAllowed(t *testing.T) {
+	svc := &APIKeyService{}
+
+	// 未配置白名单时不校验
+	require.NoError(t, svc.CheckModelAllowed(&APIKey{ID: 1}, ""))
+
+	key := &APIKey{ID: 1, AllowedModels: []string{"m*"}}
+	require.NoError(t, svc.CheckModelAllowed(key, "model"))
+	require.ErrorIs(t, svc.CheckModelAllowed(key, "other"), ErrAPIKeyModelNotAllowed)
+	// 无法确定模型时拒绝
+	require.ErrorIs(t, svc.CheckModelAllowed(key, ""), ErrAPIKeyModelRequired)
+	require.ErrorIs(t, svc.CheckModelAllowed(key, "  "), ErrAPIKeyModelRequired)
+}
+
+func TestRecordTokenUsage(t *testing.T) {
+	cache := &apiKeyRequestLimitCacheStub{}
+	svc := &APIKeyService{requestLimitCache: cache}
+
+	svc.RecordTokenUsage(context.Background(), &APIKey{ID: 1}, 100)
+	require.Zero(t, cache.tokens)
+
+	svc.RecordTokenUsage(context.Background(), &APIKey{ID: 1, RateLimitTPM: 1000}, 100)
+	require.Equal(t, 100, cache.tokens)
+}
@@ -96,6 +96,12 @@ type CreateAPIKeyRequest struct {
 	// Quota fields
 	Quota         float64 `json:"quota"`           // Quota limit in USD (0 = unlimited)
 	ExpiresInDays *int    `json:"expires_in_days"` // Days until expiry (nil = never expires)
+
+	// Request limit fields
+	AllowedModels     []string `json:"allowed_models"`      // 模型白名单（glob），为空不限制
+	RateLimitRPM      int      `json:"rate_limit_rpm"`      // 每分钟请求数（0 = 不限制）
+	RateLimitTPM      int      `json:"rate_limit_tpm"`      // 每分钟 token 数（0 = 不限制）
+	DailyRequestLimit int      `json:"daily_request_limit"` // 每日请求数（0 = 不限制）
 }
 
 // UpdateAPIKeyRequest 更新API Key请求
@@ -111,6 +117,12 @@ type UpdateAPIKeyRequest struct {
 	ExpiresAt       *time.Time `json:"expires_at"`  // Expiration time (nil = no change)
 	ClearExpiration bool       `json:"-"`           // Clear expiration (internal use)
 	ResetQuota      *bool      `json:"reset_quota"` // Reset quota_used to 0
+
+	// Request limit fields
+	AllowedModels     []string `json:"allowed_models"`      // 模型白名单（空数组清空）
+	RateLimitRPM      *int     `json:"rate_limit_rpm"`      // nil = 不修改，0 = 不限制
+	RateLimitTPM      *int     `json:"rate_limit_tpm"`      // nil = 不修改，0 = 不限制
+	DailyRequestLimit *int     `json:"daily_request_limit"` // nil = 不修改，0 = 不限制
 }
 
 // APIKeyService API Key服务
@@ -125,6 +137,8 @@ type APIKeyService struct {
 	authCacheL1       *ristretto.Cache
 	authCfg           apiKeyAuthCacheConfig
 	authGroup         singleflight.Group
+
+	requestLimitCache APIKeyRequestLimitCache
 }
 
 // NewAPIKeyService 创建API Key服务实例
@@ -252,6 +266,15 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		}
 	}
 
+	// 验证模型白名单与请求限制
+	allowedModels, err := normalizeAllowedModels(req.AllowedModels)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRequestLimits(&req.RateLimitRPM, &req.RateLimitTPM, &req.DailyRequestLimit); err != nil {
+		return nil, err
+	}
+
 	// 验证分组权限（如果指定了分组）
 	if req.GroupID != nil {
 		group, err := s.groupRepo.GetByID(ctx, *req.GroupID)
@@ -311,6 +334,11 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		IPBlacklist: req.IPBlacklist,
 		Quota:       req.Quota,
 		QuotaUsed:   0,
+
+		AllowedModels:     allowedModels,
+		RateLimitRPM:      req.RateLimitRPM,
+		RateLimitTPM:      req.RateLimitTPM,
+		DailyRequestLimit: req.DailyRequestLimit,
 	}
 
 	// Set expiration time if specified
@@ -432,6 +460,15 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 		}
 	}
 
+	// 验证模型白名单与请求限制
+	allowedModels, err := normalizeAllowedModels(req.AllowedModels)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRequestLimits(req.RateLimitRPM, req.RateLimitTPM, req.DailyRequestLimit); err != nil {
+		return nil, err
+	}
+
 	// 更新字段
 	if req.Name != nil {
 		apiKey.Name = *req.Name
@@ -497,6 +534,18 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	apiKey.IPWhitelist = req.IPWhitelist
 	apiKey.IPBlacklist = req.IPBlacklist
 
+	// 更新模型白名单（空数组会清空设置）与请求限制
+	apiKey.AllowedModels = allowedModels
+	if req.RateLimitRPM != nil {
+		apiKey.RateLimitRPM = *req.RateLimitRPM
+	}
+	if req.RateLimitTPM != nil {
+		apiKey.RateLimitTPM = *req.RateLimitTPM
+	}
+	if req.DailyRequestLimit != nil {
+		apiKey.DailyRequestLimit = *req.DailyRequestLimit
+	}
+
 	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
 		return nil, fmt.Errorf("update api key: %w", err)
 	}
@@ -531,6 +580,25 @@ func (s *APIKeyService) Delete(ctx context.Context, id int64, userID int64) erro
 	return nil
 }
 
+// Disable 将 API Key 置为禁用状态（如预算告警触发硬限制），并清除认证缓存。
+// 已非 active 状态的 Key 保持原状态不变，返回 false。
+func (s *APIKeyService) Disable(ctx context.Context, id int64) (bool, error) {
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("get api key: %w", err)
+	}
+	if apiKey.Status != StatusAPIKeyActive {
+		return false, nil
+	}
+
+	apiKey.Status = StatusAPIKeyDisabled
+	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		return false, fmt.Errorf("update api key: %w", err)
+	}
+	s.InvalidateAuthCacheByKey(ctx, apiKey.Key)
+	return true, nil
+}
+
 // ValidateKey 验证API Key是否有效（用于认证中间件）
 func (s *APIKeyService) ValidateKey(ctx context.Context, key string) (*APIKey, *User, error) {
 	// 获取API Key
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	auditLogCleanupJobName = "audit_log_cleanup"
+
+	auditLogCleanupLeaderLockKey = "audit_log:cleanup:leader"
+	auditLogCleanupLeaderLockTTL = 30 * time.Minute
+)
+
+// AuditLogCleanupService periodically deletes audit logs older than the configured retention.
+//
+// Mirrors OpsCleanupService: 5-field cron schedule, best-effort Redis leader lock with
+// DB advisory lock fallback, and batched deletes.
+type AuditLogCleanupService struct {
+	opsRepo     OpsRepository
+	db          *sql.DB
+	redisClient *redis.Client
+	cfg         *config.Config
+
+	instanceID string
+
+	cron *cron.Cron
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	warnNoRedisOnce sync.Once
+}
+
+func NewAuditLogCleanupService(
+	opsRepo OpsRepository,
+	db *sql.DB,
+	redisClient *redis.Client,
+	cfg *config.Config,
+) *AuditLogCleanupService {
+	return &AuditLogCleanupService{
+		opsRepo:     opsRepo,
+		db:          db,
+		redisClient: redisClient,
+		cfg:         cfg,
+		instanceID:  uuid.NewString(),
+	}
+}
+
+func (s *AuditLogCleanupService) Start() {
+	if s == nil || s.cfg == nil {
+		return
+	}
+	if s.cfg.AuditLog.RetentionDays <= 0 {
+		log.Printf("[AuditLogCleanup] not started (retention disabled)")
+		return
+	}
+	if s.db == nil {
+		log.Printf("[AuditLogCleanup] not started (missing deps)")
+		return
+	}
+
+	s.startOnce.Do(func() {
+		schedule := "30 3 * * *"
+		if strings.TrimSpace(s.cfg.AuditLog.CleanupSchedule) != "" {
+			schedule = strings.TrimSpace(s.cfg.AuditLog.CleanupSchedule)
+		}
+
+		loc := time.Local
+		if strings.TrimSpace(s.cfg.Timezone) != "" {
+			if parsed, err := time.LoadLocation(strings.TrimSpace(s.cfg.Timezone)); err == nil && parsed != nil {
+				loc = parsed
+			}
+		}
+
+		c := cron.New(cron.WithParser(opsCleanupCronParser), cron.WithLocation(loc))
+		_, err := c.AddFunc(schedule, func() { s.runScheduled() })
+		if err != nil {
+			log.Printf("[AuditLogCleanup] not started (invalid schedule=%q): %v", schedule, err)
+			return
+		}
+		s.cron = c
+		s.cron.Start()
+		log.Printf("[AuditLogCleanup] started (schedule=%q tz=%s retention_days=%d)", schedule, loc.String(), s.cfg.AuditLog.RetentionDays)
+	})
+}
+
+func (s *AuditLogCleanupService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.cron != nil {
+			ctx := s.cron.Stop()
+			select {
+			case <-ctx.Done():
+			case <-time.After(3 * time.Second):
+				log.Printf("[AuditLogCleanup] cron stop timed out")
+			}
+		}
+	})
+}
+
+func (s *AuditLogCleanupService) runScheduled() {
+	if s == nil || s.db == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	release, ok := s.tryAcquireLeaderLock(ctx)
+	if !ok {
+		return
+	}
+	if release != nil {
+		defer release()
+	}
+
+	startedAt := time.Now().UTC()
+
+	deleted, err := s.runCleanupOnce(ctx)
+	if err != nil {
+		s.recordHeartbeat(startedAt, time.Since(startedAt), "", err)
+		log.Printf("[AuditLogCleanup] cleanup failed: %v", err)
+		return
+	}
+	result := fmt.Sprintf("audit_logs=%d", deleted)
+	s.recordHeartbeat(startedAt, time.Since(startedAt), result, nil)
+	log.Printf("[AuditLogCleanup] cleanup complete: %s", result)
+}
+
+func (s *AuditLogCleanupService) runCleanupOnce(ctx context.Context) (int64, error) {
+	if s == nil || s.db == nil || s.cfg == nil {
+		return 0, nil
+	}
+	days := s.cfg.AuditLog.RetentionDays
+	if days <= 0 {
+		return 0, nil
+	}
+	cutoff := time.Now().UTC().AddDate(0, 0, -days)
+	return deleteOldRowsByID(ctx, s.db, "audit_logs", "created_at", cutoff, 5000, false)
+}
+
+func (s *AuditLogCleanupService) tryAcquireLeaderLock(ctx context.Context) (func(), bool) {
+	if s == nil {
+		return nil, false
+	}
+	// In simple run mode, assume single instance.
+	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
+		return nil, true
+	}
+
+	key := auditLogCleanupLeaderLockKey
+	if s.redisClient != nil {
+		ok, err := s.redisClient.SetNX(ctx, key, s.instanceID, auditLogCleanupLeaderLockTTL).Result()
+		if err == nil {
+			if !ok {
+				return nil, false
+			}
+			return func() {
+				_, _ = opsCleanupReleaseScript.Run(ctx, s.redisClient, []string{key}, s.instanceID).Result()
+			}, true
+		}
+		s.warnNoRedisOnce.Do(func() {
+			log.Printf("[AuditLogCleanup] leader lock SetNX failed; falling back to DB advisory lock: %v", err)
+		})
+	} else {
+		s.warnNoRedisOnce.Do(func() {
+			log.Printf("[AuditLogCleanup] redis not configured; using DB advisory lock")
+		})
+	}
+
+	release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(key))
+	if !ok {
+		return nil, false
+	}
+	return release, true
+}
+
+// recordHeartbeat 复用运维监控的任务心跳表，便于在 Ops 面板中观察清理任务状态
+func (s *AuditLogCleanupService) recordHeartbeat(runAt time.Time, duration time.Duration, result string, runErr error) {
+	if s == nil || s.opsRepo == nil {
+		return
+	}
+	now := time.Now().UTC()
+	durMs := duration.Milliseconds()
+	input := &OpsUpsertJobHeartbeatInput{
+		JobName:        auditLogCleanupJobName,
+		LastRunAt:      &runAt,
+		LastDurationMs: &durMs,
+	}
+	if runErr != nil {
+		msg := truncateString(runErr.Error(), 2048)
+		input.LastErrorAt = &now
+		input.LastError = &msg
+	} else {
+		input.LastSuccessAt = &now
+		input.LastResult = &result
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_ = s.opsRepo.UpsertJobHeartbeat(ctx, input)
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/util/logredact"
+)
+
+// AuditLog 管理员操作审计日志
+type AuditLog struct {
+	ID          int64                       `json:"id"`
+	ActorUserID *int64                      `json:"actor_user_id"`
+	AuthMethod  string                      `json:"auth_method"`
+	IPAddress   string                      `json:"ip_address"`
+	UserAgent   string                      `json:"user_agent"`
+	Method      string                      `json:"method"`
+	Route       string                      `json:"route"`
+	Path        string                      `json:"path"`
+	StatusCode  int                         `json:"status_code"`
+	TargetType  string                      `json:"target_type"`
+	TargetID    string                      `json:"target_id"`
+	Changes     map[string]AuditFieldChange `json:"changes,omitempty"`
+	RequestBody *string                     `json:"request_body,omitempty"`
+	CreatedAt   time.Time                   `json:"created_at"`
+
+	// JOIN 字段（仅列表查询时填充）
+	ActorEmail string `json:"actor_email,omitempty"`
+}
+
+// AuditFieldChange 单个字段的变更前后值（已脱敏）
+type AuditFieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditLogFilters 列表查询过滤条件
+type AuditLogFilters struct {
+	ActorUserID int64
+	TargetType  string
+	TargetID    string
+	Method      string
+	Route       string
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+// AuditLogRepository 审计日志仓储接口
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *AuditLog) error
+	List(ctx context.Context, filters AuditLogFilters, page, pageSize int) ([]*AuditLog, int64, error)
+}
+
+// auditSensitiveKeys 在 logredact 默认敏感字段之外，审计差异与请求体额外脱敏的字段
+var auditSensitiveKeys = []string{
+	"api_key",
+	"admin_api_key",
+	"key",
+	"secret",
+	"secret_key",
+	"session_key",
+	"token",
+	"bot_token",
+	"private_key",
+	"access_key",
+	"access_key_id",
+	"secret_access_key",
+	"smtp_password",
+	"turnstile_secret_key",
+	"linuxdo_connect_client_secret",
+	"totp_secret",
+	"totp_code",
+	"new_password",
+	"old_password",
+	"service_account_json",
+}
+
+// AuditLogService 管理员操作审计日志服务
+type AuditLogService struct {
+	repo AuditLogRepository
+	cfg  *config.AuditLogConfig
+}
+
+// NewAuditLogService 创建审计日志服务
+func NewAuditLogService(repo AuditLogRepository, cfg *config.Config) *AuditLogService {
+	var auditCfg *config.AuditLogConfig
+	if cfg != nil {
+		auditCfg = &cfg.AuditLog
+	}
+	return &AuditLogService{repo: repo, cfg: auditCfg}
+}
+
+// IsEnabled 检查是否记录审计日志
+func (s *AuditLogService) IsEnabled() bool {
+	return s != nil && s.repo != nil && s.cfg != nil && s.cfg.Enabled
+}
+
+// MaxBodySize 返回单条记录保存的请求体最大字节
+func (s *AuditLogService) MaxBodySize() int {
+	if s == nil || s.cfg == nil || s.cfg.MaxBodySize <= 0 {
+		return 16384
+	}
+	return s.cfg.MaxBodySize
+}
+
+// AuditRecordInput 一次管理后台变更请求的审计信息
+type AuditRecordInput struct {
+	ActorUserID int64
+	AuthMethod  string
+	IPAddress   string
+	UserAgent   string
+	Method      string
+	Route       string
+	Path        string
+	StatusCode  int
+	TargetType  string
+	TargetID    string
+	// RequestBody 原始请求体（JSON），写入前脱敏并截断
+	RequestBody []byte
+	// Before/After 由 handler 提供的变更前后快照（可为 nil）
+	Before any
+	After  any
+}
+
+// RecordAsync 异步写入审计日志（在 goroutine 中调用，不阻塞管理请求）
+func (s *AuditLogService) RecordAsync(input *AuditRecordInput) {
+	if !s.IsEnabled() || input == nil {
+		return
+	}
+	entry := s.buildEntry(input)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.repo.Create(ctx, entry); err != nil {
+			log.Printf("[AuditLog] Failed to save: method=%s route=%s err=%v", entry.Method, entry.Route, err)
+		}
+	}()
+}
+
+func (s *AuditLogService) buildEntry(input *AuditRecordInput) *AuditLog {
+	entry := &AuditLog{
+		AuthMethod: input.AuthMethod,
+		IPAddress:  truncateString(input.IPAddress, 45),
+		UserAgent:  truncateString(input.UserAgent, 512),
+		Method:     input.Method,
+		Route:      truncateString(input.Route, 255),
+		Path:       truncateString(input.Path, 512),
+		StatusCode: input.StatusCode,
+		TargetType: truncateString(input.TargetType, 50),
+		TargetID:   truncateString(input.TargetID, 100),
+		CreatedAt:  time.Now(),
+	}
+	if input.ActorUserID > 0 {
+		actor := input.ActorUserID
+		entry.ActorUserID = &actor
+	}
+	if len(input.RequestBody) > 0 {
+		body := truncateString(logredact.RedactJSON(input.RequestBody, auditSensitiveKeys...), s.MaxBodySize())
+		entry.RequestBody = &body
+	}
+	if input.Before != nil || input.After != nil {
+		entry.Changes = BuildAuditChanges(input.Before, input.After)
+	}
+	return entry
+}
+
+// List 分页查询审计日志
+func (s *AuditLogService) List(ctx context.Context, filters AuditLogFilters, page, pageSize int) ([]*AuditLog, int64, error) {
+	return s.repo.List(ctx, filters, page, pageSize)
+}
+
+// BuildAuditChanges 计算 before/after 快照的字段级差异并脱敏。
+//
+// 快照先按 JSON 序列化为通用结构，嵌套对象展开为以 "." 连接的路径，数组作为整体比较；
+// 敏感字段（含其子字段）的前后值统一替换为 "***"，但仍保留“发生了变更”这一事实。
+func BuildAuditChanges(before, after any) map[string]AuditFieldChange {
+	beforeRaw := toAuditValue(before)
+	afterRaw := toAuditValue(after)
+	beforeRedacted := redactAuditValue(beforeRaw)
+	afterRedacted := redactAuditValue(afterRaw)
+
+	beforeFlat := map[string]any{}
+	afterFlat := map[string]any{}
+	flattenAuditValue("", beforeRaw, beforeFlat)
+	flattenAuditValue("", afterRaw, afterFlat)
+
+	paths := make([]string, 0, len(beforeFlat)+len(afterFlat))
+	for p := range beforeFlat {
+		paths = append(paths, p)
+	}
+	for p := range afterFlat {
+		if _, ok := beforeFlat[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make(map[string]AuditFieldChange)
+	for _, p := range paths {
+		b, bok := beforeFlat[p]
+		a, aok := afterFlat[p]
+		if bok && aok && reflect.DeepEqual(b, a) {
+			continue
+		}
+		change := AuditFieldChange{}
+		if bok {
+			change.Before = lookupAuditPath(beforeRedacted, p)
+		}
+		if aok {
+			change.After = lookupAuditPath(afterRedacted, p)
+		}
+		changes[p] = change
+	}
+	return changes
+}
+
+func toAuditValue(v any) any {
+	if v == nil {
+		return nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+func redactAuditValue(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+	return logredact.RedactMap(m, auditSensitiveKeys...)
+}
+
+func flattenAuditValue(prefix string, v any, out map[string]any) {
+	if prefix == "" && v == nil {
+		return
+	}
+	m, ok := v.(map[string]any)
+	if !ok || (len(m) == 0 && prefix != "") {
+		if prefix == "" {
+			prefix = "value"
+		}
+		out[prefix] = v
+		return
+	}
+	for k, child := range m {
+		p := k
+		if prefix != "" {
+			p = prefix + "." + k
+		}
+		flattenAuditValue(p, child, out)
+	}
+}
+
+// lookupAuditPath 在脱敏后的结构中按路径取值；路径中途遇到被脱敏的字段时返回脱敏占位符
+func lookupAuditPath(v any, path string) any {
+	if path == "value" {
+		if _, ok := v.(map[string]any); !ok {
+			return v
+		}
+	}
+	cur := v
+	for _, seg := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return cur
+		}
+		cur = m[seg]
+	}
+	return cur
+}
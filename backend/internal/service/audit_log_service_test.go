@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAuditChanges_OnlyChangedFields(t *testing.T) {
+	before := map[string]any{"name": "a", "priority": 1, "status": "active"}
+	after := map[string]any{"name": "b", "priority": 1, "status": "active"}
+
+	changes := BuildAuditChanges(before, after)
+	require.Equal(t, map[string]AuditFieldChange{
+		"name": {Before: "a", After: "b"},
+	}, changes)
+}
+
+func TestBuildAuditChanges_RedactsSecrets(t *testing.T) {
+	before := map[string]any{
+		"credentials": map[string]any{"api_key": "sk-old", "base_url": "https://a"},
+	}
+	after := map[string]any{
+		"credentials": map[string]any{"api_key": "sk-new", "base_url": "https://b"},
+	}
+
+	changes := BuildAuditChanges(before, after)
+	require.Equal(t, AuditFieldChange{Before: "***", After: "***"}, changes["credentials.api_key"])
+	require.Equal(t, AuditFieldChange{Before: "https://a", After: "https://b"}, changes["credentials.base_url"])
+}
+
+func TestBuildAuditChanges_RedactsNestedUnderSensitiveKey(t *testing.T) {
+	before := map[string]any{"secret": map[string]any{"v": 1}}
+	after := map[string]any{"secret": map[string]any{"v": 2}}
+
+	changes := BuildAuditChanges(before, after)
+	require.Equal(t, AuditFieldChange{Before: "***", After: "***"}, changes["secret.v"])
+}
+
+func TestBuildAuditChanges_DeleteHasNoAfter(t *testing.T) {
+	changes := BuildAuditChanges(map[string]any{"id": 1, "password": "x"}, nil)
+	require.Equal(t, AuditFieldChange{Before: float64(1)}, changes["id"])
+	require.Equal(t, AuditFieldChange{Before: "***"}, changes["password"])
+	require.Len(t, changes, 2)
+}
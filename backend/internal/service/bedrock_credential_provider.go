@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const (
+	bedrockSigningName             = "bedrock"
+	bedrockCredentialExpiry        = 5 * time.Minute
+	bedrockAssumeRoleSessionPrefix = "sub2api-"
+)
+
+// BedrockCredentialProvider 解析 Bedrock 账号的 AWS 凭证并为上游请求做 SigV4 签名。
+//
+// 凭证字段（account.credentials）：
+//   - aws_region：必填
+//   - aws_access_key_id / aws_secret_access_key / aws_session_token：静态凭证（session token 可选）
+//   - aws_role_arn / aws_external_id：可选，以静态凭证调用 STS AssumeRole 获取临时凭证（按账号缓存，过期前自动续期）
+type BedrockCredentialProvider struct {
+	httpUpstream HTTPUpstream
+	signer       *v4.Signer
+
+	mu    sync.Mutex
+	roles map[int64]*bedrockRoleCredentials
+}
+
+type bedrockRoleCredentials struct {
+	fingerprint string
+	cache       *aws.CredentialsCache
+}
+
+// NewBedrockCredentialProvider 创建 Bedrock 凭证提供者
+func NewBedrockCredentialProvider(httpUpstream HTTPUpstream) *BedrockCredentialProvider {
+	return &BedrockCredentialProvider{
+		httpUpstream: httpUpstream,
+		signer:       v4.NewSigner(),
+		roles:        make(map[int64]*bedrockRoleCredentials),
+	}
+}
+
+// Retrieve 返回账号当前可用的 AWS 凭证
+func (p *BedrockCredentialProvider) Retrieve(ctx context.Context, account *Account) (aws.Credentials, error) {
+	if account == nil || !account.IsBedrock() {
+		return aws.Credentials{}, errors.New("not a bedrock account")
+	}
+	region := account.GetBedrockRegion()
+	if region == "" {
+		return aws.Credentials{}, errors.New("aws_region not found in credentials")
+	}
+	static := aws.Credentials{
+		AccessKeyID:     strings.TrimSpace(account.GetCredential("aws_access_key_id")),
+		SecretAccessKey: strings.TrimSpace(account.GetCredential("aws_secret_access_key")),
+		SessionToken:    strings.TrimSpace(account.GetCredential("aws_session_token")),
+		Source:          "sub2api",
+	}
+	if static.AccessKeyID == "" || static.SecretAccessKey == "" {
+		return aws.Credentials{}, errors.New("aws_access_key_id/aws_secret_access_key not found in credentials")
+	}
+
+	roleARN := strings.TrimSpace(account.GetCredential("aws_role_arn"))
+	if roleARN == "" {
+		return static, nil
+	}
+	return p.roleCredentials(account, region, roleARN, static).Retrieve(ctx)
+}
+
+// roleCredentials 返回账号的 AssumeRole 凭证缓存；凭证配置或代理变化时重建
+func (p *BedrockCredentialProvider) roleCredentials(account *Account, region, roleARN string, source aws.Credentials) *aws.CredentialsCache {
+	externalID := strings.TrimSpace(account.GetCredential("aws_external_id"))
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{region, roleARN, externalID, source.AccessKeyID, source.SecretAccessKey, source.SessionToken, proxyURL}, "\x00")))
+	fingerprint := hex.EncodeToString(sum[:8])
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if entry, ok := p.roles[account.ID]; ok && entry.fingerprint == fingerprint {
+		return entry.cache
+	}
+
+	client := sts.New(sts.Options{
+		Region:      region,
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) { return source, nil }),
+		HTTPClient:  &bedrockHTTPClient{upstream: p.httpUpstream, proxyURL: proxyURL, accountID: account.ID, concurrency: account.Concurrency},
+	})
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: aws.String(bedrockAssumeRoleSessionPrefix + strconv.FormatInt(account.ID, 10)),
+	}
+	if externalID != "" {
+		input.ExternalId = aws.String(externalID)
+	}
+	cache := aws.NewCredentialsCache(aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+		out, err := client.AssumeRole(ctx, input)
+		if err != nil {
+			return aws.Credentials{}, fmt.Errorf("assume role %s: %w", roleARN, err)
+		}
+		if out.Credentials == nil {
+			return aws.Credentials{}, fmt.Errorf("assume role %s: empty credentials", roleARN)
+		}
+		creds := aws.Credentials{
+			AccessKeyID:     aws.ToString(out.Credentials.AccessKeyId),
+			SecretAccessKey: aws.ToString(out.Credentials.SecretAccessKey),
+			SessionToken:    aws.ToString(out.Credentials.SessionToken),
+			Source:          "sub2api-assume-role",
+		}
+		if out.Credentials.Expiration != nil {
+			creds.CanExpire = true
+			creds.Expires = *out.Credentials.Expiration
+		}
+		return creds, nil
+	}), func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = bedrockCredentialExpiry
+	})
+	p.roles[account.ID] = &bedrockRoleCredentials{fingerprint: fingerprint, cache: cache}
+	return cache
+}
+
+// SignRequest 对 Bedrock Runtime 请求做 SigV4 签名；须在所有请求头设置完成后调用
+func (p *BedrockCredentialProvider) SignRequest(ctx context.Context, account *Account, req *http.Request, body []byte) error {
+	creds, err := p.Retrieve(ctx, account)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(body)
+	return p.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(sum[:]), bedrockSigningName, account.GetBedrockRegion(), time.Now())
+}
+
+// bedrockHTTPClient 让 STS 调用复用账号的代理与连接池
+type bedrockHTTPClient struct {
+	upstream    HTTPUpstream
+	proxyURL    string
+	accountID   int64
+	concurrency int
+}
+
+func (c *bedrockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return c.upstream.Do(req, c.proxyURL, c.accountID, c.concurrency)
+}
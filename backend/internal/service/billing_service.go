@@ -133,6 +133,12 @@ func (s *BillingService) initFallbackPricing() {
 		CacheReadPricePerToken:     0.03e-6, // $0.03 per MTok
 		SupportsCacheBreakdown:     false,
 	}
+
+	// Embedding 模型（仅输入计费，按 text-embedding-3-large / gemini-embedding-001 价位）
+	s.fallbackPrices["embedding"] = &ModelPricing{
+		InputPricePerToken:     0.15e-6, // $0.15 per MTok
+		SupportsCacheBreakdown: false,
+	}
 }
 
 // getFallbackPricing 根据模型系列获取回退价格
@@ -140,6 +146,9 @@ func (s *BillingService) getFallbackPricing(model string) *ModelPricing {
 	modelLower := strings.ToLower(model)
 
 	// 按模型系列匹配
+	if strings.Contains(modelLower, "embedding") {
+		return s.fallbackPrices["embedding"]
+	}
 	if strings.Contains(modelLower, "opus") {
 		if strings.Contains(modelLower, "4.5") || strings.Contains(modelLower, "4-5") {
 			return s.fallbackPrices["claude-opus-4.5"]
@@ -206,7 +215,21 @@ func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMul
 	if err != nil {
 		return nil, err
 	}
+	return calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+}
+
+// CalculateCostForAccount 计算使用费用，账号配置了模型价格覆盖（extra.model_pricing）时优先使用。
+// 用于 LiteLLM 价格表缺失的自建/第三方模型（如 OpenAI 兼容上游账号）。
+func (s *BillingService) CalculateCostForAccount(account *Account, model string, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
+	if account != nil {
+		if pricing := account.GetModelPricingOverride(model); pricing != nil {
+			return calculateCostWithPricing(pricing, tokens, rateMultiplier), nil
+		}
+	}
+	return s.CalculateCost(model, tokens, rateMultiplier)
+}
 
+func calculateCostWithPricing(pricing *ModelPricing, tokens UsageTokens, rateMultiplier float64) *CostBreakdown {
 	breakdown := &CostBreakdown{}
 
 	// 计算输入token费用（使用per-token价格）
@@ -242,7 +265,7 @@ func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMul
 	}
 	breakdown.ActualCost = breakdown.TotalCost * rateMultiplier
 
-	return breakdown, nil
+	return breakdown
 }
 
 // CalculateCostWithConfig 使用配置中的默认倍率计算费用
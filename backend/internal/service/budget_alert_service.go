@@ -0,0 +1,672 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+)
+
+// Budget alert metrics
+const (
+	BudgetAlertMetricBalance             = "balance"
+	BudgetAlertMetricAPIKeyQuota         = "api_key_quota"
+	BudgetAlertMetricSubscriptionDaily   = "subscription_daily"
+	BudgetAlertMetricSubscriptionWeekly  = "subscription_weekly"
+	BudgetAlertMetricSubscriptionMonthly = "subscription_monthly"
+)
+
+// Budget alert threshold types
+const (
+	// BudgetAlertThresholdRemainingUSD 剩余金额（USD）低于或等于阈值时触发
+	BudgetAlertThresholdRemainingUSD = "remaining_usd"
+	// BudgetAlertThresholdPercentUsed 已用比例（0-100）达到或超过阈值时触发
+	BudgetAlertThresholdPercentUsed = "percent_used"
+)
+
+const (
+	budgetAlertMaxPerUser       = 20
+	budgetAlertEvaluateTimeout  = 50 * time.Second
+	budgetAlertWebhookTimeout   = 10 * time.Second
+	budgetAlertWebhookMaxURLLen = 500
+
+	// budgetAlertArmedWindow 余额/Key 额度没有自然窗口：触发后保持该标记，直到指标恢复才清空
+	budgetAlertArmedWindow = "triggered"
+)
+
+var (
+	ErrBudgetAlertNotFound         = infraerrors.NotFound("BUDGET_ALERT_NOT_FOUND", "budget alert not found")
+	ErrBudgetAlertLimitReached     = infraerrors.BadRequest("BUDGET_ALERT_LIMIT_REACHED", fmt.Sprintf("at most %d budget alerts per user", budgetAlertMaxPerUser))
+	ErrBudgetAlertInvalidMetric    = infraerrors.BadRequest("BUDGET_ALERT_INVALID_METRIC", "invalid budget alert metric")
+	ErrBudgetAlertInvalidThreshold = infraerrors.BadRequest("BUDGET_ALERT_INVALID_THRESHOLD", "invalid budget alert threshold")
+	ErrBudgetAlertTargetRequired   = infraerrors.BadRequest("BUDGET_ALERT_TARGET_REQUIRED", "api_key_id or subscription_id is required for this metric")
+	ErrBudgetAlertNoAction         = infraerrors.BadRequest("BUDGET_ALERT_NO_ACTION", "at least one of notify_email, webhook_url or auto_disable_key is required")
+	ErrBudgetAlertInvalidWebhook   = infraerrors.BadRequest("BUDGET_ALERT_INVALID_WEBHOOK", "invalid webhook url")
+	ErrBudgetAlertDisableKeyNoKey  = infraerrors.BadRequest("BUDGET_ALERT_DISABLE_KEY_REQUIRES_KEY", "auto_disable_key requires api_key_id")
+)
+
+// BudgetAlert 用户预算告警规则
+type BudgetAlert struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	Metric         string
+	ThresholdType  string
+	Threshold      float64
+	APIKeyID       *int64
+	SubscriptionID *int64
+	NotifyEmail    bool
+	WebhookURL     *string
+	AutoDisableKey bool
+	Enabled        bool
+
+	LastTriggeredWindow *string
+	LastTriggeredAt     *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// BudgetAlertRepository 预算告警规则仓储
+type BudgetAlertRepository interface {
+	Create(ctx context.Context, alert *BudgetAlert) error
+	GetByID(ctx context.Context, id int64) (*BudgetAlert, error)
+	// Update 更新规则配置，并清空去重状态（配置变化后重新生效）
+	Update(ctx context.Context, alert *BudgetAlert) error
+	Delete(ctx context.Context, id int64) error
+	ListByUserID(ctx context.Context, userID int64) ([]BudgetAlert, error)
+	CountByUserID(ctx context.Context, userID int64) (int, error)
+	ListEnabled(ctx context.Context) ([]BudgetAlert, error)
+	// ClaimWindow 仅当 last_triggered_window 与 window 不同时写入，返回是否写入成功。
+	// 多实例同时评估时只有一个实例会抢占成功并发送通知。
+	ClaimWindow(ctx context.Context, id int64, window string, triggeredAt time.Time) (bool, error)
+	ClearWindow(ctx context.Context, id int64) error
+}
+
+// CreateBudgetAlertInput 创建预算告警参数
+type CreateBudgetAlertInput struct {
+	Name           string
+	Metric         string
+	ThresholdType  string
+	Threshold      float64
+	APIKeyID       *int64
+	SubscriptionID *int64
+	NotifyEmail    bool
+	WebhookURL     string
+	AutoDisableKey bool
+}
+
+// UpdateBudgetAlertInput 更新预算告警参数（nil 表示不修改；APIKeyID/SubscriptionID 为 0、WebhookURL 为空表示清除）
+type UpdateBudgetAlertInput struct {
+	Name           *string
+	Metric         *string
+	ThresholdType  *string
+	Threshold      *float64
+	APIKeyID       *int64
+	SubscriptionID *int64
+	NotifyEmail    *bool
+	WebhookURL     *string
+	AutoDisableKey *bool
+	Enabled        *bool
+}
+
+// budgetAlertReading 一次评估时读取到的指标值
+type budgetAlertReading struct {
+	LimitUSD     float64 // 0 表示无上限（余额）
+	UsedUSD      float64
+	RemainingUSD float64
+	PercentUsed  float64
+	Window       string
+	Subject      string // 通知中展示的监控对象描述
+}
+
+// BudgetAlertService 管理用户预算告警规则，并周期性评估触发通知与自动禁用 Key。
+type BudgetAlertService struct {
+	repo                BudgetAlertRepository
+	userRepo            UserRepository
+	apiKeyService       *APIKeyService
+	subscriptionService *SubscriptionService
+	emailQueueService   *EmailQueueService
+	settingService      *SettingService
+	cfg                 *config.Config
+
+	httpClient *http.Client
+	interval   time.Duration
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+	wg         sync.WaitGroup
+}
+
+// NewBudgetAlertService creates a new BudgetAlertService
+func NewBudgetAlertService(
+	repo BudgetAlertRepository,
+	userRepo UserRepository,
+	apiKeyService *APIKeyService,
+	subscriptionService *SubscriptionService,
+	emailQueueService *EmailQueueService,
+	settingService *SettingService,
+	cfg *config.Config,
+	interval time.Duration,
+) *BudgetAlertService {
+	allowPrivate := cfg != nil && cfg.Security.URLAllowlist.AllowPrivateHosts
+	client, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            budgetAlertWebhookTimeout,
+		ValidateResolvedIP: true,
+		AllowPrivateHosts:  allowPrivate,
+	})
+	if err != nil {
+		client = &http.Client{Timeout: budgetAlertWebhookTimeout}
+	}
+	return &BudgetAlertService{
+		repo:                repo,
+		userRepo:            userRepo,
+		apiKeyService:       apiKeyService,
+		subscriptionService: subscriptionService,
+		emailQueueService:   emailQueueService,
+		settingService:      settingService,
+		cfg:                 cfg,
+		httpClient:          client,
+		interval:            interval,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+// List 获取用户的预算告警规则
+func (s *BudgetAlertService) List(ctx context.Context, userID int64) ([]BudgetAlert, error) {
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Create 创建预算告警规则
+func (s *BudgetAlertService) Create(ctx context.Context, userID int64, input *CreateBudgetAlertInput) (*BudgetAlert, error) {
+	count, err := s.repo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count budget alerts: %w", err)
+	}
+	if count >= budgetAlertMaxPerUser {
+		return nil, ErrBudgetAlertLimitReached
+	}
+
+	alert := &BudgetAlert{
+		UserID:         userID,
+		Name:           strings.TrimSpace(input.Name),
+		Metric:         input.Metric,
+		ThresholdType:  input.ThresholdType,
+		Threshold:      input.Threshold,
+		APIKeyID:       normalizeOptionalID(input.APIKeyID),
+		SubscriptionID: normalizeOptionalID(input.SubscriptionID),
+		NotifyEmail:    input.NotifyEmail,
+		AutoDisableKey: input.AutoDisableKey,
+		Enabled:        true,
+	}
+	if webhook := strings.TrimSpace(input.WebhookURL); webhook != "" {
+		alert.WebhookURL = &webhook
+	}
+	if err := s.validate(ctx, alert); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, alert); err != nil {
+		return nil, fmt.Errorf("create budget alert: %w", err)
+	}
+	return alert, nil
+}
+
+// Update 更新预算告警规则（仅限所有者）
+func (s *BudgetAlertService) Update(ctx context.Context, id, userID int64, input *UpdateBudgetAlertInput) (*BudgetAlert, error) {
+	alert, err := s.getOwned(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		alert.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Metric != nil {
+		alert.Metric = *input.Metric
+	}
+	if input.ThresholdType != nil {
+		alert.ThresholdType = *input.ThresholdType
+	}
+	if input.Threshold != nil {
+		alert.Threshold = *input.Threshold
+	}
+	if input.APIKeyID != nil {
+		alert.APIKeyID = normalizeOptionalID(input.APIKeyID)
+	}
+	if input.SubscriptionID != nil {
+		alert.SubscriptionID = normalizeOptionalID(input.SubscriptionID)
+	}
+	if input.NotifyEmail != nil {
+		alert.NotifyEmail = *input.NotifyEmail
+	}
+	if input.WebhookURL != nil {
+		alert.WebhookURL = nil
+		if webhook := strings.TrimSpace(*input.WebhookURL); webhook != "" {
+			alert.WebhookURL = &webhook
+		}
+	}
+	if input.AutoDisableKey != nil {
+		alert.AutoDisableKey = *input.AutoDisableKey
+	}
+	if input.Enabled != nil {
+		alert.Enabled = *input.Enabled
+	}
+	if err := s.validate(ctx, alert); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, alert); err != nil {
+		return nil, fmt.Errorf("update budget alert: %w", err)
+	}
+	alert.LastTriggeredWindow = nil
+	alert.LastTriggeredAt = nil
+	return alert, nil
+}
+
+// Delete 删除预算告警规则（仅限所有者）
+func (s *BudgetAlertService) Delete(ctx context.Context, id, userID int64) error {
+	if _, err := s.getOwned(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *BudgetAlertService) getOwned(ctx context.Context, id, userID int64) (*BudgetAlert, error) {
+	alert, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 不暴露其他用户规则的存在性
+	if alert.UserID != userID {
+		return nil, ErrBudgetAlertNotFound
+	}
+	return alert, nil
+}
+
+func normalizeOptionalID(id *int64) *int64 {
+	if id == nil || *id <= 0 {
+		return nil
+	}
+	v := *id
+	return &v
+}
+
+// validate 校验规则配置，并确认关联的 API Key / 订阅归属于该用户
+func (s *BudgetAlertService) validate(ctx context.Context, alert *BudgetAlert) error {
+	if len([]rune(alert.Name)) > 100 {
+		return infraerrors.BadRequest("BUDGET_ALERT_INVALID_NAME", "name is too long")
+	}
+
+	switch alert.Metric {
+	case BudgetAlertMetricBalance:
+		// 余额没有上限，只支持剩余金额阈值
+		if alert.ThresholdType != BudgetAlertThresholdRemainingUSD {
+			return ErrBudgetAlertInvalidThreshold
+		}
+	case BudgetAlertMetricAPIKeyQuota:
+		if alert.APIKeyID == nil {
+			return ErrBudgetAlertTargetRequired
+		}
+	case BudgetAlertMetricSubscriptionDaily, BudgetAlertMetricSubscriptionWeekly, BudgetAlertMetricSubscriptionMonthly:
+		if alert.SubscriptionID == nil {
+			return ErrBudgetAlertTargetRequired
+		}
+	default:
+		return ErrBudgetAlertInvalidMetric
+	}
+
+	switch alert.ThresholdType {
+	case BudgetAlertThresholdRemainingUSD:
+		if alert.Threshold < 0 {
+			return ErrBudgetAlertInvalidThreshold
+		}
+	case BudgetAlertThresholdPercentUsed:
+		if alert.Threshold <= 0 || alert.Threshold > 100 {
+			return ErrBudgetAlertInvalidThreshold
+		}
+	default:
+		return ErrBudgetAlertInvalidThreshold
+	}
+
+	if alert.AutoDisableKey && alert.APIKeyID == nil {
+		return ErrBudgetAlertDisableKeyNoKey
+	}
+	if !alert.NotifyEmail && alert.WebhookURL == nil && !alert.AutoDisableKey {
+		return ErrBudgetAlertNoAction
+	}
+
+	if alert.WebhookURL != nil {
+		if len(*alert.WebhookURL) > budgetAlertWebhookMaxURLLen {
+			return ErrBudgetAlertInvalidWebhook
+		}
+		// 用户提供的地址：始终要求 HTTPS 并拒绝内网地址，防止 SSRF
+		normalized, err := urlvalidator.ValidateHTTPSURL(*alert.WebhookURL, urlvalidator.ValidationOptions{
+			AllowPrivate: s.cfg != nil && s.cfg.Security.URLAllowlist.AllowPrivateHosts,
+		})
+		if err != nil {
+			return ErrBudgetAlertInvalidWebhook.WithCause(err)
+		}
+		alert.WebhookURL = &normalized
+	}
+
+	if alert.APIKeyID != nil {
+		key, err := s.apiKeyService.GetByID(ctx, *alert.APIKeyID)
+		if err != nil {
+			if errors.Is(err, ErrAPIKeyNotFound) {
+				return ErrAPIKeyNotFound
+			}
+			return fmt.Errorf("get api key: %w", err)
+		}
+		if key.UserID != alert.UserID {
+			return ErrAPIKeyNotFound
+		}
+	}
+	if alert.SubscriptionID != nil {
+		sub, err := s.subscriptionService.GetByID(ctx, *alert.SubscriptionID)
+		if err != nil || sub.UserID != alert.UserID {
+			return ErrSubscriptionNotFound
+		}
+	}
+	return nil
+}
+
+// Start 启动周期性评估
+func (s *BudgetAlertService) Start() {
+	if s == nil || s.repo == nil || s.interval <= 0 {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.runOnce()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止周期性评估
+func (s *BudgetAlertService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *BudgetAlertService) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), budgetAlertEvaluateTimeout)
+	defer cancel()
+
+	alerts, err := s.repo.ListEnabled(ctx)
+	if err != nil {
+		log.Printf("[BudgetAlert] List enabled alerts failed: %v", err)
+		return
+	}
+
+	users := make(map[int64]*User)
+	for i := range alerts {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.evaluate(ctx, &alerts[i], users); err != nil {
+			log.Printf("[BudgetAlert] Evaluate alert %d failed: %v", alerts[i].ID, err)
+		}
+	}
+}
+
+// evaluate 评估单条规则：达到阈值且当前窗口未通知过则触发；指标恢复后清空去重状态以便再次触发。
+func (s *BudgetAlertService) evaluate(ctx context.Context, alert *BudgetAlert, users map[int64]*User) error {
+	user, ok := users[alert.UserID]
+	if !ok {
+		var err error
+		user, err = s.userRepo.GetByID(ctx, alert.UserID)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		users[alert.UserID] = user
+	}
+
+	reading, err := s.measure(ctx, alert, user)
+	if err != nil {
+		return err
+	}
+
+	if reading == nil || !budgetAlertBreached(alert, reading) {
+		if alert.LastTriggeredWindow != nil {
+			return s.repo.ClearWindow(ctx, alert.ID)
+		}
+		return nil
+	}
+	if alert.LastTriggeredWindow != nil && *alert.LastTriggeredWindow == reading.Window {
+		return nil
+	}
+
+	now := time.Now()
+	claimed, err := s.repo.ClaimWindow(ctx, alert.ID, reading.Window, now)
+	if err != nil || !claimed {
+		return err
+	}
+	s.fire(ctx, alert, user, reading, now)
+	return nil
+}
+
+// measure 读取规则监控的指标。返回 nil 表示当前不可评估（如 Key 无额度上限、订阅窗口未激活）。
+func (s *BudgetAlertService) measure(ctx context.Context, alert *BudgetAlert, user *User) (*budgetAlertReading, error) {
+	switch alert.Metric {
+	case BudgetAlertMetricBalance:
+		return &budgetAlertReading{
+			RemainingUSD: user.Balance,
+			Window:       budgetAlertArmedWindow,
+			Subject:      "Account balance",
+		}, nil
+
+	case BudgetAlertMetricAPIKeyQuota:
+		if alert.APIKeyID == nil {
+			return nil, nil
+		}
+		key, err := s.apiKeyService.GetByID(ctx, *alert.APIKeyID)
+		if err != nil {
+			if errors.Is(err, ErrAPIKeyNotFound) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("get api key: %w", err)
+		}
+		if key.UserID != alert.UserID || key.Quota <= 0 {
+			return nil, nil
+		}
+		return &budgetAlertReading{
+			LimitUSD:     key.Quota,
+			UsedUSD:      key.QuotaUsed,
+			RemainingUSD: key.GetQuotaRemaining(),
+			PercentUsed:  key.QuotaUsed / key.Quota * 100,
+			Window:       budgetAlertArmedWindow,
+			Subject:      fmt.Sprintf("API key %q quota", key.Name),
+		}, nil
+
+	case BudgetAlertMetricSubscriptionDaily, BudgetAlertMetricSubscriptionWeekly, BudgetAlertMetricSubscriptionMonthly:
+		if alert.SubscriptionID == nil {
+			return nil, nil
+		}
+		progress, err := s.subscriptionService.GetSubscriptionProgress(ctx, *alert.SubscriptionID)
+		if err != nil {
+			if errors.Is(err, ErrSubscriptionNotFound) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("get subscription progress: %w", err)
+		}
+		var window *UsageWindowProgress
+		var label string
+		switch alert.Metric {
+		case BudgetAlertMetricSubscriptionDaily:
+			window, label = progress.Daily, "daily"
+		case BudgetAlertMetricSubscriptionWeekly:
+			window, label = progress.Weekly, "weekly"
+		default:
+			window, label = progress.Monthly, "monthly"
+		}
+		// 窗口已过期但尚未被请求重置时，旧用量不再代表当前窗口
+		if window == nil || window.LimitUSD <= 0 || !time.Now().Before(window.ResetsAt) {
+			return nil, nil
+		}
+		return &budgetAlertReading{
+			LimitUSD:     window.LimitUSD,
+			UsedUSD:      window.UsedUSD,
+			RemainingUSD: window.RemainingUSD,
+			PercentUsed:  window.UsedUSD / window.LimitUSD * 100,
+			Window:       window.WindowStart.UTC().Format(time.RFC3339),
+			Subject:      fmt.Sprintf("Subscription %q %s limit", progress.GroupName, label),
+		}, nil
+	}
+	return nil, nil
+}
+
+func budgetAlertBreached(alert *BudgetAlert, reading *budgetAlertReading) bool {
+	switch alert.ThresholdType {
+	case BudgetAlertThresholdRemainingUSD:
+		return reading.RemainingUSD <= alert.Threshold
+	case BudgetAlertThresholdPercentUsed:
+		return reading.LimitUSD > 0 && reading.PercentUsed >= alert.Threshold
+	}
+	return false
+}
+
+// fire 执行规则动作：自动禁用 Key、发送邮件与 Webhook。单个动作失败不影响其他动作。
+func (s *BudgetAlertService) fire(ctx context.Context, alert *BudgetAlert, user *User, reading *budgetAlertReading, now time.Time) {
+	keyDisabled := false
+	if alert.AutoDisableKey && alert.APIKeyID != nil {
+		disabled, err := s.apiKeyService.Disable(ctx, *alert.APIKeyID)
+		if err != nil {
+			log.Printf("[BudgetAlert] Alert %d failed to disable api key %d: %v", alert.ID, *alert.APIKeyID, err)
+		} else if disabled {
+			keyDisabled = true
+			log.Printf("[BudgetAlert] Alert %d disabled api key %d", alert.ID, *alert.APIKeyID)
+		}
+	}
+
+	if alert.NotifyEmail && s.emailQueueService != nil && user.Email != "" {
+		siteName := "Sub2API"
+		if s.settingService != nil {
+			siteName = s.settingService.GetSiteName(ctx)
+		}
+		subject := fmt.Sprintf("[%s] Budget alert: %s", siteName, budgetAlertTitle(alert, reading))
+		body := buildBudgetAlertEmailBody(alert, reading, keyDisabled, siteName)
+		if err := s.emailQueueService.EnqueueNotification(user.Email, subject, body); err != nil {
+			log.Printf("[BudgetAlert] Alert %d failed to enqueue email: %v", alert.ID, err)
+		}
+	}
+
+	if alert.WebhookURL != nil {
+		if err := s.sendWebhook(ctx, *alert.WebhookURL, buildBudgetAlertPayload(alert, reading, keyDisabled, now)); err != nil {
+			log.Printf("[BudgetAlert] Alert %d webhook failed: %v", alert.ID, err)
+		}
+	}
+}
+
+func (s *BudgetAlertService) sendWebhook(ctx context.Context, url string, payload map[string]any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, budgetAlertWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func budgetAlertTitle(alert *BudgetAlert, reading *budgetAlertReading) string {
+	if alert.Name != "" {
+		return alert.Name
+	}
+	return reading.Subject
+}
+
+func budgetAlertCondition(alert *BudgetAlert) string {
+	if alert.ThresholdType == BudgetAlertThresholdPercentUsed {
+		return fmt.Sprintf("usage reached %.0f%%", alert.Threshold)
+	}
+	return fmt.Sprintf("remaining at or below $%.2f", alert.Threshold)
+}
+
+func buildBudgetAlertPayload(alert *BudgetAlert, reading *budgetAlertReading, keyDisabled bool, now time.Time) map[string]any {
+	payload := map[string]any{
+		"event":          "budget_alert",
+		"alert_id":       alert.ID,
+		"alert_name":     alert.Name,
+		"metric":         alert.Metric,
+		"threshold_type": alert.ThresholdType,
+		"threshold":      alert.Threshold,
+		"subject":        reading.Subject,
+		"used_usd":       reading.UsedUSD,
+		"remaining_usd":  reading.RemainingUSD,
+		"key_disabled":   keyDisabled,
+		"triggered_at":   now.UTC().Format(time.RFC3339),
+	}
+	if reading.LimitUSD > 0 {
+		payload["limit_usd"] = reading.LimitUSD
+		payload["percent_used"] = reading.PercentUsed
+	}
+	if alert.APIKeyID != nil {
+		payload["api_key_id"] = *alert.APIKeyID
+	}
+	if alert.SubscriptionID != nil {
+		payload["subscription_id"] = *alert.SubscriptionID
+	}
+	return payload
+}
+
+func buildBudgetAlertEmailBody(alert *BudgetAlert, reading *budgetAlertReading, keyDisabled bool, siteName string) string {
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html><html><body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; color: #333;">`)
+	fmt.Fprintf(&b, `<h2>%s</h2>`, html.EscapeString(budgetAlertTitle(alert, reading)))
+	fmt.Fprintf(&b, `<p>%s: %s.</p>`, html.EscapeString(reading.Subject), html.EscapeString(budgetAlertCondition(alert)))
+	b.WriteString(`<table style="border-collapse: collapse;">`)
+	if reading.LimitUSD > 0 {
+		fmt.Fprintf(&b, `<tr><td style="padding: 4px 12px 4px 0;">Limit</td><td>$%.4f</td></tr>`, reading.LimitUSD)
+		fmt.Fprintf(&b, `<tr><td style="padding: 4px 12px 4px 0;">Used</td><td>$%.4f (%.1f%%)</td></tr>`, reading.UsedUSD, reading.PercentUsed)
+	}
+	fmt.Fprintf(&b, `<tr><td style="padding: 4px 12px 4px 0;">Remaining</td><td>$%.4f</td></tr>`, reading.RemainingUSD)
+	b.WriteString(`</table>`)
+	if keyDisabled {
+		b.WriteString(`<p><strong>The API key linked to this alert has been disabled.</strong> Re-enable it from the API keys page after topping up or adjusting limits.</p>`)
+	}
+	fmt.Fprintf(&b, `<p style="color: #999; font-size: 12px;">This notification is sent once per window by %s. Manage alerts in your account settings.</p>`, html.EscapeString(siteName))
+	b.WriteString(`</body></html>`)
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type budgetAlertRepoStub struct {
+	BudgetAlertRepository
+	alerts map[int64]*BudgetAlert
+}
+
+func (r *budgetAlertRepoStub) ClaimWindow(_ context.Context, id int64, window string, at time.Time) (bool, error) {
+	a := r.alerts[id]
+	if a.LastTriggeredWindow != nil && *a.LastTriggeredWindow == window {
+		return false, nil
+	}
+	a.LastTriggeredWindow = &window
+	a.LastTriggeredAt = &at
+	return true, nil
+}
+
+func (r *budgetAlertRepoStub) ClearWindow(_ context.Context, id int64) error {
+	r.alerts[id].LastTriggeredWindow = nil
+	return nil
+}
+
+type budgetAlertUserRepoStub struct {
+	UserRepository
+	user *User
+}
+
+func (r *budgetAlertUserRepoStub) GetByID(context.Context, int64) (*User, error) {
+	clone := *r.user
+	return &clone, nil
+}
+
+type budgetAlertAPIKeyRepoStub struct {
+	APIKeyRepository
+	key     *APIKey
+	updated int
+}
+
+func (r *budgetAlertAPIKeyRepoStub) GetByID(context.Context, int64) (*APIKey, error) {
+	clone := *r.key
+	return &clone, nil
+}
+
+func (r *budgetAlertAPIKeyRepoStub) Update(_ context.Context, key *APIKey) error {
+	r.updated++
+	r.key.Status = key.Status
+	return nil
+}
+
+func TestBudgetAlertBreached(t *testing.T) {
+	remaining := &BudgetAlert{ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5}
+	require.True(t, budgetAlertBreached(remaining, &budgetAlertReading{RemainingUSD: 5}))
+	require.False(t, budgetAlertBreached(remaining, &budgetAlertReading{RemainingUSD: 5.01}))
+
+	percent := &BudgetAlert{ThresholdType: BudgetAlertThresholdPercentUsed, Threshold: 80}
+	require.True(t, budgetAlertBreached(percent, &budgetAlertReading{LimitUSD: 10, PercentUsed: 80}))
+	require.False(t, budgetAlertBreached(percent, &budgetAlertReading{LimitUSD: 10, PercentUsed: 79.9}))
+	require.False(t, budgetAlertBreached(percent, &budgetAlertReading{PercentUsed: 100}))
+}
+
+func TestBudgetAlertEvaluateDedupAndRearm(t *testing.T) {
+	var payloads []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	webhook := server.URL
+	alert := &BudgetAlert{
+		ID: 1, UserID: 7, Metric: BudgetAlertMetricBalance,
+		ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5,
+		WebhookURL: &webhook, Enabled: true,
+	}
+	repo := &budgetAlertRepoStub{alerts: map[int64]*BudgetAlert{1: alert}}
+	userRepo := &budgetAlertUserRepoStub{user: &User{ID: 7, Balance: 3}}
+	cfg := &config.Config{}
+	cfg.Security.URLAllowlist.AllowPrivateHosts = true
+	svc := NewBudgetAlertService(repo, userRepo, nil, nil, nil, nil, cfg, 0)
+
+	evaluate := func() {
+		require.NoError(t, svc.evaluate(context.Background(), alert, map[int64]*User{}))
+	}
+
+	evaluate()
+	require.Len(t, payloads, 1)
+	require.Equal(t, "budget_alert", payloads[0]["event"])
+	require.Equal(t, float64(3), payloads[0]["remaining_usd"])
+	require.NotNil(t, alert.LastTriggeredWindow)
+
+	// 同一窗口内不重复通知
+	evaluate()
+	require.Len(t, payloads, 1)
+
+	// 充值后恢复，清空去重状态
+	userRepo.user.Balance = 20
+	evaluate()
+	require.Nil(t, alert.LastTriggeredWindow)
+	require.Len(t, payloads, 1)
+
+	// 再次跌破阈值时重新通知
+	userRepo.user.Balance = 1
+	evaluate()
+	require.Len(t, payloads, 2)
+}
+
+func TestBudgetAlertAutoDisableKey(t *testing.T) {
+	keyRepo := &budgetAlertAPIKeyRepoStub{key: &APIKey{
+		ID: 11, UserID: 7, Name: "ci", Key: "sk-test", Status: StatusAPIKeyActive,
+		Quota: 10, QuotaUsed: 9,
+	}}
+	apiKeyService := NewAPIKeyService(keyRepo, nil, nil, nil, nil, nil, &config.Config{})
+
+	keyID := int64(11)
+	alert := &BudgetAlert{
+		ID: 2, UserID: 7, Metric: BudgetAlertMetricAPIKeyQuota,
+		ThresholdType: BudgetAlertThresholdPercentUsed, Threshold: 90,
+		APIKeyID: &keyID, AutoDisableKey: true, Enabled: true,
+	}
+	repo := &budgetAlertRepoStub{alerts: map[int64]*BudgetAlert{2: alert}}
+	userRepo := &budgetAlertUserRepoStub{user: &User{ID: 7}}
+	svc := NewBudgetAlertService(repo, userRepo, apiKeyService, nil, nil, nil, &config.Config{}, 0)
+
+	require.NoError(t, svc.evaluate(context.Background(), alert, map[int64]*User{}))
+	require.Equal(t, StatusAPIKeyDisabled, keyRepo.key.Status)
+	require.Equal(t, 1, keyRepo.updated)
+	require.Equal(t, budgetAlertArmedWindow, *alert.LastTriggeredWindow)
+
+	// 其他用户的 Key 不会被评估
+	keyRepo.key.UserID = 8
+	alert.LastTriggeredWindow = nil
+	keyRepo.key.Status = StatusAPIKeyActive
+	require.NoError(t, svc.evaluate(context.Background(), alert, map[int64]*User{}))
+	require.Equal(t, StatusAPIKeyActive, keyRepo.key.Status)
+}
+
+func TestBudgetAlertValidate(t *testing.T) {
+	svc := NewBudgetAlertService(nil, nil, nil, nil, nil, nil, &config.Config{}, 0)
+	ctx := context.Background()
+
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: "cpu", ThresholdType: BudgetAlertThresholdRemainingUSD, NotifyEmail: true}), ErrBudgetAlertInvalidMetric)
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: BudgetAlertMetricBalance, ThresholdType: BudgetAlertThresholdPercentUsed, Threshold: 50, NotifyEmail: true}), ErrBudgetAlertInvalidThreshold)
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: BudgetAlertMetricAPIKeyQuota, ThresholdType: BudgetAlertThresholdPercentUsed, Threshold: 50, NotifyEmail: true}), ErrBudgetAlertTargetRequired)
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: BudgetAlertMetricBalance, ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5}), ErrBudgetAlertNoAction)
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: BudgetAlertMetricBalance, ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5, AutoDisableKey: true}), ErrBudgetAlertDisableKeyNoKey)
+
+	internal := "https://127.0.0.1/hook"
+	require.ErrorIs(t, svc.validate(ctx, &BudgetAlert{Metric: BudgetAlertMetricBalance, ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5, WebhookURL: &internal}), ErrBudgetAlertInvalidWebhook)
+
+	webhook := "https://hooks.example.com/budget/"
+	alert := &BudgetAlert{Metric: BudgetAlertMetricBalance, ThresholdType: BudgetAlertThresholdRemainingUSD, Threshold: 5, WebhookURL: &webhook}
+	require.NoError(t, svc.validate(ctx, alert))
+	require.Equal(t, "https://hooks.example.com/budget", *alert.WebhookURL)
+}
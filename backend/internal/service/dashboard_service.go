@@ -140,6 +140,14 @@ func (s *DashboardService) GetModelStatsWithFilters(ctx context.Context, startTi
 	return stats, nil
 }
 
+func (s *DashboardService) GetGroupCacheStats(ctx context.Context, startTime, endTime time.Time) ([]usagestats.GroupCacheStat, error) {
+	stats, err := s.usageRepo.GetGroupCacheStats(ctx, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("get group cache stats: %w", err)
+	}
+	return stats, nil
+}
+
 func (s *DashboardService) getCachedDashboardStats(ctx context.Context) (*usagestats.DashboardStats, bool, error) {
 	data, err := s.cache.GetDashboardStats(ctx)
 	if err != nil {
@@ -28,10 +28,14 @@ const (
 
 // Account type constants
 const (
-	AccountTypeOAuth      = domain.AccountTypeOAuth      // OAuth类型账号（full scope: profile + inference）
-	AccountTypeSetupToken = domain.AccountTypeSetupToken // Setup Token类型账号（inference only scope）
-	AccountTypeAPIKey     = domain.AccountTypeAPIKey     // API Key类型账号
-	AccountTypeUpstream   = domain.AccountTypeUpstream   // 上游透传类型账号（通过 Base URL + API Key 连接上游）
+	AccountTypeOAuth        = domain.AccountTypeOAuth        // OAuth类型账号（full scope: profile + inference）
+	AccountTypeSetupToken   = domain.AccountTypeSetupToken   // Setup Token类型账号（inference only scope）
+	AccountTypeAPIKey       = domain.AccountTypeAPIKey       // API Key类型账号
+	AccountTypeUpstream     = domain.AccountTypeUpstream     // 上游透传类型账号（通过 Base URL + API Key 连接上游）
+	AccountTypeBedrock      = domain.AccountTypeBedrock      // AWS Bedrock 类型账号（AWS 凭证 + SigV4 签名）
+	AccountTypeVertex       = domain.AccountTypeVertex       // Google Vertex AI 类型账号（服务账号 JSON 密钥）
+	AccountTypeAzure        = domain.AccountTypeAzure        // Azure OpenAI 类型账号（资源端点 + api-key）
+	AccountTypeOpenAICompat = domain.AccountTypeOpenAICompat // OpenAI 兼容 Chat Completions 上游（Base URL + API Key）
 )
 
 // Redeem type constants
@@ -48,6 +52,21 @@ const (
 	PromoCodeStatusDisabled = domain.PromoCodeStatusDisabled
 )
 
+// Payment order type constants
+const (
+	PaymentOrderTypeBalance      = domain.PaymentOrderTypeBalance      // 余额充值
+	PaymentOrderTypeSubscription = domain.PaymentOrderTypeSubscription // 购买订阅套餐
+)
+
+// Payment order status constants
+const (
+	PaymentOrderStatusPending   = domain.PaymentOrderStatusPending
+	PaymentOrderStatusPaid      = domain.PaymentOrderStatusPaid
+	PaymentOrderStatusRefunding = domain.PaymentOrderStatusRefunding
+	PaymentOrderStatusRefunded  = domain.PaymentOrderStatusRefunded
+	PaymentOrderStatusVoided    = domain.PaymentOrderStatusVoided
+)
+
 // Admin adjustment type constants
 const (
 	AdjustmentTypeAdminBalance     = domain.AdjustmentTypeAdminBalance     // 管理员调整余额
@@ -160,6 +179,9 @@ const (
 	// SettingKeyOpsEmailNotificationConfig stores JSON config for ops email notifications.
 	SettingKeyOpsEmailNotificationConfig = "ops_email_notification_config"
 
+	// SettingKeyOpsNotificationChannels stores JSON config for ops notification channels (webhook/telegram/dingtalk/feishu).
+	SettingKeyOpsNotificationChannels = "ops_notification_channels"
+
 	// SettingKeyOpsAlertRuntimeSettings stores JSON config for ops alert evaluator runtime settings.
 	SettingKeyOpsAlertRuntimeSettings = "ops_alert_runtime_settings"
 
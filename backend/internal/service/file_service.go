@@ -0,0 +1,475 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	claudeAPIBaseURL = "https://api.anthropic.com"
+
+	fileListDefaultLimit = 20
+	fileListMaxLimit     = 1000
+
+	// fileMetadataMaxBytes 上传/删除接口响应（文件元数据 JSON）的最大读取字节数
+	fileMetadataMaxBytes = 1 << 20
+)
+
+var (
+	ErrUpstreamFileNotFound   = infraerrors.NotFound("FILE_NOT_FOUND", "file not found")
+	ErrFilesDisabled          = infraerrors.NotFound("FILES_API_DISABLED", "files api is not enabled")
+	ErrFilesUnsupported       = infraerrors.BadRequest("FILES_API_UNSUPPORTED_GROUP", "files api is only supported for anthropic groups")
+	ErrFileLengthRequired     = infraerrors.New(http.StatusLengthRequired, "FILE_LENGTH_REQUIRED", "file uploads require a Content-Length header")
+	ErrFileTooLarge           = infraerrors.New(http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "file exceeds the maximum allowed size")
+	ErrFileQuotaExceeded      = infraerrors.New(http.StatusRequestEntityTooLarge, "FILE_STORAGE_QUOTA_EXCEEDED", "file storage quota exceeded")
+	ErrFileAccountConflict    = infraerrors.BadRequest("FILE_ACCOUNT_CONFLICT", "files referenced in one request must be uploaded through the same upstream account")
+	ErrFileAccountUnavailable = infraerrors.ServiceUnavailable("FILE_ACCOUNT_UNAVAILABLE", "no upstream account available for files api")
+)
+
+// UpstreamFile 通过 /v1/files 上传到上游账号的文件
+type UpstreamFile struct {
+	ID           int64
+	FileID       string
+	UserID       int64
+	APIKeyID     int64
+	AccountID    int64
+	Filename     string
+	MimeType     string
+	SizeBytes    int64
+	Downloadable bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// UpstreamFileListParams 列表分页参数（before_id / after_id 为对外的 file_id）
+type UpstreamFileListParams struct {
+	BeforeID string
+	AfterID  string
+	Limit    int
+}
+
+// UpstreamFilePage 文件列表分页结果
+type UpstreamFilePage struct {
+	Data    []UpstreamFile
+	HasMore bool
+}
+
+// UpstreamFileError 上游 Files API 返回的错误，原样透传状态码与错误信息
+type UpstreamFileError struct {
+	StatusCode int
+	ErrorType  string
+	Message    string
+}
+
+func (e *UpstreamFileError) Error() string {
+	return fmt.Sprintf("upstream files api error: status=%d type=%s message=%s", e.StatusCode, e.ErrorType, e.Message)
+}
+
+type UpstreamFileRepository interface {
+	Create(ctx context.Context, file *UpstreamFile) error
+	GetByFileID(ctx context.Context, fileID string) (*UpstreamFile, error)
+	ListByFileIDs(ctx context.Context, fileIDs []string) ([]UpstreamFile, error)
+	// ListByUserID 按 id 倒序分页；beforeID/afterID 为内部自增 id 游标
+	ListByUserID(ctx context.Context, userID int64, beforeID, afterID int64, limit int) ([]UpstreamFile, bool, error)
+	SumSizeByUserID(ctx context.Context, userID int64) (int64, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+// FileService Anthropic Files API 透传
+//
+// 上游的 file_id 只在接收上传的账号上有效，因此上传时记录 file_id → account 映射，
+// 之后引用该文件的 /v1/messages 请求通过 ResolveFileAccount 固定调度到持有文件的账号。
+// 列表与元数据查询直接读取本地记录，按用户隔离。
+type FileService struct {
+	repo           UpstreamFileRepository
+	gatewayService *GatewayService
+	cfg            *config.Config
+}
+
+// NewFileService creates a new FileService
+func NewFileService(repo UpstreamFileRepository, gatewayService *GatewayService, cfg *config.Config) *FileService {
+	return &FileService{
+		repo:           repo,
+		gatewayService: gatewayService,
+		cfg:            cfg,
+	}
+}
+
+// Enabled 是否启用 Files API
+func (s *FileService) Enabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.Gateway.Files.Enabled
+}
+
+// Upload 将 multipart 上传请求转发到分组内的一个 Anthropic 账号，并记录文件归属
+func (s *FileService) Upload(ctx context.Context, apiKey *APIKey, header http.Header, body io.Reader, contentLength int64) (*UpstreamFile, error) {
+	if !s.Enabled() {
+		return nil, ErrFilesDisabled
+	}
+	if apiKey.Group != nil && apiKey.Group.Platform != PlatformAnthropic {
+		return nil, ErrFilesUnsupported
+	}
+	if contentLength < 0 {
+		return nil, ErrFileLengthRequired
+	}
+	if contentLength > s.cfg.Gateway.Files.MaxFileSize {
+		return nil, ErrFileTooLarge
+	}
+	if quota := s.cfg.Gateway.Files.UserQuotaBytes; quota > 0 {
+		used, err := s.repo.SumSizeByUserID(ctx, apiKey.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("sum file storage: %w", err)
+		}
+		if used+contentLength > quota {
+			return nil, ErrFileQuotaExceeded
+		}
+	}
+
+	account, err := s.selectUploadAccount(ctx, apiKey.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.newUpstreamRequest(ctx, account, http.MethodPost, "/v1/files", body, header)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = contentLength
+	if ct := header.Get("Content-Type"); ct != "" {
+		req.Header.Set("Content-Type", ct)
+	}
+
+	respBody, err := s.do(ctx, account, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta struct {
+		ID           string `json:"id"`
+		Filename     string `json:"filename"`
+		MimeType     string `json:"mime_type"`
+		SizeBytes    int64  `json:"size_bytes"`
+		Downloadable bool   `json:"downloadable"`
+	}
+	if err := json.Unmarshal(respBody, &meta); err != nil || meta.ID == "" {
+		return nil, fmt.Errorf("parse upstream file metadata: invalid response")
+	}
+
+	file := &UpstreamFile{
+		FileID:       meta.ID,
+		UserID:       apiKey.UserID,
+		APIKeyID:     apiKey.ID,
+		AccountID:    account.ID,
+		Filename:     meta.Filename,
+		MimeType:     meta.MimeType,
+		SizeBytes:    meta.SizeBytes,
+		Downloadable: meta.Downloadable,
+	}
+	if err := s.repo.Create(ctx, file); err != nil {
+		return nil, fmt.Errorf("record upstream file: %w", err)
+	}
+	return file, nil
+}
+
+// Get 获取当前用户的文件元数据
+func (s *FileService) Get(ctx context.Context, userID int64, fileID string) (*UpstreamFile, error) {
+	return s.getOwned(ctx, userID, fileID)
+}
+
+// List 列出当前用户上传的文件（按创建时间倒序）
+func (s *FileService) List(ctx context.Context, userID int64, params UpstreamFileListParams) (*UpstreamFilePage, error) {
+	if !s.Enabled() {
+		return nil, ErrFilesDisabled
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = fileListDefaultLimit
+	}
+	if limit > fileListMaxLimit {
+		limit = fileListMaxLimit
+	}
+
+	var beforeID, afterID int64
+	if params.BeforeID != "" {
+		cursor, err := s.getOwned(ctx, userID, params.BeforeID)
+		if err != nil {
+			return nil, err
+		}
+		beforeID = cursor.ID
+	}
+	if params.AfterID != "" {
+		cursor, err := s.getOwned(ctx, userID, params.AfterID)
+		if err != nil {
+			return nil, err
+		}
+		afterID = cursor.ID
+	}
+
+	files, hasMore, err := s.repo.ListByUserID(ctx, userID, beforeID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list upstream files: %w", err)
+	}
+	return &UpstreamFilePage{Data: files, HasMore: hasMore}, nil
+}
+
+// OpenContent 从持有文件的账号下载文件内容，调用方负责关闭 resp.Body
+func (s *FileService) OpenContent(ctx context.Context, userID int64, fileID string) (*http.Response, error) {
+	file, err := s.getOwned(ctx, userID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.gatewayService.accountRepo.GetByID(ctx, file.AccountID)
+	if err != nil {
+		if errors.Is(err, ErrAccountNotFound) {
+			return nil, ErrUpstreamFileNotFound
+		}
+		return nil, err
+	}
+
+	req, err := s.newUpstreamRequest(ctx, account, http.MethodGet, "/v1/files/"+url.PathEscape(file.FileID)+"/content", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.gatewayService.httpUpstream.DoWithTLS(req, accountProxyURL(account), account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
+	if err != nil {
+		return nil, fmt.Errorf("upstream request failed: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, fileMetadataMaxBytes))
+		_ = resp.Body.Close()
+		return nil, s.upstreamError(ctx, account, resp, respBody)
+	}
+	return resp, nil
+}
+
+// Delete 删除上游文件并移除本地记录；持有账号已不存在或上游已删除时仅移除本地记录
+func (s *FileService) Delete(ctx context.Context, userID int64, fileID string) error {
+	file, err := s.getOwned(ctx, userID, fileID)
+	if err != nil {
+		return err
+	}
+
+	account, err := s.gatewayService.accountRepo.GetByID(ctx, file.AccountID)
+	switch {
+	case errors.Is(err, ErrAccountNotFound):
+		log.Printf("[Files] owner account %d of %s no longer exists, removing local record", file.AccountID, file.FileID)
+	case err != nil:
+		return err
+	default:
+		req, err := s.newUpstreamRequest(ctx, account, http.MethodDelete, "/v1/files/"+url.PathEscape(file.FileID), nil, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := s.do(ctx, account, req); err != nil {
+			var upstreamErr *UpstreamFileError
+			if !errors.As(err, &upstreamErr) || upstreamErr.StatusCode != http.StatusNotFound {
+				return err
+			}
+		}
+	}
+	return s.repo.Delete(ctx, file.ID)
+}
+
+// ResolveFileAccount 返回请求体中引用的 file_id 所属的上游账号（未引用本地记录的文件时返回 0）。
+// 引用其他用户的文件视为文件不存在；引用分属不同账号的文件无法在一次请求中满足。
+func (s *FileService) ResolveFileAccount(ctx context.Context, userID int64, body []byte) (int64, error) {
+	if !s.Enabled() {
+		return 0, nil
+	}
+	fileIDs := extractReferencedFileIDs(body)
+	if len(fileIDs) == 0 {
+		return 0, nil
+	}
+	files, err := s.repo.ListByFileIDs(ctx, fileIDs)
+	if err != nil {
+		return 0, fmt.Errorf("lookup referenced files: %w", err)
+	}
+
+	var accountID int64
+	for _, f := range files {
+		if f.UserID != userID {
+			return 0, infraerrors.BadRequest("FILE_NOT_FOUND", "file not found: "+f.FileID)
+		}
+		if accountID != 0 && accountID != f.AccountID {
+			return 0, ErrFileAccountConflict
+		}
+		accountID = f.AccountID
+	}
+	return accountID, nil
+}
+
+func (s *FileService) getOwned(ctx context.Context, userID int64, fileID string) (*UpstreamFile, error) {
+	if !s.Enabled() {
+		return nil, ErrFilesDisabled
+	}
+	if fileID == "" {
+		return nil, ErrUpstreamFileNotFound
+	}
+	file, err := s.repo.GetByFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if file.UserID != userID {
+		return nil, ErrUpstreamFileNotFound
+	}
+	return file, nil
+}
+
+// selectUploadAccount 选择接收上传的账号：只有 Anthropic 原生账号支持 Files API，
+// 混合调度选中的 antigravity 账号与 Bedrock / Vertex 账号会被排除后重选
+func (s *FileService) selectUploadAccount(ctx context.Context, groupID *int64) (*Account, error) {
+	excluded := make(map[int64]struct{})
+	for {
+		account, err := s.gatewayService.SelectAccountForModelWithExclusions(ctx, groupID, "", "", excluded)
+		if err != nil {
+			log.Printf("[Files] select upload account failed: %v", err)
+			return nil, ErrFileAccountUnavailable
+		}
+		if account.Platform == PlatformAnthropic && !account.IsBedrock() && !account.IsVertex() {
+			return account, nil
+		}
+		excluded[account.ID] = struct{}{}
+	}
+}
+
+func (s *FileService) newUpstreamRequest(ctx context.Context, account *Account, method, path string, body io.Reader, clientHeaders http.Header) (*http.Request, error) {
+	token, tokenType, err := s.gatewayService.GetAccessToken(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("get access token: %w", err)
+	}
+
+	baseURL := claudeAPIBaseURL
+	if account.Type == AccountTypeAPIKey {
+		if raw := account.GetBaseURL(); raw != "" {
+			validated, err := s.gatewayService.validateUpstreamBaseURL(raw)
+			if err != nil {
+				return nil, err
+			}
+			baseURL = validated
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenType == "oauth" {
+		req.Header.Set("authorization", "Bearer "+token)
+	} else {
+		req.Header.Set("x-api-key", token)
+	}
+
+	version := clientHeaders.Get("anthropic-version")
+	if version == "" {
+		version = "2023-06-01"
+	}
+	req.Header.Set("anthropic-version", version)
+
+	requiredBetas := []string{claude.BetaFilesAPI}
+	if tokenType == "oauth" {
+		requiredBetas = append(requiredBetas, claude.BetaOAuth)
+	}
+	req.Header.Set("anthropic-beta", mergeAnthropicBeta(requiredBetas, clientHeaders.Get("anthropic-beta")))
+
+	// OAuth 账号：沿用该账号缓存的客户端指纹，保持与 /v1/messages 一致的请求特征
+	if account.IsOAuth() && s.gatewayService.identityService != nil {
+		if fp, err := s.gatewayService.identityService.GetOrCreateFingerprint(ctx, account.ID, clientHeaders); err == nil && fp != nil {
+			s.gatewayService.identityService.ApplyFingerprint(req, fp)
+		}
+	}
+	return req, nil
+}
+
+// do 执行返回 JSON 元数据的上游请求（上传/删除）
+func (s *FileService) do(ctx context.Context, account *Account, req *http.Request) ([]byte, error) {
+	resp, err := s.gatewayService.httpUpstream.DoWithTLS(req, accountProxyURL(account), account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
+	if err != nil {
+		return nil, fmt.Errorf("upstream request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, fileMetadataMaxBytes))
+	if err != nil {
+		return nil, fmt.Errorf("read upstream response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, s.upstreamError(ctx, account, resp, respBody)
+	}
+	return respBody, nil
+}
+
+func (s *FileService) upstreamError(ctx context.Context, account *Account, resp *http.Response, respBody []byte) error {
+	if s.gatewayService.rateLimitService != nil {
+		s.gatewayService.rateLimitService.HandleUpstreamError(ctx, account, resp.StatusCode, resp.Header, respBody)
+	}
+	errType := gjson.GetBytes(respBody, "error.type").String()
+	if errType == "" {
+		errType = "api_error"
+	}
+	msg := sanitizeUpstreamErrorMessage(strings.TrimSpace(extractUpstreamErrorMessage(respBody)))
+	if msg == "" {
+		msg = "Upstream request failed"
+	}
+	return &UpstreamFileError{StatusCode: resp.StatusCode, ErrorType: errType, Message: msg}
+}
+
+func accountProxyURL(account *Account) string {
+	if account.ProxyID != nil && account.Proxy != nil {
+		return account.Proxy.URL()
+	}
+	return ""
+}
+
+// extractReferencedFileIDs 提取 messages 中通过 {"source":{"type":"file","file_id":...}}
+// 或 container_upload 块引用的 file_id（去重，保持出现顺序）
+func extractReferencedFileIDs(body []byte) []string {
+	if !bytes.Contains(body, []byte(`"file_id"`)) {
+		return nil
+	}
+	var ids []string
+	seen := make(map[string]struct{})
+	add := func(id string) {
+		if id == "" {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	gjson.GetBytes(body, "messages").ForEach(func(_, msg gjson.Result) bool {
+		msg.Get("content").ForEach(func(_, block gjson.Result) bool {
+			if source := block.Get("source"); source.Get("type").String() == "file" {
+				add(source.Get("file_id").String())
+			}
+			if block.Get("type").String() == "container_upload" {
+				add(block.Get("file_id").String())
+			}
+			// tool_result 内嵌的文档块
+			block.Get("content").ForEach(func(_, inner gjson.Result) bool {
+				if source := inner.Get("source"); source.Get("type").String() == "file" {
+					add(source.Get("file_id").String())
+				}
+				return true
+			})
+			return true
+		})
+		return true
+	})
+	return ids
+}
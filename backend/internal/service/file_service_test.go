@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type upstreamFileRepoStub struct {
+	UpstreamFileRepository
+
+	files   []UpstreamFile
+	used    int64
+	created *UpstreamFile
+}
+
+func (r *upstreamFileRepoStub) Create(_ context.Context, file *UpstreamFile) error {
+	file.ID = int64(len(r.files) + 1)
+	r.created = file
+	r.files = append(r.files, *file)
+	return nil
+}
+
+func (r *upstreamFileRepoStub) ListByFileIDs(_ context.Context, fileIDs []string) ([]UpstreamFile, error) {
+	var out []UpstreamFile
+	for _, f := range r.files {
+		for _, id := range fileIDs {
+			if f.FileID == id {
+				out = append(out, f)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *upstreamFileRepoStub) SumSizeByUserID(context.Context, int64) (int64, error) {
+	return r.used, nil
+}
+
+// fileTestAccountRepo 补充混合调度使用的多平台查询
+type fileTestAccountRepo struct {
+	stubOpenAIAccountRepo
+}
+
+func (r fileTestAccountRepo) ListSchedulableByPlatforms(ctx context.Context, platforms []string) ([]Account, error) {
+	var result []Account
+	for _, p := range platforms {
+		accounts, _ := r.ListSchedulableByPlatform(ctx, p)
+		result = append(result, accounts...)
+	}
+	return result, nil
+}
+
+func newTestFileService(repo UpstreamFileRepository, gateway *GatewayService) *FileService {
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	cfg.Gateway.Files = config.GatewayFilesConfig{Enabled: true, MaxFileSize: 1000, UserQuotaBytes: 2000}
+	if gateway != nil {
+		gateway.cfg = cfg
+	}
+	return NewFileService(repo, gateway, cfg)
+}
+
+func TestExtractReferencedFileIDs(t *testing.T) {
+	body := []byte(`{"model":"claude-sonnet-4-5","messages":[
+		{"role":"user","content":[
+			{"type":"document","source":{"type":"file","file_id":"file_a"}},
+			{"type":"image","source":{"type":"base64","data":"..."}},
+			{"type":"container_upload","file_id":"file_b"}
+		]},
+		{"role":"user","content":[
+			{"type":"tool_result","tool_use_id":"t1","content":[{"type":"document","source":{"type":"file","file_id":"file_c"}}]},
+			{"type":"document","source":{"type":"file","file_id":"file_a"}}
+		]},
+		{"role":"assistant","content":"plain text"}
+	]}`)
+	require.Equal(t, []string{"file_a", "file_b", "file_c"}, extractReferencedFileIDs(body))
+	require.Nil(t, extractReferencedFileIDs([]byte(`{"messages":[{"role":"user","content":"hi"}]}`)))
+}
+
+func TestFileService_ResolveFileAccount(t *testing.T) {
+	repo := &upstreamFileRepoStub{files: []UpstreamFile{
+		{FileID: "file_a", UserID: 1, AccountID: 10},
+		{FileID: "file_b", UserID: 1, AccountID: 11},
+		{FileID: "file_c", UserID: 2, AccountID: 10},
+	}}
+	svc := newTestFileService(repo, nil)
+	ref := func(ids ...string) []byte {
+		blocks := make([]string, 0, len(ids))
+		for _, id := range ids {
+			blocks = append(blocks, `{"type":"document","source":{"type":"file","file_id":"`+id+`"}}`)
+		}
+		return []byte(`{"messages":[{"role":"user","content":[` + strings.Join(blocks, ",") + `]}]}`)
+	}
+	ctx := context.Background()
+
+	accountID, err := svc.ResolveFileAccount(ctx, 1, ref("file_a"))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), accountID)
+
+	accountID, err = svc.ResolveFileAccount(ctx, 1, ref("file_unknown"))
+	require.NoError(t, err)
+	require.Zero(t, accountID)
+
+	_, err = svc.ResolveFileAccount(ctx, 1, ref("file_a", "file_b"))
+	require.ErrorIs(t, err, ErrFileAccountConflict)
+
+	_, err = svc.ResolveFileAccount(ctx, 1, ref("file_c"))
+	require.Equal(t, http.StatusBadRequest, infraerrors.Code(err))
+	require.Contains(t, infraerrors.Message(err), "file_c")
+
+	svc.cfg.Gateway.Files.Enabled = false
+	accountID, err = svc.ResolveFileAccount(ctx, 1, ref("file_c"))
+	require.NoError(t, err)
+	require.Zero(t, accountID)
+}
+
+func TestGatewayService_SelectAccountForModelWithExclusions_PinnedAccount(t *testing.T) {
+	repo := stubOpenAIAccountRepo{accounts: []Account{
+		{ID: 1, Platform: PlatformAnthropic, Priority: 1, Status: StatusActive, Schedulable: true},
+		{ID: 2, Platform: PlatformAnthropic, Priority: 5, Status: StatusActive, Schedulable: true},
+		{ID: 3, Platform: PlatformAnthropic, Priority: 1, Status: StatusActive, Schedulable: false},
+	}}
+	svc := &GatewayService{accountRepo: repo, cache: &stubGatewayCache{}, cfg: &config.Config{RunMode: config.RunModeStandard}}
+
+	ctx := context.WithValue(context.Background(), ctxkey.PinnedAccountID, int64(2))
+	acc, err := svc.SelectAccountForModelWithExclusions(ctx, nil, "", "claude-sonnet-4-5", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), acc.ID, "固定账号优先于优先级调度")
+
+	_, err = svc.SelectAccountForModelWithExclusions(ctx, nil, "", "claude-sonnet-4-5", map[int64]struct{}{2: {}})
+	require.Error(t, err, "固定账号失败后不应回退到其他账号")
+
+	ctx = context.WithValue(context.Background(), ctxkey.PinnedAccountID, int64(3))
+	_, err = svc.SelectAccountForModelWithExclusions(ctx, nil, "", "claude-sonnet-4-5", nil)
+	require.Error(t, err)
+
+	groupID := int64(7)
+	ctx = context.WithValue(context.Background(), ctxkey.PinnedAccountID, int64(1))
+	_, err = svc.selectPinnedAccount(ctx, &groupID, 1, "", nil)
+	require.Error(t, err, "固定账号必须属于请求分组")
+}
+
+func TestFileService_Upload(t *testing.T) {
+	account := Account{
+		ID:          5,
+		Platform:    PlatformAnthropic,
+		Type:        AccountTypeAPIKey,
+		Status:      StatusActive,
+		Schedulable: true,
+		Credentials: map[string]any{"api_key": "sk-ant", "base_url": "https://relay.example.com"},
+	}
+	accountRepo := fileTestAccountRepo{stubOpenAIAccountRepo{accounts: []Account{account}}}
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"id":"file_011","type":"file","filename":"a.pdf","mime_type":"application/pdf","size_bytes":321,"created_at":"2025-01-01T00:00:00Z","downloadable":false}`)),
+	}}
+	gateway := &GatewayService{accountRepo: accountRepo, cache: &stubGatewayCache{}, httpUpstream: upstream}
+	repo := &upstreamFileRepoStub{used: 1500}
+	svc := newTestFileService(repo, gateway)
+	apiKey := &APIKey{ID: 3, UserID: 9}
+	header := http.Header{"Content-Type": []string{"multipart/form-data; boundary=x"}}
+	ctx := context.Background()
+
+	_, err := svc.Upload(ctx, apiKey, header, strings.NewReader("payload"), -1)
+	require.ErrorIs(t, err, ErrFileLengthRequired)
+	_, err = svc.Upload(ctx, apiKey, header, strings.NewReader("payload"), 1001)
+	require.ErrorIs(t, err, ErrFileTooLarge)
+	_, err = svc.Upload(ctx, apiKey, header, strings.NewReader("payload"), 600)
+	require.ErrorIs(t, err, ErrFileQuotaExceeded)
+	_, err = svc.Upload(ctx, &APIKey{Group: &Group{Platform: PlatformGemini}}, header, strings.NewReader("payload"), 7)
+	require.ErrorIs(t, err, ErrFilesUnsupported)
+
+	file, err := svc.Upload(ctx, apiKey, header, strings.NewReader("payload"), 7)
+	require.NoError(t, err)
+	require.Equal(t, "https://relay.example.com/v1/files", upstream.req.URL.String())
+	require.Equal(t, "sk-ant", upstream.req.Header.Get("x-api-key"))
+	require.Equal(t, "multipart/form-data; boundary=x", upstream.req.Header.Get("Content-Type"))
+	require.Contains(t, upstream.req.Header.Get("anthropic-beta"), claude.BetaFilesAPI)
+	require.Equal(t, "payload", string(upstream.body))
+
+	require.Equal(t, "file_011", file.FileID)
+	require.Equal(t, int64(5), file.AccountID)
+	require.Equal(t, int64(9), file.UserID)
+	require.Equal(t, int64(321), file.SizeBytes)
+	require.Same(t, file, repo.created)
+}
+
+func TestFileService_UploadUpstreamError(t *testing.T) {
+	account := Account{
+		ID:          5,
+		Platform:    PlatformAnthropic,
+		Type:        AccountTypeAPIKey,
+		Status:      StatusActive,
+		Schedulable: true,
+		Credentials: map[string]any{"api_key": "sk-ant"},
+	}
+	accountRepo := fileTestAccountRepo{stubOpenAIAccountRepo{accounts: []Account{account}}}
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"type":"error","error":{"type":"invalid_request_error","message":"unsupported file type"}}`)),
+	}}
+	gateway := &GatewayService{accountRepo: accountRepo, cache: &stubGatewayCache{}, httpUpstream: upstream}
+	repo := &upstreamFileRepoStub{}
+	svc := newTestFileService(repo, gateway)
+
+	_, err := svc.Upload(context.Background(), &APIKey{UserID: 1}, http.Header{}, strings.NewReader("x"), 1)
+	var upstreamErr *UpstreamFileError
+	require.ErrorAs(t, err, &upstreamErr)
+	require.Equal(t, http.StatusBadRequest, upstreamErr.StatusCode)
+	require.Equal(t, "invalid_request_error", upstreamErr.ErrorType)
+	require.Equal(t, "unsupported file type", upstreamErr.Message)
+	require.Equal(t, "https://api.anthropic.com/v1/files", upstream.req.URL.String())
+	require.Nil(t, repo.created)
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+)
+
+// 自动插入 cache_control 断点
+//
+// 许多第三方客户端从不设置 cache_control，每轮对话都按全价计费输入。
+// 分组开启后，按 tools 末尾 → system 末尾 → 最近的用户轮次（从后往前）的顺序插入断点，
+// 已有断点的位置保持不变，总数不超过 Anthropic 允许的 4 个，且不破坏 1h 断点位于 5m 断点之前的顺序要求。
+
+// applyAutoCacheControl 分组开启自动缓存断点时改写请求体，返回新请求体与插入的断点数。
+// 账号开启缓存 TTL 强制替换且目标为 1h 时，插入的断点使用 1h TTL，使实际缓存时长与计费分类一致。
+func (s *GatewayService) applyAutoCacheControl(ctx context.Context, account *Account, body []byte) ([]byte, int) {
+	group, ok := ctx.Value(ctxkey.Group).(*Group)
+	if !ok || group == nil || !group.AutoCacheControlEnabled {
+		return body, 0
+	}
+	ttl := ""
+	if account.IsCacheTTLOverrideEnabled() && account.GetCacheTTLOverrideTarget() == "1h" {
+		ttl = "1h"
+	}
+	return insertCacheControlBreakpoints(body, ttl)
+}
+
+// insertCacheControlBreakpoints 在稳定位置插入 cache_control 断点，返回新请求体与插入的断点数
+func insertCacheControlBreakpoints(body []byte, ttl string) ([]byte, int) {
+	var data map[string]any
+	if err := json.Unmarshal(body, &data); err != nil {
+		return body, 0
+	}
+
+	tools, _ := data["tools"].([]any)
+	budget := maxCacheControlBlocks - countCacheControlBlocks(data)
+	for _, tool := range tools {
+		if m, ok := tool.(map[string]any); ok {
+			if _, has := m["cache_control"]; has {
+				budget--
+			}
+		}
+	}
+	if budget <= 0 {
+		return body, 0
+	}
+
+	order := scanCacheControlOrder(data)
+	inserted := 0
+	mark := func(block map[string]any, pos int) bool {
+		if block == nil || inserted >= budget || !order.allows(pos, ttl) {
+			return false
+		}
+		if _, has := block["cache_control"]; has {
+			return false
+		}
+		cc := map[string]any{"type": "ephemeral"}
+		if ttl != "" {
+			cc["ttl"] = ttl
+		}
+		block["cache_control"] = cc
+		inserted++
+		return true
+	}
+
+	// 1. tools 末尾
+	if len(tools) > 0 {
+		last, _ := tools[len(tools)-1].(map[string]any)
+		mark(last, len(tools)-1)
+	}
+
+	// 2. system 末尾（字符串形式转换为 text 块）
+	switch system := data["system"].(type) {
+	case string:
+		if system != "" && inserted < budget {
+			block := map[string]any{"type": "text", "text": system}
+			if mark(block, order.systemPos) {
+				data["system"] = []any{block}
+			}
+		}
+	case []any:
+		block, idx := lastCacheableBlock(system)
+		mark(block, order.systemPos+idx)
+	}
+
+	// 3. 最近的用户轮次：最后一轮写入缓存供下一轮读取，之前的轮次命中上一轮写入的缓存
+	if messages, ok := data["messages"].([]any); ok {
+		for i := len(messages) - 1; i >= 0 && inserted < budget; i-- {
+			msg, ok := messages[i].(map[string]any)
+			if !ok || msg["role"] != "user" {
+				continue
+			}
+			switch content := msg["content"].(type) {
+			case string:
+				if content != "" {
+					block := map[string]any{"type": "text", "text": content}
+					if mark(block, order.messagePos[i]) {
+						msg["content"] = []any{block}
+					}
+				}
+			case []any:
+				block, idx := lastCacheableBlock(content)
+				mark(block, order.messagePos[i]+idx)
+			}
+		}
+	}
+
+	if inserted == 0 {
+		return body, 0
+	}
+	result, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("Warning: failed to insert cache_control breakpoints: %v", err)
+		return body, 0
+	}
+	return result, inserted
+}
+
+// cacheControlOrder 按 tools → system → messages 的块序号记录已有断点的位置。
+// Anthropic 要求 1h 断点全部位于 5m 断点之前，插入的断点必须落在与其 TTL 相容的区间内。
+type cacheControlOrder struct {
+	firstShort int   // 第一个 5m（默认 TTL）断点的序号，-1 表示没有
+	lastLong   int   // 最后一个 1h 断点的序号，-1 表示没有
+	systemPos  int   // system 首块的序号
+	messagePos []int // 各消息首块的序号
+}
+
+func scanCacheControlOrder(data map[string]any) cacheControlOrder {
+	order := cacheControlOrder{firstShort: -1, lastLong: -1}
+	pos := 0
+	visit := func(v any) {
+		if m, ok := v.(map[string]any); ok {
+			if _, has := m["cache_control"]; has {
+				cc, _ := m["cache_control"].(map[string]any)
+				if ttl, _ := cc["ttl"].(string); ttl == "1h" {
+					order.lastLong = pos
+				} else if order.firstShort < 0 {
+					order.firstShort = pos
+				}
+			}
+		}
+		pos++
+	}
+	// 字符串形式的 system / content 视为单个块
+	visitBlocks := func(v any) {
+		blocks, ok := v.([]any)
+		if !ok {
+			pos++
+			return
+		}
+		for _, block := range blocks {
+			visit(block)
+		}
+	}
+
+	tools, _ := data["tools"].([]any)
+	for _, tool := range tools {
+		visit(tool)
+	}
+	order.systemPos = pos
+	if system, ok := data["system"]; ok {
+		visitBlocks(system)
+	}
+	messages, _ := data["messages"].([]any)
+	order.messagePos = make([]int, len(messages))
+	for i, msg := range messages {
+		order.messagePos[i] = pos
+		m, _ := msg.(map[string]any)
+		visitBlocks(m["content"])
+	}
+	return order
+}
+
+// allows 判断在序号 pos 处插入指定 TTL 的断点是否满足 TTL 顺序：
+// 1h 断点只能位于第一个 5m 断点之前，5m 断点只能位于最后一个 1h 断点之后
+func (o cacheControlOrder) allows(pos int, ttl string) bool {
+	if ttl == "1h" {
+		return o.firstShort < 0 || pos < o.firstShort
+	}
+	return pos > o.lastLong
+}
+
+// lastCacheableBlock 返回最后一个可以设置 cache_control 的内容块及其下标（thinking 块与空文本块不支持）
+func lastCacheableBlock(blocks []any) (map[string]any, int) {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		m, ok := blocks[i].(map[string]any)
+		if !ok {
+			continue
+		}
+		switch blockType, _ := m["type"].(string); blockType {
+		case "thinking", "redacted_thinking":
+			continue
+		case "text":
+			if text, _ := m["text"].(string); text == "" {
+				continue
+			}
+		}
+		return m, i
+	}
+	return nil, -1
+}
+
+// recordAutoCacheControlSavings 记录自动断点带来的缓存读取节省（按标价计算：缓存读取 token × (输入单价 − 缓存读取单价)）
+func (s *GatewayService) recordAutoCacheControlSavings(platform string, groupID *int64, result *ForwardResult) {
+	if result == nil || result.AutoCacheBreakpoints <= 0 {
+		return
+	}
+	savings := 0.0
+	if result.Usage.CacheReadInputTokens > 0 && s.billingService != nil {
+		if pricing, err := s.billingService.GetModelPricing(result.Model); err == nil {
+			savings = float64(result.Usage.CacheReadInputTokens) * (pricing.InputPricePerToken - pricing.CacheReadPricePerToken)
+		}
+	}
+	RecordAutoCacheControl(platform, groupID, result.Model, result.Usage.CacheCreationInputTokens, result.Usage.CacheReadInputTokens, savings)
+}
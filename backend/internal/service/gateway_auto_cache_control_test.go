@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestInsertCacheControlBreakpoints(t *testing.T) {
+	body := []byte(`{"model":"claude-sonnet-4-5","tools":[{"name":"a"},{"name":"b"}],"system":"sys",` +
+		`"messages":[{"role":"user","content":"q1"},{"role":"assistant","content":"a1"},` +
+		`{"role":"user","content":[{"type":"text","text":"q2"}]},{"role":"assistant","content":"a2"},` +
+		`{"role":"user","content":[{"type":"tool_result","tool_use_id":"t","content":"r"},{"type":"text","text":""}]}]}`)
+
+	out, inserted := insertCacheControlBreakpoints(body, "")
+	require.Equal(t, 4, inserted)
+	require.False(t, gjson.GetBytes(out, "tools.0.cache_control").Exists())
+	require.Equal(t, "ephemeral", gjson.GetBytes(out, "tools.1.cache_control.type").String())
+	require.Equal(t, "sys", gjson.GetBytes(out, "system.0.text").String())
+	require.True(t, gjson.GetBytes(out, "system.0.cache_control").Exists())
+	// 最近两个用户轮次；空文本块不能设置断点
+	require.True(t, gjson.GetBytes(out, "messages.4.content.0.cache_control").Exists())
+	require.False(t, gjson.GetBytes(out, "messages.4.content.1.cache_control").Exists())
+	require.True(t, gjson.GetBytes(out, "messages.2.content.0.cache_control").Exists())
+	require.Equal(t, "q1", gjson.GetBytes(out, "messages.0.content").String(), "超出断点上限的轮次保持原样")
+	require.False(t, gjson.GetBytes(out, "tools.1.cache_control.ttl").Exists())
+}
+
+func TestInsertCacheControlBreakpoints_RespectsExistingLimit(t *testing.T) {
+	body := []byte(`{"system":[{"type":"text","text":"a","cache_control":{"type":"ephemeral"}},{"type":"text","text":"b","cache_control":{"type":"ephemeral"}}],` +
+		`"messages":[{"role":"user","content":[{"type":"text","text":"q","cache_control":{"type":"ephemeral"}}]},` +
+		`{"role":"assistant","content":"a"},{"role":"user","content":"q2"}]}`)
+
+	out, inserted := insertCacheControlBreakpoints(body, "")
+	require.Equal(t, 1, inserted)
+	require.True(t, gjson.GetBytes(out, "messages.2.content.0.cache_control").Exists())
+
+	full, inserted := insertCacheControlBreakpoints(out, "")
+	require.Zero(t, inserted)
+	require.Equal(t, out, full)
+}
+
+func TestInsertCacheControlBreakpoints_MixedTTLOrder(t *testing.T) {
+	// 客户端在 system 中设置了 5m 断点：其后不能再插入 1h 断点
+	body := []byte(`{"tools":[{"name":"a"}],"system":[{"type":"text","text":"s","cache_control":{"type":"ephemeral"}}],` +
+		`"messages":[{"role":"user","content":"q1"},{"role":"assistant","content":"a1"},{"role":"user","content":"q2"}]}`)
+	out, inserted := insertCacheControlBreakpoints(body, "1h")
+	require.Equal(t, 1, inserted)
+	require.Equal(t, "1h", gjson.GetBytes(out, "tools.0.cache_control.ttl").String())
+	require.Equal(t, "q2", gjson.GetBytes(out, "messages.2.content").String())
+	require.Equal(t, "q1", gjson.GetBytes(out, "messages.0.content").String())
+
+	// 客户端在最近轮次设置了 1h 断点：其前不能再插入 5m 断点
+	body = []byte(`{"tools":[{"name":"a"}],"system":"s",` +
+		`"messages":[{"role":"user","content":[{"type":"text","text":"q1","cache_control":{"type":"ephemeral","ttl":"1h"}}]},` +
+		`{"role":"assistant","content":"a1"},{"role":"user","content":"q2"}]}`)
+	out, inserted = insertCacheControlBreakpoints(body, "")
+	require.Equal(t, 1, inserted)
+	require.False(t, gjson.GetBytes(out, "tools.0.cache_control").Exists())
+	require.Equal(t, "s", gjson.GetBytes(out, "system").String())
+	require.Equal(t, "ephemeral", gjson.GetBytes(out, "messages.2.content.0.cache_control.type").String())
+
+	// 插入的 TTL 与已有断点一致时不受位置限制
+	out, inserted = insertCacheControlBreakpoints(body, "1h")
+	require.Equal(t, 3, inserted)
+	require.Equal(t, "1h", gjson.GetBytes(out, "tools.0.cache_control.ttl").String())
+	require.Equal(t, "1h", gjson.GetBytes(out, "system.0.cache_control.ttl").String())
+	require.Equal(t, "1h", gjson.GetBytes(out, "messages.2.content.0.cache_control.ttl").String())
+}
+
+func TestApplyAutoCacheControl_GroupAndTTLOverride(t *testing.T) {
+	svc := &GatewayService{}
+	body := []byte(`{"messages":[{"role":"user","content":"hi"}]}`)
+	account := &Account{Platform: PlatformAnthropic, Type: AccountTypeOAuth, Extra: map[string]any{
+		"cache_ttl_override_enabled": true,
+		"cache_ttl_override_target":  "1h",
+	}}
+
+	out, inserted := svc.applyAutoCacheControl(context.Background(), account, body)
+	require.Zero(t, inserted)
+	require.Equal(t, body, out)
+
+	ctx := context.WithValue(context.Background(), ctxkey.Group, &Group{ID: 1, AutoCacheControlEnabled: true})
+	out, inserted = svc.applyAutoCacheControl(ctx, account, body)
+	require.Equal(t, 1, inserted)
+	require.Equal(t, "1h", gjson.GetBytes(out, "messages.0.content.0.cache_control.ttl").String())
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
+	"github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// AWS Bedrock 账号转发
+//
+// Bedrock Runtime 的 Claude 请求体与 Anthropic Messages API 基本一致，差异在于：
+//   - 模型 ID 放在 URL 路径中（/model/{modelId}/invoke[-with-response-stream]），请求体不含 model/stream；
+//   - 版本号与 beta 特性放在请求体（anthropic_version / anthropic_beta）；
+//   - 使用 SigV4 签名认证；
+//   - 流式响应为 AWS event-stream 二进制分帧，每帧 payload 的 bytes 字段是 base64 编码的 Anthropic SSE 事件；
+//   - 错误类型通过 x-amzn-ErrorType 响应头给出（如 ThrottlingException）。
+//
+// 本文件负责请求转换与响应还原，使 Forward 的重试、故障转移与 SSE 处理逻辑对 Bedrock 账号保持不变。
+
+const (
+	bedrockAnthropicVersion = "bedrock-2023-05-31"
+	bedrockErrorTypeHeader  = "x-amzn-ErrorType"
+	bedrockRequestIDHeader  = "x-amzn-RequestId"
+
+	// Bedrock 限流按分钟配额计算，未给出 Retry-After 时冷却 1 分钟
+	bedrockRateLimitCooldown = time.Minute
+)
+
+// bedrockRuntimeURL 返回 Bedrock Runtime 端点上指定模型的操作 URL
+func bedrockRuntimeURL(account *Account, modelID, action string) string {
+	return fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com/model/%s/%s", account.GetBedrockRegion(), url.PathEscape(modelID), action)
+}
+
+// buildBedrockRequestBody 将 Anthropic Messages 请求体转换为 Bedrock InvokeModel 请求体
+func buildBedrockRequestBody(body []byte, betaHeader string) ([]byte, error) {
+	var err error
+	for _, field := range []string{"model", "stream", "metadata"} {
+		if body, err = sjson.DeleteBytes(body, field); err != nil {
+			return nil, err
+		}
+	}
+	if !gjson.GetBytes(body, "anthropic_version").Exists() {
+		if body, err = sjson.SetBytes(body, "anthropic_version", bedrockAnthropicVersion); err != nil {
+			return nil, err
+		}
+	}
+	if !gjson.GetBytes(body, "anthropic_beta").Exists() {
+		if betas := cloudProviderBetas(betaHeader); len(betas) > 0 {
+			if body, err = sjson.SetBytes(body, "anthropic_beta", betas); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return body, nil
+}
+
+// cloudProviderBetas 过滤客户端 anthropic-beta 头：OAuth / Claude Code 专用 beta 在 Bedrock、Vertex 上无效
+func cloudProviderBetas(betaHeader string) []string {
+	betas := make([]string, 0, 4)
+	for _, token := range strings.Split(betaHeader, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" || token == claude.BetaOAuth || token == claude.BetaClaudeCode {
+			continue
+		}
+		betas = append(betas, token)
+	}
+	return betas
+}
+
+// newBedrockRuntimeRequest 构建并签名 Bedrock Runtime 请求
+func newBedrockRuntimeRequest(ctx context.Context, provider *BedrockCredentialProvider, account *Account, targetURL string, body []byte, accept string) (*http.Request, error) {
+	if provider == nil {
+		return nil, errors.New("bedrock credential provider not configured")
+	}
+	if account.GetBedrockRegion() == "" {
+		return nil, errors.New("aws_region not found in credentials")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("accept", accept)
+	if err := provider.SignRequest(ctx, account, req, body); err != nil {
+		return nil, fmt.Errorf("sign bedrock request: %w", err)
+	}
+	return req, nil
+}
+
+// buildBedrockRequest 构建 Bedrock 消息请求；客户端请求头不透传（beta 特性写入请求体）
+func (s *GatewayService) buildBedrockRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, modelID string, reqStream bool) (*http.Request, error) {
+	betaHeader := ""
+	if c != nil && c.Request != nil {
+		betaHeader = c.Request.Header.Get("anthropic-beta")
+	}
+	bedrockBody, err := buildBedrockRequestBody(body, betaHeader)
+	if err != nil {
+		return nil, fmt.Errorf("build bedrock body: %w", err)
+	}
+	action, accept := "invoke", "application/json"
+	if reqStream {
+		action, accept = "invoke-with-response-stream", "application/vnd.amazon.eventstream"
+	}
+	return newBedrockRuntimeRequest(ctx, s.bedrockCredentials, account, bedrockRuntimeURL(account, modelID, action), bedrockBody, accept)
+}
+
+// buildBedrockCountTokensRequest 构建 Bedrock CountTokens 请求（输入为 base64 编码的 InvokeModel 请求体）
+func (s *GatewayService) buildBedrockCountTokensRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, modelID string) (*http.Request, error) {
+	betaHeader := ""
+	if c != nil && c.Request != nil {
+		betaHeader = c.Request.Header.Get("anthropic-beta")
+	}
+	bedrockBody, err := buildBedrockRequestBody(body, betaHeader)
+	if err != nil {
+		return nil, fmt.Errorf("build bedrock body: %w", err)
+	}
+	// count_tokens 请求可能不带 max_tokens，而 InvokeModel 请求体要求该字段
+	if !gjson.GetBytes(bedrockBody, "max_tokens").Exists() {
+		bedrockBody, _ = sjson.SetBytes(bedrockBody, "max_tokens", 1)
+	}
+	payload, err := json.Marshal(map[string]any{
+		"input": map[string]any{
+			"invokeModel": map[string]any{"body": base64.StdEncoding.EncodeToString(bedrockBody)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBedrockRuntimeRequest(ctx, s.bedrockCredentials, account, bedrockRuntimeURL(account, modelID, "count-tokens"), payload, "application/json")
+}
+
+// convertBedrockCountTokensResponse {"inputTokens":N} → {"input_tokens":N}
+func convertBedrockCountTokensResponse(body []byte) []byte {
+	out, _ := json.Marshal(map[string]int64{"input_tokens": gjson.GetBytes(body, "inputTokens").Int()})
+	return out
+}
+
+// bedrockErrorInfo 将 Bedrock 异常类型映射为 Anthropic 语义的状态码与错误类型；未知类型返回 0
+func bedrockErrorInfo(errorType string) (int, string) {
+	name := strings.ToLower(strings.TrimSpace(errorType))
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		name = name[:i]
+	}
+	switch name {
+	case "throttlingexception", "servicequotaexceededexception", "modelnotreadyexception":
+		return http.StatusTooManyRequests, "rate_limit_error"
+	case "serviceunavailableexception":
+		return 529, "overloaded_error"
+	case "unrecognizedclientexception", "invalidsignatureexception", "expiredtokenexception", "incompletesignatureexception":
+		return http.StatusUnauthorized, "authentication_error"
+	case "accessdeniedexception":
+		return http.StatusForbidden, "permission_error"
+	case "validationexception":
+		return http.StatusBadRequest, "invalid_request_error"
+	case "resourcenotfoundexception":
+		return http.StatusNotFound, "not_found_error"
+	case "internalserverexception", "modelerrorexception", "modelstreamerrorexception", "modeltimeoutexception":
+		return 0, "api_error"
+	default:
+		return 0, ""
+	}
+}
+
+// bedrockErrorStatusCode 按 x-amzn-ErrorType 归一化 Bedrock 错误状态码（如 400 ServiceQuotaExceededException → 429）
+func bedrockErrorStatusCode(statusCode int, headers http.Header) int {
+	if headers == nil {
+		return statusCode
+	}
+	if mapped, _ := bedrockErrorInfo(headers.Get(bedrockErrorTypeHeader)); mapped != 0 {
+		return mapped
+	}
+	return statusCode
+}
+
+// bedrockRetryAfter 返回 Bedrock 限流的冷却时长
+func bedrockRetryAfter(headers http.Header) time.Duration {
+	if headers != nil {
+		if secs, err := strconv.Atoi(strings.TrimSpace(headers.Get("Retry-After"))); err == nil && secs > 0 {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	return bedrockRateLimitCooldown
+}
+
+func bedrockErrorMessage(body []byte) string {
+	if msg := gjson.GetBytes(body, "message").String(); msg != "" {
+		return msg
+	}
+	return gjson.GetBytes(body, "Message").String()
+}
+
+func anthropicErrorJSON(errorType, message string) []byte {
+	if errorType == "" {
+		errorType = "api_error"
+	}
+	out, _ := json.Marshal(map[string]any{
+		"type":  "error",
+		"error": map[string]string{"type": errorType, "message": message},
+	})
+	return out
+}
+
+// adaptBedrockResponse 将 Bedrock 响应还原为 Anthropic 语义：
+// 错误响应归一化状态码并改写为 Anthropic 错误体；流式成功响应的 event-stream 分帧解码为 SSE。
+func adaptBedrockResponse(resp *http.Response, stream bool) {
+	if resp == nil {
+		return
+	}
+	if resp.Header == nil {
+		resp.Header = http.Header{}
+	}
+	if resp.Header.Get("x-request-id") == "" {
+		if requestID := resp.Header.Get(bedrockRequestIDHeader); requestID != "" {
+			resp.Header.Set("x-request-id", requestID)
+		}
+	}
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+		_ = resp.Body.Close()
+		errorType := resp.Header.Get(bedrockErrorTypeHeader)
+		if errorType == "" {
+			errorType = gjson.GetBytes(body, "__type").String()
+			if errorType != "" {
+				resp.Header.Set(bedrockErrorTypeHeader, errorType)
+			}
+		}
+		mapped, anthropicType := bedrockErrorInfo(errorType)
+		if mapped != 0 {
+			resp.StatusCode = mapped
+		}
+		message := bedrockErrorMessage(body)
+		if message == "" {
+			message = strings.TrimSpace(string(body))
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(anthropicErrorJSON(anthropicType, message)))
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		return
+	}
+
+	if stream {
+		resp.Body = newBedrockEventStreamReader(resp.Body)
+		resp.Header.Set("Content-Type", "text/event-stream")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+	}
+}
+
+// bedrockEventStreamReader 将 AWS event-stream 分帧解码为 Anthropic SSE 文本
+type bedrockEventStreamReader struct {
+	body    io.ReadCloser
+	decoder *eventstream.Decoder
+	payload []byte
+	pending bytes.Buffer
+	err     error
+}
+
+func newBedrockEventStreamReader(body io.ReadCloser) *bedrockEventStreamReader {
+	return &bedrockEventStreamReader{body: body, decoder: eventstream.NewDecoder()}
+}
+
+func (r *bedrockEventStreamReader) Read(p []byte) (int, error) {
+	for r.pending.Len() == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		msg, err := r.decoder.Decode(r.body, r.payload[:0])
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				err = io.EOF
+			}
+			r.err = err
+			continue
+		}
+		r.payload = msg.Payload
+		r.writeEvent(msg)
+	}
+	return r.pending.Read(p)
+}
+
+func (r *bedrockEventStreamReader) Close() error {
+	return r.body.Close()
+}
+
+func bedrockHeaderString(msg eventstream.Message, name string) string {
+	if v := msg.Headers.Get(name); v != nil {
+		return v.String()
+	}
+	return ""
+}
+
+// writeEvent 将一帧转换为 SSE 事件写入 pending
+func (r *bedrockEventStreamReader) writeEvent(msg eventstream.Message) {
+	switch bedrockHeaderString(msg, ":message-type") {
+	case "event":
+		if bedrockHeaderString(msg, ":event-type") != "chunk" {
+			return
+		}
+		data, err := base64.StdEncoding.DecodeString(gjson.GetBytes(msg.Payload, "bytes").String())
+		if err != nil || len(data) == 0 {
+			return
+		}
+		// message_stop 附带的 Bedrock 调用指标不属于 Anthropic 协议
+		data, _ = sjson.DeleteBytes(data, "amazon-bedrock-invocationMetrics")
+		eventType := gjson.GetBytes(data, "type").String()
+		if eventType != "" {
+			r.pending.WriteString("event: " + eventType + "\n")
+		}
+		r.pending.WriteString("data: ")
+		r.pending.Write(data)
+		r.pending.WriteString("\n\n")
+	case "exception":
+		_, anthropicType := bedrockErrorInfo(bedrockHeaderString(msg, ":exception-type"))
+		r.writeError(anthropicType, bedrockErrorMessage(msg.Payload))
+	case "error":
+		r.writeError("api_error", bedrockHeaderString(msg, ":error-code")+": "+bedrockHeaderString(msg, ":error-message"))
+	}
+}
+
+func (r *bedrockEventStreamReader) writeError(errorType, message string) {
+	r.pending.WriteString("event: error\ndata: ")
+	r.pending.Write(anthropicErrorJSON(errorType, message))
+	r.pending.WriteString("\n\n")
+}
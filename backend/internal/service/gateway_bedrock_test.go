@@ -0,0 +1,149 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func newBedrockTestAccount(credentials map[string]any) *Account {
+	creds := map[string]any{
+		"aws_region":            "us-east-1",
+		"aws_access_key_id":     "AKIDEXAMPLE",
+		"aws_secret_access_key": "secret",
+	}
+	for k, v := range credentials {
+		creds[k] = v
+	}
+	return &Account{ID: 7, Platform: PlatformAnthropic, Type: AccountTypeBedrock, Credentials: creds}
+}
+
+func TestBuildBedrockRequestBody(t *testing.T) {
+	body := []byte(`{"model":"claude-sonnet-4-5","stream":true,"metadata":{"user_id":"u"},"max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`)
+
+	out, err := buildBedrockRequestBody(body, "oauth-2025-04-20, interleaved-thinking-2025-05-14,claude-code-20250219")
+	require.NoError(t, err)
+	require.False(t, gjson.GetBytes(out, "model").Exists())
+	require.False(t, gjson.GetBytes(out, "stream").Exists())
+	require.False(t, gjson.GetBytes(out, "metadata").Exists())
+	require.Equal(t, bedrockAnthropicVersion, gjson.GetBytes(out, "anthropic_version").String())
+	require.Equal(t, `["interleaved-thinking-2025-05-14"]`, gjson.GetBytes(out, "anthropic_beta").Raw)
+	require.Equal(t, int64(16), gjson.GetBytes(out, "max_tokens").Int())
+}
+
+func TestAccountGetBedrockModelID(t *testing.T) {
+	account := newBedrockTestAccount(nil)
+	require.Equal(t, "us.anthropic.claude-sonnet-4-5-20250929-v1:0", account.GetBedrockModelID("claude-sonnet-4-5"))
+	require.True(t, account.IsModelSupported("claude-haiku-4-5-20251001"))
+	require.False(t, account.IsModelSupported("gpt-4o"))
+
+	account = newBedrockTestAccount(map[string]any{"aws_region": "eu-central-1"})
+	require.Equal(t, "eu.anthropic.claude-opus-4-5-20251101-v1:0", account.GetBedrockModelID("claude-opus-4-5"))
+
+	account = newBedrockTestAccount(map[string]any{"inference_profile_prefix": "none"})
+	require.Equal(t, "anthropic.claude-opus-4-5-20251101-v1:0", account.GetBedrockModelID("claude-opus-4-5"))
+
+	// 显式映射到推理配置文件 ARN 时原样使用
+	arn := "arn:aws:bedrock:us-east-1:123456789012:application-inference-profile/abc"
+	account = newBedrockTestAccount(map[string]any{"model_mapping": map[string]any{"claude-*": arn}})
+	require.Equal(t, arn, account.GetBedrockModelID("claude-sonnet-4-5"))
+	require.Equal(t,
+		"https://bedrock-runtime.us-east-1.amazonaws.com/model/arn:aws:bedrock:us-east-1:123456789012:application-inference-profile%2Fabc/invoke",
+		bedrockRuntimeURL(account, arn, "invoke"))
+}
+
+func TestBedrockCredentialProviderSignRequest(t *testing.T) {
+	provider := NewBedrockCredentialProvider(nil)
+	account := newBedrockTestAccount(map[string]any{"aws_session_token": "session"})
+	body := []byte(`{"messages":[]}`)
+
+	req, err := newBedrockRuntimeRequest(context.Background(), provider, account, bedrockRuntimeURL(account, "us.anthropic.claude-sonnet-4-5-20250929-v1:0", "invoke"), body, "application/json")
+	require.NoError(t, err)
+	auth := req.Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+	require.Contains(t, auth, "/us-east-1/bedrock/aws4_request")
+	require.Equal(t, "session", req.Header.Get("X-Amz-Security-Token"))
+	require.NotEmpty(t, req.Header.Get("X-Amz-Date"))
+
+	_, err = provider.Retrieve(context.Background(), newBedrockTestAccount(map[string]any{"aws_secret_access_key": ""}))
+	require.Error(t, err)
+}
+
+func TestAdaptBedrockResponse_Errors(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header: http.Header{
+			"X-Amzn-Errortype": []string{"ServiceQuotaExceededException:http://internal.amazon.com/coral/com.amazon.bedrock/"},
+			"X-Amzn-Requestid": []string{"req-1"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{"message":"Too many tokens, please wait before trying again."}`)),
+	}
+	adaptBedrockResponse(resp, true)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "req-1", resp.Header.Get("x-request-id"))
+	body, _ := io.ReadAll(resp.Body)
+	require.Equal(t, "rate_limit_error", gjson.GetBytes(body, "error.type").String())
+	require.Equal(t, "Too many tokens, please wait before trying again.", extractUpstreamErrorMessage(body))
+	require.Equal(t, http.StatusTooManyRequests, bedrockErrorStatusCode(http.StatusBadRequest, resp.Header))
+
+	resp = &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"__type":"ServiceUnavailableException","Message":"busy"}`)),
+	}
+	adaptBedrockResponse(resp, false)
+	require.Equal(t, 529, resp.StatusCode)
+	require.Equal(t, "ServiceUnavailableException", resp.Header.Get(bedrockErrorTypeHeader))
+
+	require.Equal(t, bedrockRateLimitCooldown, bedrockRetryAfter(http.Header{}))
+	require.Equal(t, 5*time.Second, bedrockRetryAfter(http.Header{"Retry-After": []string{"5"}}))
+}
+
+func encodeBedrockFrame(t *testing.T, buf *bytes.Buffer, headers map[string]string, payload []byte) {
+	t.Helper()
+	msg := eventstream.Message{Payload: payload}
+	for k, v := range headers {
+		msg.Headers.Set(k, eventstream.StringValue(v))
+	}
+	require.NoError(t, eventstream.NewEncoder().Encode(buf, msg))
+}
+
+func encodeBedrockChunk(t *testing.T, buf *bytes.Buffer, event string) {
+	t.Helper()
+	payload := []byte(`{"bytes":"` + base64.StdEncoding.EncodeToString([]byte(event)) + `"}`)
+	encodeBedrockFrame(t, buf, map[string]string{":message-type": "event", ":event-type": "chunk", ":content-type": "application/json"}, payload)
+}
+
+func TestBedrockEventStreamReader(t *testing.T) {
+	var buf bytes.Buffer
+	encodeBedrockChunk(t, &buf, `{"type":"message_start","message":{"id":"msg_1","usage":{"input_tokens":3,"output_tokens":1}}}`)
+	encodeBedrockChunk(t, &buf, `{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"hi"}}`)
+	encodeBedrockChunk(t, &buf, `{"type":"message_stop","amazon-bedrock-invocationMetrics":{"inputTokenCount":3}}`)
+	encodeBedrockFrame(t, &buf, map[string]string{":message-type": "exception", ":exception-type": "throttlingException"}, []byte(`{"message":"slow down"}`))
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(&buf)}
+	adaptBedrockResponse(resp, true)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	out, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t,
+		"event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"id\":\"msg_1\",\"usage\":{\"input_tokens\":3,\"output_tokens\":1}}}\n\n"+
+			"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\"hi\"}}\n\n"+
+			"event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n"+
+			"event: error\ndata: {\"error\":{\"message\":\"slow down\",\"type\":\"rate_limit_error\"},\"type\":\"error\"}\n\n",
+		string(out))
+}
+
+func TestConvertBedrockCountTokensResponse(t *testing.T) {
+	require.JSONEq(t, `{"input_tokens":42}`, string(convertBedrockCountTokensResponse([]byte(`{"inputTokens":42}`))))
+}
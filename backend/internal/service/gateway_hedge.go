@@ -0,0 +1,337 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// 对冲请求（hedged requests）
+//
+// 流式请求在阈值内未收到首字节时，向调度器选出的第二个账号发起同一请求。
+// 两个请求各自写入独立的缓冲 writer，先写出成功响应的一方获得下游连接，
+// 另一方立即被取消；仅胜出方的结果用于计费，落败方记录为 ops 上游事件。
+
+const (
+	defaultHedgeDelay      = 5 * time.Second
+	defaultHedgeMinDelay   = time.Second
+	defaultHedgeMaxDelay   = 30 * time.Second
+	defaultHedgeWindowSize = 200
+	defaultHedgeMinSamples = 20
+)
+
+var errHedgeAttemptLost = errors.New("hedged attempt lost the race")
+
+func (s *GatewayService) hedgingConfig() config.GatewayHedgingConfig {
+	if s.cfg == nil {
+		return config.GatewayHedgingConfig{
+			DefaultDelay: defaultHedgeDelay,
+			MinDelay:     defaultHedgeMinDelay,
+			MaxDelay:     defaultHedgeMaxDelay,
+			WindowSize:   defaultHedgeWindowSize,
+			MinSamples:   defaultHedgeMinSamples,
+		}
+	}
+	return s.cfg.Gateway.Hedging
+}
+
+// HedgeDelay 返回分组的对冲阈值；分组未开启对冲时返回 false。
+// 分组设置了固定阈值时直接使用，否则按滚动 p95 首字时间计算（样本不足时使用默认阈值）。
+func (s *GatewayService) HedgeDelay(group *Group) (time.Duration, bool) {
+	if group == nil || !group.HedgeEnabled {
+		return 0, false
+	}
+	if group.HedgeDelayMs > 0 {
+		return time.Duration(group.HedgeDelayMs) * time.Millisecond, true
+	}
+	hc := s.hedgingConfig()
+	p95, ok := s.firstTokenStats.P95(group.ID, hc.MinSamples)
+	if !ok {
+		return hc.DefaultDelay, true
+	}
+	if p95 < hc.MinDelay {
+		return hc.MinDelay, true
+	}
+	if p95 > hc.MaxDelay {
+		return hc.MaxDelay, true
+	}
+	return p95, true
+}
+
+// ForwardHedged 以对冲方式转发流式请求，返回胜出尝试的结果与其使用的账号。
+// 调用方负责主账号的并发槽位；对冲账号的槽位在本方法内获取与释放。
+func (s *GatewayService) ForwardHedged(ctx context.Context, c *gin.Context, primary *Account, parsed *ParsedRequest, delay time.Duration, groupID *int64, excludedIDs map[int64]struct{}) (*ForwardResult, *Account, error) {
+	race := &hedgeRace{target: c.Writer, claimed: make(chan struct{})}
+	first := s.startHedgeAttempt(ctx, c, race, primary, parsed, nil)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-first.done:
+		// 对冲尚未发起，直接返回主请求结果
+		return first.finish(c)
+	case <-race.claimed:
+		<-first.done
+		return first.finish(c)
+	case <-timer.C:
+	}
+
+	second := s.startHedge(ctx, c, race, primary, parsed, groupID, excludedIDs)
+	if second == nil {
+		<-first.done
+		return first.finish(c)
+	}
+	<-first.done
+	<-second.done
+
+	winner, loser := first, second
+	if race.winner() == second.writer {
+		winner, loser = second, first
+	} else if race.winner() == nil && first.err != nil && second.err == nil {
+		// 均未写出成功响应：优先回放无错误的尝试，否则回放主请求
+		winner, loser = second, first
+	}
+	result, account, err := winner.finish(c)
+	appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+		Platform:    loser.account.Platform,
+		AccountID:   loser.account.ID,
+		AccountName: loser.account.Name,
+		Kind:        "hedge_canceled",
+		Message:     fmt.Sprintf("hedged attempt canceled, account %d won after %dms delay", account.ID, delay.Milliseconds()),
+	})
+	log.Printf("Hedged request: primary=%d hedge=%d winner=%d delay=%dms", primary.ID, second.account.ID, account.ID, delay.Milliseconds())
+	return result, account, err
+}
+
+// startHedge 选择对冲账号并发起请求；没有可立即占用槽位的账号时返回 nil。
+func (s *GatewayService) startHedge(ctx context.Context, c *gin.Context, race *hedgeRace, primary *Account, parsed *ParsedRequest, groupID *int64, excludedIDs map[int64]struct{}) *hedgeAttempt {
+	if race.winner() != nil {
+		return nil
+	}
+	excluded := make(map[int64]struct{}, len(excludedIDs)+1)
+	for id := range excludedIDs {
+		excluded[id] = struct{}{}
+	}
+	excluded[primary.ID] = struct{}{}
+
+	selection, err := s.SelectAccountWithLoadAwareness(ctx, groupID, "", parsed.Model, excluded, "")
+	if err != nil || selection == nil || selection.Account == nil {
+		return nil
+	}
+	account := selection.Account
+	// 对冲不排队等待槽位；antigravity OAuth 账号走独立的转发链路，不参与对冲
+	if !selection.Acquired || (account.Platform == PlatformAntigravity && account.Type != AccountTypeAPIKey) {
+		if selection.Acquired && selection.ReleaseFunc != nil {
+			selection.ReleaseFunc()
+		}
+		return nil
+	}
+	return s.startHedgeAttempt(ctx, c, race, account, parsed, selection.ReleaseFunc)
+}
+
+// hedgeAttempt 单个账号上的一次转发尝试
+type hedgeAttempt struct {
+	account *Account
+	c       *gin.Context
+	writer  *hedgeAttemptWriter
+	done    chan struct{}
+	result  *ForwardResult
+	err     error
+}
+
+func (s *GatewayService) startHedgeAttempt(ctx context.Context, c *gin.Context, race *hedgeRace, account *Account, parsed *ParsedRequest, release func()) *hedgeAttempt {
+	attemptCtx, cancel := context.WithCancel(ctx)
+	writer := &hedgeAttemptWriter{race: race, header: make(http.Header), cancel: cancel}
+	// 每个尝试使用独立的请求与 Keys 副本，并发追加 ops 事件时互不影响
+	attemptC := newBackgroundGinContext(c.Request.Clone(attemptCtx), writer, c.Keys)
+	attempt := &hedgeAttempt{account: account, c: attemptC, writer: writer, done: make(chan struct{})}
+	race.register(writer)
+
+	go func() {
+		defer close(attempt.done)
+		defer cancel()
+		if release != nil {
+			defer release()
+		}
+		attempt.result, attempt.err = s.Forward(attemptCtx, attemptC, account, parsed)
+	}()
+	return attempt
+}
+
+// finish 采用一次已结束的尝试：合并其上下文并回放未写出的响应。
+// 只对胜出方调用，落败方的 Keys 直接丢弃。
+func (a *hedgeAttempt) finish(c *gin.Context) (*ForwardResult, *Account, error) {
+	for k, v := range a.c.Keys {
+		c.Set(k, v)
+	}
+	a.writer.replay()
+	return a.result, a.account, a.err
+}
+
+// hedgeRace 记录获得下游连接的尝试
+type hedgeRace struct {
+	mu       sync.Mutex
+	target   gin.ResponseWriter
+	attempts []*hedgeAttemptWriter
+	won      *hedgeAttemptWriter
+	claimed  chan struct{}
+}
+
+func (r *hedgeRace) register(w *hedgeAttemptWriter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts = append(r.attempts, w)
+	if r.won != nil {
+		w.cancel()
+	}
+}
+
+func (r *hedgeRace) winner() *hedgeAttemptWriter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.won
+}
+
+// hedgeAttemptWriter 在获得下游连接前缓冲响应；首次写出成功响应时尝试获胜，
+// 获胜后直接写入下游，落败后写入返回错误（转发逻辑按客户端断开处理）。
+type hedgeAttemptWriter struct {
+	race   *hedgeRace
+	header http.Header
+	status int
+	buf    bytes.Buffer
+	cancel context.CancelFunc
+}
+
+func (w *hedgeAttemptWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *hedgeAttemptWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+}
+
+func (w *hedgeAttemptWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	r := w.race
+	r.mu.Lock()
+	if r.won == nil && w.status < http.StatusBadRequest {
+		r.won = w
+		close(r.claimed)
+		for _, other := range r.attempts {
+			if other != w {
+				other.cancel()
+			}
+		}
+		w.copyHeaderTo(r.target)
+		r.target.WriteHeader(w.status)
+	}
+	won := r.won
+	r.mu.Unlock()
+
+	// 获胜后只有胜出方写入下游，无需持锁
+	switch won {
+	case w:
+		return r.target.Write(p)
+	case nil:
+		return w.buf.Write(p)
+	default:
+		return 0, errHedgeAttemptLost
+	}
+}
+
+func (w *hedgeAttemptWriter) Flush() {
+	if w.race.winner() == w {
+		w.race.target.Flush()
+	}
+}
+
+func (w *hedgeAttemptWriter) copyHeaderTo(target http.ResponseWriter) {
+	dst := target.Header()
+	for k, v := range w.header {
+		dst[k] = v
+	}
+}
+
+// replay 将未获胜尝试的缓冲响应（通常是错误响应）写入下游
+func (w *hedgeAttemptWriter) replay() {
+	r := w.race
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.won != nil || w.status == 0 {
+		return
+	}
+	w.copyHeaderTo(r.target)
+	r.target.WriteHeader(w.status)
+	_, _ = r.target.Write(w.buf.Bytes())
+}
+
+// firstTokenTracker 按分组保存最近流式请求的首字时间（环形缓冲），用于计算对冲阈值
+type firstTokenTracker struct {
+	mu      sync.Mutex
+	size    int
+	windows map[int64]*firstTokenWindow
+}
+
+type firstTokenWindow struct {
+	samples []int
+	next    int
+}
+
+func newFirstTokenTracker(size int) *firstTokenTracker {
+	if size <= 0 {
+		size = defaultHedgeWindowSize
+	}
+	return &firstTokenTracker{size: size, windows: make(map[int64]*firstTokenWindow)}
+}
+
+// Observe 记录一次首字时间（毫秒）
+func (t *firstTokenTracker) Observe(groupID int64, firstTokenMs int) {
+	if t == nil || firstTokenMs < 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w := t.windows[groupID]
+	if w == nil {
+		w = &firstTokenWindow{samples: make([]int, 0, t.size)}
+		t.windows[groupID] = w
+	}
+	if len(w.samples) < t.size {
+		w.samples = append(w.samples, firstTokenMs)
+		return
+	}
+	w.samples[w.next] = firstTokenMs
+	w.next = (w.next + 1) % t.size
+}
+
+// P95 返回分组首字时间的 p95；样本数少于 minSamples 时返回 false
+func (t *firstTokenTracker) P95(groupID int64, minSamples int) (time.Duration, bool) {
+	if t == nil {
+		return 0, false
+	}
+	t.mu.Lock()
+	w := t.windows[groupID]
+	if w == nil || len(w.samples) == 0 || len(w.samples) < minSamples {
+		t.mu.Unlock()
+		return 0, false
+	}
+	sorted := append([]int(nil), w.samples...)
+	t.mu.Unlock()
+
+	sort.Ints(sorted)
+	idx := (len(sorted)*95+99)/100 - 1
+	return time.Duration(sorted[idx]) * time.Millisecond, true
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHedgeRace(t *testing.T) (*hedgeRace, *httptest.ResponseRecorder) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	return &hedgeRace{target: c.Writer, claimed: make(chan struct{})}, rec
+}
+
+func newTestHedgeWriter(race *hedgeRace) (*hedgeAttemptWriter, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &hedgeAttemptWriter{race: race, header: make(http.Header), cancel: cancel}
+	race.register(w)
+	return w, ctx
+}
+
+func TestHedgeAttemptWriter_FirstSuccessfulWriteWins(t *testing.T) {
+	race, rec := newTestHedgeRace(t)
+	primary, primaryCtx := newTestHedgeWriter(race)
+	hedge, hedgeCtx := newTestHedgeWriter(race)
+
+	// 错误响应只缓冲，不会占用下游连接
+	primary.WriteHeader(http.StatusTooManyRequests)
+	_, err := primary.Write([]byte(`{"error":"busy"}`))
+	require.NoError(t, err)
+	require.Nil(t, race.winner())
+
+	hedge.Header().Set("Content-Type", "text/event-stream")
+	_, err = hedge.Write([]byte("event: message_start\n\n"))
+	require.NoError(t, err)
+	hedge.Flush()
+	require.Equal(t, hedge, race.winner())
+	require.ErrorIs(t, primaryCtx.Err(), context.Canceled)
+	require.NoError(t, hedgeCtx.Err())
+
+	_, err = primary.Write([]byte("late"))
+	require.ErrorIs(t, err, errHedgeAttemptLost)
+
+	// 已有胜出方时不回放落败方的缓冲
+	primary.replay()
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	require.Equal(t, "event: message_start\n\n", rec.Body.String())
+}
+
+func TestHedgeAttemptWriter_LateRegistrationIsCanceled(t *testing.T) {
+	race, _ := newTestHedgeRace(t)
+	primary, _ := newTestHedgeWriter(race)
+	_, err := primary.Write([]byte("data"))
+	require.NoError(t, err)
+
+	_, lateCtx := newTestHedgeWriter(race)
+	require.ErrorIs(t, lateCtx.Err(), context.Canceled)
+}
+
+func TestHedgeAttemptWriter_ReplayBufferedError(t *testing.T) {
+	race, rec := newTestHedgeRace(t)
+	primary, _ := newTestHedgeWriter(race)
+	primary.Header().Set("Content-Type", "application/json")
+	primary.WriteHeader(http.StatusBadGateway)
+	_, err := primary.Write([]byte(`{"error":"upstream"}`))
+	require.NoError(t, err)
+
+	primary.replay()
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.Equal(t, `{"error":"upstream"}`, rec.Body.String())
+}
+
+func TestFirstTokenTracker_P95(t *testing.T) {
+	tracker := newFirstTokenTracker(100)
+	for i := 1; i <= 100; i++ {
+		tracker.Observe(1, i*10)
+	}
+	p95, ok := tracker.P95(1, 20)
+	require.True(t, ok)
+	require.Equal(t, 950*time.Millisecond, p95)
+
+	// 环形缓冲只保留最近 window 个样本
+	for i := 0; i < 100; i++ {
+		tracker.Observe(1, 100)
+	}
+	p95, ok = tracker.P95(1, 20)
+	require.True(t, ok)
+	require.Equal(t, 100*time.Millisecond, p95)
+
+	_, ok = tracker.P95(2, 20)
+	require.False(t, ok)
+
+	var nilTracker *firstTokenTracker
+	nilTracker.Observe(1, 10)
+	_, ok = nilTracker.P95(1, 1)
+	require.False(t, ok)
+}
+
+func TestGatewayService_HedgeDelay(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.Hedging = config.GatewayHedgingConfig{
+		DefaultDelay: 5 * time.Second,
+		MinDelay:     time.Second,
+		MaxDelay:     10 * time.Second,
+		WindowSize:   10,
+		MinSamples:   3,
+	}
+	svc := &GatewayService{cfg: cfg, firstTokenStats: newFirstTokenTracker(10)}
+
+	_, ok := svc.HedgeDelay(nil)
+	require.False(t, ok)
+	_, ok = svc.HedgeDelay(&Group{ID: 1})
+	require.False(t, ok)
+
+	delay, ok := svc.HedgeDelay(&Group{ID: 1, HedgeEnabled: true, HedgeDelayMs: 1500})
+	require.True(t, ok)
+	require.Equal(t, 1500*time.Millisecond, delay)
+
+	group := &Group{ID: 1, HedgeEnabled: true}
+	delay, _ = svc.HedgeDelay(group)
+	require.Equal(t, 5*time.Second, delay, "样本不足时使用默认阈值")
+
+	for i := 0; i < 3; i++ {
+		svc.firstTokenStats.Observe(1, 200)
+	}
+	delay, _ = svc.HedgeDelay(group)
+	require.Equal(t, time.Second, delay, "低于下限时取 min_delay")
+
+	for i := 0; i < 3; i++ {
+		svc.firstTokenStats.Observe(1, 60000)
+	}
+	delay, _ = svc.HedgeDelay(group)
+	require.Equal(t, 10*time.Second, delay, "高于上限时取 max_delay")
+
+	svc.firstTokenStats = newFirstTokenTracker(10)
+	for i := 0; i < 5; i++ {
+		svc.firstTokenStats.Observe(1, 3000)
+	}
+	delay, _ = svc.HedgeDelay(group)
+	require.Equal(t, 3*time.Second, delay)
+}
+
+func TestHedgeAttempt_ConcurrentOpsEventsMergeOnlyWinner(t *testing.T) {
+	race, rec := newTestHedgeRace(t)
+	parent, _ := gin.CreateTestContext(httptest.NewRecorder())
+	parent.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+	// 预留容量：浅拷贝 Keys 时两个尝试会向同一底层数组追加
+	initial := make([]*OpsUpstreamErrorEvent, 1, 8)
+	initial[0] = &OpsUpstreamErrorEvent{Kind: "failover", AccountID: 1}
+	parent.Set(OpsUpstreamErrorsKey, initial)
+
+	attempts := make([]*hedgeAttempt, 2)
+	for i := range attempts {
+		w, ctx := newTestHedgeWriter(race)
+		attempts[i] = &hedgeAttempt{
+			account: &Account{ID: int64(i + 10)},
+			c:       newBackgroundGinContext(parent.Request.Clone(ctx), w, parent.Keys),
+			writer:  w,
+			done:    make(chan struct{}),
+		}
+	}
+	for _, a := range attempts {
+		go func(a *hedgeAttempt) {
+			defer close(a.done)
+			for j := 0; j < 5; j++ {
+				appendOpsUpstreamError(a.c, OpsUpstreamErrorEvent{Kind: "http_error", AccountID: a.account.ID})
+			}
+			a.c.Writer.WriteHeader(http.StatusOK)
+			_, _ = a.c.Writer.Write([]byte("ok"))
+		}(a)
+	}
+	for _, a := range attempts {
+		<-a.done
+	}
+
+	winner := attempts[0]
+	if race.winner() == attempts[1].writer {
+		winner = attempts[1]
+	}
+	_, account, err := winner.finish(parent)
+	require.NoError(t, err)
+	require.Equal(t, winner.account.ID, account.ID)
+	require.Equal(t, "ok", rec.Body.String())
+
+	raw, ok := parent.Get(OpsUpstreamErrorsKey)
+	require.True(t, ok)
+	events := raw.([]*OpsUpstreamErrorEvent)
+	require.Len(t, events, 6)
+	require.Equal(t, int64(1), events[0].AccountID)
+	for _, ev := range events[1:] {
+		require.Equal(t, winner.account.ID, ev.AccountID)
+	}
+	require.Len(t, initial, 1)
+	require.Nil(t, initial[:2][1], "parent backing array must not be written by attempts")
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+)
+
+// Prometheus 指标定义（/metrics 导出）。
+// 标签中的 group_id/account_id 为空字符串表示未关联分组/账号。
+var (
+	gatewayRequestsTotal = metrics.Default.NewCounterVec(
+		"sub2api_gateway_requests_total",
+		"Total gateway requests by platform, group, model, account and response status code.",
+		"platform", "group_id", "model", "account_id", "status_code",
+	)
+	gatewayRequestDuration = metrics.Default.NewHistogramVec(
+		"sub2api_gateway_request_duration_seconds",
+		"Gateway request latency in seconds (until the response is fully written).",
+		metrics.DefBuckets,
+		"platform", "group_id", "model", "account_id",
+	)
+	gatewayFirstTokenDuration = metrics.Default.NewHistogramVec(
+		"sub2api_gateway_first_token_seconds",
+		"Time to first token of successful streaming requests in seconds.",
+		[]float64{0.1, 0.25, 0.5, 1, 2, 3, 5, 10, 20, 30, 60},
+		"platform", "group_id", "model", "account_id",
+	)
+	gatewayUpstreamErrorsTotal = metrics.Default.NewCounterVec(
+		"sub2api_gateway_upstream_errors_total",
+		"Upstream error attempts by platform, account, upstream status code and kind.",
+		"platform", "account_id", "status_code", "kind",
+	)
+	gatewayFailoverSwitchesTotal = metrics.Default.NewCounterVec(
+		"sub2api_gateway_failover_switches_total",
+		"Account switches performed by the failover loop.",
+		"platform",
+	)
+
+	accountConcurrencyGauge = metrics.Default.NewGaugeVec(
+		"sub2api_account_concurrency",
+		"Current in-flight requests per schedulable account.",
+		"platform", "account_id",
+	)
+	accountMaxConcurrencyGauge = metrics.Default.NewGaugeVec(
+		"sub2api_account_max_concurrency",
+		"Configured concurrency limit per schedulable account.",
+		"platform", "account_id",
+	)
+	accountWaitingGauge = metrics.Default.NewGaugeVec(
+		"sub2api_account_waiting_requests",
+		"Requests waiting in the account wait queue.",
+		"platform", "account_id",
+	)
+
+	schedulerOutboxLagSeconds = metrics.Default.NewGaugeVec(
+		"sub2api_scheduler_outbox_lag_seconds",
+		"Age of the oldest scheduler outbox event processed in the last poll.",
+	)
+	schedulerOutboxBacklog = metrics.Default.NewGaugeVec(
+		"sub2api_scheduler_outbox_backlog",
+		"Scheduler outbox events not yet applied to the snapshot cache.",
+	)
+
+	tokenRefreshTotal = metrics.Default.NewCounterVec(
+		"sub2api_token_refresh_total",
+		"OAuth token refresh outcomes by platform.",
+		"platform", "result",
+	)
+
+	billingCostTotal = metrics.Default.NewCounterVec(
+		"sub2api_billing_cost_usd_total",
+		"Billed cost in USD; kind=total is the list price, kind=actual applies rate multipliers.",
+		"platform", "group_id", "model", "kind",
+	)
+	billingTokensTotal = metrics.Default.NewCounterVec(
+		"sub2api_billing_tokens_total",
+		"Billed tokens by platform, group, model and token type.",
+		"platform", "group_id", "model", "type",
+	)
+
+	autoCacheControlRequestsTotal = metrics.Default.NewCounterVec(
+		"sub2api_auto_cache_control_requests_total",
+		"Requests that had cache_control breakpoints inserted automatically.",
+		"platform", "group_id", "model",
+	)
+	autoCacheControlTokensTotal = metrics.Default.NewCounterVec(
+		"sub2api_auto_cache_control_tokens_total",
+		"Cache creation/read tokens of requests with automatically inserted breakpoints.",
+		"platform", "group_id", "model", "type",
+	)
+	autoCacheControlSavingsTotal = metrics.Default.NewCounterVec(
+		"sub2api_auto_cache_control_savings_usd_total",
+		"List-price savings in USD from cache reads on requests with automatically inserted breakpoints.",
+		"platform", "group_id", "model",
+	)
+)
+
+// ObserveGatewayRequest 记录一次网关请求（由 handler 层中间件在请求结束时调用）
+func ObserveGatewayRequest(platform string, groupID *int64, model string, accountID int64, statusCode int, duration time.Duration) {
+	group := metricsIDLabel(groupID)
+	account := ""
+	if accountID > 0 {
+		account = strconv.FormatInt(accountID, 10)
+	}
+	gatewayRequestsTotal.WithLabelValues(platform, group, model, account, strconv.Itoa(statusCode)).Inc()
+	gatewayRequestDuration.WithLabelValues(platform, group, model, account).Observe(duration.Seconds())
+}
+
+// RecordFailoverSwitch 记录一次故障转移切换账号
+func RecordFailoverSwitch(platform string) {
+	gatewayFailoverSwitchesTotal.WithLabelValues(platform).Inc()
+}
+
+// RecordAutoCacheControl 记录一次自动插入缓存断点的请求及其缓存读写量与节省金额
+func RecordAutoCacheControl(platform string, groupID *int64, model string, cacheCreationTokens, cacheReadTokens int, savingsUSD float64) {
+	group := metricsIDLabel(groupID)
+	autoCacheControlRequestsTotal.WithLabelValues(platform, group, model).Inc()
+	autoCacheControlTokensTotal.WithLabelValues(platform, group, model, "cache_creation").Add(float64(cacheCreationTokens))
+	autoCacheControlTokensTotal.WithLabelValues(platform, group, model, "cache_read").Add(float64(cacheReadTokens))
+	if savingsUSD > 0 {
+		autoCacheControlSavingsTotal.WithLabelValues(platform, group, model).Add(savingsUSD)
+	}
+}
+
+func recordUpstreamErrorMetric(ev *OpsUpstreamErrorEvent) {
+	if ev == nil {
+		return
+	}
+	account := ""
+	if ev.AccountID > 0 {
+		account = strconv.FormatInt(ev.AccountID, 10)
+	}
+	gatewayUpstreamErrorsTotal.WithLabelValues(ev.Platform, account, strconv.Itoa(ev.UpstreamStatusCode), ev.Kind).Inc()
+}
+
+func recordTokenRefreshMetric(platform string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	tokenRefreshTotal.WithLabelValues(platform, result).Inc()
+}
+
+// observeUsageMetrics 在写入使用日志时记录计费与首 token 指标
+func observeUsageMetrics(platform string, usageLog *UsageLog) {
+	if usageLog == nil {
+		return
+	}
+	group := metricsIDLabel(usageLog.GroupID)
+	billingCostTotal.WithLabelValues(platform, group, usageLog.Model, "total").Add(usageLog.TotalCost)
+	billingCostTotal.WithLabelValues(platform, group, usageLog.Model, "actual").Add(usageLog.ActualCost)
+	billingTokensTotal.WithLabelValues(platform, group, usageLog.Model, "input").Add(float64(usageLog.InputTokens))
+	billingTokensTotal.WithLabelValues(platform, group, usageLog.Model, "output").Add(float64(usageLog.OutputTokens))
+	billingTokensTotal.WithLabelValues(platform, group, usageLog.Model, "cache_creation").Add(float64(usageLog.CacheCreationTokens))
+	billingTokensTotal.WithLabelValues(platform, group, usageLog.Model, "cache_read").Add(float64(usageLog.CacheReadTokens))
+
+	if usageLog.FirstTokenMs != nil && *usageLog.FirstTokenMs > 0 {
+		account := strconv.FormatInt(usageLog.AccountID, 10)
+		gatewayFirstTokenDuration.WithLabelValues(platform, group, usageLog.Model, account).Observe(float64(*usageLog.FirstTokenMs) / 1000)
+	}
+}
+
+func metricsIDLabel(id *int64) string {
+	if id == nil || *id <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(*id, 10)
+}
+
+// GatewayMetricsCollector 在每次抓取 /metrics 时刷新账号并发与等待队列 gauge
+type GatewayMetricsCollector struct {
+	accountRepo        AccountRepository
+	concurrencyService *ConcurrencyService
+}
+
+// NewGatewayMetricsCollector 创建采集器并注册到默认指标注册表
+func NewGatewayMetricsCollector(accountRepo AccountRepository, concurrencyService *ConcurrencyService) *GatewayMetricsCollector {
+	c := &GatewayMetricsCollector{
+		accountRepo:        accountRepo,
+		concurrencyService: concurrencyService,
+	}
+	metrics.Default.OnCollect(c.collectAccountLoad)
+	return c
+}
+
+// Handler 返回 Prometheus 文本格式的导出 handler
+func (c *GatewayMetricsCollector) Handler() http.Handler {
+	return metrics.Default.Handler()
+}
+
+func (c *GatewayMetricsCollector) collectAccountLoad() {
+	if c == nil || c.accountRepo == nil || c.concurrencyService == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	accounts, err := c.accountRepo.ListSchedulable(ctx)
+	if err != nil {
+		log.Printf("[Metrics] list schedulable accounts failed: %v", err)
+		return
+	}
+	batch := make([]AccountWithConcurrency, 0, len(accounts))
+	for i := range accounts {
+		batch = append(batch, AccountWithConcurrency{ID: accounts[i].ID, MaxConcurrency: accounts[i].Concurrency})
+	}
+	loads, err := c.concurrencyService.GetAccountsLoadBatch(ctx, batch)
+	if err != nil {
+		log.Printf("[Metrics] get accounts load failed: %v", err)
+		return
+	}
+
+	accountConcurrencyGauge.Reset()
+	accountMaxConcurrencyGauge.Reset()
+	accountWaitingGauge.Reset()
+	for i := range accounts {
+		account := &accounts[i]
+		id := strconv.FormatInt(account.ID, 10)
+		accountMaxConcurrencyGauge.WithLabelValues(account.Platform, id).Set(float64(account.Concurrency))
+		load := loads[account.ID]
+		if load == nil {
+			accountConcurrencyGauge.WithLabelValues(account.Platform, id).Set(0)
+			accountWaitingGauge.WithLabelValues(account.Platform, id).Set(0)
+			continue
+		}
+		accountConcurrencyGauge.WithLabelValues(account.Platform, id).Set(float64(load.CurrentConcurrency))
+		accountWaitingGauge.WithLabelValues(account.Platform, id).Set(float64(load.WaitingCount))
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveUsageMetrics(t *testing.T) {
+	groupID := int64(42)
+	firstTokenMs := 1500
+	observeUsageMetrics(PlatformAnthropic, &UsageLog{
+		AccountID:    7,
+		GroupID:      &groupID,
+		Model:        "metrics-test-model",
+		InputTokens:  100,
+		OutputTokens: 20,
+		TotalCost:    0.5,
+		ActualCost:   0.25,
+		FirstTokenMs: &firstTokenMs,
+	})
+
+	var sb strings.Builder
+	metrics.Default.WriteText(&sb)
+	out := sb.String()
+	require.Contains(t, out, `sub2api_billing_cost_usd_total{platform="anthropic",group_id="42",model="metrics-test-model",kind="actual"} 0.25`)
+	require.Contains(t, out, `sub2api_billing_tokens_total{platform="anthropic",group_id="42",model="metrics-test-model",type="input"} 100`)
+	require.Contains(t, out, `sub2api_gateway_first_token_seconds_bucket{platform="anthropic",group_id="42",model="metrics-test-model",account_id="7",le="2"} 1`)
+	require.Contains(t, out, `sub2api_gateway_first_token_seconds_bucket{platform="anthropic",group_id="42",model="metrics-test-model",account_id="7",le="1"} 0`)
+}
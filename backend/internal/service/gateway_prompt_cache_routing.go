@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/cespare/xxhash/v2"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Prompt cache 亲和调度
+//
+// 上游 prompt cache 按账号隔离，且只命中与此前请求完全相同的前缀。
+// 按 tools → system → messages 的顺序对请求做累积哈希，在每个 cache_control 断点处记录一次，
+// 转发成功后将各断点前缀绑定到所用账号；后续请求优先选择最近服务过其最长前缀、且当前有空闲槽位的账号，
+// 否则回退到负载感知选择。
+
+const (
+	promptCacheDefaultTTL     = 5 * time.Minute
+	promptCacheExtendedTTL    = time.Hour
+	promptCacheMaxBreakpoints = 4 // Anthropic 单个请求最多 4 个 cache_control 断点
+	promptCacheKeyPrefix      = "pcache:"
+)
+
+// promptCachePrefix 截至某个 cache_control 断点（含）的请求前缀
+type promptCachePrefix struct {
+	Hash string
+	TTL  time.Duration
+}
+
+// promptCachePrefixHashes 计算请求各 cache_control 断点的前缀哈希，按前缀长度从长到短返回。
+// 断点本身的 cache_control 字段不参与哈希，断点位置变化不影响已有前缀的匹配；
+// 字符串形式的 system / content 与等价的 text 块哈希相同（自动断点会把前者转换为后者）。
+func promptCachePrefixHashes(model string, body []byte) []promptCachePrefix {
+	if len(body) == 0 {
+		return nil
+	}
+	d := xxhash.New()
+	_, _ = d.WriteString(model)
+
+	var prefixes []promptCachePrefix
+	writeText := func(text string) {
+		_, _ = d.WriteString("text:")
+		_, _ = d.WriteString(text)
+	}
+	addBlock := func(block gjson.Result) {
+		cc := block.Get("cache_control")
+		if block.Get("type").String() == "text" {
+			writeText(block.Get("text").String())
+		} else {
+			raw := block.Raw
+			if cc.Exists() {
+				if cleaned, err := sjson.Delete(raw, "cache_control"); err == nil {
+					raw = cleaned
+				}
+			}
+			_, _ = d.WriteString(raw)
+		}
+		if cc.Get("type").String() != "ephemeral" {
+			return
+		}
+		ttl := promptCacheDefaultTTL
+		if cc.Get("ttl").String() == "1h" {
+			ttl = promptCacheExtendedTTL
+		}
+		prefixes = append(prefixes, promptCachePrefix{Hash: strconv.FormatUint(d.Sum64(), 36), TTL: ttl})
+	}
+	addContent := func(content gjson.Result) {
+		if content.Type == gjson.String {
+			writeText(content.String())
+			return
+		}
+		if !content.IsArray() {
+			_, _ = d.WriteString(content.Raw)
+			return
+		}
+		content.ForEach(func(_, block gjson.Result) bool {
+			addBlock(block)
+			return true
+		})
+	}
+
+	parsed := gjson.ParseBytes(body)
+	parsed.Get("tools").ForEach(func(_, tool gjson.Result) bool {
+		addBlock(tool)
+		return true
+	})
+	if system := parsed.Get("system"); system.Exists() {
+		addContent(system)
+	}
+	parsed.Get("messages").ForEach(func(_, msg gjson.Result) bool {
+		_, _ = d.WriteString(msg.Get("role").String())
+		addContent(msg.Get("content"))
+		return true
+	})
+
+	// 最长前缀优先
+	for i, j := 0, len(prefixes)-1; i < j; i, j = i+1, j-1 {
+		prefixes[i], prefixes[j] = prefixes[j], prefixes[i]
+	}
+	if len(prefixes) > promptCacheMaxBreakpoints {
+		prefixes = prefixes[:promptCacheMaxBreakpoints]
+	}
+	return prefixes
+}
+
+func promptCacheSessionKey(hash string) string {
+	return promptCacheKeyPrefix + hash
+}
+
+// WithPromptCachePrefixes 分组开启 prompt cache 亲和调度时，将请求的前缀哈希写入 context
+func (s *GatewayService) WithPromptCachePrefixes(ctx context.Context, group *Group, parsed *ParsedRequest) context.Context {
+	if group == nil || !group.PromptCacheRoutingEnabled {
+		return ctx
+	}
+	if parsed == nil {
+		return ctx
+	}
+	// 开启自动断点的分组按转发时实际发送的断点计算前缀（与 applyAutoCacheControl 共用插入逻辑）。
+	// 账号强制 1h TTL 时上游断点 TTL 更长，这里按默认 TTL 绑定，只会让亲和关系提前过期
+	body := parsed.Body
+	if group.AutoCacheControlEnabled {
+		body, _ = insertCacheControlBreakpoints(body, "")
+	}
+	prefixes := promptCachePrefixHashes(parsed.Model, body)
+	if len(prefixes) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.PromptCachePrefixes, prefixes)
+}
+
+func promptCachePrefixesFromContext(ctx context.Context) []promptCachePrefix {
+	prefixes, _ := ctx.Value(ctxkey.PromptCachePrefixes).([]promptCachePrefix)
+	return prefixes
+}
+
+// BindPromptCachePrefixes 转发成功后将请求的各前缀绑定到所用账号，绑定时长与上游缓存 TTL 一致
+func (s *GatewayService) BindPromptCachePrefixes(ctx context.Context, group *Group, accountID int64) {
+	if group == nil || !group.PromptCacheRoutingEnabled || s.cache == nil || accountID <= 0 {
+		return
+	}
+	for _, prefix := range promptCachePrefixesFromContext(ctx) {
+		_ = s.cache.SetSessionAccountID(ctx, group.ID, promptCacheSessionKey(prefix.Hash), accountID, prefix.TTL)
+	}
+}
+
+// selectByPromptCachePrefix 选择最近服务过请求最长前缀、且可立即占用槽位的账号；没有时返回 nil。
+// 与粘性会话不同，这里不生成等待计划：账号繁忙时宁可放弃缓存，交给负载感知选择。
+func (s *GatewayService) selectByPromptCachePrefix(ctx context.Context, group *Group, groupID *int64, accountByID map[int64]*Account, isExcluded func(int64) bool, platform string, useMixed bool, requestedModel, sessionHash string) *AccountSelectionResult {
+	if group == nil || !group.PromptCacheRoutingEnabled || s.cache == nil {
+		return nil
+	}
+	for _, prefix := range promptCachePrefixesFromContext(ctx) {
+		accountID, err := s.cache.GetSessionAccountID(ctx, derefGroupID(groupID), promptCacheSessionKey(prefix.Hash))
+		if err != nil || accountID <= 0 || isExcluded(accountID) {
+			continue
+		}
+		account, ok := accountByID[accountID]
+		if !ok || !account.IsSchedulable() ||
+			!s.isAccountAllowedForPlatform(account, platform, useMixed) ||
+			(requestedModel != "" && !s.isModelSupportedByAccountWithContext(ctx, account, requestedModel)) ||
+			!account.IsSchedulableForModelWithContext(ctx, requestedModel) ||
+			!s.isAccountSchedulableForWindowCost(ctx, account, true) {
+			continue
+		}
+		result, err := s.tryAcquireAccountSlot(ctx, accountID, account.Concurrency)
+		if err != nil || !result.Acquired {
+			continue
+		}
+		if !s.checkAndRegisterSession(ctx, account, sessionHash) {
+			result.ReleaseFunc()
+			continue
+		}
+		return &AccountSelectionResult{
+			Account:     account,
+			Acquired:    true,
+			ReleaseFunc: result.ReleaseFunc,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptCachePrefixHashes(t *testing.T) {
+	base := `{"model":"claude-sonnet-4-5","tools":[{"name":"f","input_schema":{}}],` +
+		`"system":[{"type":"text","text":"sys","cache_control":{"type":"ephemeral","ttl":"1h"}}],` +
+		`"messages":[{"role":"user","content":[{"type":"text","text":"doc","cache_control":{"type":"ephemeral"}}]}`
+
+	first := promptCachePrefixHashes("claude-sonnet-4-5", []byte(base+`]}`))
+	require.Len(t, first, 2)
+	require.Equal(t, promptCacheDefaultTTL, first[0].TTL, "最长前缀在前")
+	require.Equal(t, promptCacheExtendedTTL, first[1].TTL)
+
+	// 后续轮次在末尾追加消息并移动断点：已有前缀的哈希保持不变
+	next := base + `,{"role":"assistant","content":"ok"},{"role":"user","content":[{"type":"text","text":"q","cache_control":{"type":"ephemeral"}}]}]}`
+	second := promptCachePrefixHashes("claude-sonnet-4-5", []byte(next))
+	require.Len(t, second, 3)
+	require.Equal(t, first[0].Hash, second[1].Hash)
+	require.Equal(t, first[1].Hash, second[2].Hash)
+
+	moved := `{"tools":[{"name":"f","input_schema":{}}],"system":[{"type":"text","text":"sys"}],` +
+		`"messages":[{"role":"user","content":[{"type":"text","text":"doc","cache_control":{"type":"ephemeral"}}]}]}`
+	require.Equal(t, first[0].Hash, promptCachePrefixHashes("claude-sonnet-4-5", []byte(moved))[0].Hash,
+		"cache_control 本身不参与哈希")
+
+	// 不同模型的缓存互不共享
+	other := promptCachePrefixHashes("claude-opus-4-5", []byte(base+`]}`))
+	require.NotEqual(t, first[0].Hash, other[0].Hash)
+
+	require.Empty(t, promptCachePrefixHashes("", []byte(`{"messages":[{"role":"user","content":"hi"}]}`)))
+}
+
+func TestSelectByPromptCachePrefix(t *testing.T) {
+	cache := &stubGatewayCache{}
+	svc := &GatewayService{cache: cache}
+	group := &Group{ID: 7, Platform: PlatformAnthropic, PromptCacheRoutingEnabled: true}
+	groupID := group.ID
+	parsed := &ParsedRequest{
+		Model: "claude-sonnet-4-5",
+		Body:  []byte(`{"system":[{"type":"text","text":"sys","cache_control":{"type":"ephemeral"}}],"messages":[{"role":"user","content":"hi"}]}`),
+	}
+	ctx := svc.WithPromptCachePrefixes(context.Background(), group, parsed)
+
+	accountByID := map[int64]*Account{
+		1: {ID: 1, Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true, Concurrency: 1},
+		2: {ID: 2, Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true, Concurrency: 1},
+	}
+	notExcluded := func(int64) bool { return false }
+
+	// 尚未绑定时回退到负载感知选择
+	require.Nil(t, svc.selectByPromptCachePrefix(ctx, group, &groupID, accountByID, notExcluded, PlatformAnthropic, false, "", ""))
+
+	svc.BindPromptCachePrefixes(ctx, group, 2)
+	result := svc.selectByPromptCachePrefix(ctx, group, &groupID, accountByID, notExcluded, PlatformAnthropic, false, "", "")
+	require.NotNil(t, result)
+	require.Equal(t, int64(2), result.Account.ID)
+	require.True(t, result.Acquired)
+
+	// 绑定账号被排除或不可调度时不使用缓存亲和
+	require.Nil(t, svc.selectByPromptCachePrefix(ctx, group, &groupID, accountByID, func(id int64) bool { return id == 2 }, PlatformAnthropic, false, "", ""))
+	resetAt := time.Now().Add(time.Minute)
+	accountByID[2].RateLimitResetAt = &resetAt
+	require.Nil(t, svc.selectByPromptCachePrefix(ctx, group, &groupID, accountByID, notExcluded, PlatformAnthropic, false, "", ""))
+
+	// 分组未开启时不生效
+	disabled := &Group{ID: 7, Platform: PlatformAnthropic}
+	require.Equal(t, context.Background(), svc.WithPromptCachePrefixes(context.Background(), disabled, parsed))
+	require.Nil(t, svc.selectByPromptCachePrefix(ctx, disabled, &groupID, accountByID, notExcluded, PlatformAnthropic, false, "", ""))
+}
+
+func TestWithPromptCachePrefixes_UsesAutoCacheControlBreakpoints(t *testing.T) {
+	svc := &GatewayService{}
+	group := &Group{ID: 7, PromptCacheRoutingEnabled: true, AutoCacheControlEnabled: true}
+	turn1 := &ParsedRequest{Model: "claude-sonnet-4-5", Body: []byte(`{"system":"sys","messages":[{"role":"user","content":"q1"}]}`)}
+	turn2 := &ParsedRequest{Model: "claude-sonnet-4-5", Body: []byte(`{"system":"sys","messages":[{"role":"user","content":"q1"},` +
+		`{"role":"assistant","content":"a1"},{"role":"user","content":"q2"}]}`)}
+
+	// 客户端未设置断点：按转发时插入的断点计算前缀
+	first := promptCachePrefixesFromContext(svc.WithPromptCachePrefixes(context.Background(), group, turn1))
+	require.Len(t, first, 2)
+	upstream, _ := svc.applyAutoCacheControl(context.WithValue(context.Background(), ctxkey.Group, group), &Account{}, turn1.Body)
+	require.Equal(t, promptCachePrefixHashes(turn1.Model, upstream), first)
+
+	// 下一轮中上一轮的前缀保持可匹配
+	second := promptCachePrefixesFromContext(svc.WithPromptCachePrefixes(context.Background(), group, turn2))
+	require.Len(t, second, 3)
+	require.Equal(t, first[0].Hash, second[1].Hash)
+	require.Equal(t, first[1].Hash, second[2].Hash)
+
+	// 未开启自动断点时没有可用前缀
+	group.AutoCacheControlEnabled = false
+	require.Empty(t, promptCachePrefixesFromContext(svc.WithPromptCachePrefixes(context.Background(), group, turn1)))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
 	"github.com/cespare/xxhash/v2"
@@ -366,6 +367,12 @@ type ForwardResult struct {
 	// 图片生成计费字段（仅 gemini-3-pro-image 使用）
 	ImageCount int    // 生成的图片数量
 	ImageSize  string // 图片尺寸 "1K", "2K", "4K"
+
+	ResponseCacheHit bool // 命中响应缓存（未请求上游，按折扣计费）
+
+	RequestType string // 请求类型标记，见 UsageRequestType* 常量
+
+	AutoCacheBreakpoints int // 自动插入的 cache_control 断点数（分组开启自动缓存断点时）
 }
 
 // UpstreamFailoverError indicates an upstream error that should trigger account failover.
@@ -415,7 +422,11 @@ type GatewayService struct {
 	deferredService     *DeferredService
 	concurrencyService  *ConcurrencyService
 	claudeTokenProvider *ClaudeTokenProvider
+	bedrockCredentials  *BedrockCredentialProvider
+	vertexTokenProvider *VertexTokenProvider
 	sessionLimitCache   SessionLimitCache // 会话数量限制缓存（仅 Anthropic OAuth/SetupToken）
+	responseCache       *ResponseCacheService
+	firstTokenStats     *firstTokenTracker // 分组流式首字时间，用于对冲阈值
 }
 
 // NewGatewayService creates a new GatewayService
@@ -437,9 +448,16 @@ func NewGatewayService(
 	httpUpstream HTTPUpstream,
 	deferredService *DeferredService,
 	claudeTokenProvider *ClaudeTokenProvider,
+	bedrockCredentials *BedrockCredentialProvider,
+	vertexTokenProvider *VertexTokenProvider,
 	sessionLimitCache SessionLimitCache,
 	digestStore *DigestSessionStore,
+	responseCache *ResponseCacheService,
 ) *GatewayService {
+	hedgeWindow := defaultHedgeWindowSize
+	if cfg != nil {
+		hedgeWindow = cfg.Gateway.Hedging.WindowSize
+	}
 	return &GatewayService{
 		accountRepo:         accountRepo,
 		groupRepo:           groupRepo,
@@ -459,7 +477,11 @@ func NewGatewayService(
 		httpUpstream:        httpUpstream,
 		deferredService:     deferredService,
 		claudeTokenProvider: claudeTokenProvider,
+		bedrockCredentials:  bedrockCredentials,
+		vertexTokenProvider: vertexTokenProvider,
 		sessionLimitCache:   sessionLimitCache,
+		responseCache:       responseCache,
+		firstTokenStats:     newFirstTokenTracker(hedgeWindow),
 	}
 }
 
@@ -886,6 +908,11 @@ func (s *GatewayService) SelectAccountForModel(ctx context.Context, groupID *int
 
 // SelectAccountForModelWithExclusions selects an account supporting the requested model while excluding specified accounts.
 func (s *GatewayService) SelectAccountForModelWithExclusions(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (*Account, error) {
+	// 固定账号（如引用了 Files API 文件）：只能使用指定账号
+	if pinnedID, ok := ctx.Value(ctxkey.PinnedAccountID).(int64); ok && pinnedID > 0 {
+		return s.selectPinnedAccount(ctx, groupID, pinnedID, requestedModel, excludedIDs)
+	}
+
 	// 优先检查 context 中的强制平台（/antigravity 路由）
 	var platform string
 	forcePlatform, hasForcePlatform := ctx.Value(ctxkey.ForcePlatform).(string)
@@ -915,9 +942,34 @@ func (s *GatewayService) SelectAccountForModelWithExclusions(ctx context.Context
 	return s.selectAccountForModelWithPlatform(ctx, groupID, sessionHash, requestedModel, excludedIDs, platform)
 }
 
+// selectPinnedAccount 返回 context 指定的固定账号；账号已被排除、不属于分组或当前不可调度时返回错误，
+// 不会回退到其他账号（其他账号无法处理该请求）。
+func (s *GatewayService) selectPinnedAccount(ctx context.Context, groupID *int64, accountID int64, requestedModel string, excludedIDs map[int64]struct{}) (*Account, error) {
+	if _, excluded := excludedIDs[accountID]; excluded {
+		return nil, fmt.Errorf("pinned account %d failed", accountID)
+	}
+	account, err := s.getSchedulableAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("pinned account %d not found: %w", accountID, err)
+	}
+	if !s.isAccountInGroup(account, groupID) {
+		return nil, fmt.Errorf("pinned account %d is not in the requested group", accountID)
+	}
+	if requestedModel != "" && !s.isModelSupportedByAccountWithContext(ctx, account, requestedModel) {
+		return nil, fmt.Errorf("pinned account %d does not support model %s", accountID, requestedModel)
+	}
+	if !account.IsSchedulableForModelWithContext(ctx, requestedModel) {
+		return nil, fmt.Errorf("pinned account %d is temporarily unavailable", accountID)
+	}
+	return account, nil
+}
+
 // SelectAccountWithLoadAwareness selects account with load-awareness and wait plan.
 // metadataUserID: 已废弃参数，会话限制现在统一使用 sessionHash
-func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}, metadataUserID string) (*AccountSelectionResult, error) {
+func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}, metadataUserID string) (result *AccountSelectionResult, err error) {
+	ctx, span := startSelectAccountSpan(ctx, groupID, requestedModel, excludedIDs)
+	defer func() { endSelectAccountSpan(span, result, err) }()
+
 	// 调试日志：记录调度入口参数
 	excludedIDsList := make([]int64, 0, len(excludedIDs))
 	for id := range excludedIDs {
@@ -954,7 +1006,9 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 			derefGroupID(groupID), groupPlatform, requestedModel, shortSessionHash(sessionHash), stickyAccountID, cfg.LoadBatchEnabled, s.concurrencyService != nil)
 	}
 
-	if s.concurrencyService == nil || !cfg.LoadBatchEnabled {
+	// 固定账号的请求无需负载均衡，走简单路径（选择逻辑见 SelectAccountForModelWithExclusions）
+	_, pinned := ctx.Value(ctxkey.PinnedAccountID).(int64)
+	if s.concurrencyService == nil || !cfg.LoadBatchEnabled || pinned {
 		// 复制排除列表，用于会话限制拒绝时的重试
 		localExcluded := make(map[int64]struct{})
 		for k, v := range excludedIDs {
@@ -1323,6 +1377,13 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 		}
 	}
 
+	// ============ Layer 1.6: Prompt cache 前缀亲和（分组开启且无模型路由配置时生效） ============
+	if len(routingAccountIDs) == 0 {
+		if result := s.selectByPromptCachePrefix(ctx, group, groupID, accountByID, isExcluded, platform, useMixed, requestedModel, sessionHash); result != nil {
+			return result, nil
+		}
+	}
+
 	// ============ Layer 2: 负载感知选择 ============
 	candidates := make([]*Account, 0, len(accounts))
 	for i := range accounts {
@@ -2569,6 +2630,21 @@ func (s *GatewayService) GetAccessToken(ctx context.Context, account *Account) (
 			return "", "", errors.New("api_key not found in credentials")
 		}
 		return apiKey, "apikey", nil
+	case AccountTypeBedrock:
+		// Bedrock 使用 AWS 凭证在构建请求时做 SigV4 签名，没有 token
+		return "", "bedrock", nil
+	case AccountTypeVertex:
+		if s.vertexTokenProvider == nil {
+			return "", "", errors.New("vertex token provider not configured")
+		}
+		accessToken, err := s.vertexTokenProvider.GetAccessToken(ctx, account)
+		if err != nil {
+			return "", "", err
+		}
+		return accessToken, "vertex", nil
+	case AccountTypeOpenAICompat:
+		// 自建上游（如 vLLM）可不配置 api_key
+		return strings.TrimSpace(account.GetCredential("api_key")), "openai-compat", nil
 	default:
 		return "", "", fmt.Errorf("unsupported account type: %s", account.Type)
 	}
@@ -3006,6 +3082,34 @@ func removeCacheControlFromThinkingBlocks(data map[string]any) {
 
 // Forward 转发请求到Claude API
 func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *Account, parsed *ParsedRequest) (*ForwardResult, error) {
+	return s.forward(ctx, c, account, parsed, s.newStreamRecovery(parsed))
+}
+
+// ServeResponseCacheHit 在选择账号之前查询精确响应缓存（仅分组开启、非流式且 temperature=0 的请求参与）。
+// 命中时直接写回客户端，返回结果与产生该响应的账号（用于记录使用量，不占用其并发槽位）；
+// 未命中返回 nil，由 Forward 写入缓存。
+func (s *GatewayService) ServeResponseCacheHit(c *gin.Context, parsed *ParsedRequest) (*ForwardResult, *Account) {
+	if parsed == nil {
+		return nil, nil
+	}
+	startTime := time.Now()
+	entry, account := s.responseCache.Lookup(c, s.accountRepo, ResponseCacheEndpointMessages, parsed.Model, parsed.Body)
+	if entry == nil {
+		return nil, nil
+	}
+	var usage ClaudeUsage
+	_ = json.Unmarshal(entry.Usage, &usage)
+	return &ForwardResult{
+		RequestID:        s.responseCache.NewHitRequestID(),
+		Usage:            usage,
+		Model:            parsed.Model,
+		Duration:         time.Since(startTime),
+		ResponseCacheHit: true,
+	}, account
+}
+
+// forward 转发请求；recovery 非 nil 时记录流式输出以便中断后续写（见 ForwardContinuation）
+func (s *GatewayService) forward(ctx context.Context, c *gin.Context, account *Account, parsed *ParsedRequest, recovery *StreamRecovery) (*ForwardResult, error) {
 	startTime := time.Now()
 	if parsed == nil {
 		return nil, fmt.Errorf("parse request: empty request")
@@ -3016,6 +3120,11 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 	reqStream := parsed.Stream
 	originalModel := reqModel
 
+	// OpenAI 兼容账号：转换为 Chat Completions 请求转发
+	if account.IsOpenAICompat() {
+		return s.forwardOpenAICompat(ctx, c, account, parsed)
+	}
+
 	isClaudeCode := isClaudeCodeRequest(ctx, c, parsed)
 	shouldMimicClaudeCode := account.IsOAuth() && !isClaudeCode
 
@@ -3050,8 +3159,13 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 	// 强制执行 cache_control 块数量限制（最多 4 个）
 	body = enforceCacheControlLimit(body)
 
+	// 分组开启时为客户端未覆盖的稳定位置插入缓存断点
+	body, autoCacheBreakpoints := s.applyAutoCacheControl(ctx, account, body)
+
 	// 应用模型映射：
 	// - APIKey 账号：使用账号级别的显式映射（如果配置），否则透传原始模型名
+	// - Bedrock 账号：映射为 Bedrock 模型 ID（账号映射或默认映射 + 跨区域推理前缀）
+	// - Vertex 账号：映射为 Vertex 模型 ID（账号映射或默认映射）
 	// - OAuth/SetupToken 账号：使用 Anthropic 标准映射（短ID → 长ID）
 	mappedModel := reqModel
 	mappingSource := ""
@@ -3060,8 +3174,18 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 		if mappedModel != reqModel {
 			mappingSource = "account"
 		}
+	} else if account.IsBedrock() {
+		mappedModel = account.GetBedrockModelID(reqModel)
+		if mappedModel != reqModel {
+			mappingSource = "bedrock"
+		}
+	} else if account.IsVertex() {
+		mappedModel = account.GetMappedModel(reqModel)
+		if mappedModel != reqModel {
+			mappingSource = "vertex"
+		}
 	}
-	if mappingSource == "" && account.Platform == PlatformAnthropic && account.Type != AccountTypeAPIKey {
+	if mappingSource == "" && account.Platform == PlatformAnthropic && account.Type != AccountTypeAPIKey && !account.IsBedrock() && !account.IsVertex() {
 		normalized := claude.NormalizeModelID(reqModel)
 		if normalized != reqModel {
 			mappedModel = normalized
@@ -3105,6 +3229,9 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 
 		// 发送请求
 		resp, err = s.httpUpstream.DoWithTLS(upstreamReq, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
+		if err == nil && account.IsBedrock() {
+			adaptBedrockResponse(resp, reqStream)
+		}
 		if err != nil {
 			if resp != nil && resp.Body != nil {
 				_ = resp.Body.Close()
@@ -3180,6 +3307,9 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 					if buildErr == nil {
 						retryResp, retryErr := s.httpUpstream.DoWithTLS(retryReq, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
 						if retryErr == nil {
+							if account.IsBedrock() {
+								adaptBedrockResponse(retryResp, reqStream)
+							}
 							if retryResp.StatusCode < 400 {
 								log.Printf("Account %d: signature error retry succeeded (thinking downgraded)", account.ID)
 								resp = retryResp
@@ -3212,6 +3342,9 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 									if buildErr2 == nil {
 										retryResp2, retryErr2 := s.httpUpstream.DoWithTLS(retryReq2, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
 										if retryErr2 == nil {
+											if account.IsBedrock() {
+												adaptBedrockResponse(retryResp2, reqStream)
+											}
 											resp = retryResp2
 											break
 										}
@@ -3431,8 +3564,35 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 	var firstTokenMs *int
 	var clientDisconnect bool
 	if reqStream {
-		streamResult, err := s.handleStreamingResponse(ctx, resp, c, account, startTime, originalModel, reqModel, shouldMimicClaudeCode)
+		streamResult, err := s.handleStreamingResponse(ctx, resp, c, account, startTime, originalModel, reqModel, shouldMimicClaudeCode, recovery)
 		if err != nil {
+			var interrupted *StreamInterruptedError
+			if errors.As(err, &interrupted) {
+				// 中断前已输出部分按当前账号计费：output tokens 以上游 usage 与已输出文本估算值中的较大者为准
+				legUsage := *streamResult.usage
+				if est := recovery.interrupt(); legUsage.OutputTokens < est {
+					legUsage.OutputTokens = est
+				}
+				interrupted.Result = &ForwardResult{
+					RequestID:    resp.Header.Get("x-request-id"),
+					Usage:        legUsage,
+					Model:        originalModel,
+					Stream:       reqStream,
+					Duration:     time.Since(startTime),
+					FirstTokenMs: streamResult.firstTokenMs,
+
+					AutoCacheBreakpoints: autoCacheBreakpoints,
+				}
+				appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+					Platform:          account.Platform,
+					AccountID:         account.ID,
+					AccountName:       account.Name,
+					UpstreamRequestID: resp.Header.Get("x-request-id"),
+					Kind:              "stream_interrupted",
+					Message:           interrupted.Cause.Error(),
+				})
+				return nil, interrupted
+			}
 			if err.Error() == "have error in stream" {
 				return nil, &UpstreamFailoverError{
 					StatusCode: 403,
@@ -3444,10 +3604,14 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 		firstTokenMs = streamResult.firstTokenMs
 		clientDisconnect = streamResult.clientDisconnect
 	} else {
+		// 精确响应缓存未命中（见 ServeResponseCacheHit）：记录成功响应写入缓存
+		capture := s.responseCache.BeginCapture(c, boundResponseCacheLookup(c), account.ID)
 		usage, err = s.handleNonStreamingResponse(ctx, resp, c, account, originalModel, reqModel)
 		if err != nil {
+			capture.Finish(ctx, nil)
 			return nil, err
 		}
+		capture.Finish(ctx, usage)
 	}
 
 	return &ForwardResult{
@@ -3458,10 +3622,19 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 		Duration:         time.Since(startTime),
 		FirstTokenMs:     firstTokenMs,
 		ClientDisconnect: clientDisconnect,
+
+		AutoCacheBreakpoints: autoCacheBreakpoints,
 	}, nil
 }
 
 func (s *GatewayService) buildUpstreamRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, token, tokenType, modelID string, reqStream bool, mimicClaudeCode bool) (*http.Request, error) {
+	if account.IsBedrock() {
+		return s.buildBedrockRequest(ctx, c, account, body, modelID, reqStream)
+	}
+	if account.IsVertex() {
+		return s.buildVertexClaudeRequest(ctx, c, account, body, token, modelID, reqStream)
+	}
+
 	// 确定目标URL
 	targetURL := claudeAPIURL
 	if account.Type == AccountTypeAPIKey {
@@ -4131,7 +4304,7 @@ type streamingResult struct {
 	clientDisconnect bool // 客户端是否在流式传输过程中断开
 }
 
-func (s *GatewayService) handleStreamingResponse(ctx context.Context, resp *http.Response, c *gin.Context, account *Account, startTime time.Time, originalModel, mappedModel string, mimicClaudeCode bool) (*streamingResult, error) {
+func (s *GatewayService) handleStreamingResponse(ctx context.Context, resp *http.Response, c *gin.Context, account *Account, startTime time.Time, originalModel, mappedModel string, mimicClaudeCode bool, recovery *StreamRecovery) (*streamingResult, error) {
 	// 更新5h窗口状态
 	s.rateLimitService.UpdateSessionWindow(ctx, account, resp.Header)
 
@@ -4318,6 +4491,12 @@ func (s *GatewayService) handleStreamingResponse(ctx context.Context, resp *http
 			}
 		}
 
+		// 流中断恢复：续写请求跳过重复的 message_start 并调整块序号，同时记录已输出内容
+		if recovery != nil && !recovery.rewrite(eventType, event) {
+			s.parseSSEUsage(dataLine, usage)
+			return nil, "", nil
+		}
+
 		newData, err := json.Marshal(event)
 		if err != nil {
 			// 序列化失败，直接透传原始数据
@@ -4361,6 +4540,10 @@ func (s *GatewayService) handleStreamingResponse(ctx context.Context, resp *http
 					sendErrorEvent("response_too_large")
 					return &streamingResult{usage: usage, firstTokenMs: firstTokenMs}, ev.err
 				}
+				if s.canResume(recovery) {
+					log.Printf("Upstream stream interrupted, resuming on another account: account=%d error=%v", account.ID, ev.err)
+					return &streamingResult{usage: usage, firstTokenMs: firstTokenMs}, &StreamInterruptedError{Recovery: recovery, Cause: fmt.Errorf("stream read error: %w", ev.err)}
+				}
 				sendErrorEvent("stream_read_error")
 				return &streamingResult{usage: usage, firstTokenMs: firstTokenMs}, fmt.Errorf("stream read error: %w", ev.err)
 			}
@@ -4418,6 +4601,9 @@ func (s *GatewayService) handleStreamingResponse(ctx context.Context, resp *http
 			if s.rateLimitService != nil {
 				s.rateLimitService.HandleStreamTimeout(ctx, account, originalModel)
 			}
+			if s.canResume(recovery) {
+				return &streamingResult{usage: usage, firstTokenMs: firstTokenMs}, &StreamInterruptedError{Recovery: recovery, Cause: fmt.Errorf("stream data interval timeout")}
+			}
 			sendErrorEvent("stream_timeout")
 			return &streamingResult{usage: usage, firstTokenMs: firstTokenMs}, fmt.Errorf("stream data interval timeout")
 		}
@@ -4640,19 +4826,29 @@ type RecordUsageInput struct {
 	APIKeyService     APIKeyQuotaUpdater // 可选：用于更新API Key配额
 }
 
-// APIKeyQuotaUpdater defines the interface for updating API Key quota
+// APIKeyQuotaUpdater defines the interface for updating API Key quota and TPM usage
 type APIKeyQuotaUpdater interface {
 	UpdateQuotaUsed(ctx context.Context, apiKeyID int64, cost float64) error
+	RecordTokenUsage(ctx context.Context, apiKey *APIKey, tokens int)
 }
 
 // RecordUsage 记录使用量并扣费（或更新订阅用量）
-func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInput) error {
+func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInput) (err error) {
+	ctx, span := startRecordUsageSpan(ctx, input.Account, input.APIKey, input.Result.Model)
+	defer func() { tracing.End(span, err) }()
+
 	result := input.Result
 	apiKey := input.APIKey
 	user := input.User
 	account := input.Account
 	subscription := input.Subscription
 
+	// 记录分组流式首字时间，作为对冲请求的自适应阈值样本
+	if result.Stream && result.FirstTokenMs != nil && apiKey.GroupID != nil {
+		s.firstTokenStats.Observe(*apiKey.GroupID, *result.FirstTokenMs)
+	}
+	s.recordAutoCacheControlSavings(account.Platform, apiKey.GroupID, result)
+
 	// 强制缓存计费：将 input_tokens 转为 cache_read_input_tokens
 	// 用于粘性会话切换时的特殊计费处理
 	if input.ForceCacheBilling && result.Usage.InputTokens > 0 {
@@ -4707,13 +4903,22 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 			CacheCreation1hTokens: result.Usage.CacheCreation1hTokens,
 		}
 		var err error
-		cost, err = s.billingService.CalculateCost(result.Model, tokens, multiplier)
+		cost, err = s.billingService.CalculateCostForAccount(account, result.Model, tokens, multiplier)
 		if err != nil {
 			log.Printf("Calculate cost failed: %v", err)
 			cost = &CostBreakdown{ActualCost: 0}
 		}
 	}
 
+	// 命中响应缓存：未请求上游，按配置比例折算费用
+	if result.ResponseCacheHit {
+		applyResponseCacheHitDiscount(cost, s.responseCache.HitCostMultiplier())
+	}
+	// 批处理请求：按分组配置的批处理折扣计费
+	if result.RequestType == UsageRequestTypeBatch && apiKey.Group != nil {
+		applyResponseCacheHitDiscount(cost, apiKey.Group.BatchDiscountMultiplier)
+	}
+
 	// 判断计费方式：订阅模式 vs 余额模式
 	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 	billingType := BillingTypeBalance
@@ -4755,6 +4960,8 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 		ImageCount:            result.ImageCount,
 		ImageSize:             imageSize,
 		CacheTTLOverridden:    cacheTTLOverridden,
+		ResponseCacheHit:      result.ResponseCacheHit,
+		RequestType:           result.RequestType,
 		CreatedAt:             time.Now(),
 	}
 
@@ -4777,10 +4984,16 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	observeUsageMetrics(account.Platform, usageLog)
 	if err != nil {
 		log.Printf("Create usage log failed: %v", err)
 	}
 
+	// API Key TPM 计数（含简易模式）
+	if input.APIKeyService != nil {
+		input.APIKeyService.RecordTokenUsage(ctx, apiKey, usageLog.TotalTokens())
+	}
+
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -4839,7 +5052,10 @@ type RecordUsageLongContextInput struct {
 }
 
 // RecordUsageWithLongContext 记录使用量并扣费，支持长上下文双倍计费（用于 Gemini）
-func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *RecordUsageLongContextInput) error {
+func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *RecordUsageLongContextInput) (err error) {
+	ctx, span := startRecordUsageSpan(ctx, input.Account, input.APIKey, input.Result.Model)
+	defer func() { tracing.End(span, err) }()
+
 	result := input.Result
 	apiKey := input.APIKey
 	user := input.User
@@ -4907,6 +5123,11 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 		}
 	}
 
+	// 命中响应缓存：未请求上游，按配置比例折算费用
+	if result.ResponseCacheHit {
+		applyResponseCacheHitDiscount(cost, s.responseCache.HitCostMultiplier())
+	}
+
 	// 判断计费方式：订阅模式 vs 余额模式
 	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 	billingType := BillingTypeBalance
@@ -4948,6 +5169,8 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 		ImageCount:            result.ImageCount,
 		ImageSize:             imageSize,
 		CacheTTLOverridden:    cacheTTLOverridden,
+		ResponseCacheHit:      result.ResponseCacheHit,
+		RequestType:           result.RequestType,
 		CreatedAt:             time.Now(),
 	}
 
@@ -4970,10 +5193,16 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	observeUsageMetrics(account.Platform, usageLog)
 	if err != nil {
 		log.Printf("Create usage log failed: %v", err)
 	}
 
+	// API Key TPM 计数（含简易模式）
+	if input.APIKeyService != nil {
+		input.APIKeyService.RecordTokenUsage(ctx, apiKey, usageLog.TotalTokens())
+	}
+
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -5040,6 +5269,12 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 		return nil
 	}
 
+	// OpenAI 兼容账号上游没有 count_tokens 接口，本地估算
+	if account.IsOpenAICompat() {
+		s.countTokensOpenAICompat(c, body)
+		return nil
+	}
+
 	// 应用模型映射：
 	// - APIKey 账号：使用账号级别的显式映射（如果配置），否则透传原始模型名
 	// - OAuth/SetupToken 账号：使用 Anthropic 标准映射（短ID → 长ID）
@@ -5051,8 +5286,19 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 			if mappedModel != reqModel {
 				mappingSource = "account"
 			}
+		} else if account.IsBedrock() {
+			// CountTokens 只接受基础模型 ID，不追加跨区域推理前缀
+			mappedModel = account.GetMappedModel(reqModel)
+			if mappedModel != reqModel {
+				mappingSource = "bedrock"
+			}
+		} else if account.IsVertex() {
+			mappedModel = account.GetMappedModel(reqModel)
+			if mappedModel != reqModel {
+				mappingSource = "vertex"
+			}
 		}
-		if mappingSource == "" && account.Platform == PlatformAnthropic && account.Type != AccountTypeAPIKey {
+		if mappingSource == "" && account.Platform == PlatformAnthropic && account.Type != AccountTypeAPIKey && !account.IsBedrock() && !account.IsVertex() {
 			normalized := claude.NormalizeModelID(reqModel)
 			if normalized != reqModel {
 				mappedModel = normalized
@@ -5093,6 +5339,9 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 		s.countTokensError(c, http.StatusBadGateway, "upstream_error", "Request failed")
 		return fmt.Errorf("upstream request failed: %w", err)
 	}
+	if account.IsBedrock() {
+		adaptBedrockResponse(resp, false)
+	}
 
 	// 读取响应体
 	respBody, err := io.ReadAll(resp.Body)
@@ -5111,6 +5360,9 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 		if buildErr == nil {
 			retryResp, retryErr := s.httpUpstream.DoWithTLS(retryReq, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
 			if retryErr == nil {
+				if account.IsBedrock() {
+					adaptBedrockResponse(retryResp, false)
+				}
 				resp = retryResp
 				respBody, err = io.ReadAll(resp.Body)
 				_ = resp.Body.Close()
@@ -5167,12 +5419,22 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 	}
 
 	// 透传成功响应
+	if account.IsBedrock() {
+		respBody = convertBedrockCountTokensResponse(respBody)
+	}
 	c.Data(resp.StatusCode, "application/json", respBody)
 	return nil
 }
 
 // buildCountTokensRequest 构建 count_tokens 上游请求
 func (s *GatewayService) buildCountTokensRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, token, tokenType, modelID string, mimicClaudeCode bool) (*http.Request, error) {
+	if account.IsBedrock() {
+		return s.buildBedrockCountTokensRequest(ctx, c, account, body, modelID)
+	}
+	if account.IsVertex() {
+		return s.buildVertexClaudeCountTokensRequest(ctx, c, account, body, token)
+	}
+
 	// 确定目标 URL
 	targetURL := claudeAPICountTokensURL
 	if account.Type == AccountTypeAPIKey {
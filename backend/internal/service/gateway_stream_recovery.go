@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// 流中断恢复（mid-stream failover）
+//
+// 已向客户端输出部分内容后上游连接断开时，记录已输出的文本，
+// 以其作为 assistant 预填充在其他账号上重新发起请求；续写响应跳过 message_start、
+// 调整 content block 序号后拼接到同一 SSE 流中。两段请求分别按各自账号计费。
+
+// StreamRecovery 单个流式请求的恢复状态，跨账号的多段请求共享
+type StreamRecovery struct {
+	text          strings.Builder // 已发送给客户端的 assistant 文本
+	legText       strings.Builder // 当前这段请求输出的文本（用于估算中断时的 output tokens）
+	started       bool            // 已发送 message_start
+	finished      bool            // 已收到 message_delta/message_stop，无需恢复
+	unsupported   bool            // 输出了非文本块（tool_use/thinking 等），无法以预填充续写
+	nextIndex     int             // 下一个 content block 序号（客户端视角）
+	openIndex     int             // 未结束的 content block 序号，-1 表示没有
+	interruptions int             // 已发生的中断次数
+
+	// 续写请求的事件改写参数
+	continuing    bool
+	baseIndex     int
+	skipFirstOpen bool
+	trimLeading   bool
+}
+
+// StreamInterruptedError 流式响应已输出部分内容后中断，handler 可在其他账号上续写
+type StreamInterruptedError struct {
+	Result   *ForwardResult // 中断前这段请求的用量（按原账号计费）
+	Recovery *StreamRecovery
+	Cause    error
+}
+
+func (e *StreamInterruptedError) Error() string {
+	return fmt.Sprintf("upstream stream interrupted: %v", e.Cause)
+}
+
+func (e *StreamInterruptedError) Unwrap() error {
+	return e.Cause
+}
+
+// newStreamRecovery 为支持恢复的请求创建状态；未启用或请求不适用时返回 nil
+func (s *GatewayService) newStreamRecovery(parsed *ParsedRequest) *StreamRecovery {
+	if s.cfg == nil || !s.cfg.Gateway.StreamRecovery.Enabled || parsed == nil || !parsed.Stream {
+		return nil
+	}
+	// thinking 不支持 assistant 预填充；客户端自带预填充时也不再追加
+	if parsed.ThinkingEnabled || gjson.GetBytes(parsed.Body, "messages.@reverse.0.role").String() == "assistant" {
+		return nil
+	}
+	return &StreamRecovery{openIndex: -1}
+}
+
+// canResume 判断中断后是否可以续写
+func (s *GatewayService) canResume(r *StreamRecovery) bool {
+	if r == nil || s.cfg == nil {
+		return false
+	}
+	return r.started && !r.finished && !r.unsupported && r.interruptions < s.cfg.Gateway.StreamRecovery.MaxAttempts
+}
+
+// ForwardContinuation 在指定账号上续写已中断的流式响应
+func (s *GatewayService) ForwardContinuation(ctx context.Context, c *gin.Context, account *Account, parsed *ParsedRequest, recovery *StreamRecovery) (*ForwardResult, error) {
+	// antigravity OAuth 账号走独立的转发链路，无法拼接，交由 handler 切换账号
+	if account.Platform == PlatformAntigravity && account.Type != AccountTypeAPIKey {
+		return nil, &UpstreamFailoverError{StatusCode: http.StatusBadGateway}
+	}
+	cont, err := buildContinuationRequest(parsed, recovery)
+	if err != nil {
+		return nil, err
+	}
+	recovery.beginLeg()
+
+	result, err := s.forward(ctx, c, account, cont, recovery)
+	if err == nil {
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:    account.Platform,
+			AccountID:   account.ID,
+			AccountName: account.Name,
+			Kind:        "stream_recovered",
+			Message:     fmt.Sprintf("stream resumed after %d interruption(s)", recovery.interruptions),
+		})
+	}
+	return result, err
+}
+
+// buildContinuationRequest 以已输出文本作为 assistant 预填充构造续写请求
+func buildContinuationRequest(parsed *ParsedRequest, recovery *StreamRecovery) (*ParsedRequest, error) {
+	emitted := recovery.text.String()
+	// 预填充内容不能以空白结尾，裁掉的空白在续写时从首个 delta 中去除
+	prefill := strings.TrimRightFunc(emitted, unicode.IsSpace)
+	recovery.trimLeading = prefill != emitted
+
+	cont := *parsed
+	body := parsed.Body
+	var err error
+	if prefill != "" {
+		msg := map[string]any{"role": "assistant", "content": prefill}
+		if body, err = sjson.SetBytes(body, "messages.-1", msg); err != nil {
+			return nil, fmt.Errorf("build continuation request: %w", err)
+		}
+		cont.Messages = append(append([]any(nil), parsed.Messages...), msg)
+	}
+	// 续写部分与已输出部分共享 max_tokens
+	if parsed.MaxTokens > 0 {
+		remaining := parsed.MaxTokens - estimateTokensForText(emitted)
+		if remaining < 1 {
+			remaining = 1
+		}
+		if body, err = sjson.SetBytes(body, "max_tokens", remaining); err != nil {
+			return nil, fmt.Errorf("build continuation request: %w", err)
+		}
+		cont.MaxTokens = remaining
+	}
+	cont.Body = body
+	return &cont, nil
+}
+
+// beginLeg 开始一段续写请求：续写的第 0 个块接在未结束的块之后，否则从下一个序号开始
+func (r *StreamRecovery) beginLeg() {
+	r.continuing = true
+	r.legText.Reset()
+	if r.openIndex >= 0 {
+		r.baseIndex = r.openIndex
+		r.skipFirstOpen = true
+	} else {
+		r.baseIndex = r.nextIndex
+		r.skipFirstOpen = false
+	}
+}
+
+// interrupt 记录一次中断，返回这段请求已输出文本的 token 估算值
+func (r *StreamRecovery) interrupt() int {
+	r.interruptions++
+	return estimateTokensForText(r.legText.String())
+}
+
+// rewrite 改写续写请求的 SSE 事件并记录已输出内容；返回 false 表示该事件不发送给客户端
+func (r *StreamRecovery) rewrite(eventType string, event map[string]any) bool {
+	if r.continuing {
+		switch eventType {
+		case "message_start":
+			return false
+		case "content_block_start", "content_block_delta", "content_block_stop":
+			idx := sseEventIndex(event)
+			if eventType == "content_block_start" && idx == 0 && r.skipFirstOpen {
+				return false
+			}
+			event["index"] = r.baseIndex + idx
+			if eventType == "content_block_delta" && r.trimLeading {
+				if delta, ok := event["delta"].(map[string]any); ok {
+					if text, ok := delta["text"].(string); ok {
+						text = strings.TrimLeftFunc(text, unicode.IsSpace)
+						if text == "" {
+							return false
+						}
+						delta["text"] = text
+						r.trimLeading = false
+					}
+				}
+			}
+		}
+	}
+	r.observe(eventType, event)
+	return true
+}
+
+func (r *StreamRecovery) observe(eventType string, event map[string]any) {
+	switch eventType {
+	case "message_start":
+		r.started = true
+	case "content_block_start":
+		idx := sseEventIndex(event)
+		block, _ := event["content_block"].(map[string]any)
+		if blockType, _ := block["type"].(string); blockType != "text" {
+			r.unsupported = true
+		}
+		if text, _ := block["text"].(string); text != "" {
+			r.text.WriteString(text)
+			r.legText.WriteString(text)
+		}
+		r.openIndex = idx
+		r.nextIndex = idx + 1
+	case "content_block_delta":
+		delta, _ := event["delta"].(map[string]any)
+		if deltaType, _ := delta["type"].(string); deltaType != "text_delta" {
+			return
+		}
+		if text, _ := delta["text"].(string); text != "" {
+			r.text.WriteString(text)
+			r.legText.WriteString(text)
+		}
+	case "content_block_stop":
+		r.openIndex = -1
+	case "message_delta", "message_stop":
+		r.finished = true
+	}
+}
+
+func sseEventIndex(event map[string]any) int {
+	switch v := event["index"].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+type streamDropReader struct{}
+
+func (streamDropReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func newStreamRecoveryTestService() *GatewayService {
+	cfg := &config.Config{}
+	cfg.Gateway.MaxLineSize = defaultMaxLineSize
+	cfg.Gateway.StreamRecovery = config.GatewayStreamRecoveryConfig{Enabled: true, MaxAttempts: 2}
+	return &GatewayService{cfg: cfg}
+}
+
+func runRecoveryStream(t *testing.T, svc *GatewayService, recovery *StreamRecovery, sse string, drop bool) (*streamingResult, string, error) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+
+	var body io.Reader = strings.NewReader(sse)
+	if drop {
+		body = io.MultiReader(body, streamDropReader{})
+	}
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(body)}
+	result, err := svc.handleStreamingResponse(context.Background(), resp, c, &Account{ID: 1}, time.Now(), "claude-sonnet-4-5", "claude-sonnet-4-5", false, recovery)
+	return result, rec.Body.String(), err
+}
+
+func TestStreamRecovery_SpliceContinuation(t *testing.T) {
+	svc := newStreamRecoveryTestService()
+	parsed := &ParsedRequest{
+		Body:      []byte(`{"model":"claude-sonnet-4-5","max_tokens":100,"stream":true,"messages":[{"role":"user","content":"hi"}]}`),
+		Model:     "claude-sonnet-4-5",
+		Stream:    true,
+		MaxTokens: 100,
+	}
+	recovery := svc.newStreamRecovery(parsed)
+	require.NotNil(t, recovery)
+
+	first := "event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"usage\":{\"input_tokens\":10,\"output_tokens\":1}}}\n\n" +
+		"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":0,\"content_block\":{\"type\":\"text\",\"text\":\"\"}}\n\n" +
+		"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\"Hello \"}}\n\n"
+	result, out, err := runRecoveryStream(t, svc, recovery, first, true)
+	var interrupted *StreamInterruptedError
+	require.ErrorAs(t, err, &interrupted)
+	require.Same(t, recovery, interrupted.Recovery)
+	require.Equal(t, 10, result.usage.InputTokens)
+	require.NotContains(t, out, "event: error")
+	require.Equal(t, 2, recovery.interrupt())
+
+	cont, err := buildContinuationRequest(parsed, recovery)
+	require.NoError(t, err)
+	require.Equal(t, "assistant", gjson.GetBytes(cont.Body, "messages.1.role").String())
+	require.Equal(t, "Hello", gjson.GetBytes(cont.Body, "messages.1.content").String())
+	require.Equal(t, int64(98), gjson.GetBytes(cont.Body, "max_tokens").Int())
+	require.Len(t, cont.Messages, 1, "原请求不应被修改")
+	require.Len(t, parsed.Messages, 0)
+	recovery.beginLeg()
+
+	second := "event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"usage\":{\"input_tokens\":12,\"output_tokens\":1}}}\n\n" +
+		"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":0,\"content_block\":{\"type\":\"text\",\"text\":\"\"}}\n\n" +
+		"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"index\":0,\"delta\":{\"type\":\"text_delta\",\"text\":\" world\"}}\n\n" +
+		"event: content_block_stop\ndata: {\"type\":\"content_block_stop\",\"index\":0}\n\n" +
+		"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":1,\"content_block\":{\"type\":\"text\",\"text\":\"\"}}\n\n" +
+		"event: content_block_stop\ndata: {\"type\":\"content_block_stop\",\"index\":1}\n\n" +
+		"event: message_delta\ndata: {\"type\":\"message_delta\",\"delta\":{\"stop_reason\":\"end_turn\"},\"usage\":{\"output_tokens\":5}}\n\n" +
+		"event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n"
+	result, out, err = runRecoveryStream(t, svc, recovery, second, false)
+	require.NoError(t, err)
+	require.Equal(t, 12, result.usage.InputTokens)
+	require.Equal(t, 5, result.usage.OutputTokens)
+
+	// 续写跳过 message_start 与接续块的 content_block_start，并去掉预填充裁掉的空白
+	require.NotContains(t, out, "message_start")
+	require.Equal(t, 1, strings.Count(out, "event: content_block_start"))
+	require.Contains(t, out, `"text":"world"`)
+	require.Contains(t, out, `{"index":0,"type":"content_block_stop"}`)
+	require.Contains(t, out, `"index":1`)
+	require.Equal(t, "Hello world", recovery.text.String())
+}
+
+func TestStreamRecovery_NotResumable(t *testing.T) {
+	svc := newStreamRecoveryTestService()
+
+	require.Nil(t, svc.newStreamRecovery(&ParsedRequest{Stream: true, ThinkingEnabled: true}))
+	require.Nil(t, svc.newStreamRecovery(&ParsedRequest{
+		Stream: true,
+		Body:   []byte(`{"messages":[{"role":"user","content":"hi"},{"role":"assistant","content":"{"}]}`),
+	}))
+
+	recovery := svc.newStreamRecovery(&ParsedRequest{Stream: true, Body: []byte(`{"messages":[]}`)})
+	require.NotNil(t, recovery)
+	toolUse := "event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"usage\":{\"input_tokens\":10}}}\n\n" +
+		"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":0,\"content_block\":{\"type\":\"tool_use\",\"id\":\"toolu_1\",\"name\":\"f\",\"input\":{}}}\n\n"
+	_, out, err := runRecoveryStream(t, svc, recovery, toolUse, true)
+	var interrupted *StreamInterruptedError
+	require.False(t, errors.As(err, &interrupted))
+	require.Error(t, err)
+	require.Contains(t, out, "stream_read_error")
+
+	// 达到最大续写次数后不再恢复
+	recovery = &StreamRecovery{openIndex: -1, started: true, interruptions: 2}
+	require.False(t, svc.canResume(recovery))
+	require.False(t, svc.canResume(nil))
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSelectAccountSpan 为账号调度创建 span（SelectAccountWithLoadAwareness）
+func startSelectAccountSpan(ctx context.Context, groupID *int64, model string, excludedIDs map[int64]struct{}) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "scheduler.select_account",
+		attribute.Int64("group_id", derefGroupID(groupID)),
+		attribute.String("model", model),
+		attribute.Int("excluded_accounts", len(excludedIDs)),
+	)
+}
+
+// endSelectAccountSpan 记录调度结果（选中账号或需要排队等待）并结束 span
+func endSelectAccountSpan(span trace.Span, result *AccountSelectionResult, err error) {
+	if result != nil && result.Account != nil {
+		span.SetAttributes(
+			attribute.Int64("account_id", result.Account.ID),
+			attribute.Bool("slot_acquired", result.Acquired),
+			attribute.Bool("wait_plan", result.WaitPlan != nil),
+		)
+	}
+	tracing.End(span, err)
+}
+
+// startTokenRefreshSpan 为 OAuth token 刷新创建 span（后台刷新与请求内按需刷新共用）
+func startTokenRefreshSpan(ctx context.Context, account *Account) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "token.refresh",
+		attribute.Int64("account_id", account.ID),
+		attribute.String("platform", account.Platform),
+	)
+}
+
+// startRecordUsageSpan 为使用量记录与扣费创建 span（由 handler 异步调用，ctx 已脱离请求取消但保留链路）
+func startRecordUsageSpan(ctx context.Context, account *Account, apiKey *APIKey, model string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attribute.String("model", model)}
+	if account != nil {
+		attrs = append(attrs, attribute.Int64("account_id", account.ID), attribute.String("platform", account.Platform))
+	}
+	if apiKey != nil {
+		attrs = append(attrs, attribute.Int64("api_key_id", apiKey.ID))
+	}
+	return tracing.Start(ctx, "usage.record", attrs...)
+}
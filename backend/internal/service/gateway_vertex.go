@@ -0,0 +1,145 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Google Vertex AI 账号转发
+//
+// Vertex 账号使用服务账号密钥换取的 Bearer 令牌访问区域化的 aiplatform 端点：
+//   - gemini 平台：publishers/google/models/{model}:generateContent|streamGenerateContent|countTokens，请求/响应与 AI Studio 一致；
+//   - anthropic 平台：publishers/anthropic/models/{model}:rawPredict|streamRawPredict，请求体为 Anthropic Messages 格式，
+//     模型放在 URL 中（请求体不含 model），版本号通过请求体 anthropic_version 指定，响应与 Anthropic API 一致。
+//
+// 本文件只负责构建上游请求，响应处理复用各自的 Forward 逻辑。
+
+const (
+	vertexAnthropicVersion = "vertex-2023-10-16"
+
+	vertexPublisherGoogle    = "google"
+	vertexPublisherAnthropic = "anthropic"
+
+	// vertexClaudeCountTokensModel Vertex 上 Claude count_tokens 的固定模型路径（实际模型在请求体中）
+	vertexClaudeCountTokensModel = "count-tokens"
+)
+
+var vertexLocationPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// vertexModelURL 返回 Vertex AI 上指定发布方模型的操作 URL；global 区域使用不带区域前缀的主机名
+func vertexModelURL(account *Account, publisher, model, action string) (string, error) {
+	projectID := VertexProjectID(account)
+	if projectID == "" {
+		return "", errors.New("vertex project_id not configured")
+	}
+	location := account.GetVertexLocation()
+	if !vertexLocationPattern.MatchString(location) {
+		return "", fmt.Errorf("invalid vertex location: %s", location)
+	}
+	host := "aiplatform.googleapis.com"
+	if location != "global" {
+		host = location + "-aiplatform.googleapis.com"
+	}
+	return fmt.Sprintf("https://%s/v1/projects/%s/locations/%s/publishers/%s/models/%s:%s",
+		host, url.PathEscape(projectID), location, publisher, url.PathEscape(model), action), nil
+}
+
+// newVertexRequest 构建带 Bearer 令牌的 Vertex 请求
+func newVertexRequest(ctx context.Context, targetURL, token string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req, nil
+}
+
+// buildVertexGeminiRequest 构建 Vertex 上 Gemini 模型的请求（流式请求使用 SSE 输出）
+func buildVertexGeminiRequest(ctx context.Context, provider *VertexTokenProvider, account *Account, model, action string, stream bool, body []byte) (*http.Request, error) {
+	if provider == nil {
+		return nil, errors.New("vertex token provider not configured")
+	}
+	targetURL, err := vertexModelURL(account, vertexPublisherGoogle, model, action)
+	if err != nil {
+		return nil, err
+	}
+	if stream {
+		targetURL += "?alt=sse"
+	}
+	token, err := provider.GetAccessToken(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return newVertexRequest(ctx, targetURL, token, body)
+}
+
+// buildVertexClaudeRequestBody 将 Anthropic Messages 请求体转换为 Vertex rawPredict 请求体
+func buildVertexClaudeRequestBody(body []byte) ([]byte, error) {
+	body, err := sjson.DeleteBytes(body, "model")
+	if err != nil {
+		return nil, err
+	}
+	if !gjson.GetBytes(body, "anthropic_version").Exists() {
+		if body, err = sjson.SetBytes(body, "anthropic_version", vertexAnthropicVersion); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+// setVertexClaudeBetaHeader 透传客户端 beta 特性（去掉 OAuth / Claude Code 专用 beta）
+func setVertexClaudeBetaHeader(c *gin.Context, req *http.Request) {
+	if c == nil || c.Request == nil {
+		return
+	}
+	if betas := cloudProviderBetas(c.Request.Header.Get("anthropic-beta")); len(betas) > 0 {
+		req.Header.Set("anthropic-beta", strings.Join(betas, ","))
+	}
+}
+
+// buildVertexClaudeRequest 构建 Vertex 上 Claude 模型的消息请求；其余客户端请求头不透传
+func (s *GatewayService) buildVertexClaudeRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, token, modelID string, reqStream bool) (*http.Request, error) {
+	action := "rawPredict"
+	if reqStream {
+		action = "streamRawPredict"
+	}
+	targetURL, err := vertexModelURL(account, vertexPublisherAnthropic, modelID, action)
+	if err != nil {
+		return nil, err
+	}
+	vertexBody, err := buildVertexClaudeRequestBody(body)
+	if err != nil {
+		return nil, fmt.Errorf("build vertex body: %w", err)
+	}
+	req, err := newVertexRequest(ctx, targetURL, token, vertexBody)
+	if err != nil {
+		return nil, err
+	}
+	setVertexClaudeBetaHeader(c, req)
+	return req, nil
+}
+
+// buildVertexClaudeCountTokensRequest 构建 Vertex 上 Claude 的 count_tokens 请求（模型保留在请求体中）
+func (s *GatewayService) buildVertexClaudeCountTokensRequest(ctx context.Context, c *gin.Context, account *Account, body []byte, token string) (*http.Request, error) {
+	targetURL, err := vertexModelURL(account, vertexPublisherAnthropic, vertexClaudeCountTokensModel, "rawPredict")
+	if err != nil {
+		return nil, err
+	}
+	req, err := newVertexRequest(ctx, targetURL, token, body)
+	if err != nil {
+		return nil, err
+	}
+	setVertexClaudeBetaHeader(c, req)
+	return req, nil
+}
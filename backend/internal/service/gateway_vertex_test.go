@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+type vertexTokenCacheStub struct {
+	tokens map[string]string
+}
+
+func (s *vertexTokenCacheStub) GetAccessToken(_ context.Context, cacheKey string) (string, error) {
+	return s.tokens[cacheKey], nil
+}
+
+func (s *vertexTokenCacheStub) SetAccessToken(_ context.Context, cacheKey string, token string, _ time.Duration) error {
+	s.tokens[cacheKey] = token
+	return nil
+}
+
+func (s *vertexTokenCacheStub) DeleteAccessToken(_ context.Context, cacheKey string) error {
+	delete(s.tokens, cacheKey)
+	return nil
+}
+
+func (s *vertexTokenCacheStub) AcquireRefreshLock(context.Context, string, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (s *vertexTokenCacheStub) ReleaseRefreshLock(context.Context, string) error {
+	return nil
+}
+
+type vertexTokenUpstreamStub struct {
+	t         *testing.T
+	publicKey *rsa.PublicKey
+	calls     int
+}
+
+func (u *vertexTokenUpstreamStub) Do(req *http.Request, _ string, _ int64, _ int) (*http.Response, error) {
+	u.calls++
+	body, _ := io.ReadAll(req.Body)
+	form, err := url.ParseQuery(string(body))
+	require.NoError(u.t, err)
+	require.Equal(u.t, vertexJWTBearerGrant, form.Get("grant_type"))
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(form.Get("assertion"), claims, func(*jwt.Token) (any, error) { return u.publicKey, nil })
+	require.NoError(u.t, err)
+	require.Equal(u.t, "key-1", token.Header["kid"])
+	require.Equal(u.t, "sa@demo-project.iam.gserviceaccount.com", claims["iss"])
+	require.Equal(u.t, vertexTokenScope, claims["scope"])
+	require.Equal(u.t, "https://oauth2.googleapis.com/token", claims["aud"])
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"access_token":"ya29.token","expires_in":3599,"token_type":"Bearer"}`)),
+	}, nil
+}
+
+func (u *vertexTokenUpstreamStub) DoWithTLS(req *http.Request, proxyURL string, accountID int64, concurrency int, _ bool) (*http.Response, error) {
+	return u.Do(req, proxyURL, accountID, concurrency)
+}
+
+func newVertexTestAccount(t *testing.T, platform string, credentials map[string]any) (*Account, *rsa.PrivateKey) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	serviceAccount, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "demo-project",
+		"private_key_id": "key-1",
+		"private_key":    string(keyPEM),
+		"client_email":   "sa@demo-project.iam.gserviceaccount.com",
+		"token_uri":      "https://oauth2.googleapis.com/token",
+	})
+	creds := map[string]any{"service_account_json": string(serviceAccount)}
+	for k, v := range credentials {
+		creds[k] = v
+	}
+	return &Account{ID: 9, Platform: platform, Type: AccountTypeVertex, Credentials: creds}, privateKey
+}
+
+func TestVertexModelURL(t *testing.T) {
+	account, _ := newVertexTestAccount(t, PlatformGemini, nil)
+	targetURL, err := vertexModelURL(account, vertexPublisherGoogle, "gemini-2.5-pro", "streamGenerateContent")
+	require.NoError(t, err)
+	require.Equal(t, "https://aiplatform.googleapis.com/v1/projects/demo-project/locations/global/publishers/google/models/gemini-2.5-pro:streamGenerateContent", targetURL)
+
+	account, _ = newVertexTestAccount(t, PlatformAnthropic, map[string]any{"project_id": "other-project", "location": "us-east5"})
+	modelID := account.GetMappedModel("claude-sonnet-4-5")
+	require.Equal(t, "claude-sonnet-4-5@20250929", modelID)
+	targetURL, err = vertexModelURL(account, vertexPublisherAnthropic, modelID, "rawPredict")
+	require.NoError(t, err)
+	require.Equal(t, "https://us-east5-aiplatform.googleapis.com/v1/projects/other-project/locations/us-east5/publishers/anthropic/models/claude-sonnet-4-5@20250929:rawPredict", targetURL)
+
+	account, _ = newVertexTestAccount(t, PlatformAnthropic, map[string]any{"location": "evil.example.com/"})
+	_, err = vertexModelURL(account, vertexPublisherAnthropic, modelID, "rawPredict")
+	require.Error(t, err)
+}
+
+func TestBuildVertexClaudeRequestBody(t *testing.T) {
+	out, err := buildVertexClaudeRequestBody([]byte(`{"model":"claude-sonnet-4-5@20250929","stream":true,"max_tokens":16,"messages":[]}`))
+	require.NoError(t, err)
+	require.False(t, gjson.GetBytes(out, "model").Exists())
+	require.True(t, gjson.GetBytes(out, "stream").Bool())
+	require.Equal(t, vertexAnthropicVersion, gjson.GetBytes(out, "anthropic_version").String())
+}
+
+func TestVertexTokenProviderMintsAndCaches(t *testing.T) {
+	account, privateKey := newVertexTestAccount(t, PlatformGemini, nil)
+	upstream := &vertexTokenUpstreamStub{t: t, publicKey: &privateKey.PublicKey}
+	cache := &vertexTokenCacheStub{tokens: map[string]string{}}
+	provider := NewVertexTokenProvider(cache, upstream)
+
+	token, err := provider.GetAccessToken(context.Background(), account)
+	require.NoError(t, err)
+	require.Equal(t, "ya29.token", token)
+	require.Equal(t, "ya29.token", cache.tokens[VertexTokenCacheKey(account)])
+
+	token, err = provider.GetAccessToken(context.Background(), account)
+	require.NoError(t, err)
+	require.Equal(t, "ya29.token", token)
+	require.Equal(t, 1, upstream.calls)
+
+	// 401 后失效缓存，下次请求重新签发
+	require.NoError(t, NewCompositeTokenCacheInvalidator(cache).InvalidateToken(context.Background(), account))
+	_, err = provider.GetAccessToken(context.Background(), account)
+	require.NoError(t, err)
+	require.Equal(t, 2, upstream.calls)
+
+	req, err := buildVertexGeminiRequest(context.Background(), provider, account, "gemini-2.5-flash", "streamGenerateContent", true, []byte(`{"contents":[]}`))
+	require.NoError(t, err)
+	require.Equal(t, "Bearer ya29.token", req.Header.Get("Authorization"))
+	require.Equal(t, "alt=sse", req.URL.RawQuery)
+
+	_, err = provider.GetAccessToken(context.Background(), &Account{ID: 1, Type: AccountTypeVertex, Credentials: map[string]any{}})
+	require.Error(t, err)
+}
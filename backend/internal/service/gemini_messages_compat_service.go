@@ -47,6 +47,7 @@ type GeminiMessagesCompatService struct {
 	cache                     GatewayCache
 	schedulerSnapshot         *SchedulerSnapshotService
 	tokenProvider             *GeminiTokenProvider
+	vertexTokenProvider       *VertexTokenProvider
 	rateLimitService          *RateLimitService
 	httpUpstream              HTTPUpstream
 	antigravityGatewayService *AntigravityGatewayService
@@ -59,6 +60,7 @@ func NewGeminiMessagesCompatService(
 	cache GatewayCache,
 	schedulerSnapshot *SchedulerSnapshotService,
 	tokenProvider *GeminiTokenProvider,
+	vertexTokenProvider *VertexTokenProvider,
 	rateLimitService *RateLimitService,
 	httpUpstream HTTPUpstream,
 	antigravityGatewayService *AntigravityGatewayService,
@@ -70,6 +72,7 @@ func NewGeminiMessagesCompatService(
 		cache:                     cache,
 		schedulerSnapshot:         schedulerSnapshot,
 		tokenProvider:             tokenProvider,
+		vertexTokenProvider:       vertexTokenProvider,
 		rateLimitService:          rateLimitService,
 		httpUpstream:              httpUpstream,
 		antigravityGatewayService: antigravityGatewayService,
@@ -528,7 +531,7 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 
 	originalModel := req.Model
 	mappedModel := req.Model
-	if account.Type == AccountTypeAPIKey {
+	if account.Type == AccountTypeAPIKey || account.IsVertex() {
 		mappedModel = account.GetMappedModel(req.Model)
 	}
 
@@ -659,6 +662,20 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 		}
 		requestIDHeader = "x-request-id"
 
+	case AccountTypeVertex:
+		buildReq = func(ctx context.Context) (*http.Request, string, error) {
+			action := "generateContent"
+			if req.Stream {
+				action = "streamGenerateContent"
+			}
+			upstreamReq, err := buildVertexGeminiRequest(ctx, s.vertexTokenProvider, account, mappedModel, action, req.Stream, geminiReq)
+			if err != nil {
+				return nil, "", err
+			}
+			return upstreamReq, "x-request-id", nil
+		}
+		requestIDHeader = "x-request-id"
+
 	default:
 		return nil, fmt.Errorf("unsupported account type: %s", account.Type)
 	}
@@ -985,7 +1002,7 @@ func (s *GeminiMessagesCompatService) Forward(ctx context.Context, c *gin.Contex
 	// 图片生成计费
 	imageCount := 0
 	imageSize := s.extractImageSize(body)
-	if isImageGenerationModel(originalModel) {
+	if IsImageGenerationModel(originalModel) {
 		imageCount = 1
 	}
 
@@ -1028,7 +1045,7 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 	}
 
 	switch action {
-	case "generateContent", "streamGenerateContent", "countTokens":
+	case "generateContent", "streamGenerateContent", "countTokens", "embedContent", "batchEmbedContents":
 		// ok
 	default:
 		return nil, s.writeGoogleError(c, http.StatusNotFound, "Unsupported action: "+action)
@@ -1039,7 +1056,7 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 	body = ensureGeminiFunctionCallThoughtSignatures(body)
 
 	mappedModel := originalModel
-	if account.Type == AccountTypeAPIKey {
+	if account.Type == AccountTypeAPIKey || account.IsVertex() {
 		mappedModel = account.GetMappedModel(originalModel)
 	}
 
@@ -1055,7 +1072,9 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 		useUpstreamStream = true
 		upstreamAction = "streamGenerateContent"
 	}
-	forceAIStudio := action == "countTokens"
+	isEmbed := isGeminiEmbedAction(action)
+	// countTokens 与向量接口只存在于 AI Studio（Code Assist 没有对应端点）
+	forceAIStudio := action == "countTokens" || isEmbed
 
 	var requestIDHeader string
 	var buildReq func(ctx context.Context) (*http.Request, string, error)
@@ -1158,6 +1177,20 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 		}
 		requestIDHeader = "x-request-id"
 
+	case AccountTypeVertex:
+		// Vertex 的 publishers/google 模型没有 embedContent / batchEmbedContents
+		if isEmbed {
+			return nil, s.writeGoogleError(c, http.StatusBadRequest, "Embedding actions are not supported by Vertex AI accounts")
+		}
+		buildReq = func(ctx context.Context) (*http.Request, string, error) {
+			upstreamReq, err := buildVertexGeminiRequest(ctx, s.vertexTokenProvider, account, mappedModel, upstreamAction, useUpstreamStream, body)
+			if err != nil {
+				return nil, "", err
+			}
+			return upstreamReq, "x-request-id", nil
+		}
+		requestIDHeader = "x-request-id"
+
 	default:
 		return nil, s.writeGoogleError(c, http.StatusBadGateway, "Unsupported account type: "+account.Type)
 	}
@@ -1481,10 +1514,24 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 		usage = &ClaudeUsage{}
 	}
 
+	// 向量请求：上游响应不含 usageMetadata，按请求文本估算输入 token
+	if isEmbed {
+		if usage.InputTokens == 0 {
+			usage.InputTokens = EstimateGeminiEmbedTokens(body)
+		}
+		return &ForwardResult{
+			RequestID:   requestID,
+			Usage:       *usage,
+			Model:       originalModel,
+			Duration:    time.Since(startTime),
+			RequestType: UsageRequestTypeEmbedding,
+		}, nil
+	}
+
 	// 图片生成计费
 	imageCount := 0
 	imageSize := s.extractImageSize(body)
-	if isImageGenerationModel(originalModel) {
+	if IsImageGenerationModel(originalModel) {
 		imageCount = 1
 	}
 
@@ -1500,6 +1547,36 @@ func (s *GeminiMessagesCompatService) ForwardNative(ctx context.Context, c *gin.
 	}, nil
 }
 
+// isGeminiEmbedAction 是否为 Gemini 向量接口（embedContent / batchEmbedContents）
+func isGeminiEmbedAction(action string) bool {
+	return action == "embedContent" || action == "batchEmbedContents"
+}
+
+// EstimateGeminiEmbedTokens 估算 embedContent / batchEmbedContents 请求的输入 token 数
+func EstimateGeminiEmbedTokens(reqBody []byte) int {
+	type embedRequest struct {
+		Content struct {
+			Parts []struct {
+				Text string `json:"text"`
+			} `json:"parts"`
+		} `json:"content"`
+	}
+	var req struct {
+		embedRequest
+		Requests []embedRequest `json:"requests"`
+	}
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return 0
+	}
+	total := 0
+	for _, r := range append([]embedRequest{req.embedRequest}, req.Requests...) {
+		for _, p := range r.Content.Parts {
+			total += estimateTokensForText(p.Text)
+		}
+	}
+	return total
+}
+
 // checkErrorPolicyInLoop 在重试循环内预检查错误策略。
 // 返回 true 表示策略已匹配（调用者应 break），resp 已重建可直接使用。
 // 返回 false 表示 ErrorPolicyNone，resp 已重建，调用者继续走重试逻辑。
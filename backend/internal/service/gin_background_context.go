@@ -0,0 +1,122 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newBackgroundGinContext 为后台任务（批处理 worker、健康探测、对冲尝试）构造 gin.Context，
+// 使转发链路可以在没有真实 HTTP 请求的情况下复用。
+//
+// 上下文不关联 gin.Engine：ClientIP、multipart 解析等依赖 engine 的方法不可用，
+// 转发链路只使用 Request、Writer 与 Keys。keys 中的切片与 map 会被浅层复制一份，
+// 避免多个并发上下文向同一底层数组追加（例如 ops 上游事件）。
+func newBackgroundGinContext(req *http.Request, w http.ResponseWriter, keys map[string]any) *gin.Context {
+	c := &gin.Context{
+		Request: req,
+		Writer:  &backgroundResponseWriter{ResponseWriter: w, status: http.StatusOK, size: -1},
+	}
+	for k, v := range keys {
+		c.Set(k, cloneContextValue(v))
+	}
+	return c
+}
+
+// cloneContextValue 复制切片与 map 的最外层，其余值原样返回
+func cloneContextValue(v any) any {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v
+		}
+		cp := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(cp, rv)
+		return cp.Interface()
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+		cp := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			cp.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return cp.Interface()
+	default:
+		return v
+	}
+}
+
+// backgroundResponseWriter 将 http.ResponseWriter 适配为 gin.ResponseWriter，语义与 gin 内置实现一致
+type backgroundResponseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *backgroundResponseWriter) WriteHeader(code int) {
+	if code > 0 && !w.Written() {
+		w.status = code
+	}
+}
+
+func (w *backgroundResponseWriter) WriteHeaderNow() {
+	if !w.Written() {
+		w.size = 0
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+func (w *backgroundResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeaderNow()
+	n, err := w.ResponseWriter.Write(data)
+	w.size += n
+	return n, err
+}
+
+func (w *backgroundResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *backgroundResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *backgroundResponseWriter) Size() int {
+	return w.size
+}
+
+func (w *backgroundResponseWriter) Written() bool {
+	return w.size != -1
+}
+
+func (w *backgroundResponseWriter) Flush() {
+	w.WriteHeaderNow()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *backgroundResponseWriter) Pusher() http.Pusher {
+	if p, ok := w.ResponseWriter.(http.Pusher); ok {
+		return p
+	}
+	return nil
+}
+
+func (w *backgroundResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("background response writer does not support hijacking")
+}
+
+func (w *backgroundResponseWriter) CloseNotify() <-chan bool {
+	return make(chan bool)
+}
@@ -48,6 +48,24 @@ type Group struct {
 	// 分组排序
 	SortOrder int
 
+	// 精确响应缓存（确定性请求命中后不请求上游）
+	ResponseCacheEnabled bool
+	// 响应缓存 TTL（秒），0 表示使用全局默认值
+	ResponseCacheTTLSeconds int
+
+	// 批处理（Message Batches）请求按正常费用的该比例计费，1 表示不打折
+	BatchDiscountMultiplier float64
+
+	// 对冲请求：流式请求在等待首字节超过阈值后，向调度器选出的第二个账号发起同一请求，先响应者胜出
+	HedgeEnabled bool
+	// 对冲阈值（毫秒），0 表示按分组滚动 p95 首字时间自动计算
+	HedgeDelayMs int
+
+	// Prompt Cache 前缀路由：相同的可缓存前缀优先调度到最近服务过它的账号，提高上游缓存命中率
+	PromptCacheRoutingEnabled bool
+	// 自动插入 cache_control 断点：为未设置缓存断点的客户端在 tools/system/最近的用户轮次末尾插入断点
+	AutoCacheControlEnabled bool
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Idempotency-Key 去重
+//
+// 同一 API Key 下首个携带某 Idempotency-Key 的请求获得执行权，其响应（状态码、Content-Type 与响应体分片）
+// 在写出的同时记录到 Redis；并发或随后到达的重复请求不再执行 handler，而是等待并回放记录的响应，
+// 首个请求仍在流式输出时逐片接入。重复请求不经过转发与计费，因此不会产生第二条 usage_logs。
+// 首个请求失败（5xx/429/408 或未写出响应）时删除记录，允许重试重新执行；
+// 成功响应超过保存上限或记录写入失败时标记为不可回放，重复请求返回冲突而非再次执行。
+
+// IdempotencyKeyHeader 客户端提供的幂等键请求头
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyReplayedHeader 标记回放响应的响应头
+const IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+const (
+	IdempotencyStateInProgress = "in_progress"
+	IdempotencyStateCompleted  = "completed"
+
+	idempotencyMaxKeyLength  = 255
+	idempotencyPollInterval  = 100 * time.Millisecond
+	idempotencyStoreTimeout  = 5 * time.Second
+	idempotencyChunkPageSize = 256
+)
+
+var (
+	ErrIdempotencyKeyInvalid  = errors.New("idempotency key must be 1-255 characters")
+	ErrIdempotencyKeyMismatch = errors.New("idempotency key has already been used with a different request body")
+	ErrIdempotencyInProgress  = errors.New("a request with the same idempotency key is still in progress")
+	ErrIdempotencyUnavailable = errors.New("the original response for this idempotency key is unavailable for replay")
+)
+
+// IdempotencyRecord 幂等键对应的执行记录
+type IdempotencyRecord struct {
+	State       string
+	Fingerprint string
+	StatusCode  int // 0 表示首个请求尚未写出响应
+	ContentType string
+	Truncated   bool // 响应超过保存上限，无法回放
+}
+
+// IdempotencyCache 幂等记录存储（Redis）；key 为 API Key 维度的记录键
+type IdempotencyCache interface {
+	// Acquire 记录不存在时创建执行中记录并返回 true
+	Acquire(ctx context.Context, key, fingerprint string, ttl time.Duration) (bool, error)
+	// Get 记录不存在时返回 nil, nil
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+	SetResponse(ctx context.Context, key string, statusCode int, contentType string) error
+	AppendChunk(ctx context.Context, key string, chunk []byte, ttl time.Duration) error
+	// GetChunks 返回从 start（含）开始的响应体分片
+	GetChunks(ctx context.Context, key string, start, count int64) ([][]byte, error)
+	MarkTruncated(ctx context.Context, key string) error
+	Complete(ctx context.Context, key string, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// IdempotencyService Idempotency-Key 去重服务
+type IdempotencyService struct {
+	cache IdempotencyCache
+	cfg   config.GatewayIdempotencyConfig
+}
+
+// NewIdempotencyService 创建幂等服务
+func NewIdempotencyService(cache IdempotencyCache, cfg *config.Config) *IdempotencyService {
+	svc := &IdempotencyService{cache: cache}
+	if cfg != nil {
+		svc.cfg = cfg.Gateway.Idempotency
+	}
+	return svc
+}
+
+// Enabled 是否启用幂等去重
+func (s *IdempotencyService) Enabled() bool {
+	return s != nil && s.cache != nil && s.cfg.Enabled
+}
+
+// idempotencyRecordKey 记录键按 API Key 与请求路径隔离：同一幂等键用于不同接口时互不影响
+func idempotencyRecordKey(apiKeyID int64, path, idempotencyKey string) string {
+	sum := sha256.Sum256([]byte(path + "\x00" + idempotencyKey))
+	return strconv.FormatInt(apiKeyID, 10) + ":" + hex.EncodeToString(sum[:16])
+}
+
+func idempotencyFingerprint(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// HasRecord 当前请求路径上是否已存在该幂等键的记录（执行中或已完成）。
+// 存在记录的请求会被回放而不执行 handler，认证阶段据此跳过请求数计数；存储不可用时返回 false。
+func (s *IdempotencyService) HasRecord(c *gin.Context, apiKeyID int64, idempotencyKey string) bool {
+	if !s.Enabled() || idempotencyKey == "" || len(idempotencyKey) > idempotencyMaxKeyLength {
+		return false
+	}
+	record, err := s.cache.Get(c.Request.Context(), idempotencyRecordKey(apiKeyID, c.Request.URL.Path, idempotencyKey))
+	return err == nil && record != nil
+}
+
+// Begin 处理携带 Idempotency-Key 的请求：
+//   - 获得执行权时接管 c.Writer 记录响应并返回 lease，调用方执行 handler 后必须调用 lease.Finish；
+//   - 重复请求在此处等待并回放首个请求的响应，返回 handled=true，调用方应中止后续 handler；
+//   - 幂等存储不可用时返回 nil, false, nil，按普通请求处理。
+//
+// 回放前出错时返回错误（尚未写出任何内容）。
+func (s *IdempotencyService) Begin(c *gin.Context, apiKeyID int64, idempotencyKey string, body []byte) (lease *IdempotencyLease, handled bool, err error) {
+	if idempotencyKey == "" || len(idempotencyKey) > idempotencyMaxKeyLength {
+		return nil, false, ErrIdempotencyKeyInvalid
+	}
+	key := idempotencyRecordKey(apiKeyID, c.Request.URL.Path, idempotencyKey)
+	fingerprint := idempotencyFingerprint(body)
+	ctx := c.Request.Context()
+
+	for {
+		acquired, err := s.cache.Acquire(ctx, key, fingerprint, s.lockTTL())
+		if err != nil {
+			// 存储不可用时不阻断请求，按未携带幂等键处理
+			log.Printf("[Idempotency] acquire failed: key=%s err=%v", key, err)
+			return nil, false, nil
+		}
+		if acquired {
+			w := &idempotencyCaptureWriter{ResponseWriter: c.Writer, ctx: ctx, svc: s, key: key, limit: s.cfg.MaxResponseBytes}
+			c.Writer = w
+			return &IdempotencyLease{svc: s, c: c, key: key, writer: w}, false, nil
+		}
+
+		retry, err := s.replay(c, key, fingerprint)
+		if err != nil {
+			return nil, false, err
+		}
+		if !retry {
+			return nil, true, nil
+		}
+		// 首个请求失败且记录已删除：重新竞争执行权
+	}
+}
+
+// replay 等待并回放首个请求的响应；首个请求失败且尚未写出任何内容时返回 retry=true
+func (s *IdempotencyService) replay(c *gin.Context, key, fingerprint string) (retry bool, err error) {
+	ctx := c.Request.Context()
+	var next int64
+	headerWritten := false
+	ticker := time.NewTicker(idempotencyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		record, err := s.cache.Get(ctx, key)
+		if err != nil {
+			if headerWritten {
+				return false, nil
+			}
+			return false, ErrIdempotencyInProgress
+		}
+		if record == nil {
+			// 首个请求失败或占位过期
+			return !headerWritten, nil
+		}
+		if record.Fingerprint != fingerprint {
+			return false, ErrIdempotencyKeyMismatch
+		}
+		if record.Truncated && !headerWritten {
+			return false, ErrIdempotencyUnavailable
+		}
+
+		// 首个请求的失败响应不回放：等待其删除记录后重新竞争执行权
+		if record.StatusCode > 0 && (headerWritten || idempotencyStatusReplayable(record.StatusCode)) {
+			if !headerWritten {
+				contentType := record.ContentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				c.Header("Content-Type", contentType)
+				c.Header(IdempotencyReplayedHeader, "true")
+				c.Status(record.StatusCode)
+				c.Writer.WriteHeaderNow()
+				headerWritten = true
+			}
+			for {
+				chunks, err := s.cache.GetChunks(ctx, key, next, idempotencyChunkPageSize)
+				if err != nil {
+					return false, nil
+				}
+				if len(chunks) == 0 {
+					break
+				}
+				for _, chunk := range chunks {
+					if _, err := c.Writer.Write(chunk); err != nil {
+						return false, nil
+					}
+				}
+				next += int64(len(chunks))
+				c.Writer.Flush()
+			}
+			if record.State == IdempotencyStateCompleted || record.Truncated {
+				// 完成前写入的分片已在上面全部读取（Complete 晚于最后一次 AppendChunk）
+				return false, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *IdempotencyService) lockTTL() time.Duration {
+	return time.Duration(s.cfg.LockTTLSeconds) * time.Second
+}
+
+func (s *IdempotencyService) resultTTL() time.Duration {
+	return time.Duration(s.cfg.TTLSeconds) * time.Second
+}
+
+// storeContext 记录操作不随客户端断开取消，保证断开后上游结果仍能保存供重试回放
+func storeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), idempotencyStoreTimeout)
+}
+
+// IdempotencyLease 首个请求的执行权
+type IdempotencyLease struct {
+	svc    *IdempotencyService
+	c      *gin.Context
+	key    string
+	writer *idempotencyCaptureWriter
+}
+
+// Finish 恢复 c.Writer；响应成功写出时标记完成以供回放，否则删除记录允许重试重新执行。
+// 2xx 响应已写出但记录写入失败时，上游已执行并计费，标记为不可回放而非删除，避免重试重复执行。
+func (l *IdempotencyLease) Finish() {
+	if l == nil {
+		return
+	}
+	l.c.Writer = l.writer.ResponseWriter
+	ctx, cancel := storeContext(l.c.Request.Context())
+	defer cancel()
+
+	status := l.writer.status
+	succeeded := status >= http.StatusOK && status < http.StatusMultipleChoices
+	if !l.writer.written || !idempotencyStatusReplayable(status) || (l.writer.failed && !succeeded) {
+		if err := l.svc.cache.Delete(ctx, l.key); err != nil {
+			log.Printf("[Idempotency] release failed: key=%s err=%v", l.key, err)
+		}
+		return
+	}
+	if l.writer.failed {
+		if err := l.svc.cache.MarkTruncated(ctx, l.key); err != nil {
+			log.Printf("[Idempotency] mark truncated failed: key=%s err=%v", l.key, err)
+		}
+	}
+	if err := l.svc.cache.Complete(ctx, l.key, l.svc.resultTTL()); err != nil {
+		log.Printf("[Idempotency] complete failed: key=%s err=%v", l.key, err)
+	}
+}
+
+// idempotencyStatusReplayable 可重试的失败（服务端错误、限流、超时）不保存，其余结果原样回放
+func idempotencyStatusReplayable(status int) bool {
+	return status < http.StatusInternalServerError && status != http.StatusTooManyRequests && status != http.StatusRequestTimeout
+}
+
+// idempotencyCaptureWriter 在透传写出的同时将响应写入幂等记录
+type idempotencyCaptureWriter struct {
+	gin.ResponseWriter
+	ctx      context.Context
+	svc      *IdempotencyService
+	key      string
+	limit    int
+	size     int
+	status   int
+	written  bool
+	failed   bool // 记录写入失败，结果不完整
+	overflow bool
+}
+
+func (w *idempotencyCaptureWriter) record(p []byte) {
+	if w.failed || w.overflow {
+		return
+	}
+	ctx, cancel := storeContext(w.ctx)
+	defer cancel()
+	if !w.written {
+		w.written = true
+		w.status = w.ResponseWriter.Status()
+		if err := w.svc.cache.SetResponse(ctx, w.key, w.status, w.Header().Get("Content-Type")); err != nil {
+			log.Printf("[Idempotency] record response failed: key=%s err=%v", w.key, err)
+			w.failed = true
+			return
+		}
+	}
+	if len(p) == 0 {
+		return
+	}
+	if w.limit > 0 && w.size+len(p) > w.limit {
+		w.overflow = true
+		if err := w.svc.cache.MarkTruncated(ctx, w.key); err != nil {
+			log.Printf("[Idempotency] mark truncated failed: key=%s err=%v", w.key, err)
+		}
+		return
+	}
+	w.size += len(p)
+	if err := w.svc.cache.AppendChunk(ctx, w.key, p, w.svc.lockTTL()); err != nil {
+		log.Printf("[Idempotency] append chunk failed: key=%s err=%v", w.key, err)
+		w.failed = true
+	}
+}
+
+func (w *idempotencyCaptureWriter) Write(p []byte) (int, error) {
+	w.record(p)
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *idempotencyCaptureWriter) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *idempotencyCaptureWriter) WriteHeaderNow() {
+	if !w.written {
+		w.record(nil)
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
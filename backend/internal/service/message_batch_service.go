@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// Message batch processing status
+const (
+	MessageBatchStatusInProgress = "in_progress"
+	MessageBatchStatusCanceling  = "canceling"
+	MessageBatchStatusEnded      = "ended"
+)
+
+// Message batch item status
+const (
+	MessageBatchItemPending    = "pending"
+	MessageBatchItemProcessing = "processing"
+	MessageBatchItemSucceeded  = "succeeded"
+	MessageBatchItemErrored    = "errored"
+	MessageBatchItemCanceled   = "canceled"
+	MessageBatchItemExpired    = "expired"
+)
+
+const (
+	messageBatchIDPrefix = "msgbatch_"
+	// messageBatchTTL 批次从创建起的最长处理时间，超时未执行的请求标记为 expired
+	messageBatchTTL = 24 * time.Hour
+
+	messageBatchListDefaultLimit = 20
+	messageBatchListMaxLimit     = 1000
+	// messageBatchResultsPageSize 导出结果时每次从数据库读取的请求数
+	messageBatchResultsPageSize = 200
+)
+
+var messageBatchCustomIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+var (
+	ErrMessageBatchNotFound    = infraerrors.NotFound("MESSAGE_BATCH_NOT_FOUND", "message batch not found")
+	ErrMessageBatchDisabled    = infraerrors.NotFound("MESSAGE_BATCH_DISABLED", "message batches are not enabled")
+	ErrMessageBatchNotEnded    = infraerrors.BadRequest("MESSAGE_BATCH_NOT_ENDED", "message batch results are not available until processing has ended")
+	ErrMessageBatchEnded       = infraerrors.BadRequest("MESSAGE_BATCH_ENDED", "message batch has already ended")
+	ErrMessageBatchUnsupported = infraerrors.BadRequest("MESSAGE_BATCH_UNSUPPORTED_GROUP", "message batches are only supported for anthropic and antigravity groups")
+	ErrInvalidBatchDiscount    = infraerrors.BadRequest("INVALID_BATCH_DISCOUNT_MULTIPLIER", "batch_discount_multiplier must be between 0 and 1")
+)
+
+// MessageBatch Message Batches 批次
+type MessageBatch struct {
+	ID                int64
+	BatchID           string
+	UserID            int64
+	APIKeyID          int64
+	GroupID           *int64
+	ProcessingStatus  string
+	RequestCount      int
+	ExpiresAt         time.Time
+	CancelInitiatedAt *time.Time
+	EndedAt           *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	// RequestCounts 按状态统计的请求数（查询时填充）
+	RequestCounts MessageBatchRequestCounts
+}
+
+// MessageBatchRequestCounts 批次内各状态的请求数；Processing 包含尚未开始的请求
+type MessageBatchRequestCounts struct {
+	Processing int `json:"processing"`
+	Succeeded  int `json:"succeeded"`
+	Errored    int `json:"errored"`
+	Canceled   int `json:"canceled"`
+	Expired    int `json:"expired"`
+}
+
+// Add 按请求状态累加计数
+func (c *MessageBatchRequestCounts) Add(status string, n int) {
+	switch status {
+	case MessageBatchItemPending, MessageBatchItemProcessing:
+		c.Processing += n
+	case MessageBatchItemSucceeded:
+		c.Succeeded += n
+	case MessageBatchItemErrored:
+		c.Errored += n
+	case MessageBatchItemCanceled:
+		c.Canceled += n
+	case MessageBatchItemExpired:
+		c.Expired += n
+	}
+}
+
+// MessageBatchItem 批次内的单条请求
+type MessageBatchItem struct {
+	ID            int64
+	BatchID       int64
+	CustomID      string
+	Params        string
+	Status        string
+	Result        *string
+	Attempts      int
+	NextAttemptAt time.Time
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+}
+
+// MessageBatchRequest 创建批次时的单条请求
+type MessageBatchRequest struct {
+	CustomID string          `json:"custom_id"`
+	Params   json.RawMessage `json:"params"`
+}
+
+// MessageBatchListParams 批次列表分页参数（按创建时间倒序，before_id/after_id 为游标）
+type MessageBatchListParams struct {
+	Limit    int
+	BeforeID string
+	AfterID  string
+}
+
+// MessageBatchPage 批次列表分页结果
+type MessageBatchPage struct {
+	Data    []MessageBatch
+	HasMore bool
+}
+
+// MessageBatchRepository 批次与批次内请求的持久化
+type MessageBatchRepository interface {
+	// Create 在同一事务内创建批次及其全部请求
+	Create(ctx context.Context, batch *MessageBatch, items []MessageBatchItem) error
+	GetByID(ctx context.Context, id int64) (*MessageBatch, error)
+	GetByBatchID(ctx context.Context, batchID string) (*MessageBatch, error)
+	// ListByUserID 按 id 倒序返回；beforeID/afterID 为内部 id 游标（0 表示不限）
+	ListByUserID(ctx context.Context, userID int64, beforeID, afterID int64, limit int) ([]MessageBatch, bool, error)
+	CountItemsByStatus(ctx context.Context, batchIDs []int64) (map[int64]MessageBatchRequestCounts, error)
+	// ListItems 按 id 升序返回 afterItemID 之后的请求
+	ListItems(ctx context.Context, batchID int64, afterItemID int64, limit int) ([]MessageBatchItem, error)
+
+	// Cancel 将批次置为 canceling 并取消尚未开始的请求
+	Cancel(ctx context.Context, id int64, now time.Time) error
+	// ClaimNext 抢占一条到期的 pending 请求并置为 processing；无可执行请求时返回 nil
+	ClaimNext(ctx context.Context, now time.Time) (*MessageBatchItem, error)
+	// Requeue 将 processing 请求放回队列；countAttempt 表示本次执行是否计入尝试次数
+	Requeue(ctx context.Context, itemID int64, nextAttemptAt time.Time, countAttempt bool) error
+	// Complete 记录 processing 请求的最终状态与结果
+	Complete(ctx context.Context, itemID int64, status string, result *string, now time.Time) error
+	// ExpireItems 将已过期批次中尚未开始的请求标记为 expired
+	ExpireItems(ctx context.Context, now time.Time) (int, error)
+	// RequeueStale 将 started_at 早于 before 的 processing 请求放回队列（worker 中断后恢复）
+	RequeueStale(ctx context.Context, before time.Time, now time.Time) (int, error)
+	// FinalizeEnded 将不再有 pending/processing 请求的批次置为 ended
+	FinalizeEnded(ctx context.Context, now time.Time) (int, error)
+}
+
+// MessageBatchService 提供 Message Batches API，并以后台 worker 池逐条执行批次内请求。
+//
+// 批处理请求优先级低于实时流量：worker 只在账号负载率不超过 idle_load_threshold 时
+// 占用其并发槽位，否则将请求延后重试；每条请求完成后立即按分组批处理折扣计费。
+type MessageBatchService struct {
+	repo                      MessageBatchRepository
+	apiKeyRepo                APIKeyRepository
+	gatewayService            *GatewayService
+	antigravityGatewayService *AntigravityGatewayService
+	concurrencyService        *ConcurrencyService
+	billingCacheService       *BillingCacheService
+	subscriptionService       *SubscriptionService
+	apiKeyService             *APIKeyService
+	cfg                       *config.Config
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewMessageBatchService creates a new MessageBatchService
+func NewMessageBatchService(
+	repo MessageBatchRepository,
+	apiKeyRepo APIKeyRepository,
+	gatewayService *GatewayService,
+	antigravityGatewayService *AntigravityGatewayService,
+	concurrencyService *ConcurrencyService,
+	billingCacheService *BillingCacheService,
+	subscriptionService *SubscriptionService,
+	apiKeyService *APIKeyService,
+	cfg *config.Config,
+) *MessageBatchService {
+	return &MessageBatchService{
+		repo:                      repo,
+		apiKeyRepo:                apiKeyRepo,
+		gatewayService:            gatewayService,
+		antigravityGatewayService: antigravityGatewayService,
+		concurrencyService:        concurrencyService,
+		billingCacheService:       billingCacheService,
+		subscriptionService:       subscriptionService,
+		apiKeyService:             apiKeyService,
+		cfg:                       cfg,
+		stopCh:                    make(chan struct{}),
+	}
+}
+
+// Enabled 是否启用 Message Batches
+func (s *MessageBatchService) Enabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.Gateway.Batch.Enabled
+}
+
+// Create 校验请求并创建批次
+func (s *MessageBatchService) Create(ctx context.Context, apiKey *APIKey, requests []MessageBatchRequest) (*MessageBatch, error) {
+	if !s.Enabled() {
+		return nil, ErrMessageBatchDisabled
+	}
+	if apiKey.Group != nil && apiKey.Group.Platform != PlatformAnthropic && apiKey.Group.Platform != PlatformAntigravity {
+		return nil, ErrMessageBatchUnsupported
+	}
+	if len(requests) == 0 {
+		return nil, messageBatchInvalidRequest("requests: must contain at least one request")
+	}
+	if maxRequests := s.cfg.Gateway.Batch.MaxRequestsPerBatch; len(requests) > maxRequests {
+		return nil, messageBatchInvalidRequest(fmt.Sprintf("requests: at most %d requests are allowed per batch", maxRequests))
+	}
+
+	now := time.Now()
+	seen := make(map[string]struct{}, len(requests))
+	items := make([]MessageBatchItem, 0, len(requests))
+	for i, req := range requests {
+		if !messageBatchCustomIDPattern.MatchString(req.CustomID) {
+			return nil, messageBatchInvalidRequest(fmt.Sprintf("requests.%d.custom_id: must be 1-64 characters of letters, digits, underscores or hyphens", i))
+		}
+		if _, dup := seen[req.CustomID]; dup {
+			return nil, messageBatchInvalidRequest(fmt.Sprintf("requests.%d.custom_id: duplicate custom_id %q", i, req.CustomID))
+		}
+		seen[req.CustomID] = struct{}{}
+		if err := validateMessageBatchParams(apiKey, req.Params); err != nil {
+			return nil, messageBatchInvalidRequest(fmt.Sprintf("requests.%d.params.%s", i, err.Error()))
+		}
+		items = append(items, MessageBatchItem{
+			CustomID:      req.CustomID,
+			Params:        string(req.Params),
+			Status:        MessageBatchItemPending,
+			NextAttemptAt: now,
+		})
+	}
+
+	batchID, err := newMessageBatchID()
+	if err != nil {
+		return nil, fmt.Errorf("generate batch id: %w", err)
+	}
+	batch := &MessageBatch{
+		BatchID:          batchID,
+		UserID:           apiKey.UserID,
+		APIKeyID:         apiKey.ID,
+		GroupID:          apiKey.GroupID,
+		ProcessingStatus: MessageBatchStatusInProgress,
+		RequestCount:     len(items),
+		ExpiresAt:        now.Add(messageBatchTTL),
+	}
+	if err := s.repo.Create(ctx, batch, items); err != nil {
+		return nil, fmt.Errorf("create message batch: %w", err)
+	}
+	batch.RequestCounts = MessageBatchRequestCounts{Processing: len(items)}
+	return batch, nil
+}
+
+// Get 获取用户的批次（含请求计数）
+func (s *MessageBatchService) Get(ctx context.Context, userID int64, batchID string) (*MessageBatch, error) {
+	batch, err := s.getOwned(ctx, userID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fillRequestCounts(ctx, []*MessageBatch{batch}); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// List 分页列出用户的批次
+func (s *MessageBatchService) List(ctx context.Context, userID int64, params MessageBatchListParams) (*MessageBatchPage, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = messageBatchListDefaultLimit
+	}
+	if limit > messageBatchListMaxLimit {
+		limit = messageBatchListMaxLimit
+	}
+
+	var beforeID, afterID int64
+	if params.BeforeID != "" {
+		cursor, err := s.getOwned(ctx, userID, params.BeforeID)
+		if err != nil {
+			return nil, err
+		}
+		beforeID = cursor.ID
+	}
+	if params.AfterID != "" {
+		cursor, err := s.getOwned(ctx, userID, params.AfterID)
+		if err != nil {
+			return nil, err
+		}
+		afterID = cursor.ID
+	}
+
+	batches, hasMore, err := s.repo.ListByUserID(ctx, userID, beforeID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list message batches: %w", err)
+	}
+	ptrs := make([]*MessageBatch, len(batches))
+	for i := range batches {
+		ptrs[i] = &batches[i]
+	}
+	if err := s.fillRequestCounts(ctx, ptrs); err != nil {
+		return nil, err
+	}
+	return &MessageBatchPage{Data: batches, HasMore: hasMore}, nil
+}
+
+// Cancel 取消批次：尚未开始的请求立即标记为 canceled，执行中的请求完成后批次进入 ended
+func (s *MessageBatchService) Cancel(ctx context.Context, userID int64, batchID string) (*MessageBatch, error) {
+	batch, err := s.getOwned(ctx, userID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.ProcessingStatus == MessageBatchStatusEnded {
+		return nil, ErrMessageBatchEnded
+	}
+	if batch.ProcessingStatus == MessageBatchStatusInProgress {
+		if err := s.repo.Cancel(ctx, batch.ID, time.Now()); err != nil {
+			return nil, fmt.Errorf("cancel message batch: %w", err)
+		}
+		if _, err := s.repo.FinalizeEnded(ctx, time.Now()); err != nil {
+			return nil, fmt.Errorf("finalize message batch: %w", err)
+		}
+	}
+	return s.Get(ctx, userID, batchID)
+}
+
+// WriteResults 逐行输出批次结果（JSONL），仅在批次 ended 后可用
+func (s *MessageBatchService) WriteResults(ctx context.Context, userID int64, batchID string, write func(line []byte) error) error {
+	batch, err := s.getOwned(ctx, userID, batchID)
+	if err != nil {
+		return err
+	}
+	if batch.ProcessingStatus != MessageBatchStatusEnded {
+		return ErrMessageBatchNotEnded
+	}
+
+	var afterID int64
+	for {
+		items, err := s.repo.ListItems(ctx, batch.ID, afterID, messageBatchResultsPageSize)
+		if err != nil {
+			return fmt.Errorf("list message batch items: %w", err)
+		}
+		for i := range items {
+			line, err := messageBatchResultLine(&items[i])
+			if err != nil {
+				return err
+			}
+			if err := write(line); err != nil {
+				return err
+			}
+			afterID = items[i].ID
+		}
+		if len(items) < messageBatchResultsPageSize {
+			return nil
+		}
+	}
+}
+
+func (s *MessageBatchService) getOwned(ctx context.Context, userID int64, batchID string) (*MessageBatch, error) {
+	if !s.Enabled() {
+		return nil, ErrMessageBatchDisabled
+	}
+	if !strings.HasPrefix(batchID, messageBatchIDPrefix) {
+		return nil, ErrMessageBatchNotFound
+	}
+	batch, err := s.repo.GetByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.UserID != userID {
+		return nil, ErrMessageBatchNotFound
+	}
+	return batch, nil
+}
+
+func (s *MessageBatchService) fillRequestCounts(ctx context.Context, batches []*MessageBatch) error {
+	if len(batches) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(batches))
+	for i, b := range batches {
+		ids[i] = b.ID
+	}
+	counts, err := s.repo.CountItemsByStatus(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("count message batch items: %w", err)
+	}
+	for _, b := range batches {
+		b.RequestCounts = counts[b.ID]
+	}
+	return nil
+}
+
+// validateMessageBatchParams 校验单条请求的 Messages 参数（批处理不支持流式）
+func validateMessageBatchParams(apiKey *APIKey, params json.RawMessage) error {
+	var p struct {
+		Model     string          `json:"model"`
+		MaxTokens *int            `json:"max_tokens"`
+		Messages  json.RawMessage `json:"messages"`
+		Stream    bool            `json:"stream"`
+	}
+	if len(params) == 0 {
+		return fmt.Errorf("params: field required")
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return fmt.Errorf("params: must be a JSON object")
+	}
+	if strings.TrimSpace(p.Model) == "" {
+		return fmt.Errorf("model: field required")
+	}
+	if p.MaxTokens == nil || *p.MaxTokens <= 0 {
+		return fmt.Errorf("max_tokens: must be a positive integer")
+	}
+	var messages []json.RawMessage
+	if err := json.Unmarshal(p.Messages, &messages); err != nil || len(messages) == 0 {
+		return fmt.Errorf("messages: must be a non-empty array")
+	}
+	if p.Stream {
+		return fmt.Errorf("stream: streaming is not supported in message batches")
+	}
+	if !apiKey.IsModelAllowed(p.Model) {
+		return fmt.Errorf("model: %s is not allowed for this API key", p.Model)
+	}
+	return nil
+}
+
+func validateBatchDiscountMultiplier(v float64) error {
+	if v < 0 || v > 1 {
+		return ErrInvalidBatchDiscount
+	}
+	return nil
+}
+
+func messageBatchInvalidRequest(message string) error {
+	return infraerrors.BadRequest("MESSAGE_BATCH_INVALID_REQUEST", message)
+}
+
+func newMessageBatchID() (string, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return messageBatchIDPrefix + hex.EncodeToString(buf), nil
+}
+
+// messageBatchResultLine 生成结果文件中的一行：{"custom_id":...,"result":{...}}
+func messageBatchResultLine(item *MessageBatchItem) ([]byte, error) {
+	var result json.RawMessage
+	switch {
+	case item.Result != nil && *item.Result != "":
+		result = json.RawMessage(*item.Result)
+	case item.Status == MessageBatchItemCanceled || item.Status == MessageBatchItemExpired:
+		result = json.RawMessage(`{"type":"` + item.Status + `"}`)
+	default:
+		result = messageBatchErroredResult("api_error", "request did not complete")
+	}
+	return json.Marshal(struct {
+		CustomID string          `json:"custom_id"`
+		Result   json.RawMessage `json:"result"`
+	}{CustomID: item.CustomID, Result: result})
+}
+
+// messageBatchErroredResult 构造 errored 结果（error 字段与 Messages API 错误响应一致）
+func messageBatchErroredResult(errType, message string) json.RawMessage {
+	b, _ := json.Marshal(map[string]any{
+		"type": MessageBatchItemErrored,
+		"error": map[string]any{
+			"type":  "error",
+			"error": map[string]any{"type": errType, "message": message},
+		},
+	})
+	return b
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type messageBatchRepoStub struct {
+	MessageBatchRepository
+
+	batch    *MessageBatch
+	items    []MessageBatchItem
+	requeued []bool
+	complete []string
+	results  []*string
+}
+
+func (r *messageBatchRepoStub) Create(_ context.Context, batch *MessageBatch, items []MessageBatchItem) error {
+	batch.ID = 1
+	r.batch, r.items = batch, items
+	return nil
+}
+
+func (r *messageBatchRepoStub) GetByID(context.Context, int64) (*MessageBatch, error) {
+	return r.batch, nil
+}
+
+func (r *messageBatchRepoStub) GetByBatchID(_ context.Context, batchID string) (*MessageBatch, error) {
+	if r.batch == nil || r.batch.BatchID != batchID {
+		return nil, ErrMessageBatchNotFound
+	}
+	return r.batch, nil
+}
+
+func (r *messageBatchRepoStub) Requeue(_ context.Context, _ int64, _ time.Time, countAttempt bool) error {
+	r.requeued = append(r.requeued, countAttempt)
+	return nil
+}
+
+func (r *messageBatchRepoStub) Complete(_ context.Context, _ int64, status string, result *string, _ time.Time) error {
+	r.complete = append(r.complete, status)
+	r.results = append(r.results, result)
+	return nil
+}
+
+func newTestMessageBatchService(repo MessageBatchRepository) *MessageBatchService {
+	return NewMessageBatchService(repo, nil, nil, nil, nil, nil, nil, nil, &config.Config{
+		Gateway: config.GatewayConfig{Batch: config.GatewayBatchConfig{
+			Enabled:             true,
+			MaxRequestsPerBatch: 3,
+			MaxAttempts:         2,
+			PollInterval:        time.Second,
+			RetryDelay:          time.Second,
+			RequestTimeout:      time.Second,
+		}},
+	})
+}
+
+func batchRequest(customID, params string) MessageBatchRequest {
+	return MessageBatchRequest{CustomID: customID, Params: json.RawMessage(params)}
+}
+
+const validBatchParams = `{"model":"claude-sonnet-4-5","max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`
+
+func TestMessageBatchService_CreateValidation(t *testing.T) {
+	repo := &messageBatchRepoStub{}
+	svc := newTestMessageBatchService(repo)
+	apiKey := &APIKey{ID: 5, UserID: 9, AllowedModels: []string{"claude-*"}}
+
+	cases := []struct {
+		requests []MessageBatchRequest
+		want     string
+	}{
+		{nil, "at least one request"},
+		{[]MessageBatchRequest{batchRequest("a", validBatchParams), batchRequest("b", validBatchParams), batchRequest("c", validBatchParams), batchRequest("d", validBatchParams)}, "at most 3 requests"},
+		{[]MessageBatchRequest{batchRequest("bad id", validBatchParams)}, "requests.0.custom_id"},
+		{[]MessageBatchRequest{batchRequest("a", validBatchParams), batchRequest("a", validBatchParams)}, `duplicate custom_id "a"`},
+		{[]MessageBatchRequest{batchRequest("a", `{"max_tokens":16,"messages":[{}]}`)}, "requests.0.params.model"},
+		{[]MessageBatchRequest{batchRequest("a", `{"model":"claude-sonnet-4-5","messages":[{}]}`)}, "params.max_tokens"},
+		{[]MessageBatchRequest{batchRequest("a", `{"model":"claude-sonnet-4-5","max_tokens":1,"messages":[]}`)}, "params.messages"},
+		{[]MessageBatchRequest{batchRequest("a", `{"model":"claude-sonnet-4-5","max_tokens":1,"messages":[{}],"stream":true}`)}, "params.stream"},
+		{[]MessageBatchRequest{batchRequest("a", `{"model":"gpt-4o","max_tokens":1,"messages":[{}]}`)}, "not allowed for this API key"},
+	}
+	for _, tc := range cases {
+		_, err := svc.Create(context.Background(), apiKey, tc.requests)
+		require.Error(t, err, tc.want)
+		require.Equal(t, http.StatusBadRequest, infraerrors.Code(err), tc.want)
+		require.Contains(t, infraerrors.Message(err), tc.want)
+	}
+
+	_, err := svc.Create(context.Background(), &APIKey{Group: &Group{Platform: PlatformOpenAI}}, []MessageBatchRequest{batchRequest("a", validBatchParams)})
+	require.ErrorIs(t, err, ErrMessageBatchUnsupported)
+
+	batch, err := svc.Create(context.Background(), apiKey, []MessageBatchRequest{batchRequest("a", validBatchParams), batchRequest("b-2", validBatchParams)})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(batch.BatchID, messageBatchIDPrefix))
+	require.Equal(t, int64(9), batch.UserID)
+	require.Equal(t, MessageBatchStatusInProgress, batch.ProcessingStatus)
+	require.Equal(t, 2, batch.RequestCounts.Processing)
+	require.Len(t, repo.items, 2)
+	require.Equal(t, MessageBatchItemPending, repo.items[1].Status)
+}
+
+func TestMessageBatchService_OwnershipAndResultsAvailability(t *testing.T) {
+	repo := &messageBatchRepoStub{batch: &MessageBatch{ID: 1, BatchID: "msgbatch_x", UserID: 1, ProcessingStatus: MessageBatchStatusInProgress}}
+	svc := newTestMessageBatchService(repo)
+
+	_, err := svc.getOwned(context.Background(), 2, "msgbatch_x")
+	require.ErrorIs(t, err, ErrMessageBatchNotFound)
+	_, err = svc.getOwned(context.Background(), 1, "batch_x")
+	require.ErrorIs(t, err, ErrMessageBatchNotFound)
+
+	err = svc.WriteResults(context.Background(), 1, "msgbatch_x", func([]byte) error { return nil })
+	require.ErrorIs(t, err, ErrMessageBatchNotEnded)
+
+	svc.cfg.Gateway.Batch.Enabled = false
+	_, err = svc.getOwned(context.Background(), 1, "msgbatch_x")
+	require.ErrorIs(t, err, ErrMessageBatchDisabled)
+}
+
+func TestMessageBatchService_ProcessItemSkipsCanceledAndExpired(t *testing.T) {
+	repo := &messageBatchRepoStub{batch: &MessageBatch{ProcessingStatus: MessageBatchStatusCanceling, ExpiresAt: time.Now().Add(time.Hour)}}
+	svc := newTestMessageBatchService(repo)
+	require.True(t, svc.processItem(context.Background(), &MessageBatchItem{ID: 1}))
+
+	repo.batch = &MessageBatch{ProcessingStatus: MessageBatchStatusInProgress, ExpiresAt: time.Now().Add(-time.Minute)}
+	require.True(t, svc.processItem(context.Background(), &MessageBatchItem{ID: 2}))
+
+	require.Equal(t, []string{MessageBatchItemCanceled, MessageBatchItemExpired}, repo.complete)
+	require.Nil(t, repo.results[0])
+}
+
+func TestMessageBatchUpstreamError(t *testing.T) {
+	outcome := messageBatchUpstreamError(http.StatusBadRequest, []byte(`{"type":"error","error":{"type":"invalid_request_error","message":"bad"}}`))
+	require.Equal(t, MessageBatchItemErrored, outcome.status)
+	require.JSONEq(t, `{"type":"errored","error":{"type":"error","error":{"type":"invalid_request_error","message":"bad"}}}`, string(outcome.result))
+
+	outcome = messageBatchUpstreamError(http.StatusTooManyRequests, nil)
+	require.Empty(t, outcome.status)
+	require.True(t, outcome.countAttempt)
+	require.Contains(t, string(outcome.result), "Too Many Requests")
+}
+
+func TestMessageBatchResultLine(t *testing.T) {
+	succeeded := `{"type":"succeeded","message":{"id":"msg_1"}}`
+	line, err := messageBatchResultLine(&MessageBatchItem{CustomID: "a", Status: MessageBatchItemSucceeded, Result: &succeeded})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"custom_id":"a","result":{"type":"succeeded","message":{"id":"msg_1"}}}`, string(line))
+
+	line, err = messageBatchResultLine(&MessageBatchItem{CustomID: "b", Status: MessageBatchItemCanceled})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"custom_id":"b","result":{"type":"canceled"}}`, string(line))
+}
+
+func TestValidateBatchDiscountMultiplier(t *testing.T) {
+	require.NoError(t, validateBatchDiscountMultiplier(0.5))
+	require.ErrorIs(t, validateBatchDiscountMultiplier(1.5), ErrInvalidBatchDiscount)
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+)
+
+const (
+	// messageBatchMaxResponseBytes 单条请求响应体的缓冲上限
+	messageBatchMaxResponseBytes   = 32 << 20
+	messageBatchMaxAccountSwitches = 3
+	messageBatchUsageTimeout       = 10 * time.Second
+)
+
+// messageBatchOutcome 单条请求的执行结果
+type messageBatchOutcome struct {
+	// status 为 succeeded/errored；为空表示延后重试
+	status string
+	result json.RawMessage
+	// countAttempt 延后重试是否计入尝试次数（账号繁忙不计入，上游错误计入）
+	countAttempt bool
+}
+
+func messageBatchDeferred(countAttempt bool, result json.RawMessage) messageBatchOutcome {
+	return messageBatchOutcome{countAttempt: countAttempt, result: result}
+}
+
+func messageBatchErrored(errType, message string) messageBatchOutcome {
+	return messageBatchOutcome{status: MessageBatchItemErrored, result: messageBatchErroredResult(errType, message)}
+}
+
+// Start 启动 worker 池与维护任务（过期、恢复中断请求、结束批次）
+func (s *MessageBatchService) Start() {
+	if !s.Enabled() || s.repo == nil || s.gatewayService == nil {
+		return
+	}
+	for i := 0; i < s.cfg.Gateway.Batch.Workers; i++ {
+		s.wg.Add(1)
+		go s.workerLoop()
+	}
+	s.wg.Add(1)
+	go s.maintenanceLoop()
+}
+
+// Stop 停止 worker；执行中的请求被中断后放回队列
+func (s *MessageBatchService) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *MessageBatchService) workerLoop() {
+	defer s.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+		if s.runOnce(ctx) {
+			continue
+		}
+		select {
+		case <-s.stopCh:
+			return
+		case <-time.After(s.cfg.Gateway.Batch.PollInterval):
+		}
+	}
+}
+
+func (s *MessageBatchService) maintenanceLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.cfg.Gateway.Batch.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.runMaintenance()
+		}
+	}
+}
+
+func (s *MessageBatchService) runMaintenance() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	now := time.Now()
+
+	if n, err := s.repo.ExpireItems(ctx, now); err != nil {
+		log.Printf("[MessageBatch] expire items failed: %v", err)
+	} else if n > 0 {
+		log.Printf("[MessageBatch] expired %d requests", n)
+	}
+	if n, err := s.repo.RequeueStale(ctx, now.Add(-s.cfg.Gateway.Batch.ProcessingTimeout), now); err != nil {
+		log.Printf("[MessageBatch] requeue stale items failed: %v", err)
+	} else if n > 0 {
+		log.Printf("[MessageBatch] requeued %d stale requests", n)
+	}
+	if _, err := s.repo.FinalizeEnded(ctx, now); err != nil {
+		log.Printf("[MessageBatch] finalize batches failed: %v", err)
+	}
+}
+
+// runOnce 抢占并执行一条请求；返回 true 表示应立即继续抢占下一条
+func (s *MessageBatchService) runOnce(ctx context.Context) bool {
+	item, err := s.repo.ClaimNext(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[MessageBatch] claim item failed: %v", err)
+		}
+		return false
+	}
+	if item == nil {
+		return false
+	}
+	return s.processItem(ctx, item)
+}
+
+func (s *MessageBatchService) processItem(ctx context.Context, item *MessageBatchItem) bool {
+	batch, err := s.repo.GetByID(ctx, item.BatchID)
+	if err != nil {
+		log.Printf("[MessageBatch] load batch %d failed: %v", item.BatchID, err)
+		s.requeue(item, s.cfg.Gateway.Batch.RetryDelay, false)
+		return false
+	}
+
+	var outcome messageBatchOutcome
+	switch {
+	case batch.ProcessingStatus != MessageBatchStatusInProgress:
+		outcome = messageBatchOutcome{status: MessageBatchItemCanceled}
+	case time.Now().After(batch.ExpiresAt):
+		outcome = messageBatchOutcome{status: MessageBatchItemExpired}
+	default:
+		reqCtx, cancel := context.WithTimeout(ctx, s.cfg.Gateway.Batch.RequestTimeout)
+		outcome = s.execute(reqCtx, batch, item)
+		cancel()
+		// worker 停止导致中断：放回队列，不计入尝试次数
+		if ctx.Err() != nil {
+			outcome = messageBatchDeferred(false, nil)
+		}
+	}
+
+	if outcome.status == "" {
+		if !outcome.countAttempt {
+			s.requeue(item, s.cfg.Gateway.Batch.PollInterval, false)
+			return false
+		}
+		if item.Attempts+1 < s.cfg.Gateway.Batch.MaxAttempts {
+			s.requeue(item, s.cfg.Gateway.Batch.RetryDelay, true)
+			return true
+		}
+		// 尝试次数用尽：以最后一次错误作为结果
+		outcome.status = MessageBatchItemErrored
+		if outcome.result == nil {
+			outcome.result = messageBatchErroredResult("api_error", "request failed after maximum attempts")
+		}
+	}
+
+	var result *string
+	if outcome.result != nil {
+		str := string(outcome.result)
+		result = &str
+	}
+	completeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.repo.Complete(completeCtx, item.ID, outcome.status, result, time.Now()); err != nil {
+		log.Printf("[MessageBatch] complete item %d failed: %v", item.ID, err)
+	}
+	return true
+}
+
+func (s *MessageBatchService) requeue(item *MessageBatchItem, delay time.Duration, countAttempt bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.repo.Requeue(ctx, item.ID, time.Now().Add(delay), countAttempt); err != nil {
+		log.Printf("[MessageBatch] requeue item %d failed: %v", item.ID, err)
+	}
+}
+
+// execute 以批次所属 API Key 的身份执行一条 Messages 请求
+func (s *MessageBatchService) execute(ctx context.Context, batch *MessageBatch, item *MessageBatchItem) messageBatchOutcome {
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, batch.APIKeyID)
+	if err != nil {
+		if errors.Is(err, ErrAPIKeyNotFound) {
+			return messageBatchErrored("authentication_error", "API key not found")
+		}
+		log.Printf("[MessageBatch] load api key %d failed: %v", batch.APIKeyID, err)
+		return messageBatchDeferred(false, nil)
+	}
+	switch {
+	case !apiKey.IsActive() || apiKey.IsExpired():
+		return messageBatchErrored("authentication_error", "API key is disabled or expired")
+	case apiKey.IsQuotaExhausted():
+		return messageBatchErrored("billing_error", "API key quota exhausted")
+	case apiKey.User == nil || !apiKey.User.IsActive():
+		return messageBatchErrored("authentication_error", "User account is not active")
+	}
+
+	body := []byte(item.Params)
+	parsed, err := ParseGatewayRequest(body, domain.PlatformAnthropic)
+	if err != nil {
+		return messageBatchErrored("invalid_request_error", "Failed to parse request body")
+	}
+	if !apiKey.IsModelAllowed(parsed.Model) {
+		return messageBatchErrored("invalid_request_error", "model "+parsed.Model+" is not allowed for this API key")
+	}
+
+	subscription, failed := s.checkEligibility(ctx, apiKey)
+	if failed != nil {
+		return *failed
+	}
+
+	failedAccountIDs := make(map[int64]struct{})
+	var lastFailoverErr *UpstreamFailoverError
+	for switches := 0; switches <= messageBatchMaxAccountSwitches; switches++ {
+		selection, err := s.gatewayService.SelectAccountWithLoadAwareness(ctx, apiKey.GroupID, "", parsed.Model, failedAccountIDs, "")
+		if err != nil {
+			break
+		}
+		account := selection.Account
+		if !selection.Acquired || !s.accountIdle(ctx, account) {
+			if selection.Acquired && selection.ReleaseFunc != nil {
+				selection.ReleaseFunc()
+			}
+			return messageBatchDeferred(false, nil)
+		}
+
+		result, status, respBody, err := s.forward(ctx, account, parsed, body)
+		if selection.ReleaseFunc != nil {
+			selection.ReleaseFunc()
+		}
+		if err != nil {
+			var failoverErr *UpstreamFailoverError
+			if errors.As(err, &failoverErr) {
+				lastFailoverErr = failoverErr
+				if failoverErr.RetryableOnSameAccount {
+					s.gatewayService.TempUnscheduleRetryableError(ctx, account.ID, failoverErr)
+				}
+				failedAccountIDs[account.ID] = struct{}{}
+				continue
+			}
+			if ctx.Err() != nil {
+				return messageBatchDeferred(true, messageBatchErroredResult("api_error", "request timed out"))
+			}
+			if status < http.StatusBadRequest {
+				status = http.StatusBadGateway
+			}
+			return messageBatchUpstreamError(status, respBody)
+		}
+		if status >= http.StatusBadRequest {
+			return messageBatchUpstreamError(status, respBody)
+		}
+		if result == nil || !json.Valid(respBody) {
+			return messageBatchErrored("api_error", "upstream response is too large or malformed")
+		}
+
+		s.recordUsage(apiKey, subscription, account, result)
+		out, _ := json.Marshal(map[string]any{
+			"type":    MessageBatchItemSucceeded,
+			"message": json.RawMessage(respBody),
+		})
+		return messageBatchOutcome{status: MessageBatchItemSucceeded, result: out}
+	}
+
+	if lastFailoverErr != nil {
+		outcome := messageBatchUpstreamError(lastFailoverErr.StatusCode, lastFailoverErr.ResponseBody)
+		if outcome.status == MessageBatchItemErrored {
+			outcome = messageBatchDeferred(true, outcome.result)
+		}
+		return outcome
+	}
+	// 没有可调度账号：等待账号恢复，直到批次过期
+	return messageBatchDeferred(false, nil)
+}
+
+// checkEligibility 执行前复核订阅与余额（与 API Key 认证中间件一致）
+func (s *MessageBatchService) checkEligibility(ctx context.Context, apiKey *APIKey) (*UserSubscription, *messageBatchOutcome) {
+	if s.cfg.RunMode == config.RunModeSimple {
+		return nil, nil
+	}
+	var subscription *UserSubscription
+	if apiKey.Group != nil && apiKey.Group.IsSubscriptionType() && s.subscriptionService != nil {
+		sub, err := s.subscriptionService.GetActiveSubscription(ctx, apiKey.User.ID, apiKey.Group.ID)
+		if err != nil {
+			outcome := messageBatchErrored("permission_error", "No active subscription found for this group")
+			return nil, &outcome
+		}
+		if err := s.subscriptionService.ValidateSubscription(ctx, sub); err != nil {
+			outcome := messageBatchErrored("permission_error", err.Error())
+			return nil, &outcome
+		}
+		if err := s.subscriptionService.CheckAndActivateWindow(ctx, sub); err != nil {
+			log.Printf("[MessageBatch] activate subscription windows failed: %v", err)
+		}
+		if err := s.subscriptionService.CheckAndResetWindows(ctx, sub); err != nil {
+			log.Printf("[MessageBatch] reset subscription windows failed: %v", err)
+		}
+		if err := s.subscriptionService.CheckUsageLimits(ctx, sub, apiKey.Group, 0); err != nil {
+			outcome := messageBatchErrored("billing_error", err.Error())
+			return nil, &outcome
+		}
+		subscription = sub
+	}
+	if s.billingCacheService != nil {
+		if err := s.billingCacheService.CheckBillingEligibility(ctx, apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
+			if errors.Is(err, ErrBillingServiceUnavailable) {
+				outcome := messageBatchDeferred(false, nil)
+				return nil, &outcome
+			}
+			outcome := messageBatchErrored("billing_error", err.Error())
+			return nil, &outcome
+		}
+	}
+	return subscription, nil
+}
+
+// accountIdle 判断账号是否有空闲容量：不计入本次占用的槽位，存在排队请求时视为繁忙
+func (s *MessageBatchService) accountIdle(ctx context.Context, account *Account) bool {
+	if s.concurrencyService == nil || account.Concurrency <= 0 {
+		return true
+	}
+	loads, err := s.concurrencyService.GetAccountsLoadBatch(ctx, []AccountWithConcurrency{{ID: account.ID, MaxConcurrency: account.Concurrency}})
+	if err != nil {
+		return true
+	}
+	info := loads[account.ID]
+	if info == nil {
+		return true
+	}
+	if info.WaitingCount > 0 {
+		return false
+	}
+	others := max(info.CurrentConcurrency-1, 0)
+	return others*100/account.Concurrency <= s.cfg.Gateway.Batch.IdleLoadThreshold
+}
+
+// forward 通过合成的 gin.Context 复用网关转发逻辑，返回响应状态码与响应体
+func (s *MessageBatchService) forward(ctx context.Context, account *Account, parsed *ParsedRequest, body []byte) (*ForwardResult, int, []byte, error) {
+	w := newLimitedResponseWriter(messageBatchMaxResponseBytes)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("anthropic-version", "2023-06-01")
+	c := newBackgroundGinContext(req, w, nil)
+
+	var result *ForwardResult
+	if account.Platform == PlatformAntigravity && account.Type != AccountTypeAPIKey && s.antigravityGatewayService != nil {
+		result, err = s.antigravityGatewayService.Forward(ctx, c, account, body, false)
+	} else {
+		result, err = s.gatewayService.Forward(ctx, c, account, parsed)
+	}
+	if w.truncated() {
+		return result, c.Writer.Status(), nil, err
+	}
+	return result, c.Writer.Status(), w.bodyBytes(), err
+}
+
+func (s *MessageBatchService) recordUsage(apiKey *APIKey, subscription *UserSubscription, account *Account, result *ForwardResult) {
+	result.RequestType = UsageRequestTypeBatch
+	ctx, cancel := context.WithTimeout(context.Background(), messageBatchUsageTimeout)
+	defer cancel()
+	input := &RecordUsageInput{
+		Result:       result,
+		APIKey:       apiKey,
+		User:         apiKey.User,
+		Account:      account,
+		Subscription: subscription,
+	}
+	if s.apiKeyService != nil {
+		input.APIKeyService = s.apiKeyService
+	}
+	if err := s.gatewayService.RecordUsage(ctx, input); err != nil {
+		log.Printf("[MessageBatch] record usage failed: %v", err)
+	}
+}
+
+// messageBatchUpstreamError 将上游错误响应转换为结果；429/5xx 视为暂时性错误，延后重试
+func messageBatchUpstreamError(status int, body []byte) messageBatchOutcome {
+	var parsed struct {
+		Type  string `json:"type"`
+		Error struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	errType, message := "api_error", http.StatusText(status)
+	if json.Unmarshal(body, &parsed) == nil && parsed.Error.Type != "" {
+		errType, message = parsed.Error.Type, parsed.Error.Message
+	}
+	result := messageBatchErroredResult(errType, message)
+	if status == http.StatusTooManyRequests || status >= http.StatusInternalServerError {
+		return messageBatchDeferred(true, result)
+	}
+	return messageBatchOutcome{status: MessageBatchItemErrored, result: result}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Azure OpenAI 账号转发
+//
+// Azure OpenAI 与 OpenAI Platform API 的差异：
+//   - 端点为资源域名（https://{resource}.openai.azure.com），请求需携带 api-version 查询参数（"v1" 表示使用 /openai/v1 GA 接口）；
+//   - 使用 api-key 请求头认证；
+//   - 请求体中的 model 为部署名（credentials.deployment_mapping 将模型映射到部署），向量接口的部署名在 URL 路径中；
+//   - 内容过滤错误（code=content_filter）的 type 为 null，命中类别位于 innererror.content_filter_result。
+//
+// 本文件负责 URL 构建与错误体规范化，使错误透传规则与临时不可调度规则可以按消息与关键词匹配 Azure 错误。
+
+const (
+	azureOpenAIDefaultAPIVersion = "2025-04-01-preview"
+	azureAPIKeyHeader            = "api-key"
+	azureRequestIDHeader         = "apim-request-id"
+)
+
+// azureOpenAIURL 返回 Azure OpenAI 操作 URL（operation 为 responses 或 embeddings）
+func azureOpenAIURL(baseURL, apiVersion, deployment, operation string) string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if apiVersion == "v1" {
+		return baseURL + "/openai/v1/" + operation
+	}
+	query := "?api-version=" + url.QueryEscape(apiVersion)
+	if operation == "responses" {
+		return baseURL + "/openai/responses" + query
+	}
+	return baseURL + "/openai/deployments/" + url.PathEscape(deployment) + "/" + operation + query
+}
+
+// azureTargetURL 校验账号端点并返回操作 URL
+func (s *OpenAIGatewayService) azureTargetURL(account *Account, deployment, operation string) (string, error) {
+	endpoint := account.GetAzureEndpoint()
+	if endpoint == "" {
+		return "", errors.New("azure_endpoint not found in credentials")
+	}
+	validatedURL, err := s.validateUpstreamBaseURL(endpoint)
+	if err != nil {
+		return "", err
+	}
+	return azureOpenAIURL(validatedURL, account.GetAzureAPIVersion(), deployment, operation), nil
+}
+
+// adaptAzureResponse 补齐 x-request-id 并规范化错误响应体
+func adaptAzureResponse(resp *http.Response) {
+	if resp.Header.Get("x-request-id") == "" {
+		if requestID := resp.Header.Get(azureRequestIDHeader); requestID != "" {
+			resp.Header.Set("x-request-id", requestID)
+		}
+	}
+	if resp.StatusCode < http.StatusBadRequest {
+		return
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+	_ = resp.Body.Close()
+	body = normalizeAzureErrorBody(body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+}
+
+// normalizeAzureErrorBody 将 Azure 错误体规范化为 OpenAI 错误格式：
+//   - APIM 网关错误 {"statusCode":401,"message":"..."} 包装为 {"error":{...}}；
+//   - 内容过滤错误在 message 末尾附加命中的类别，type 为空时补为 invalid_request_error。
+func normalizeAzureErrorBody(body []byte) []byte {
+	root := gjson.ParseBytes(body)
+	errObj := root.Get("error")
+	if !errObj.Exists() {
+		msg := strings.TrimSpace(root.Get("message").String())
+		if msg == "" {
+			return body
+		}
+		out, err := json.Marshal(map[string]any{
+			"error": map[string]any{
+				"message": msg,
+				"type":    "upstream_error",
+				"code":    root.Get("statusCode").String(),
+			},
+		})
+		if err != nil {
+			return body
+		}
+		return out
+	}
+	if errObj.Get("code").String() != "content_filter" && errObj.Get("innererror.code").String() != "ResponsibleAIPolicyViolation" {
+		return body
+	}
+
+	out := body
+	if categories := azureFilteredCategories(errObj); len(categories) > 0 {
+		msg := strings.TrimSpace(errObj.Get("message").String())
+		msg += " (filtered categories: " + strings.Join(categories, ", ") + ")"
+		if updated, err := sjson.SetBytes(out, "error.message", strings.TrimSpace(msg)); err == nil {
+			out = updated
+		}
+	}
+	if errType := errObj.Get("type"); errType.Type == gjson.Null || strings.TrimSpace(errType.String()) == "" {
+		if updated, err := sjson.SetBytes(out, "error.type", "invalid_request_error"); err == nil {
+			out = updated
+		}
+	}
+	return out
+}
+
+// azureFilteredCategories 返回内容过滤命中的类别（如 hate(high)、jailbreak）
+func azureFilteredCategories(errObj gjson.Result) []string {
+	var categories []string
+	collect := func(results gjson.Result) {
+		results.ForEach(func(name, result gjson.Result) bool {
+			if !result.Get("filtered").Bool() {
+				return true
+			}
+			category := name.String()
+			if severity := result.Get("severity").String(); severity != "" && severity != "safe" {
+				category += "(" + severity + ")"
+			}
+			categories = append(categories, category)
+			return true
+		})
+	}
+	collect(errObj.Get("innererror.content_filter_result"))
+	errObj.Get("content_filters").ForEach(func(_, filter gjson.Result) bool {
+		collect(filter.Get("content_filter_results"))
+		return true
+	})
+	return categories
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func newAzureTestAccount(credentials map[string]any) *Account {
+	creds := map[string]any{
+		"api_key":        "azure-key",
+		"azure_endpoint": "https://contoso.openai.azure.com/openai/",
+	}
+	for k, v := range credentials {
+		creds[k] = v
+	}
+	return &Account{ID: 3, Platform: PlatformOpenAI, Type: AccountTypeAzure, Credentials: creds}
+}
+
+func TestAzureOpenAIURL(t *testing.T) {
+	account := newAzureTestAccount(nil)
+	require.Equal(t, "https://contoso.openai.azure.com", account.GetAzureEndpoint())
+	require.Equal(t,
+		"https://contoso.openai.azure.com/openai/responses?api-version="+azureOpenAIDefaultAPIVersion,
+		azureOpenAIURL(account.GetAzureEndpoint(), account.GetAzureAPIVersion(), "gpt-4o-prod", "responses"))
+	require.Equal(t,
+		"https://contoso.openai.azure.com/openai/deployments/embed-large/embeddings?api-version=2024-10-21",
+		azureOpenAIURL(account.GetAzureEndpoint(), "2024-10-21", "embed-large", "embeddings"))
+	require.Equal(t,
+		"https://contoso.openai.azure.com/openai/v1/responses",
+		azureOpenAIURL(account.GetAzureEndpoint(), "v1", "gpt-4o-prod", "responses"))
+}
+
+func TestAccountAzureDeployment(t *testing.T) {
+	account := newAzureTestAccount(map[string]any{
+		"deployment_mapping": map[string]any{"gpt-4o": "gpt-4o-prod", "gpt-5*": "gpt5-shared"},
+	})
+	require.Equal(t, "gpt-4o-prod", account.GetAzureDeployment("gpt-4o"))
+	require.Equal(t, "gpt5-shared", account.GetAzureDeployment("gpt-5-mini"))
+	require.True(t, account.IsModelSupported("gpt-5"))
+	require.False(t, account.IsModelSupported("o3"))
+
+	// 未配置部署映射时部署名即模型名
+	account = newAzureTestAccount(nil)
+	require.Equal(t, "o3", account.GetAzureDeployment("o3"))
+	require.True(t, account.IsModelSupported("o3"))
+}
+
+func TestNormalizeAzureErrorBody(t *testing.T) {
+	body := []byte(`{"error":{"message":"The response was filtered due to the prompt triggering Azure OpenAI's content management policy.","type":null,"param":"prompt","code":"content_filter","status":400,"innererror":{"code":"ResponsibleAIPolicyViolation","content_filter_result":{"hate":{"filtered":true,"severity":"high"},"jailbreak":{"filtered":true,"detected":true},"sexual":{"filtered":false,"severity":"safe"}}}}}`)
+	out := normalizeAzureErrorBody(body)
+	require.Equal(t, "invalid_request_error", gjson.GetBytes(out, "error.type").String())
+	require.Equal(t, "content_filter", gjson.GetBytes(out, "error.code").String())
+	require.True(t, strings.HasSuffix(extractUpstreamErrorMessage(out), "(filtered categories: hate(high), jailbreak)"), extractUpstreamErrorMessage(out))
+	require.True(t, gjson.GetBytes(out, "error.innererror.content_filter_result.hate.filtered").Bool())
+
+	out = normalizeAzureErrorBody([]byte(`{"statusCode":401,"message":"Access denied due to invalid subscription key or wrong API endpoint."}`))
+	require.Equal(t, "Access denied due to invalid subscription key or wrong API endpoint.", extractUpstreamErrorMessage(out))
+	require.Equal(t, "401", gjson.GetBytes(out, "error.code").String())
+
+	plain := []byte(`{"error":{"message":"bad","type":"invalid_request_error"}}`)
+	require.Equal(t, plain, normalizeAzureErrorBody(plain))
+}
+
+func TestOpenAIGatewayService_ForwardEmbeddings_Azure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/embeddings", nil)
+
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Apim-Request-Id": []string{"apim-1"}},
+		Body:       io.NopCloser(strings.NewReader(`{"object":"list","data":[],"model":"text-embedding-3-large","usage":{"prompt_tokens":4,"total_tokens":4}}`)),
+	}}
+	svc := &OpenAIGatewayService{
+		cfg:          &config.Config{Security: config.SecurityConfig{URLAllowlist: config.URLAllowlistConfig{Enabled: false}}},
+		httpUpstream: upstream,
+	}
+	account := newAzureTestAccount(map[string]any{
+		"api_version":        "2024-10-21",
+		"deployment_mapping": map[string]any{"text-embedding-3-large": "embed-large"},
+	})
+
+	result, err := svc.ForwardEmbeddings(c.Request.Context(), c, account, []byte(`{"model":"text-embedding-3-large","input":"hi"}`))
+	require.NoError(t, err)
+	require.Equal(t, "https://contoso.openai.azure.com/openai/deployments/embed-large/embeddings?api-version=2024-10-21", upstream.req.URL.String())
+	require.Equal(t, "azure-key", upstream.req.Header.Get("api-key"))
+	require.Empty(t, upstream.req.Header.Get("authorization"))
+	require.Equal(t, "text-embedding-3-large", result.Model)
+	require.Equal(t, "apim-1", result.RequestID)
+}
+
+func TestCalculateOpenAI429ResetTime_AzureRateLimitHeaders(t *testing.T) {
+	svc := &RateLimitService{}
+
+	headers := http.Header{}
+	headers.Set("x-ratelimit-remaining-requests", "12")
+	headers.Set("x-ratelimit-remaining-tokens", "0")
+	headers.Set("x-ratelimit-reset-requests", "1s")
+	headers.Set("x-ratelimit-reset-tokens", "45s")
+	resetAt := svc.calculateOpenAI429ResetTime(headers)
+	require.NotNil(t, resetAt)
+	require.InDelta(t, 45, time.Until(*resetAt).Seconds(), 2)
+
+	// Azure 只返回 retry-after 时使用其值
+	headers = http.Header{}
+	headers.Set("x-ratelimit-remaining-tokens", "0")
+	headers.Set("Retry-After", "6")
+	resetAt = svc.calculateOpenAI429ResetTime(headers)
+	require.NotNil(t, resetAt)
+	require.InDelta(t, 6, time.Until(*resetAt).Seconds(), 2)
+
+	require.Nil(t, svc.calculateOpenAI429ResetTime(http.Header{}))
+}
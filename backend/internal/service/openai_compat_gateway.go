@@ -0,0 +1,463 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/apicompat"
+	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// OpenAI 兼容 Chat Completions 上游账号转发
+//
+// openai-compat 账号只对接上游的 {base_url}/chat/completions（DeepSeek、Qwen、OpenRouter、vLLM 等）：
+//   - anthropic 平台（Claude Code 分组）：Messages 请求转换为 Chat 请求，响应（含 SSE）转换回 Messages；
+//   - openai 平台（Codex 分组）：Responses 请求经 Messages 中间格式转换为 Chat 请求，响应转换回 Responses。
+//
+// 模型名按账号 model_mapping 映射；计费使用客户端请求的模型名，价格优先取账号 extra.model_pricing。
+
+// openAICompatStream 转换上游 chat.completion.chunk 流并累计用量
+type openAICompatStream interface {
+	apicompat.StreamConverter
+	Usage() apicompat.AnthropicUsage
+}
+
+// openAICompatStreamResult 流式转发结果
+type openAICompatStreamResult struct {
+	usage            apicompat.AnthropicUsage
+	firstTokenMs     *int
+	clientDisconnect bool
+}
+
+// openAICompatTargetURL 校验账号 base_url 并返回 chat/completions 地址
+func openAICompatTargetURL(account *Account, validate func(string) (string, error)) (string, error) {
+	baseURL := account.GetOpenAICompatBaseURL()
+	if baseURL == "" {
+		return "", errors.New("base_url not found in credentials")
+	}
+	validatedURL, err := validate(baseURL)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(validatedURL, "/") + "/chat/completions", nil
+}
+
+// newOpenAICompatRequest 构建 Chat Completions 上游请求；客户端请求头不透传
+func newOpenAICompatRequest(ctx context.Context, targetURL, apiKey string, body []byte, stream bool) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("content-type", "application/json")
+	if stream {
+		req.Header.Set("accept", "text/event-stream")
+	} else {
+		req.Header.Set("accept", "application/json")
+	}
+	if apiKey != "" {
+		req.Header.Set("authorization", "Bearer "+apiKey)
+	}
+	return req, nil
+}
+
+// openAICompatRequestID 兼容上游常见的请求 ID 响应头
+func openAICompatRequestID(resp *http.Response) string {
+	for _, key := range []string{"x-request-id", "x-ds-trace-id", "x-generation-id"} {
+		if v := resp.Header.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// openAICompatUsage 从非流式 Chat 响应中提取用量
+func openAICompatUsage(body []byte) apicompat.AnthropicUsage {
+	var usage apicompat.ChatUsage
+	raw := gjson.GetBytes(body, "usage")
+	if !raw.Exists() || json.Unmarshal([]byte(raw.Raw), &usage) != nil {
+		return apicompat.AnthropicUsage{}
+	}
+	return apicompat.ChatUsageToAnthropic(&usage)
+}
+
+// hasOpenAICompatDelta 上游分片是否携带输出内容（用于首字时间统计）
+func hasOpenAICompatDelta(line string) bool {
+	data, ok := strings.CutPrefix(strings.TrimSpace(line), "data:")
+	if !ok {
+		return false
+	}
+	delta := gjson.Get(strings.TrimSpace(data), "choices.0.delta")
+	return delta.Get("content").String() != "" ||
+		delta.Get("reasoning_content").String() != "" ||
+		delta.Get("reasoning").String() != "" ||
+		delta.Get("tool_calls").Exists()
+}
+
+// streamOpenAICompatResponse 逐行转换上游 SSE 并写给客户端。
+// 客户端断开后继续读取上游直到结束，以获取完整用量用于计费。
+func streamOpenAICompatResponse(c *gin.Context, resp *http.Response, converter openAICompatStream, startTime time.Time, maxLineSize int) (*openAICompatStreamResult, error) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	if requestID := openAICompatRequestID(resp); requestID != "" {
+		c.Header("x-request-id", requestID)
+	}
+	c.Status(http.StatusOK)
+
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming not supported")
+	}
+
+	result := &openAICompatStreamResult{}
+	write := func(out []byte) {
+		if len(out) == 0 || result.clientDisconnect {
+			return
+		}
+		if _, err := c.Writer.Write(out); err != nil {
+			result.clientDisconnect = true
+			log.Printf("[OpenAICompat] client disconnected during streaming, continue draining upstream for usage")
+			return
+		}
+		flusher.Flush()
+	}
+
+	if maxLineSize <= 0 {
+		maxLineSize = defaultMaxLineSize
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if result.firstTokenMs == nil && hasOpenAICompatDelta(line) {
+			ms := int(time.Since(startTime).Milliseconds())
+			result.firstTokenMs = &ms
+		}
+		write(converter.ProcessLine(line))
+	}
+	if err := scanner.Err(); err != nil {
+		result.usage = converter.Usage()
+		if result.clientDisconnect {
+			return result, nil
+		}
+		return result, fmt.Errorf("stream read error: %w", err)
+	}
+	write(converter.Finish())
+	result.usage = converter.Usage()
+	return result, nil
+}
+
+// forwardOpenAICompat 通过 Chat Completions 上游处理 Messages 请求
+func (s *GatewayService) forwardOpenAICompat(ctx context.Context, c *gin.Context, account *Account, parsed *ParsedRequest) (*ForwardResult, error) {
+	startTime := time.Now()
+	originalModel := parsed.Model
+
+	var anthropicReq apicompat.AnthropicRequest
+	if err := json.Unmarshal(parsed.Body, &anthropicReq); err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+	mappedModel := account.GetMappedModel(originalModel)
+	if mappedModel != originalModel {
+		log.Printf("Model mapping applied: %s -> %s (account: %s, source=account)", originalModel, mappedModel, account.Name)
+	}
+	anthropicReq.Model = mappedModel
+	chatReq, err := apicompat.AnthropicToChatRequest(&anthropicReq)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"type":  "error",
+			"error": gin.H{"type": "invalid_request_error", "message": err.Error()},
+		})
+		return nil, fmt.Errorf("convert request: %w", err)
+	}
+	body, err := json.Marshal(chatReq)
+	if err != nil {
+		return nil, fmt.Errorf("serialize request body: %w", err)
+	}
+
+	token, _, err := s.GetAccessToken(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	targetURL, err := openAICompatTargetURL(account, s.validateUpstreamBaseURL)
+	if err != nil {
+		return nil, err
+	}
+	upstreamReq, err := newOpenAICompatRequest(ctx, targetURL, token, body, parsed.Stream)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+	c.Set(OpsUpstreamRequestBodyKey, string(body))
+
+	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		safeErr := sanitizeUpstreamErrorMessage(err.Error())
+		setOpsUpstreamError(c, 0, safeErr, "")
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: 0,
+			Kind:               "request_error",
+			Message:            safeErr,
+		})
+		c.JSON(http.StatusBadGateway, gin.H{
+			"type": "error",
+			"error": gin.H{
+				"type":    "upstream_error",
+				"message": "Upstream request failed",
+			},
+		})
+		return nil, fmt.Errorf("upstream request failed: %s", safeErr)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		if s.shouldFailoverUpstreamError(resp.StatusCode) {
+			respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+			_ = resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+			log.Printf("[OpenAICompat] Upstream error (failover): Account=%d(%s) Status=%d Body=%s",
+				account.ID, account.Name, resp.StatusCode, truncateString(string(respBody), 1000))
+
+			s.handleFailoverSideEffects(ctx, resp, account)
+			appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+				Platform:           account.Platform,
+				AccountID:          account.ID,
+				AccountName:        account.Name,
+				UpstreamStatusCode: resp.StatusCode,
+				UpstreamRequestID:  openAICompatRequestID(resp),
+				Kind:               "failover",
+				Message:            extractUpstreamErrorMessage(respBody),
+			})
+			return nil, &UpstreamFailoverError{StatusCode: resp.StatusCode, ResponseBody: respBody}
+		}
+		return s.handleErrorResponse(ctx, resp, c, account)
+	}
+
+	result := &ForwardResult{
+		RequestID: openAICompatRequestID(resp),
+		Model:     originalModel,
+		Stream:    parsed.Stream,
+	}
+	var usage apicompat.AnthropicUsage
+	if parsed.Stream {
+		maxLineSize := 0
+		if s.cfg != nil {
+			maxLineSize = s.cfg.Gateway.MaxLineSize
+		}
+		streamResult, err := streamOpenAICompatResponse(c, resp, apicompat.NewChatToAnthropicStream(originalModel), startTime, maxLineSize)
+		if err != nil {
+			return nil, err
+		}
+		usage = streamResult.usage
+		result.FirstTokenMs = streamResult.firstTokenMs
+		result.ClientDisconnect = streamResult.clientDisconnect
+	} else {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+		if err != nil {
+			return nil, fmt.Errorf("read response: %w", err)
+		}
+		out, err := apicompat.ChatToAnthropicResponse(respBody, originalModel)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{
+				"type":  "error",
+				"error": gin.H{"type": "upstream_error", "message": "Failed to parse upstream response"},
+			})
+			return nil, err
+		}
+		usage = openAICompatUsage(respBody)
+		if s.cfg != nil {
+			responseheaders.WriteFilteredHeaders(c.Writer.Header(), resp.Header, s.cfg.Security.ResponseHeaders)
+		}
+		c.Data(http.StatusOK, "application/json", out)
+	}
+
+	result.Usage = ClaudeUsage{
+		InputTokens:              usage.InputTokens,
+		OutputTokens:             usage.OutputTokens,
+		CacheCreationInputTokens: usage.CacheCreationInputTokens,
+		CacheReadInputTokens:     usage.CacheReadInputTokens,
+	}
+	result.Duration = time.Since(startTime)
+	return result, nil
+}
+
+// countTokensOpenAICompat 兼容上游没有 count_tokens 接口，按文本长度估算输入 token
+func (s *GatewayService) countTokensOpenAICompat(c *gin.Context, body []byte) {
+	var req apicompat.AnthropicRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		s.countTokensError(c, http.StatusBadRequest, "invalid_request_error", "Failed to parse request body")
+		return
+	}
+	chatReq, err := apicompat.AnthropicToChatRequest(&req)
+	if err != nil {
+		s.countTokensError(c, http.StatusBadRequest, "invalid_request_error", err.Error())
+		return
+	}
+	total := 0
+	for _, msg := range chatReq.Messages {
+		total += estimateTokensForText(gjson.ParseBytes(msg.Content).String())
+		for _, call := range msg.ToolCalls {
+			total += estimateTokensForText(call.Function.Name + call.Function.Arguments)
+		}
+	}
+	for _, tool := range chatReq.Tools {
+		total += estimateTokensForText(tool.Function.Name + tool.Function.Description + string(tool.Function.Parameters))
+	}
+	c.JSON(http.StatusOK, gin.H{"input_tokens": total})
+}
+
+// forwardOpenAICompat 通过 Chat Completions 上游处理 Responses 请求
+func (s *OpenAIGatewayService) forwardOpenAICompat(ctx context.Context, c *gin.Context, account *Account, body []byte, reasoningEffort *string) (*OpenAIForwardResult, error) {
+	startTime := time.Now()
+
+	var responsesReq apicompat.ResponsesRequest
+	if err := json.Unmarshal(body, &responsesReq); err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+	originalModel := responsesReq.Model
+	mappedModel := account.GetMappedModel(originalModel)
+	if mappedModel != originalModel {
+		log.Printf("[OpenAI] Model mapping applied: %s -> %s (account: %s)", originalModel, mappedModel, account.Name)
+	}
+	responsesReq.Model = mappedModel
+	chatReq, err := apicompat.ResponsesToChatRequest(&responsesReq)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{"type": "invalid_request_error", "message": err.Error()},
+		})
+		return nil, fmt.Errorf("convert request: %w", err)
+	}
+	chatBody, err := json.Marshal(chatReq)
+	if err != nil {
+		return nil, fmt.Errorf("serialize request body: %w", err)
+	}
+
+	token, _, err := s.GetAccessToken(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	targetURL, err := openAICompatTargetURL(account, s.validateUpstreamBaseURL)
+	if err != nil {
+		return nil, err
+	}
+	upstreamReq, err := newOpenAICompatRequest(ctx, targetURL, token, chatBody, responsesReq.Stream)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+	if c != nil {
+		c.Set(OpsUpstreamRequestBodyKey, string(chatBody))
+	}
+
+	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		safeErr := sanitizeUpstreamErrorMessage(err.Error())
+		setOpsUpstreamError(c, 0, safeErr, "")
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: 0,
+			Kind:               "request_error",
+			Message:            safeErr,
+		})
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error": gin.H{
+				"type":    "upstream_error",
+				"message": "Upstream request failed",
+			},
+		})
+		return nil, fmt.Errorf("upstream request failed: %s", safeErr)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		if s.shouldFailoverUpstreamError(resp.StatusCode) {
+			respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+			_ = resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+			appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+				Platform:           account.Platform,
+				AccountID:          account.ID,
+				AccountName:        account.Name,
+				UpstreamStatusCode: resp.StatusCode,
+				UpstreamRequestID:  openAICompatRequestID(resp),
+				Kind:               "failover",
+				Message:            sanitizeUpstreamErrorMessage(strings.TrimSpace(extractUpstreamErrorMessage(respBody))),
+			})
+			s.handleFailoverSideEffects(ctx, resp, account)
+			return nil, &UpstreamFailoverError{StatusCode: resp.StatusCode, ResponseBody: respBody}
+		}
+		return s.handleErrorResponse(ctx, resp, c, account)
+	}
+
+	result := &OpenAIForwardResult{
+		RequestID:       openAICompatRequestID(resp),
+		Model:           originalModel,
+		ReasoningEffort: reasoningEffort,
+		Stream:          responsesReq.Stream,
+	}
+	var usage apicompat.AnthropicUsage
+	if responsesReq.Stream {
+		maxLineSize := 0
+		if s.cfg != nil {
+			maxLineSize = s.cfg.Gateway.MaxLineSize
+		}
+		streamResult, err := streamOpenAICompatResponse(c, resp, apicompat.NewChatToResponsesStream(originalModel), startTime, maxLineSize)
+		if err != nil {
+			return nil, err
+		}
+		usage = streamResult.usage
+		result.FirstTokenMs = streamResult.firstTokenMs
+	} else {
+		respBody, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+		if err != nil {
+			return nil, fmt.Errorf("read response: %w", err)
+		}
+		out, err := apicompat.ChatToResponsesResponse(respBody, originalModel)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{
+				"error": gin.H{"type": "upstream_error", "message": "Failed to parse upstream response"},
+			})
+			return nil, err
+		}
+		usage = openAICompatUsage(respBody)
+		if s.cfg != nil {
+			responseheaders.WriteFilteredHeaders(c.Writer.Header(), resp.Header, s.cfg.Security.ResponseHeaders)
+		}
+		c.Data(http.StatusOK, "application/json", out)
+	}
+
+	// OpenAI 用量的 input_tokens 含缓存命中部分
+	result.Usage = OpenAIUsage{
+		InputTokens:          usage.InputTokens + usage.CacheReadInputTokens,
+		OutputTokens:         usage.OutputTokens,
+		CacheReadInputTokens: usage.CacheReadInputTokens,
+	}
+	result.Duration = time.Since(startTime)
+	return result, nil
+}
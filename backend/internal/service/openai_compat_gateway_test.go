@@ -0,0 +1,201 @@
+package service
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func newOpenAICompatTestAccount(platform string, extra map[string]any) *Account {
+	return &Account{
+		ID:       7,
+		Name:     "deepseek",
+		Platform: platform,
+		Type:     AccountTypeOpenAICompat,
+		Credentials: map[string]any{
+			"base_url":      "https://api.deepseek.com/v1/chat/completions",
+			"api_key":       "sk-ds",
+			"model_mapping": map[string]any{"claude-sonnet-4-5": "deepseek-chat", "gpt-5": "deepseek-reasoner"},
+		},
+		Extra: extra,
+	}
+}
+
+func newOpenAICompatTestConfig() *config.Config {
+	return &config.Config{Security: config.SecurityConfig{URLAllowlist: config.URLAllowlistConfig{Enabled: false}}}
+}
+
+func TestAccountOpenAICompat(t *testing.T) {
+	account := newOpenAICompatTestAccount(PlatformAnthropic, nil)
+	require.True(t, account.IsOpenAICompat())
+	require.Equal(t, "https://api.deepseek.com/v1", account.GetOpenAICompatBaseURL())
+
+	account.Platform = PlatformGemini
+	require.False(t, account.IsOpenAICompat())
+}
+
+func TestGatewayService_ForwardOpenAICompat_NonStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+		Body: io.NopCloser(strings.NewReader(`{"id":"c1","object":"chat.completion","model":"deepseek-chat","choices":[{"index":0,"message":{"role":"assistant","content":null,"tool_calls":[{"id":"call_1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":\"Paris\"}"}}]},"finish_reason":"tool_calls"}],` +
+			`"usage":{"prompt_tokens":120,"completion_tokens":15,"total_tokens":135,"prompt_cache_hit_tokens":100}}`)),
+	}}
+	svc := &GatewayService{cfg: newOpenAICompatTestConfig(), httpUpstream: upstream}
+	account := newOpenAICompatTestAccount(PlatformAnthropic, nil)
+
+	body := []byte(`{"model":"claude-sonnet-4-5","max_tokens":256,"system":"be brief","messages":[{"role":"user","content":"weather in Paris?"}],"tools":[{"name":"get_weather","input_schema":{"type":"object","properties":{"city":{"type":"string"}}}}]}`)
+	parsed, err := ParseGatewayRequest(body, PlatformAnthropic)
+	require.NoError(t, err)
+
+	result, err := svc.forwardOpenAICompat(c.Request.Context(), c, account, parsed)
+	require.NoError(t, err)
+
+	require.Equal(t, "https://api.deepseek.com/v1/chat/completions", upstream.req.URL.String())
+	require.Equal(t, "Bearer sk-ds", upstream.req.Header.Get("authorization"))
+	require.Empty(t, upstream.req.Header.Get("x-api-key"))
+	require.Equal(t, "deepseek-chat", gjson.GetBytes(upstream.body, "model").String())
+	require.Equal(t, "system", gjson.GetBytes(upstream.body, "messages.0.role").String())
+	require.Equal(t, "get_weather", gjson.GetBytes(upstream.body, "tools.0.function.name").String())
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	out := rec.Body.Bytes()
+	require.Equal(t, "claude-sonnet-4-5", gjson.GetBytes(out, "model").String())
+	require.Equal(t, "tool_use", gjson.GetBytes(out, "stop_reason").String())
+	require.Equal(t, "Paris", gjson.GetBytes(out, "content.0.input.city").String())
+
+	require.Equal(t, "claude-sonnet-4-5", result.Model)
+	require.Equal(t, "req-1", result.RequestID)
+	require.Equal(t, 20, result.Usage.InputTokens)
+	require.Equal(t, 100, result.Usage.CacheReadInputTokens)
+	require.Equal(t, 15, result.Usage.OutputTokens)
+}
+
+func TestGatewayService_ForwardOpenAICompat_Stream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+
+	stream := strings.Join([]string{
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"role":"assistant","content":"Hel"}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"content":"lo"},"finish_reason":"stop"}]}`,
+		`data: {"id":"c1","choices":[],"usage":{"prompt_tokens":9,"completion_tokens":2,"total_tokens":11}}`,
+		`data: [DONE]`,
+		``,
+	}, "\n\n")
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:       io.NopCloser(strings.NewReader(stream)),
+	}}
+	svc := &GatewayService{cfg: newOpenAICompatTestConfig(), httpUpstream: upstream}
+	account := newOpenAICompatTestAccount(PlatformAnthropic, nil)
+
+	parsed, err := ParseGatewayRequest([]byte(`{"model":"claude-sonnet-4-5","max_tokens":64,"stream":true,"messages":[{"role":"user","content":"hi"}]}`), PlatformAnthropic)
+	require.NoError(t, err)
+
+	result, err := svc.forwardOpenAICompat(c.Request.Context(), c, account, parsed)
+	require.NoError(t, err)
+	require.True(t, gjson.GetBytes(upstream.body, "stream_options.include_usage").Bool())
+	require.Equal(t, "text/event-stream", upstream.req.Header.Get("accept"))
+
+	body := rec.Body.String()
+	require.Contains(t, body, "event: message_start")
+	require.Contains(t, body, `"text":"Hel"`)
+	require.Contains(t, body, `"stop_reason":"end_turn"`)
+	require.Contains(t, body, "event: message_stop")
+	require.NotNil(t, result.FirstTokenMs)
+	require.Equal(t, 9, result.Usage.InputTokens)
+	require.Equal(t, 2, result.Usage.OutputTokens)
+}
+
+func TestOpenAIGatewayService_ForwardOpenAICompat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/responses", nil)
+
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body: io.NopCloser(strings.NewReader(`{"id":"c1","object":"chat.completion","model":"deepseek-reasoner","choices":[{"index":0,"message":{"role":"assistant","content":"4","reasoning_content":"2+2"},"finish_reason":"stop"}],` +
+			`"usage":{"prompt_tokens":30,"completion_tokens":5,"total_tokens":35,"prompt_tokens_details":{"cached_tokens":10}}}`)),
+	}}
+	svc := &OpenAIGatewayService{cfg: newOpenAICompatTestConfig(), httpUpstream: upstream}
+	account := newOpenAICompatTestAccount(PlatformOpenAI, nil)
+	account.Credentials["api_key"] = ""
+
+	result, err := svc.Forward(c.Request.Context(), c, account, []byte(`{"model":"gpt-5","input":"2+2?"}`))
+	require.NoError(t, err)
+
+	require.Equal(t, "https://api.deepseek.com/v1/chat/completions", upstream.req.URL.String())
+	require.Empty(t, upstream.req.Header.Get("authorization"))
+	require.Equal(t, "deepseek-reasoner", gjson.GetBytes(upstream.body, "model").String())
+
+	out := rec.Body.Bytes()
+	require.Equal(t, "response", gjson.GetBytes(out, "object").String())
+	require.Equal(t, "gpt-5", gjson.GetBytes(out, "model").String())
+	require.Contains(t, rec.Body.String(), `"text":"4"`)
+
+	// OpenAI 用量的 input_tokens 含缓存命中部分
+	require.Equal(t, "gpt-5", result.Model)
+	require.Equal(t, 30, result.Usage.InputTokens)
+	require.Equal(t, 10, result.Usage.CacheReadInputTokens)
+	require.Equal(t, 5, result.Usage.OutputTokens)
+}
+
+func TestGatewayService_ForwardCountTokens_OpenAICompat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages/count_tokens", nil)
+
+	svc := &GatewayService{cfg: newOpenAICompatTestConfig()}
+	account := newOpenAICompatTestAccount(PlatformAnthropic, nil)
+	parsed, err := ParseGatewayRequest([]byte(`{"model":"claude-sonnet-4-5","messages":[{"role":"user","content":"count these tokens please"}]}`), PlatformAnthropic)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.ForwardCountTokens(c.Request.Context(), c, account, parsed))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Greater(t, gjson.Get(rec.Body.String(), "input_tokens").Int(), int64(0))
+}
+
+func TestBillingService_CalculateCostForAccount_PricingOverride(t *testing.T) {
+	svc := &BillingService{}
+	account := newOpenAICompatTestAccount(PlatformAnthropic, map[string]any{
+		"model_pricing": map[string]any{
+			"deepseek-*":    map[string]any{"input": 0.27, "output": 1.1, "cache_read": 0.07},
+			"deepseek-chat": map[string]any{"input": 0.5, "output": 2},
+		},
+	})
+
+	// 请求模型未配置，按映射后的上游模型精确匹配
+	pricing := account.GetModelPricingOverride("claude-sonnet-4-5")
+	require.NotNil(t, pricing)
+	require.InDelta(t, 0.5e-6, pricing.InputPricePerToken, 1e-15)
+
+	// 通配符匹配
+	pricing = account.GetModelPricingOverride("gpt-5")
+	require.NotNil(t, pricing)
+	require.InDelta(t, 0.07e-6, pricing.CacheReadPricePerToken, 1e-15)
+
+	require.Nil(t, account.GetModelPricingOverride("qwen-max"))
+
+	cost, err := svc.CalculateCostForAccount(account, "gpt-5", UsageTokens{InputTokens: 1_000_000, OutputTokens: 1_000_000, CacheReadTokens: 1_000_000}, 2)
+	require.NoError(t, err)
+	require.InDelta(t, 0.27+1.1+0.07, cost.TotalCost, 1e-9)
+	require.InDelta(t, 2*(0.27+1.1+0.07), cost.ActualCost, 1e-9)
+}
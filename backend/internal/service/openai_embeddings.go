@@ -0,0 +1,197 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// OpenAI Platform Embeddings API（API Key 账号未配置 base_url 时使用）
+const openaiEmbeddingsAPIURL = "https://api.openai.com/v1/embeddings"
+
+// ForwardEmbeddings 转发 OpenAI Embeddings 请求（仅 API Key / 上游透传 / Azure 账号）
+//
+// 错误处理与 Forward 一致：可 failover 的状态码返回 UpstreamFailoverError，其余错误直接写回客户端。
+func (s *OpenAIGatewayService) ForwardEmbeddings(ctx context.Context, c *gin.Context, account *Account, body []byte) (*OpenAIForwardResult, error) {
+	startTime := time.Now()
+
+	var reqBody map[string]any
+	if err := json.Unmarshal(body, &reqBody); err != nil {
+		return nil, fmt.Errorf("parse request: %w", err)
+	}
+	originalModel, _ := reqBody["model"].(string)
+
+	mappedModel := account.GetMappedModel(originalModel)
+	if account.IsAzureOpenAI() {
+		mappedModel = account.GetAzureDeployment(mappedModel)
+	}
+	if mappedModel != originalModel {
+		log.Printf("[OpenAI] Embeddings model mapping applied: %s -> %s (account: %s)", originalModel, mappedModel, account.Name)
+		reqBody["model"] = mappedModel
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("serialize request body: %w", err)
+		}
+	}
+
+	upstreamReq, err := s.buildEmbeddingsRequest(ctx, c, account, body)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+
+	if c != nil {
+		c.Set(OpsUpstreamRequestBodyKey, string(body))
+	}
+
+	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		safeErr := sanitizeUpstreamErrorMessage(err.Error())
+		setOpsUpstreamError(c, 0, safeErr, "")
+		appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+			Platform:           account.Platform,
+			AccountID:          account.ID,
+			AccountName:        account.Name,
+			UpstreamStatusCode: 0,
+			Kind:               "request_error",
+			Message:            safeErr,
+		})
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error": gin.H{
+				"type":    "upstream_error",
+				"message": "Upstream request failed",
+			},
+		})
+		return nil, fmt.Errorf("upstream request failed: %s", safeErr)
+	}
+	if account.IsAzureOpenAI() {
+		adaptAzureResponse(resp)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 400 {
+		if s.shouldFailoverUpstreamError(resp.StatusCode) {
+			respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+			_ = resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+			upstreamMsg := strings.TrimSpace(extractUpstreamErrorMessage(respBody))
+			upstreamMsg = sanitizeUpstreamErrorMessage(upstreamMsg)
+			upstreamDetail := ""
+			if s.cfg != nil && s.cfg.Gateway.LogUpstreamErrorBody {
+				maxBytes := s.cfg.Gateway.LogUpstreamErrorBodyMaxBytes
+				if maxBytes <= 0 {
+					maxBytes = 2048
+				}
+				upstreamDetail = truncateString(string(respBody), maxBytes)
+			}
+			appendOpsUpstreamError(c, OpsUpstreamErrorEvent{
+				Platform:           account.Platform,
+				AccountID:          account.ID,
+				AccountName:        account.Name,
+				UpstreamStatusCode: resp.StatusCode,
+				UpstreamRequestID:  resp.Header.Get("x-request-id"),
+				Kind:               "failover",
+				Message:            upstreamMsg,
+				Detail:             upstreamDetail,
+			})
+
+			s.handleFailoverSideEffects(ctx, resp, account)
+			return nil, &UpstreamFailoverError{StatusCode: resp.StatusCode, ResponseBody: respBody}
+		}
+		return s.handleErrorResponse(ctx, resp, c, account)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var parsed struct {
+		Usage struct {
+			PromptTokens int `json:"prompt_tokens"`
+		} `json:"usage"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+	if originalModel != mappedModel {
+		respBody = s.replaceModelInResponseBody(respBody, mappedModel, originalModel)
+	}
+
+	responseheaders.WriteFilteredHeaders(c.Writer.Header(), resp.Header, s.cfg.Security.ResponseHeaders)
+	c.Data(resp.StatusCode, "application/json", respBody)
+
+	return &OpenAIForwardResult{
+		RequestID:   resp.Header.Get("x-request-id"),
+		Usage:       OpenAIUsage{InputTokens: parsed.Usage.PromptTokens},
+		Model:       originalModel,
+		Duration:    time.Since(startTime),
+		RequestType: UsageRequestTypeEmbedding,
+	}, nil
+}
+
+func (s *OpenAIGatewayService) buildEmbeddingsRequest(ctx context.Context, c *gin.Context, account *Account, body []byte) (*http.Request, error) {
+	if !account.SupportsEmbeddings() {
+		return nil, fmt.Errorf("account type %s does not support embeddings", account.Type)
+	}
+	apiKey := strings.TrimSpace(account.GetCredential("api_key"))
+	if apiKey == "" {
+		return nil, errors.New("api_key not found in credentials")
+	}
+
+	targetURL := openaiEmbeddingsAPIURL
+	if account.IsAzureOpenAI() {
+		// 部署名已在 ForwardEmbeddings 中写入请求体的 model
+		azureURL, err := s.azureTargetURL(account, gjson.GetBytes(body, "model").String(), "embeddings")
+		if err != nil {
+			return nil, err
+		}
+		targetURL = azureURL
+	} else if baseURL := strings.TrimSpace(account.GetCredential("base_url")); baseURL != "" {
+		validatedURL, err := s.validateUpstreamBaseURL(baseURL)
+		if err != nil {
+			return nil, err
+		}
+		targetURL = strings.TrimRight(validatedURL, "/") + "/embeddings"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if account.IsAzureOpenAI() {
+		req.Header.Set(azureAPIKeyHeader, apiKey)
+	} else {
+		req.Header.Set("authorization", "Bearer "+apiKey)
+	}
+	for key, values := range c.Request.Header {
+		if openaiAllowedHeaders[strings.ToLower(key)] {
+			for _, v := range values {
+				req.Header.Add(key, v)
+			}
+		}
+	}
+	if customUA := account.GetOpenAIUserAgent(); customUA != "" {
+		req.Header.Set("user-agent", customUA)
+	}
+	if req.Header.Get("content-type") == "" {
+		req.Header.Set("content-type", "application/json")
+	}
+	return req, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddingsUpstreamRecorder struct {
+	req  *http.Request
+	body []byte
+	resp *http.Response
+}
+
+func (r *embeddingsUpstreamRecorder) Do(req *http.Request, _ string, _ int64, _ int) (*http.Response, error) {
+	r.req = req
+	r.body, _ = io.ReadAll(req.Body)
+	return r.resp, nil
+}
+
+func (r *embeddingsUpstreamRecorder) DoWithTLS(req *http.Request, proxyURL string, accountID int64, concurrency int, _ bool) (*http.Response, error) {
+	return r.Do(req, proxyURL, accountID, concurrency)
+}
+
+func TestOpenAIGatewayService_ForwardEmbeddings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/embeddings", nil)
+
+	upstream := &embeddingsUpstreamRecorder{resp: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+		Body: io.NopCloser(strings.NewReader(
+			`{"object":"list","data":[{"object":"embedding","index":0,"embedding":[0.1]}],"model":"text-embedding-3-large","usage":{"prompt_tokens":5,"total_tokens":5}}`)),
+	}}
+	svc := &OpenAIGatewayService{
+		cfg:          &config.Config{Security: config.SecurityConfig{URLAllowlist: config.URLAllowlistConfig{Enabled: false}}},
+		httpUpstream: upstream,
+	}
+	account := &Account{
+		ID:       1,
+		Platform: PlatformOpenAI,
+		Type:     AccountTypeUpstream,
+		Credentials: map[string]any{
+			"api_key":       "sk-upstream",
+			"base_url":      "https://relay.example.com/v1",
+			"model_mapping": map[string]any{"text-embedding-3-small": "text-embedding-3-large"},
+		},
+	}
+
+	result, err := svc.ForwardEmbeddings(c.Request.Context(), c, account, []byte(`{"model":"text-embedding-3-small","input":"hi"}`))
+	require.NoError(t, err)
+	require.Equal(t, "https://relay.example.com/v1/embeddings", upstream.req.URL.String())
+	require.Equal(t, "Bearer sk-upstream", upstream.req.Header.Get("authorization"))
+	require.JSONEq(t, `{"model":"text-embedding-3-large","input":"hi"}`, string(upstream.body))
+
+	require.Equal(t, "text-embedding-3-small", result.Model)
+	require.Equal(t, 5, result.Usage.InputTokens)
+	require.Equal(t, UsageRequestTypeEmbedding, result.RequestType)
+	require.Equal(t, "req-1", result.RequestID)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &out))
+	require.Equal(t, "text-embedding-3-small", out["model"])
+}
+
+func TestOpenAIGatewayService_ForwardEmbeddings_RejectsOAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/embeddings", nil)
+
+	svc := &OpenAIGatewayService{cfg: &config.Config{}}
+	account := &Account{Platform: PlatformOpenAI, Type: AccountTypeOAuth, Credentials: map[string]any{"access_token": "x"}}
+	_, err := svc.ForwardEmbeddings(c.Request.Context(), c, account, []byte(`{"model":"text-embedding-3-small","input":"hi"}`))
+	require.ErrorContains(t, err, "does not support embeddings")
+}
+
+func TestAccountSupportsEmbeddings(t *testing.T) {
+	cases := []struct {
+		account *Account
+		want    bool
+	}{
+		{&Account{Platform: PlatformOpenAI, Type: AccountTypeAPIKey}, true},
+		{&Account{Platform: PlatformOpenAI, Type: AccountTypeUpstream}, true},
+		{&Account{Platform: PlatformOpenAI, Type: AccountTypeAzure}, true},
+		{&Account{Platform: PlatformOpenAI, Type: AccountTypeOAuth}, false},
+		{&Account{Platform: PlatformGemini, Type: AccountTypeAPIKey}, true},
+		{&Account{Platform: PlatformGemini, Type: AccountTypeOAuth, Credentials: map[string]any{"oauth_type": "ai_studio"}}, true},
+		{&Account{Platform: PlatformGemini, Type: AccountTypeOAuth, Credentials: map[string]any{"project_id": "p"}}, false},
+		{&Account{Platform: PlatformGemini, Type: AccountTypeOAuth, Credentials: map[string]any{"oauth_type": "google_one"}}, false},
+		{&Account{Platform: PlatformAntigravity, Type: AccountTypeOAuth}, false},
+		{&Account{Platform: PlatformAnthropic, Type: AccountTypeAPIKey}, false},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.want, tc.account.SupportsEmbeddings(), "%s/%s", tc.account.Platform, tc.account.Type)
+	}
+}
+
+func TestEstimateGeminiEmbedTokens(t *testing.T) {
+	require.Equal(t, 2, EstimateGeminiEmbedTokens([]byte(`{"content":{"parts":[{"text":"hello"}]}}`)))
+	require.Equal(t, 3, EstimateGeminiEmbedTokens([]byte(`{"requests":[
+		{"model":"models/m","content":{"parts":[{"text":"hello"}]}},
+		{"model":"models/m","content":{"parts":[{"text":"hi"}]}}
+	]}`)))
+	require.Equal(t, 0, EstimateGeminiEmbedTokens([]byte(`not json`)))
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/tracing"
 	"github.com/Wei-Shaw/sub2api/internal/util/responseheaders"
 	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
 	"github.com/gin-gonic/gin"
@@ -165,6 +166,10 @@ type OpenAIForwardResult struct {
 	Stream          bool
 	Duration        time.Duration
 	FirstTokenMs    *int
+	// ResponseCacheHit 命中响应缓存（未请求上游，按折扣计费）
+	ResponseCacheHit bool
+	// RequestType 请求类型标记，见 UsageRequestType* 常量
+	RequestType string
 }
 
 // OpenAIGatewayService handles OpenAI API gateway operations
@@ -184,6 +189,7 @@ type OpenAIGatewayService struct {
 	deferredService     *DeferredService
 	openAITokenProvider *OpenAITokenProvider
 	toolCorrector       *CodexToolCorrector
+	responseCache       *ResponseCacheService
 }
 
 // NewOpenAIGatewayService creates a new OpenAIGatewayService
@@ -202,6 +208,7 @@ func NewOpenAIGatewayService(
 	httpUpstream HTTPUpstream,
 	deferredService *DeferredService,
 	openAITokenProvider *OpenAITokenProvider,
+	responseCache *ResponseCacheService,
 ) *OpenAIGatewayService {
 	return &OpenAIGatewayService{
 		accountRepo:         accountRepo,
@@ -219,6 +226,7 @@ func NewOpenAIGatewayService(
 		deferredService:     deferredService,
 		openAITokenProvider: openAITokenProvider,
 		toolCorrector:       NewCodexToolCorrector(),
+		responseCache:       responseCache,
 	}
 }
 
@@ -430,7 +438,10 @@ func (s *OpenAIGatewayService) isBetterAccount(candidate, current *Account) bool
 }
 
 // SelectAccountWithLoadAwareness selects an account with load-awareness and wait plan.
-func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (*AccountSelectionResult, error) {
+func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Context, groupID *int64, sessionHash string, requestedModel string, excludedIDs map[int64]struct{}) (result *AccountSelectionResult, err error) {
+	ctx, span := startSelectAccountSpan(ctx, groupID, requestedModel, excludedIDs)
+	defer func() { endSelectAccountSpan(span, result, err) }()
+
 	cfg := s.schedulingConfig()
 	var stickyAccountID int64
 	if sessionHash != "" && s.cache != nil {
@@ -721,6 +732,15 @@ func (s *OpenAIGatewayService) GetAccessToken(ctx context.Context, account *Acco
 			return "", "", errors.New("api_key not found in credentials")
 		}
 		return apiKey, "apikey", nil
+	case AccountTypeAzure:
+		apiKey := strings.TrimSpace(account.GetCredential("api_key"))
+		if apiKey == "" {
+			return "", "", errors.New("api_key not found in credentials")
+		}
+		return apiKey, "azure", nil
+	case AccountTypeOpenAICompat:
+		// 自建上游（如 vLLM）可不配置 api_key
+		return strings.TrimSpace(account.GetCredential("api_key")), "openai-compat", nil
 	default:
 		return "", "", fmt.Errorf("unsupported account type: %s", account.Type)
 	}
@@ -740,6 +760,32 @@ func (s *OpenAIGatewayService) handleFailoverSideEffects(ctx context.Context, re
 	s.rateLimitService.HandleUpstreamError(ctx, account, resp.StatusCode, resp.Header, body)
 }
 
+// ServeResponseCacheHit 在选择账号之前查询精确响应缓存（仅分组开启、非流式且 temperature=0 的请求参与）。
+// 命中时直接写回客户端，返回结果与产生该响应的账号（用于记录使用量，不占用其并发槽位）；
+// 未命中返回 nil，由 Forward 写入缓存。
+func (s *OpenAIGatewayService) ServeResponseCacheHit(c *gin.Context, body []byte) (*OpenAIForwardResult, *Account) {
+	startTime := time.Now()
+	var reqBody map[string]any
+	if err := json.Unmarshal(body, &reqBody); err != nil {
+		return nil, nil
+	}
+	reqModel, _ := reqBody["model"].(string)
+	entry, account := s.responseCache.Lookup(c, s.accountRepo, ResponseCacheEndpointResponses, reqModel, body)
+	if entry == nil {
+		return nil, nil
+	}
+	var usage OpenAIUsage
+	_ = json.Unmarshal(entry.Usage, &usage)
+	return &OpenAIForwardResult{
+		RequestID:        s.responseCache.NewHitRequestID(),
+		Usage:            usage,
+		Model:            reqModel,
+		ReasoningEffort:  extractOpenAIReasoningEffort(reqBody, reqModel),
+		Duration:         time.Since(startTime),
+		ResponseCacheHit: true,
+	}, account
+}
+
 // Forward forwards request to OpenAI API
 func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, account *Account, body []byte) (*OpenAIForwardResult, error) {
 	startTime := time.Now()
@@ -762,6 +808,11 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 	bodyModified := false
 	originalModel := reqModel
 
+	// OpenAI 兼容账号：转换为 Chat Completions 请求转发
+	if account.IsOpenAICompat() {
+		return s.forwardOpenAICompat(ctx, c, account, body, extractOpenAIReasoningEffort(reqBody, originalModel))
+	}
+
 	isCodexCLI := openai.IsCodexCLIRequest(c.GetHeader("User-Agent"))
 
 	// 对所有请求执行模型映射（包含 Codex CLI）。
@@ -784,6 +835,16 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 		}
 	}
 
+	// Azure 账号：请求体中的 model 为部署名
+	if account.IsAzureOpenAI() {
+		if deployment := account.GetAzureDeployment(mappedModel); deployment != mappedModel {
+			log.Printf("[OpenAI] Azure deployment mapping applied: %s -> %s (account: %s)", mappedModel, deployment, account.Name)
+			reqBody["model"] = deployment
+			mappedModel = deployment
+			bodyModified = true
+		}
+	}
+
 	// 规范化 reasoning.effort 参数（minimal -> none），与上游允许值对齐。
 	if reasoning, ok := reqBody["reasoning"].(map[string]any); ok {
 		if effort, ok := reasoning["effort"].(string); ok && effort == "minimal" {
@@ -837,7 +898,7 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 
 		// Also handle max_completion_tokens (similar logic)
 		if _, hasMaxCompletionTokens := reqBody["max_completion_tokens"]; hasMaxCompletionTokens {
-			if account.Type == AccountTypeAPIKey || account.Platform != PlatformOpenAI {
+			if account.Type == AccountTypeAPIKey || account.IsAzureOpenAI() || account.Platform != PlatformOpenAI {
 				delete(reqBody, "max_completion_tokens")
 				bodyModified = true
 			}
@@ -906,6 +967,9 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 		})
 		return nil, fmt.Errorf("upstream request failed: %s", safeErr)
 	}
+	if account.IsAzureOpenAI() {
+		adaptAzureResponse(resp)
+	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Handle error response
@@ -953,10 +1017,14 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 		usage = streamResult.usage
 		firstTokenMs = streamResult.firstTokenMs
 	} else {
+		// 精确响应缓存未命中（见 ServeResponseCacheHit）：记录成功响应写入缓存
+		capture := s.responseCache.BeginCapture(c, boundResponseCacheLookup(c), account.ID)
 		usage, err = s.handleNonStreamingResponse(ctx, resp, c, account, originalModel, mappedModel)
 		if err != nil {
+			capture.Finish(ctx, nil)
 			return nil, err
 		}
+		capture.Finish(ctx, usage)
 	}
 
 	// Extract and save Codex usage snapshot from response headers (for OAuth accounts)
@@ -998,6 +1066,13 @@ func (s *OpenAIGatewayService) buildUpstreamRequest(ctx context.Context, c *gin.
 			}
 			targetURL = validatedURL + "/responses"
 		}
+	case AccountTypeAzure:
+		// Azure OpenAI 的 Responses API 按请求体中的 model（部署名）路由
+		azureURL, err := s.azureTargetURL(account, "", "responses")
+		if err != nil {
+			return nil, err
+		}
+		targetURL = azureURL
 	default:
 		targetURL = openaiPlatformAPIURL
 	}
@@ -1008,7 +1083,11 @@ func (s *OpenAIGatewayService) buildUpstreamRequest(ctx context.Context, c *gin.
 	}
 
 	// Set authentication header
-	req.Header.Set("authorization", "Bearer "+token)
+	if account.IsAzureOpenAI() {
+		req.Header.Set(azureAPIKeyHeader, token)
+	} else {
+		req.Header.Set("authorization", "Bearer "+token)
+	}
 
 	// Set headers specific to OAuth accounts (ChatGPT internal API)
 	if account.Type == AccountTypeOAuth {
@@ -1715,7 +1794,10 @@ type OpenAIRecordUsageInput struct {
 }
 
 // RecordUsage records usage and deducts balance
-func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRecordUsageInput) error {
+func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRecordUsageInput) (err error) {
+	ctx, span := startRecordUsageSpan(ctx, input.Account, input.APIKey, input.Result.Model)
+	defer func() { tracing.End(span, err) }()
+
 	result := input.Result
 	apiKey := input.APIKey
 	user := input.User
@@ -1743,11 +1825,16 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		multiplier = apiKey.Group.RateMultiplier
 	}
 
-	cost, err := s.billingService.CalculateCost(result.Model, tokens, multiplier)
+	cost, err := s.billingService.CalculateCostForAccount(account, result.Model, tokens, multiplier)
 	if err != nil {
 		cost = &CostBreakdown{ActualCost: 0}
 	}
 
+	// 命中响应缓存：未请求上游，按配置比例折算费用
+	if result.ResponseCacheHit {
+		applyResponseCacheHitDiscount(cost, s.responseCache.HitCostMultiplier())
+	}
+
 	// Determine billing type
 	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 	billingType := BillingTypeBalance
@@ -1781,6 +1868,8 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		Stream:                result.Stream,
 		DurationMs:            &durationMs,
 		FirstTokenMs:          result.FirstTokenMs,
+		ResponseCacheHit:      result.ResponseCacheHit,
+		RequestType:           result.RequestType,
 		CreatedAt:             time.Now(),
 	}
 
@@ -1802,6 +1891,12 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	observeUsageMetrics(account.Platform, usageLog)
+	// API Key TPM 计数（含简易模式）
+	if input.APIKeyService != nil {
+		input.APIKeyService.RecordTokenUsage(ctx, apiKey, usageLog.TotalTokens())
+	}
+
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -0,0 +1,449 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+)
+
+const (
+	opsNotificationRequestTimeout = 10 * time.Second
+	opsNotificationMaxAttempts    = 3
+	opsNotificationRetryBackoff   = time.Second
+	opsNotificationMaxTextLen     = 3800 // Telegram 单条消息上限 4096 字符，预留余量
+
+	opsNotificationTelegramAPIBase = "https://api.telegram.org"
+
+	// Webhook 签名头：HMAC-SHA256(secret, timestamp + "." + body)
+	opsWebhookTimestampHeader = "X-Sub2API-Timestamp"
+	opsWebhookSignatureHeader = "X-Sub2API-Signature"
+)
+
+var ErrOpsNotificationChannelNotFound = errors.New("notification channel not found")
+
+// OpsNotificationService 将告警与定时报告投递到邮件以外的通知渠道（Webhook/Telegram/钉钉/飞书）。
+// 渠道配置存储在 settings 表中，每个渠道独立限流与按严重级别过滤。
+type OpsNotificationService struct {
+	opsService *OpsService
+
+	httpClient      *http.Client
+	telegramAPIBase string
+	retryBackoff    time.Duration
+
+	limitersMu sync.Mutex
+	limiters   map[string]*slidingWindowLimiter
+}
+
+// opsNotification 是渠道无关的消息内容。
+type opsNotification struct {
+	Event    string // ops_alert / ops_report / test
+	Title    string
+	Severity string // critical/warning/info（仅告警）
+	Text     string
+	Data     map[string]any
+}
+
+func NewOpsNotificationService(opsService *OpsService) *OpsNotificationService {
+	client, err := httpclient.GetClient(httpclient.Options{Timeout: opsNotificationRequestTimeout})
+	if err != nil {
+		client = &http.Client{Timeout: opsNotificationRequestTimeout}
+	}
+	return &OpsNotificationService{
+		opsService:      opsService,
+		httpClient:      client,
+		telegramAPIBase: opsNotificationTelegramAPIBase,
+		retryBackoff:    opsNotificationRetryBackoff,
+		limiters:        map[string]*slidingWindowLimiter{},
+	}
+}
+
+// NotifyAlert 将告警事件投递到所有启用且满足严重级别过滤的渠道，返回成功投递的渠道数。
+func (s *OpsNotificationService) NotifyAlert(ctx context.Context, rule *OpsAlertRule, event *OpsAlertEvent) int {
+	if s == nil || rule == nil || event == nil {
+		return 0
+	}
+	msg := &opsNotification{
+		Event:    "ops_alert",
+		Title:    fmt.Sprintf("[Ops Alert][%s] %s", strings.TrimSpace(rule.Severity), strings.TrimSpace(rule.Name)),
+		Severity: opsEmailSeverityForOps(rule.Severity),
+		Text:     buildOpsAlertNotificationText(rule, event),
+		Data: map[string]any{
+			"event_id":        event.ID,
+			"rule_id":         rule.ID,
+			"rule_name":       rule.Name,
+			"rule_severity":   rule.Severity,
+			"status":          event.Status,
+			"metric_type":     rule.MetricType,
+			"operator":        rule.Operator,
+			"metric_value":    event.MetricValue,
+			"threshold_value": event.ThresholdValue,
+			"description":     event.Description,
+			"dimensions":      event.Dimensions,
+			"fired_at":        event.FiredAt.UTC().Format(time.RFC3339),
+		},
+	}
+	return s.dispatch(ctx, msg, func(ch *OpsNotificationChannel) bool {
+		return ch.NotifyAlerts && shouldSendOpsAlertEmailByMinSeverity(ch.MinSeverity, rule.Severity)
+	})
+}
+
+// NotifyReport 将定时报告投递到所有开启报告推送的渠道，返回成功投递的渠道数。
+func (s *OpsNotificationService) NotifyReport(ctx context.Context, report *opsScheduledReport, title, contentHTML string) int {
+	if s == nil || report == nil {
+		return 0
+	}
+	msg := &opsNotification{
+		Event: "ops_report",
+		Title: title,
+		Text:  opsHTMLToText(contentHTML),
+		Data: map[string]any{
+			"report_type": report.ReportType,
+			"report_name": report.Name,
+			"html":        contentHTML,
+		},
+	}
+	return s.dispatch(ctx, msg, func(ch *OpsNotificationChannel) bool {
+		return ch.NotifyReports
+	})
+}
+
+// SendTest 向指定渠道发送测试消息（忽略启用状态、过滤条件与限流）。
+func (s *OpsNotificationService) SendTest(ctx context.Context, channelID string) error {
+	if s == nil || s.opsService == nil {
+		return errors.New("ops notification service not initialized")
+	}
+	cfg, err := s.opsService.loadNotificationChannelsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	channelID = strings.TrimSpace(channelID)
+	for i := range cfg.Channels {
+		ch := &cfg.Channels[i]
+		if ch.ID != channelID {
+			continue
+		}
+		msg := &opsNotification{
+			Event:    "test",
+			Title:    "[Ops] Test notification",
+			Severity: "info",
+			Text:     fmt.Sprintf("This is a test message for channel %q sent at %s.", ch.Name, time.Now().UTC().Format(time.RFC3339)),
+		}
+		return s.deliverWithRetry(ctx, ch, msg)
+	}
+	return ErrOpsNotificationChannelNotFound
+}
+
+func (s *OpsNotificationService) dispatch(ctx context.Context, msg *opsNotification, filter func(ch *OpsNotificationChannel) bool) int {
+	if s.opsService == nil {
+		return 0
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg, err := s.opsService.loadNotificationChannelsConfig(ctx)
+	if err != nil || cfg == nil {
+		return 0
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sent int
+	)
+	for i := range cfg.Channels {
+		ch := &cfg.Channels[i]
+		if !ch.Enabled || !filter(ch) {
+			continue
+		}
+		if !s.limiterFor(ch).Allow(time.Now().UTC()) {
+			continue
+		}
+		wg.Add(1)
+		go func(ch *OpsNotificationChannel) {
+			defer wg.Done()
+			if err := s.deliverWithRetry(ctx, ch, msg); err != nil {
+				log.Printf("[OpsNotification] deliver failed (channel=%s type=%s): %v", ch.ID, ch.Type, err)
+				return
+			}
+			mu.Lock()
+			sent++
+			mu.Unlock()
+		}(ch)
+	}
+	wg.Wait()
+	return sent
+}
+
+func (s *OpsNotificationService) limiterFor(ch *OpsNotificationChannel) *slidingWindowLimiter {
+	s.limitersMu.Lock()
+	defer s.limitersMu.Unlock()
+	limiter, ok := s.limiters[ch.ID]
+	if !ok {
+		limiter = newSlidingWindowLimiter(0, time.Hour)
+		s.limiters[ch.ID] = limiter
+	}
+	limiter.SetLimit(ch.RateLimitPerHour)
+	return limiter
+}
+
+// opsNotificationError 标记投递错误是否值得重试（网络错误、429、5xx）。
+type opsNotificationError struct {
+	err       error
+	retryable bool
+}
+
+func (e *opsNotificationError) Error() string { return e.err.Error() }
+func (e *opsNotificationError) Unwrap() error { return e.err }
+
+func (s *OpsNotificationService) deliverWithRetry(ctx context.Context, ch *OpsNotificationChannel, msg *opsNotification) error {
+	var lastErr error
+	for attempt := 0; attempt < opsNotificationMaxAttempts; attempt++ {
+		if attempt > 0 {
+			backoff := s.retryBackoff * time.Duration(1<<(attempt-1))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+		lastErr = s.deliver(ctx, ch, msg)
+		if lastErr == nil {
+			return nil
+		}
+		var nErr *opsNotificationError
+		if errors.As(lastErr, &nErr) && !nErr.retryable {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+func (s *OpsNotificationService) deliver(ctx context.Context, ch *OpsNotificationChannel, msg *opsNotification) error {
+	req, err := buildOpsNotificationRequest(ctx, ch, msg, time.Now(), s.telegramAPIBase)
+	if err != nil {
+		return &opsNotificationError{err: err}
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return &opsNotificationError{err: err, retryable: true}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	return checkOpsNotificationResponse(ch.Type, resp.StatusCode, body)
+}
+
+func buildOpsNotificationRequest(ctx context.Context, ch *OpsNotificationChannel, msg *opsNotification, now time.Time, telegramAPIBase string) (*http.Request, error) {
+	text := truncateString(msg.Title+"\n\n"+msg.Text, opsNotificationMaxTextLen)
+
+	var (
+		target  = ch.URL
+		payload any
+		headers = map[string]string{}
+	)
+	switch ch.Type {
+	case OpsNotificationChannelWebhook:
+		payload = map[string]any{
+			"event":     msg.Event,
+			"title":     msg.Title,
+			"severity":  msg.Severity,
+			"text":      msg.Text,
+			"timestamp": now.UTC().Format(time.RFC3339),
+			"data":      msg.Data,
+		}
+	case OpsNotificationChannelTelegram:
+		target = strings.TrimRight(telegramAPIBase, "/") + "/bot" + ch.BotToken + "/sendMessage"
+		payload = map[string]any{
+			"chat_id":                  ch.ChatID,
+			"text":                     text,
+			"disable_web_page_preview": true,
+		}
+	case OpsNotificationChannelDingTalk:
+		if ch.Secret != "" {
+			ts := strconv.FormatInt(now.UnixMilli(), 10)
+			signed, err := appendOpsQuery(ch.URL, url.Values{"timestamp": {ts}, "sign": {dingTalkSign(ts, ch.Secret)}})
+			if err != nil {
+				return nil, err
+			}
+			target = signed
+		}
+		payload = map[string]any{
+			"msgtype": "text",
+			"text":    map[string]any{"content": text},
+		}
+	case OpsNotificationChannelFeishu:
+		body := map[string]any{
+			"msg_type": "text",
+			"content":  map[string]any{"text": text},
+		}
+		if ch.Secret != "" {
+			ts := strconv.FormatInt(now.Unix(), 10)
+			body["timestamp"] = ts
+			body["sign"] = feishuSign(ts, ch.Secret)
+		}
+		payload = body
+	default:
+		return nil, fmt.Errorf("unsupported channel type: %s", ch.Type)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if ch.Type == OpsNotificationChannelWebhook {
+		for k, v := range ch.Headers {
+			headers[k] = v
+		}
+		if ch.Secret != "" {
+			ts := strconv.FormatInt(now.Unix(), 10)
+			headers[opsWebhookTimestampHeader] = ts
+			headers[opsWebhookSignatureHeader] = "sha256=" + webhookSign(ts, raw, ch.Secret)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func checkOpsNotificationResponse(channelType string, status int, body []byte) error {
+	if status == http.StatusTooManyRequests || status >= 500 {
+		return &opsNotificationError{err: fmt.Errorf("upstream status %d: %s", status, truncateString(string(body), 256)), retryable: true}
+	}
+	if status < 200 || status >= 300 {
+		return &opsNotificationError{err: fmt.Errorf("upstream status %d: %s", status, truncateString(string(body), 256))}
+	}
+
+	// 钉钉/飞书/Telegram 在 HTTP 200 下通过响应体返回业务错误
+	var parsed struct {
+		ErrCode     *int   `json:"errcode"`
+		ErrMsg      string `json:"errmsg"`
+		Code        *int   `json:"code"`
+		Msg         string `json:"msg"`
+		OK          *bool  `json:"ok"`
+		Description string `json:"description"`
+	}
+	switch channelType {
+	case OpsNotificationChannelDingTalk, OpsNotificationChannelFeishu, OpsNotificationChannelTelegram:
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	switch {
+	case parsed.ErrCode != nil && *parsed.ErrCode != 0:
+		return &opsNotificationError{err: fmt.Errorf("dingtalk error %d: %s", *parsed.ErrCode, parsed.ErrMsg)}
+	case parsed.Code != nil && *parsed.Code != 0:
+		return &opsNotificationError{err: fmt.Errorf("feishu error %d: %s", *parsed.Code, parsed.Msg)}
+	case parsed.OK != nil && !*parsed.OK:
+		return &opsNotificationError{err: fmt.Errorf("telegram error: %s", parsed.Description)}
+	}
+	return nil
+}
+
+// webhookSign 计算通用 Webhook 签名：hex(HMAC-SHA256(secret, timestamp + "." + body))。
+func webhookSign(timestamp string, body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// dingTalkSign 钉钉机器人加签：base64(HMAC-SHA256(secret, timestamp + "\n" + secret))。
+func dingTalkSign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp + "\n" + secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// feishuSign 飞书机器人签名校验：以 timestamp + "\n" + secret 为密钥对空串做 HMAC-SHA256。
+func feishuSign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func appendOpsQuery(raw string, values url.Values) (string, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	q := parsed.Query()
+	for k, vs := range values {
+		for _, v := range vs {
+			q.Set(k, v)
+		}
+	}
+	parsed.RawQuery = q.Encode()
+	return parsed.String(), nil
+}
+
+func buildOpsAlertNotificationText(rule *OpsAlertRule, event *OpsAlertEvent) string {
+	value := "-"
+	threshold := fmt.Sprintf("%.2f", rule.Threshold)
+	if event.MetricValue != nil {
+		value = fmt.Sprintf("%.2f", *event.MetricValue)
+	}
+	if event.ThresholdValue != nil {
+		threshold = fmt.Sprintf("%.2f", *event.ThresholdValue)
+	}
+	lines := []string{
+		"Rule: " + strings.TrimSpace(rule.Name),
+		"Severity: " + strings.TrimSpace(rule.Severity),
+		"Status: " + strings.TrimSpace(event.Status),
+		fmt.Sprintf("Metric: %s %s %s (threshold %s)", strings.TrimSpace(rule.MetricType), strings.TrimSpace(rule.Operator), value, threshold),
+		"Fired at: " + event.FiredAt.UTC().Format(time.RFC3339),
+	}
+	if desc := strings.TrimSpace(event.Description); desc != "" {
+		lines = append(lines, "Description: "+desc)
+	}
+	return strings.Join(lines, "\n")
+}
+
+var (
+	opsHTMLBreakRe     = regexp.MustCompile(`(?i)<br\s*/?>|</(p|h[1-6]|li|tr|ul|table|thead|tbody)>`)
+	opsHTMLListItemRe  = regexp.MustCompile(`(?i)<li[^>]*>`)
+	opsHTMLCellRe      = regexp.MustCompile(`(?i)</t[dh]>`)
+	opsHTMLTagRe       = regexp.MustCompile(`<[^>]*>`)
+	opsHTMLBlankLineRe = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// opsHTMLToText 将报告邮件 HTML 转为聊天渠道可读的纯文本。
+func opsHTMLToText(s string) string {
+	s = opsHTMLListItemRe.ReplaceAllString(s, "- ")
+	s = opsHTMLCellRe.ReplaceAllString(s, " | ")
+	s = opsHTMLBreakRe.ReplaceAllString(s, "\n")
+	s = opsHTMLTagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(strings.TrimSpace(line), " |")
+	}
+	s = strings.Join(lines, "\n")
+	s = opsHTMLBlankLineRe.ReplaceAllString(s, "\n")
+	return strings.TrimSpace(s)
+}
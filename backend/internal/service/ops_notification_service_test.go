@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// opsChannelSettingRepoStub 仅支持 GetValue/Set，其余方法不会被通知服务调用
+type opsChannelSettingRepoStub struct {
+	SettingRepository
+	values map[string]string
+}
+
+func (s *opsChannelSettingRepoStub) Set(_ context.Context, key, value string) error {
+	s.values[key] = value
+	return nil
+}
+
+func (s *opsChannelSettingRepoStub) GetValue(_ context.Context, key string) (string, error) {
+	if v, ok := s.values[key]; ok {
+		return v, nil
+	}
+	return "", ErrSettingNotFound
+}
+
+func newTestOpsNotificationService(t *testing.T, channels []OpsNotificationChannel) *OpsNotificationService {
+	t.Helper()
+	raw, err := json.Marshal(OpsNotificationChannelsConfig{Channels: channels})
+	require.NoError(t, err)
+	opsService := &OpsService{settingRepo: &opsChannelSettingRepoStub{values: map[string]string{
+		SettingKeyOpsNotificationChannels: string(raw),
+	}}}
+	svc := NewOpsNotificationService(opsService)
+	svc.retryBackoff = time.Millisecond
+	return svc
+}
+
+func TestOpsNotificationService_WebhookSignedAndFilteredBySeverity(t *testing.T) {
+	var received atomic.Int32
+	var gotBody []byte
+	var gotTS, gotSig string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		gotBody, _ = io.ReadAll(r.Body)
+		gotTS = r.Header.Get(opsWebhookTimestampHeader)
+		gotSig = r.Header.Get(opsWebhookSignatureHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	svc := newTestOpsNotificationService(t, []OpsNotificationChannel{
+		{ID: "wh", Type: OpsNotificationChannelWebhook, Enabled: true, NotifyAlerts: true, MinSeverity: "warning", URL: srv.URL, Secret: "s3cret"},
+		{ID: "off", Type: OpsNotificationChannelWebhook, Enabled: false, NotifyAlerts: true, URL: srv.URL},
+	})
+
+	rule := &OpsAlertRule{ID: 1, Name: "error rate", Severity: "P1", MetricType: "error_rate", Operator: ">", Threshold: 5}
+	event := &OpsAlertEvent{ID: 9, Status: OpsAlertStatusFiring, FiredAt: time.Now()}
+
+	require.Equal(t, 1, svc.NotifyAlert(context.Background(), rule, event))
+	require.EqualValues(t, 1, received.Load())
+	require.Equal(t, "sha256="+webhookSign(gotTS, gotBody, "s3cret"), gotSig)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, "ops_alert", payload["event"])
+	require.Equal(t, "warning", payload["severity"])
+
+	// P2 maps to info, below the channel's min severity.
+	rule.Severity = "P2"
+	require.Equal(t, 0, svc.NotifyAlert(context.Background(), rule, event))
+	require.EqualValues(t, 1, received.Load())
+}
+
+func TestOpsNotificationService_RetryAndRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer srv.Close()
+
+	svc := newTestOpsNotificationService(t, []OpsNotificationChannel{
+		{ID: "dt", Type: OpsNotificationChannelDingTalk, Enabled: true, NotifyReports: true, RateLimitPerHour: 1, URL: srv.URL},
+	})
+	report := &opsScheduledReport{Name: "日报", ReportType: "daily_summary"}
+
+	require.Equal(t, 1, svc.NotifyReport(context.Background(), report, "[Ops Report] 日报", "<h2>日报</h2>"))
+	require.EqualValues(t, 2, calls.Load())
+
+	// Second report within the hour is dropped by the per-channel limiter.
+	require.Equal(t, 0, svc.NotifyReport(context.Background(), report, "[Ops Report] 日报", "<h2>日报</h2>"))
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestOpsNotificationService_SendTestReportsBusinessError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`))
+	}))
+	defer srv.Close()
+
+	svc := newTestOpsNotificationService(t, []OpsNotificationChannel{
+		{ID: "fs", Type: OpsNotificationChannelFeishu, URL: srv.URL, Secret: "x"},
+	})
+	err := svc.SendTest(context.Background(), "fs")
+	require.ErrorContains(t, err, "feishu error 19021")
+	require.ErrorIs(t, svc.SendTest(context.Background(), "missing"), ErrOpsNotificationChannelNotFound)
+}
+
+func TestBuildOpsNotificationRequest_Signing(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+	msg := &opsNotification{Event: "test", Title: "t", Text: "body"}
+
+	req, err := buildOpsNotificationRequest(context.Background(), &OpsNotificationChannel{
+		Type: OpsNotificationChannelDingTalk, URL: "https://oapi.dingtalk.com/robot/send?access_token=abc", Secret: "SEC",
+	}, msg, now, opsNotificationTelegramAPIBase)
+	require.NoError(t, err)
+	require.Equal(t, "abc", req.URL.Query().Get("access_token"))
+	require.Equal(t, "1700000000123", req.URL.Query().Get("timestamp"))
+	require.Equal(t, dingTalkSign("1700000000123", "SEC"), req.URL.Query().Get("sign"))
+
+	req, err = buildOpsNotificationRequest(context.Background(), &OpsNotificationChannel{
+		Type: OpsNotificationChannelFeishu, URL: "https://open.feishu.cn/open-apis/bot/v2/hook/x", Secret: "SEC",
+	}, msg, now, opsNotificationTelegramAPIBase)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(req.Body)
+	var feishu map[string]any
+	require.NoError(t, json.Unmarshal(body, &feishu))
+	require.Equal(t, "1700000000", feishu["timestamp"])
+	require.Equal(t, feishuSign("1700000000", "SEC"), feishu["sign"])
+
+	req, err = buildOpsNotificationRequest(context.Background(), &OpsNotificationChannel{
+		Type: OpsNotificationChannelTelegram, BotToken: "123:abc", ChatID: "-100",
+	}, msg, now, "https://tg.example")
+	require.NoError(t, err)
+	require.Equal(t, "https://tg.example/bot123:abc/sendMessage", req.URL.String())
+}
+
+func TestOpsHTMLToText(t *testing.T) {
+	in := `
+<h2>日报</h2>
+<ul>
+  <li><b>Total Requests</b>: 10</li>
+  <li><b>SLA</b>: 99.00%</li>
+</ul>
+<table><tr><td>a &amp; b</td><td>500</td></tr></table>`
+	require.Equal(t, "日报\n- Total Requests: 10\n- SLA: 99.00%\na & b | 500", opsHTMLToText(in))
+}
+
+func TestOpsNotificationChannelsConfig_ValidateAndRedact(t *testing.T) {
+	cfg := &OpsNotificationChannelsConfig{Channels: []OpsNotificationChannel{
+		{Type: " Telegram ", BotToken: "tok", ChatID: "1"},
+	}}
+	normalizeOpsNotificationChannelsConfig(cfg)
+	require.NoError(t, validateOpsNotificationChannelsConfig(cfg))
+	require.NotEmpty(t, cfg.Channels[0].ID)
+	require.Equal(t, OpsNotificationChannelTelegram, cfg.Channels[0].Type)
+
+	redacted := redactOpsNotificationChannelsConfig(cfg)
+	require.Empty(t, redacted.Channels[0].BotToken)
+	require.True(t, redacted.Channels[0].BotTokenConfigured)
+	require.Equal(t, "tok", cfg.Channels[0].BotToken)
+
+	require.Error(t, validateOpsNotificationChannelsConfig(&OpsNotificationChannelsConfig{Channels: []OpsNotificationChannel{
+		{ID: "a", Type: OpsNotificationChannelDingTalk, URL: "http://oapi.dingtalk.com/robot/send"},
+	}}))
+	require.Error(t, validateOpsNotificationChannelsConfig(&OpsNotificationChannelsConfig{Channels: []OpsNotificationChannel{
+		{ID: "a", Type: "slack", URL: "https://example.com"},
+	}}))
+}
+
+func TestOpsNotificationChannelsConfig_RedactsURLAndHeaders(t *testing.T) {
+	stored := []OpsNotificationChannel{
+		{ID: "wh", Type: OpsNotificationChannelWebhook, URL: "https://hooks.example.com/ops?token=t0k", Headers: map[string]string{"Authorization": "Bearer abc"}},
+		{ID: "dt", Type: OpsNotificationChannelDingTalk, URL: "https://oapi.dingtalk.com/robot/send?access_token=abc"},
+		{ID: "fs", Type: OpsNotificationChannelFeishu, URL: "https://open.feishu.cn/open-apis/bot/v2/hook/f5e1"},
+	}
+	raw, err := json.Marshal(OpsNotificationChannelsConfig{Channels: stored})
+	require.NoError(t, err)
+	repo := &opsChannelSettingRepoStub{values: map[string]string{SettingKeyOpsNotificationChannels: string(raw)}}
+	svc := &OpsService{settingRepo: repo}
+
+	got, err := svc.GetNotificationChannelsConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "https://hooks.example.com/ops?token=***", got.Channels[0].URL)
+	require.Equal(t, map[string]string{"Authorization": "***"}, got.Channels[0].Headers)
+	require.Equal(t, "https://oapi.dingtalk.com/robot/send?access_token=***", got.Channels[1].URL)
+	require.Equal(t, "https://open.feishu.cn/open-apis/bot/v2/hook/***", got.Channels[2].URL)
+
+	// 回传脱敏值时保留已存储的 URL 与请求头；新增的请求头与修改的 URL 正常保存
+	got.Channels[0].Headers["X-Extra"] = "1"
+	got.Channels[1].URL = "https://oapi.dingtalk.com/robot/send?access_token=new"
+	_, err = svc.UpdateNotificationChannelsConfig(context.Background(), got)
+	require.NoError(t, err)
+
+	saved, err := svc.loadNotificationChannelsConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, stored[0].URL, saved.Channels[0].URL)
+	require.Equal(t, map[string]string{"Authorization": "Bearer abc", "X-Extra": "1"}, saved.Channels[0].Headers)
+	require.Equal(t, "https://oapi.dingtalk.com/robot/send?access_token=new", saved.Channels[1].URL)
+	require.Equal(t, stored[2].URL, saved.Channels[2].URL)
+}
@@ -36,11 +36,12 @@ return 0
 `)
 
 type OpsScheduledReportService struct {
-	opsService   *OpsService
-	userService  *UserService
-	emailService *EmailService
-	redisClient  *redis.Client
-	cfg          *config.Config
+	opsService          *OpsService
+	userService         *UserService
+	emailService        *EmailService
+	notificationService *OpsNotificationService
+	redisClient         *redis.Client
+	cfg                 *config.Config
 
 	instanceID string
 	loc        *time.Location
@@ -59,6 +60,7 @@ func NewOpsScheduledReportService(
 	opsService *OpsService,
 	userService *UserService,
 	emailService *EmailService,
+	notificationService *OpsNotificationService,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsScheduledReportService {
@@ -71,11 +73,12 @@ func NewOpsScheduledReportService(
 		}
 	}
 	return &OpsScheduledReportService{
-		opsService:   opsService,
-		userService:  userService,
-		emailService: emailService,
-		redisClient:  redisClient,
-		cfg:          cfg,
+		opsService:          opsService,
+		userService:         userService,
+		emailService:        emailService,
+		notificationService: notificationService,
+		redisClient:         redisClient,
+		cfg:                 cfg,
 
 		instanceID:        uuid.NewString(),
 		loc:               loc,
@@ -325,6 +328,13 @@ func (s *OpsScheduledReportService) runReport(ctx context.Context, report *opsSc
 		return 0, nil
 	}
 
+	subject := fmt.Sprintf("[Ops Report] %s", strings.TrimSpace(report.Name))
+
+	attempts := 0
+	if s.notificationService != nil {
+		attempts += s.notificationService.NotifyReport(ctx, report, subject, content)
+	}
+
 	recipients := report.Recipients
 	if len(recipients) == 0 && s.userService != nil {
 		admin, err := s.userService.GetFirstAdmin(ctx)
@@ -332,13 +342,6 @@ func (s *OpsScheduledReportService) runReport(ctx context.Context, report *opsSc
 			recipients = []string{strings.TrimSpace(admin.Email)}
 		}
 	}
-	if len(recipients) == 0 {
-		return 0, nil
-	}
-
-	subject := fmt.Sprintf("[Ops Report] %s", strings.TrimSpace(report.Name))
-
-	attempts := 0
 	for _, to := range recipients {
 		addr := strings.TrimSpace(to)
 		if addr == "" {
@@ -4,10 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net/url"
 	"strings"
 	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+	"github.com/google/uuid"
 )
 
+// opsRedactedValue replaces channel credentials in admin API responses.
+const opsRedactedValue = "***"
+
 const (
 	opsAlertEvaluatorLeaderLockKeyDefault = "ops:alert:evaluator:leader"
 	opsAlertEvaluatorLeaderLockTTLDefault = 30 * time.Second
@@ -191,6 +199,228 @@ func validateOpsEmailNotificationConfig(cfg *OpsEmailNotificationConfig) error {
 	return nil
 }
 
+// =========================
+// Notification channels
+// =========================
+
+// GetNotificationChannelsConfig returns the channel registry for admin UI (secrets redacted).
+func (s *OpsService) GetNotificationChannelsConfig(ctx context.Context) (*OpsNotificationChannelsConfig, error) {
+	cfg, err := s.loadNotificationChannelsConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return redactOpsNotificationChannelsConfig(cfg), nil
+}
+
+// UpdateNotificationChannelsConfig replaces the channel registry.
+// Empty secret/bot_token keeps the value stored for the same channel id; so do
+// masked url/header values echoed back from GetNotificationChannelsConfig.
+func (s *OpsService) UpdateNotificationChannelsConfig(ctx context.Context, req *OpsNotificationChannelsConfig) (*OpsNotificationChannelsConfig, error) {
+	if s == nil || s.settingRepo == nil {
+		return nil, errors.New("setting repository not initialized")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if req == nil {
+		return nil, errors.New("invalid request")
+	}
+
+	existing, err := s.loadNotificationChannelsConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingByID := make(map[string]OpsNotificationChannel, len(existing.Channels))
+	for _, ch := range existing.Channels {
+		existingByID[ch.ID] = ch
+	}
+
+	cfg := &OpsNotificationChannelsConfig{Channels: make([]OpsNotificationChannel, 0, len(req.Channels))}
+	for _, ch := range req.Channels {
+		ch.ID = strings.TrimSpace(ch.ID)
+		if prev, ok := existingByID[ch.ID]; ok && ch.ID != "" {
+			if strings.TrimSpace(ch.Secret) == "" {
+				ch.Secret = prev.Secret
+			}
+			if strings.TrimSpace(ch.BotToken) == "" {
+				ch.BotToken = prev.BotToken
+			}
+			if prev.URL != "" && strings.TrimSpace(ch.URL) == redactOpsChannelURL(prev.URL) {
+				ch.URL = prev.URL
+			}
+			for k, v := range ch.Headers {
+				if prevValue, ok := prev.Headers[k]; ok && v == opsRedactedValue {
+					ch.Headers[k] = prevValue
+				}
+			}
+		}
+		cfg.Channels = append(cfg.Channels, ch)
+	}
+
+	normalizeOpsNotificationChannelsConfig(cfg)
+	if err := validateOpsNotificationChannelsConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.settingRepo.Set(ctx, SettingKeyOpsNotificationChannels, string(raw)); err != nil {
+		return nil, err
+	}
+	return redactOpsNotificationChannelsConfig(cfg), nil
+}
+
+// loadNotificationChannelsConfig returns the channel registry including secrets (for delivery).
+func (s *OpsService) loadNotificationChannelsConfig(ctx context.Context) (*OpsNotificationChannelsConfig, error) {
+	defaultCfg := &OpsNotificationChannelsConfig{Channels: []OpsNotificationChannel{}}
+	if s == nil || s.settingRepo == nil {
+		return defaultCfg, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	raw, err := s.settingRepo.GetValue(ctx, SettingKeyOpsNotificationChannels)
+	if err != nil {
+		if errors.Is(err, ErrSettingNotFound) {
+			return defaultCfg, nil
+		}
+		return nil, err
+	}
+
+	cfg := &OpsNotificationChannelsConfig{}
+	if err := json.Unmarshal([]byte(raw), cfg); err != nil {
+		// Corrupted JSON should not break ops UI; fall back to defaults.
+		return defaultCfg, nil
+	}
+	normalizeOpsNotificationChannelsConfig(cfg)
+	return cfg, nil
+}
+
+func normalizeOpsNotificationChannelsConfig(cfg *OpsNotificationChannelsConfig) {
+	if cfg == nil {
+		return
+	}
+	if cfg.Channels == nil {
+		cfg.Channels = []OpsNotificationChannel{}
+	}
+	for i := range cfg.Channels {
+		ch := &cfg.Channels[i]
+		ch.ID = strings.TrimSpace(ch.ID)
+		if ch.ID == "" {
+			ch.ID = uuid.NewString()
+		}
+		ch.Name = strings.TrimSpace(ch.Name)
+		ch.Type = strings.ToLower(strings.TrimSpace(ch.Type))
+		ch.MinSeverity = strings.ToLower(strings.TrimSpace(ch.MinSeverity))
+		ch.URL = strings.TrimSpace(ch.URL)
+		ch.Secret = strings.TrimSpace(ch.Secret)
+		ch.BotToken = strings.TrimSpace(ch.BotToken)
+		ch.ChatID = strings.TrimSpace(ch.ChatID)
+		// Only meaningful in API responses.
+		ch.SecretConfigured = false
+		ch.BotTokenConfigured = false
+	}
+}
+
+func validateOpsNotificationChannelsConfig(cfg *OpsNotificationChannelsConfig) error {
+	if cfg == nil {
+		return errors.New("invalid config")
+	}
+	seen := make(map[string]struct{}, len(cfg.Channels))
+	for i, ch := range cfg.Channels {
+		if _, ok := seen[ch.ID]; ok {
+			return fmt.Errorf("channels[%d].id is duplicated", i)
+		}
+		seen[ch.ID] = struct{}{}
+
+		if ch.RateLimitPerHour < 0 {
+			return fmt.Errorf("channels[%d].rate_limit_per_hour must be >= 0", i)
+		}
+		switch ch.MinSeverity {
+		case "", "critical", "warning", "info":
+		default:
+			return fmt.Errorf("channels[%d].min_severity must be one of: critical, warning, info, or empty", i)
+		}
+
+		switch ch.Type {
+		case OpsNotificationChannelWebhook:
+			// Internal receivers are commonly plain HTTP.
+			if _, err := urlvalidator.ValidateURLFormat(ch.URL, true); err != nil {
+				return fmt.Errorf("channels[%d].url: %w", i, err)
+			}
+		case OpsNotificationChannelDingTalk, OpsNotificationChannelFeishu:
+			if _, err := urlvalidator.ValidateURLFormat(ch.URL, false); err != nil {
+				return fmt.Errorf("channels[%d].url: %w", i, err)
+			}
+		case OpsNotificationChannelTelegram:
+			if ch.BotToken == "" {
+				return fmt.Errorf("channels[%d].bot_token is required", i)
+			}
+			if ch.ChatID == "" {
+				return fmt.Errorf("channels[%d].chat_id is required", i)
+			}
+		default:
+			return fmt.Errorf("channels[%d].type must be one of: webhook, telegram, dingtalk, feishu", i)
+		}
+	}
+	return nil
+}
+
+func redactOpsNotificationChannelsConfig(cfg *OpsNotificationChannelsConfig) *OpsNotificationChannelsConfig {
+	out := &OpsNotificationChannelsConfig{Channels: make([]OpsNotificationChannel, 0)}
+	if cfg == nil {
+		return out
+	}
+	for _, ch := range cfg.Channels {
+		ch.SecretConfigured = ch.Secret != ""
+		ch.BotTokenConfigured = ch.BotToken != ""
+		ch.Secret = ""
+		ch.BotToken = ""
+		ch.URL = redactOpsChannelURL(ch.URL)
+		if len(ch.Headers) > 0 {
+			headers := make(map[string]string, len(ch.Headers))
+			for k := range ch.Headers {
+				headers[k] = opsRedactedValue
+			}
+			ch.Headers = headers
+		}
+		out.Channels = append(out.Channels, ch)
+	}
+	return out
+}
+
+// redactOpsChannelURL masks credentials embedded in channel URLs: query values
+// (dingtalk access_token, webhook tokens), userinfo and the feishu hook token path segment.
+func redactOpsChannelURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	hasUserInfo := u.User != nil
+	u.User = nil
+	if prefix, _, ok := strings.Cut(u.Path, "/hook/"); ok {
+		u.Path = prefix + "/hook/" + opsRedactedValue
+		u.RawPath = u.Path
+	}
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		for i, p := range params {
+			if key, _, ok := strings.Cut(p, "="); ok {
+				params[i] = key + "=" + opsRedactedValue
+			}
+		}
+		u.RawQuery = strings.Join(params, "&")
+	}
+	out := u.String()
+	if hasUserInfo {
+		out = strings.Replace(out, "://", "://"+opsRedactedValue+"@", 1)
+	}
+	return out
+}
+
 // =========================
 // Alert runtime settings
 // =========================
@@ -38,6 +38,46 @@ type OpsEmailNotificationConfigUpdateRequest struct {
 	Report *OpsEmailReportConfig `json:"report"`
 }
 
+// Notification channel types.
+const (
+	OpsNotificationChannelWebhook  = "webhook"
+	OpsNotificationChannelTelegram = "telegram"
+	OpsNotificationChannelDingTalk = "dingtalk"
+	OpsNotificationChannelFeishu   = "feishu"
+)
+
+// OpsNotificationChannelsConfig is the registry of non-email notification channels.
+type OpsNotificationChannelsConfig struct {
+	Channels []OpsNotificationChannel `json:"channels"`
+}
+
+type OpsNotificationChannel struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+
+	// Alert/report toggles and filters.
+	NotifyAlerts     bool   `json:"notify_alerts"`
+	NotifyReports    bool   `json:"notify_reports"`
+	MinSeverity      string `json:"min_severity"`
+	RateLimitPerHour int    `json:"rate_limit_per_hour"`
+
+	// URL is the target for webhook/dingtalk/feishu channels.
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Secret signs webhook payloads (HMAC-SHA256) and dingtalk/feishu robot requests.
+	Secret string `json:"secret,omitempty"`
+
+	// Telegram bot settings.
+	BotToken string `json:"bot_token,omitempty"`
+	ChatID   string `json:"chat_id,omitempty"`
+
+	// Read-only flags returned to admin UI in place of secrets.
+	SecretConfigured   bool `json:"secret_configured"`
+	BotTokenConfigured bool `json:"bot_token_configured"`
+}
+
 type OpsDistributedLockSettings struct {
 	Enabled    bool   `json:"enabled"`
 	Key        string `json:"key"`
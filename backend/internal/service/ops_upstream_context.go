@@ -62,7 +62,7 @@ type OpsUpstreamErrorEvent struct {
 	// Best-effort upstream response capture (sanitized+trimmed).
 	UpstreamResponseBody string `json:"upstream_response_body,omitempty"`
 
-	// Kind: http_error | request_error | retry_exhausted | failover
+	// Kind: http_error | request_error | retry_exhausted | failover | hedge_canceled | stream_interrupted | stream_recovered
 	Kind string `json:"kind,omitempty"`
 
 	Message string `json:"message,omitempty"`
@@ -107,6 +107,7 @@ func appendOpsUpstreamError(c *gin.Context, ev OpsUpstreamErrorEvent) {
 	evCopy := ev
 	existing = append(existing, &evCopy)
 	c.Set(OpsUpstreamErrorsKey, existing)
+	recordUpstreamErrorMetric(&evCopy)
 
 	checkSkipMonitoringForUpstreamEvent(c, &evCopy)
 }
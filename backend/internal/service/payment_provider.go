@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Payment provider names
+const (
+	PaymentProviderEPay   = "epay"
+	PaymentProviderStripe = "stripe"
+)
+
+// PaymentProvider 支付渠道抽象：创建收银台、校验回调签名、发起退款
+type PaymentProvider interface {
+	// Name 渠道标识（同时也是回调路径 /api/v1/payments/callback/{name} 的最后一段）
+	Name() string
+	// CreateCheckout 为订单创建支付链接
+	CreateCheckout(ctx context.Context, order *PaymentOrder, req *PaymentCheckoutRequest) (*PaymentCheckout, error)
+	// VerifyCallback 校验回调签名并解析支付结果；返回 nil 表示与支付无关的通知（直接应答即可）
+	VerifyCallback(ctx context.Context, req *PaymentCallbackRequest) (*PaymentCallbackResult, error)
+	// Refund 全额退款
+	Refund(ctx context.Context, order *PaymentOrder) error
+	// CallbackAck 回调处理成功后应答给渠道的响应体
+	CallbackAck() string
+}
+
+// PaymentCheckoutRequest 创建收银台所需的上下文信息
+type PaymentCheckoutRequest struct {
+	Subject   string
+	NotifyURL string
+	ReturnURL string
+	ClientIP  string
+}
+
+// PaymentCheckout 收银台信息
+type PaymentCheckout struct {
+	PayURL string
+	// ProviderRef 渠道侧的会话/预下单标识（可选）
+	ProviderRef string
+}
+
+// PaymentCallbackRequest 原始回调请求（handler 负责读取 body 与解析参数）
+type PaymentCallbackRequest struct {
+	Header http.Header
+	// Params 合并后的 query 与 form 参数
+	Params  url.Values
+	RawBody []byte
+}
+
+// PaymentCallbackResult 渠道回调解析结果
+type PaymentCallbackResult struct {
+	OrderNo string
+	TradeNo string
+	// Amount 实付金额（支付币种）；必须为正且与订单金额一致才会履约
+	Amount float64
+	// Currency 实付币种（ISO 4217 大写）；必须与订单币种一致才会履约
+	Currency string
+	Paid     bool
+}
+
+// PaymentProviderRegistry 已启用的支付渠道集合（按注册顺序展示）
+type PaymentProviderRegistry struct {
+	providers map[string]PaymentProvider
+	names     []string
+}
+
+// NewPaymentProviderRegistry 创建支付渠道集合，忽略 nil 与重名渠道
+func NewPaymentProviderRegistry(providers ...PaymentProvider) *PaymentProviderRegistry {
+	r := &PaymentProviderRegistry{providers: make(map[string]PaymentProvider, len(providers))}
+	for _, p := range providers {
+		if p == nil {
+			continue
+		}
+		name := p.Name()
+		if _, exists := r.providers[name]; exists {
+			continue
+		}
+		r.providers[name] = p
+		r.names = append(r.names, name)
+	}
+	return r
+}
+
+// Get 按名称获取渠道
+func (r *PaymentProviderRegistry) Get(name string) (PaymentProvider, bool) {
+	if r == nil {
+		return nil, false
+	}
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Names 返回已启用渠道名称
+func (r *PaymentProviderRegistry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	return append([]string(nil), r.names...)
+}
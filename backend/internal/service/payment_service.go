@@ -0,0 +1,579 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrPaymentDisabled            = infraerrors.Forbidden("PAYMENT_DISABLED", "online payment is not enabled")
+	ErrPaymentProviderUnavailable = infraerrors.BadRequest("PAYMENT_PROVIDER_UNAVAILABLE", "payment provider is not available")
+	ErrPaymentInvalidAmount       = infraerrors.BadRequest("PAYMENT_INVALID_AMOUNT", "payment amount is out of range")
+	ErrPaymentPlanNotFound        = infraerrors.NotFound("PAYMENT_PLAN_NOT_FOUND", "subscription plan not found")
+	ErrPaymentOrderNotFound       = infraerrors.NotFound("PAYMENT_ORDER_NOT_FOUND", "payment order not found")
+	ErrPaymentOrderStatusChanged  = infraerrors.Conflict("PAYMENT_ORDER_STATUS_CHANGED", "payment order status has changed")
+	ErrPaymentOrderNotPayable     = infraerrors.Conflict("PAYMENT_ORDER_NOT_PAYABLE", "payment order is not payable")
+	ErrPaymentOrderNotRefundable  = infraerrors.Conflict("PAYMENT_ORDER_NOT_REFUNDABLE", "only paid orders can be refunded")
+	ErrPaymentOrderNotVoidable    = infraerrors.Conflict("PAYMENT_ORDER_NOT_VOIDABLE", "only pending orders can be voided")
+	ErrPaymentAmountMismatch      = infraerrors.BadRequest("PAYMENT_AMOUNT_MISMATCH", "paid amount does not match order amount")
+	ErrPaymentCurrencyMismatch    = infraerrors.BadRequest("PAYMENT_CURRENCY_MISMATCH", "paid currency does not match order currency")
+	ErrPaymentInvalidSignature    = infraerrors.Unauthorized("PAYMENT_INVALID_SIGNATURE", "invalid payment callback signature")
+	ErrPaymentRefundUnsupported   = infraerrors.BadRequest("PAYMENT_REFUND_UNSUPPORTED", "payment provider does not support refunds")
+)
+
+// paymentAmountEpsilon 回调金额与订单金额的允许误差（分以下的精度差异）
+const paymentAmountEpsilon = 0.005
+
+// PaymentOrder 支付订单
+type PaymentOrder struct {
+	ID              int64
+	OrderNo         string
+	UserID          int64
+	Type            string
+	Provider        string
+	Status          string
+	Amount          float64
+	Currency        string
+	CreditAmount    float64
+	PlanID          *string
+	GroupID         *int64
+	ValidityDays    int
+	SubscriptionID  *int64
+	ProviderTradeNo *string
+	PayURL          *string
+	ClientIP        string
+	Notes           *string
+	PaidAt          *time.Time
+	RefundedAt      *time.Time
+	VoidedAt        *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// PaymentOrderFilters 订单列表过滤条件
+type PaymentOrderFilters struct {
+	UserID   int64
+	Status   string
+	Type     string
+	Provider string
+	OrderNo  string
+}
+
+// PaymentOrderRepository 支付订单仓储
+type PaymentOrderRepository interface {
+	Create(ctx context.Context, order *PaymentOrder) error
+	GetByID(ctx context.Context, id int64) (*PaymentOrder, error)
+	GetByOrderNo(ctx context.Context, orderNo string) (*PaymentOrder, error)
+	UpdateCheckout(ctx context.Context, id int64, payURL, providerRef string) error
+	// MarkPaid 仅当订单仍为 pending 时标记为已支付，否则返回 ErrPaymentOrderStatusChanged
+	MarkPaid(ctx context.Context, id int64, tradeNo string, paidAt time.Time) error
+	SetSubscriptionID(ctx context.Context, id, subscriptionID int64) error
+	// MarkRefunding 仅当订单为 paid 时标记为退款中（渠道退款期间占位），否则返回 ErrPaymentOrderStatusChanged
+	MarkRefunding(ctx context.Context, id int64) error
+	// RevertRefunding 渠道退款失败时将退款中的订单恢复为 paid，否则返回 ErrPaymentOrderStatusChanged
+	RevertRefunding(ctx context.Context, id int64) error
+	// MarkRefunded 仅当订单为 refunding 时标记为已退款，否则返回 ErrPaymentOrderStatusChanged
+	MarkRefunded(ctx context.Context, id int64, refundedAt time.Time, notes string) error
+	// MarkVoided 仅当订单为 pending 时作废，否则返回 ErrPaymentOrderStatusChanged
+	MarkVoided(ctx context.Context, id int64, voidedAt time.Time, notes string) error
+	List(ctx context.Context, params pagination.PaginationParams, filters PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error)
+}
+
+// PaymentPlan 可购买的订阅套餐
+type PaymentPlan struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	GroupID      int64   `json:"group_id"`
+	ValidityDays int     `json:"validity_days"`
+	Price        float64 `json:"price"`
+}
+
+// PaymentOptions 用户端展示的支付配置
+type PaymentOptions struct {
+	Enabled    bool          `json:"enabled"`
+	Currency   string        `json:"currency"`
+	CreditRate float64       `json:"credit_rate"`
+	MinAmount  float64       `json:"min_amount"`
+	MaxAmount  float64       `json:"max_amount"`
+	Providers  []string      `json:"providers"`
+	Plans      []PaymentPlan `json:"plans"`
+}
+
+// CreatePaymentOrderInput 创建订单参数
+type CreatePaymentOrderInput struct {
+	UserID   int64
+	Type     string
+	Provider string
+	// Amount 余额充值金额（支付币种）
+	Amount float64
+	// PlanID 订阅套餐 ID
+	PlanID   string
+	ClientIP string
+}
+
+// PaymentService 在线支付服务：下单、回调履约、退款与作废
+type PaymentService struct {
+	orderRepo            PaymentOrderRepository
+	userRepo             UserRepository
+	subscriptionService  *SubscriptionService
+	billingCacheService  *BillingCacheService
+	entClient            *dbent.Client
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	providers            *PaymentProviderRegistry
+	cfg                  *config.PaymentConfig
+}
+
+// NewPaymentService 创建支付服务
+func NewPaymentService(
+	orderRepo PaymentOrderRepository,
+	userRepo UserRepository,
+	subscriptionService *SubscriptionService,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	providers *PaymentProviderRegistry,
+	cfg *config.Config,
+) *PaymentService {
+	var paymentCfg *config.PaymentConfig
+	if cfg != nil {
+		paymentCfg = &cfg.Payment
+	}
+	return &PaymentService{
+		orderRepo:            orderRepo,
+		userRepo:             userRepo,
+		subscriptionService:  subscriptionService,
+		billingCacheService:  billingCacheService,
+		entClient:            entClient,
+		authCacheInvalidator: authCacheInvalidator,
+		providers:            providers,
+		cfg:                  paymentCfg,
+	}
+}
+
+// IsEnabled 是否开启在线支付
+func (s *PaymentService) IsEnabled() bool {
+	return s != nil && s.cfg != nil && s.cfg.Enabled
+}
+
+// Options 返回用户端可见的支付配置
+func (s *PaymentService) Options() *PaymentOptions {
+	if !s.IsEnabled() {
+		return &PaymentOptions{Enabled: false, Providers: []string{}, Plans: []PaymentPlan{}}
+	}
+	plans := make([]PaymentPlan, 0, len(s.cfg.Plans))
+	for _, p := range s.cfg.Plans {
+		plans = append(plans, paymentPlanFromConfig(p))
+	}
+	return &PaymentOptions{
+		Enabled:    true,
+		Currency:   s.cfg.Currency,
+		CreditRate: s.cfg.CreditRate,
+		MinAmount:  s.cfg.MinAmount,
+		MaxAmount:  s.cfg.MaxAmount,
+		Providers:  s.providers.Names(),
+		Plans:      plans,
+	}
+}
+
+func paymentPlanFromConfig(p config.PaymentPlanConfig) PaymentPlan {
+	return PaymentPlan{
+		ID:           p.ID,
+		Name:         p.Name,
+		GroupID:      p.GroupID,
+		ValidityDays: p.ValidityDays,
+		Price:        p.Price,
+	}
+}
+
+func (s *PaymentService) findPlan(id string) (*PaymentPlan, bool) {
+	for _, p := range s.cfg.Plans {
+		if p.ID == id {
+			plan := paymentPlanFromConfig(p)
+			return &plan, true
+		}
+	}
+	return nil, false
+}
+
+// CreateOrder 创建订单并向渠道申请支付链接
+func (s *PaymentService) CreateOrder(ctx context.Context, input *CreatePaymentOrderInput) (*PaymentOrder, error) {
+	if !s.IsEnabled() {
+		return nil, ErrPaymentDisabled
+	}
+	provider, ok := s.providers.Get(input.Provider)
+	if !ok {
+		return nil, ErrPaymentProviderUnavailable
+	}
+
+	orderNo, err := generatePaymentOrderNo()
+	if err != nil {
+		return nil, fmt.Errorf("generate order no: %w", err)
+	}
+	order := &PaymentOrder{
+		OrderNo:  orderNo,
+		UserID:   input.UserID,
+		Type:     input.Type,
+		Provider: provider.Name(),
+		Status:   PaymentOrderStatusPending,
+		Currency: s.cfg.Currency,
+		ClientIP: input.ClientIP,
+	}
+
+	var subject string
+	switch input.Type {
+	case PaymentOrderTypeBalance:
+		amount := math.Round(input.Amount*100) / 100
+		if amount < s.cfg.MinAmount || amount > s.cfg.MaxAmount {
+			return nil, ErrPaymentInvalidAmount
+		}
+		order.Amount = amount
+		order.CreditAmount = amount * s.cfg.CreditRate
+		subject = fmt.Sprintf("Balance top-up %.2f %s", amount, s.cfg.Currency)
+	case PaymentOrderTypeSubscription:
+		plan, found := s.findPlan(input.PlanID)
+		if !found {
+			return nil, ErrPaymentPlanNotFound
+		}
+		planID, groupID := plan.ID, plan.GroupID
+		order.Amount = plan.Price
+		order.PlanID = &planID
+		order.GroupID = &groupID
+		order.ValidityDays = plan.ValidityDays
+		subject = plan.Name
+		if subject == "" {
+			subject = fmt.Sprintf("Subscription %s", plan.ID)
+		}
+	default:
+		return nil, infraerrors.BadRequest("PAYMENT_INVALID_TYPE", "order type must be balance or subscription")
+	}
+
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("create order: %w", err)
+	}
+
+	checkout, err := provider.CreateCheckout(ctx, order, &PaymentCheckoutRequest{
+		Subject:   subject,
+		NotifyURL: s.notifyURL(provider.Name()),
+		ReturnURL: s.cfg.ReturnURL,
+		ClientIP:  input.ClientIP,
+	})
+	if err != nil {
+		// 收银台创建失败：作废订单，避免遗留无法支付的 pending 订单
+		if voidErr := s.orderRepo.MarkVoided(ctx, order.ID, time.Now(), "checkout failed: "+err.Error()); voidErr != nil {
+			log.Printf("[Payment] void order %s after checkout failure: %v", order.OrderNo, voidErr)
+		}
+		return nil, infraerrors.ServiceUnavailable("PAYMENT_CHECKOUT_FAILED", "failed to create payment checkout").WithCause(err)
+	}
+	if err := s.orderRepo.UpdateCheckout(ctx, order.ID, checkout.PayURL, checkout.ProviderRef); err != nil {
+		return nil, fmt.Errorf("update order checkout: %w", err)
+	}
+	payURL := checkout.PayURL
+	order.PayURL = &payURL
+	if checkout.ProviderRef != "" {
+		ref := checkout.ProviderRef
+		order.ProviderTradeNo = &ref
+	}
+	return order, nil
+}
+
+func (s *PaymentService) notifyURL(provider string) string {
+	return strings.TrimRight(s.cfg.NotifyBaseURL, "/") + "/api/v1/payments/callback/" + provider
+}
+
+// HandleCallback 校验渠道回调并履约；同一订单重复回调是幂等的。
+// 返回应答给渠道的响应体。
+func (s *PaymentService) HandleCallback(ctx context.Context, providerName string, req *PaymentCallbackRequest) (string, error) {
+	if !s.IsEnabled() {
+		return "", ErrPaymentDisabled
+	}
+	provider, ok := s.providers.Get(providerName)
+	if !ok {
+		return "", ErrPaymentProviderUnavailable
+	}
+
+	result, err := provider.VerifyCallback(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || !result.Paid {
+		return provider.CallbackAck(), nil
+	}
+	if err := s.fulfill(ctx, provider.Name(), result); err != nil {
+		return "", err
+	}
+	return provider.CallbackAck(), nil
+}
+
+func (s *PaymentService) fulfill(ctx context.Context, providerName string, result *PaymentCallbackResult) error {
+	order, err := s.orderRepo.GetByOrderNo(ctx, result.OrderNo)
+	if err != nil {
+		return err
+	}
+	if order.Provider != providerName {
+		return ErrPaymentOrderNotFound
+	}
+	if order.Status == PaymentOrderStatusPaid || order.Status == PaymentOrderStatusRefunding {
+		return nil
+	}
+	if order.Status != PaymentOrderStatusPending {
+		log.Printf("[Payment] callback for non-payable order %s (status=%s trade_no=%s)", order.OrderNo, order.Status, result.TradeNo)
+		return ErrPaymentOrderNotPayable
+	}
+	// 金额与币种必须由渠道回调明确给出且与订单一致，缺失视为不匹配
+	if result.Amount <= 0 || math.Abs(result.Amount-order.Amount) > paymentAmountEpsilon {
+		log.Printf("[Payment] amount mismatch for order %s: paid=%.2f expected=%.2f", order.OrderNo, result.Amount, order.Amount)
+		return ErrPaymentAmountMismatch
+	}
+	if !strings.EqualFold(strings.TrimSpace(result.Currency), order.Currency) {
+		log.Printf("[Payment] currency mismatch for order %s: paid=%q expected=%q", order.OrderNo, result.Currency, order.Currency)
+		return ErrPaymentCurrencyMismatch
+	}
+
+	// 使用数据库事务保证订单状态与权益发放的原子性
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	// 【关键】先以条件更新（WHERE status = 'pending'）锁定订单，并发回调只有一个能成功
+	if err := s.orderRepo.MarkPaid(txCtx, order.ID, result.TradeNo, time.Now()); err != nil {
+		if errors.Is(err, ErrPaymentOrderStatusChanged) {
+			return s.checkAlreadyPaid(ctx, order.ID)
+		}
+		return fmt.Errorf("mark order paid: %w", err)
+	}
+
+	switch order.Type {
+	case PaymentOrderTypeBalance:
+		if err := s.userRepo.UpdateBalance(txCtx, order.UserID, order.CreditAmount); err != nil {
+			return fmt.Errorf("update user balance: %w", err)
+		}
+	case PaymentOrderTypeSubscription:
+		if order.GroupID == nil {
+			return fmt.Errorf("subscription order %s missing group_id", order.OrderNo)
+		}
+		sub, _, err := s.subscriptionService.AssignOrExtendSubscription(txCtx, &AssignSubscriptionInput{
+			UserID:       order.UserID,
+			GroupID:      *order.GroupID,
+			ValidityDays: order.ValidityDays,
+			AssignedBy:   0, // 系统分配
+			Notes:        fmt.Sprintf("通过在线支付订单 %s 购买", order.OrderNo),
+		})
+		if err != nil {
+			return fmt.Errorf("assign or extend subscription: %w", err)
+		}
+		if err := s.orderRepo.SetSubscriptionID(txCtx, order.ID, sub.ID); err != nil {
+			return fmt.Errorf("set order subscription: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported order type: %s", order.Type)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	s.invalidateCaches(ctx, order)
+	log.Printf("[Payment] order %s paid: user=%d type=%s amount=%.2f %s", order.OrderNo, order.UserID, order.Type, order.Amount, order.Currency)
+	return nil
+}
+
+// checkAlreadyPaid 条件更新失败时重新读取订单：已被并发回调处理则视为成功
+func (s *PaymentService) checkAlreadyPaid(ctx context.Context, id int64) error {
+	current, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.Status == PaymentOrderStatusPaid || current.Status == PaymentOrderStatusRefunding {
+		return nil
+	}
+	return ErrPaymentOrderNotPayable
+}
+
+// invalidateCaches 失效订单用户的余额/订阅缓存
+func (s *PaymentService) invalidateCaches(ctx context.Context, order *PaymentOrder) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, order.UserID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	userID := order.UserID
+	switch order.Type {
+	case PaymentOrderTypeBalance:
+		go func() {
+			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+		}()
+	case PaymentOrderTypeSubscription:
+		if order.GroupID == nil {
+			return
+		}
+		groupID := *order.GroupID
+		go func() {
+			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = s.billingCacheService.InvalidateSubscription(cacheCtx, userID, groupID)
+		}()
+	}
+}
+
+// GetUserOrder 获取当前用户的订单
+func (s *PaymentService) GetUserOrder(ctx context.Context, userID int64, orderNo string) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, ErrPaymentOrderNotFound
+	}
+	return order, nil
+}
+
+// ListUserOrders 用户订单历史
+func (s *PaymentService) ListUserOrders(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	return s.orderRepo.List(ctx, params, PaymentOrderFilters{UserID: userID, Status: status})
+}
+
+// List 管理端订单列表
+func (s *PaymentService) List(ctx context.Context, params pagination.PaginationParams, filters PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	return s.orderRepo.List(ctx, params, filters)
+}
+
+// GetByID 管理端订单详情
+func (s *PaymentService) GetByID(ctx context.Context, id int64) (*PaymentOrder, error) {
+	return s.orderRepo.GetByID(ctx, id)
+}
+
+// Refund 全额退款：撤回已发放的余额/订阅时长，并通过渠道原路退款。
+// 渠道退款失败时回滚本地变更。
+func (s *PaymentService) Refund(ctx context.Context, id int64, reason string) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != PaymentOrderStatusPaid {
+		return nil, ErrPaymentOrderNotRefundable
+	}
+	provider, ok := s.providers.Get(order.Provider)
+	if !ok {
+		return nil, ErrPaymentProviderUnavailable
+	}
+
+	// 1. 条件更新为退款中，并发退款只有一个能成功；渠道调用不在事务内，避免慢请求长时间持有行锁
+	if err := s.orderRepo.MarkRefunding(ctx, order.ID); err != nil {
+		if errors.Is(err, ErrPaymentOrderStatusChanged) {
+			return nil, ErrPaymentOrderNotRefundable
+		}
+		return nil, fmt.Errorf("mark order refunding: %w", err)
+	}
+
+	// 渠道调用之后的本地更新不随请求取消而中断
+	ctx = context.WithoutCancel(ctx)
+
+	// 2. 渠道退款；失败时恢复为 paid
+	if err := provider.Refund(ctx, order); err != nil {
+		if revertErr := s.orderRepo.RevertRefunding(ctx, order.ID); revertErr != nil {
+			log.Printf("[Payment] order %s provider refund failed and revert to paid failed: %v", order.OrderNo, revertErr)
+		}
+		return nil, err
+	}
+
+	// 3. 标记已退款并扣回权益
+	if err := s.finalizeRefund(ctx, order, reason); err != nil {
+		// 渠道已退款但本地结算失败：订单保持 refunding，需人工对账
+		log.Printf("[Payment] CRITICAL: order %s refunded at provider but local finalize failed: %v", order.OrderNo, err)
+		return nil, err
+	}
+
+	s.invalidateCaches(ctx, order)
+	log.Printf("[Payment] order %s refunded: user=%d type=%s amount=%.2f %s", order.OrderNo, order.UserID, order.Type, order.Amount, order.Currency)
+	return s.orderRepo.GetByID(ctx, order.ID)
+}
+
+// finalizeRefund 在同一事务内将退款中的订单标记为已退款并扣回余额/订阅
+func (s *PaymentService) finalizeRefund(ctx context.Context, order *PaymentOrder, reason string) error {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	if err := s.orderRepo.MarkRefunded(txCtx, order.ID, time.Now(), reason); err != nil {
+		return fmt.Errorf("mark order refunded: %w", err)
+	}
+
+	switch order.Type {
+	case PaymentOrderTypeBalance:
+		if err := s.userRepo.UpdateBalance(txCtx, order.UserID, -order.CreditAmount); err != nil {
+			return fmt.Errorf("revert user balance: %w", err)
+		}
+	case PaymentOrderTypeSubscription:
+		if err := s.revertSubscription(txCtx, order); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// revertSubscription 退款时扣回订阅时长，剩余时长不足时直接撤销订阅
+func (s *PaymentService) revertSubscription(ctx context.Context, order *PaymentOrder) error {
+	if order.SubscriptionID == nil || order.ValidityDays <= 0 {
+		return nil
+	}
+	subID := *order.SubscriptionID
+	_, err := s.subscriptionService.ExtendSubscription(ctx, subID, -order.ValidityDays)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, ErrSubscriptionNotFound) {
+		return nil
+	}
+	if errors.Is(err, ErrAdjustWouldExpire) || infraerrors.Reason(err) == "CANNOT_SHORTEN_EXPIRED" {
+		if err := s.subscriptionService.RevokeSubscription(ctx, subID); err != nil {
+			return fmt.Errorf("revoke subscription: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("shorten subscription: %w", err)
+}
+
+// Void 作废未支付订单（作废后的支付回调将被拒绝）
+func (s *PaymentService) Void(ctx context.Context, id int64, reason string) (*PaymentOrder, error) {
+	if err := s.orderRepo.MarkVoided(ctx, id, time.Now(), reason); err != nil {
+		if errors.Is(err, ErrPaymentOrderStatusChanged) {
+			return nil, ErrPaymentOrderNotVoidable
+		}
+		return nil, err
+	}
+	return s.orderRepo.GetByID(ctx, id)
+}
+
+// generatePaymentOrderNo 生成商户订单号：时间前缀 + 随机串，便于人工排查
+func generatePaymentOrderNo() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return time.Now().UTC().Format("20060102150405") + strings.ToUpper(hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+type paymentOrderRepoStub struct {
+	mu     sync.Mutex
+	orders map[int64]*PaymentOrder
+}
+
+func (r *paymentOrderRepoStub) Create(_ context.Context, order *PaymentOrder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order.ID = int64(len(r.orders) + 1)
+	copied := *order
+	r.orders[order.ID] = &copied
+	return nil
+}
+
+func (r *paymentOrderRepoStub) GetByID(_ context.Context, id int64) (*PaymentOrder, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, ErrPaymentOrderNotFound
+	}
+	copied := *order
+	return &copied, nil
+}
+
+func (r *paymentOrderRepoStub) GetByOrderNo(ctx context.Context, orderNo string) (*PaymentOrder, error) {
+	r.mu.Lock()
+	var id int64
+	for _, order := range r.orders {
+		if order.OrderNo == orderNo {
+			id = order.ID
+		}
+	}
+	r.mu.Unlock()
+	return r.GetByID(ctx, id)
+}
+
+func (r *paymentOrderRepoStub) UpdateCheckout(_ context.Context, id int64, payURL, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orders[id].PayURL = &payURL
+	return nil
+}
+
+func (r *paymentOrderRepoStub) transition(id int64, from, to string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.orders[id].Status != from {
+		return ErrPaymentOrderStatusChanged
+	}
+	r.orders[id].Status = to
+	return nil
+}
+
+func (r *paymentOrderRepoStub) MarkPaid(_ context.Context, id int64, tradeNo string, _ time.Time) error {
+	if err := r.transition(id, PaymentOrderStatusPending, PaymentOrderStatusPaid); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orders[id].ProviderTradeNo = &tradeNo
+	return nil
+}
+
+func (r *paymentOrderRepoStub) SetSubscriptionID(_ context.Context, id, subscriptionID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orders[id].SubscriptionID = &subscriptionID
+	return nil
+}
+
+func (r *paymentOrderRepoStub) MarkRefunding(_ context.Context, id int64) error {
+	return r.transition(id, PaymentOrderStatusPaid, PaymentOrderStatusRefunding)
+}
+
+func (r *paymentOrderRepoStub) RevertRefunding(_ context.Context, id int64) error {
+	return r.transition(id, PaymentOrderStatusRefunding, PaymentOrderStatusPaid)
+}
+
+func (r *paymentOrderRepoStub) MarkRefunded(_ context.Context, id int64, _ time.Time, _ string) error {
+	return r.transition(id, PaymentOrderStatusRefunding, PaymentOrderStatusRefunded)
+}
+
+func (r *paymentOrderRepoStub) MarkVoided(_ context.Context, id int64, _ time.Time, _ string) error {
+	return r.transition(id, PaymentOrderStatusPending, PaymentOrderStatusVoided)
+}
+
+func (r *paymentOrderRepoStub) List(context.Context, pagination.PaginationParams, PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+type paymentUserRepoStub struct {
+	UserRepository
+	mu      sync.Mutex
+	balance float64
+}
+
+func (r *paymentUserRepoStub) UpdateBalance(_ context.Context, _ int64, amount float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.balance += amount
+	return nil
+}
+
+// paymentProviderStubName 测试渠道名称
+const paymentProviderStubName = "stub"
+
+// paymentProviderStub 回调参数直接决定支付结果
+type paymentProviderStub struct {
+	refunded []string
+	// onRefund 在渠道退款时调用，可返回错误模拟退款失败
+	onRefund func(order *PaymentOrder) error
+}
+
+func (p *paymentProviderStub) Name() string        { return paymentProviderStubName }
+func (p *paymentProviderStub) CallbackAck() string { return "success" }
+
+func (p *paymentProviderStub) CreateCheckout(_ context.Context, order *PaymentOrder, _ *PaymentCheckoutRequest) (*PaymentCheckout, error) {
+	return &PaymentCheckout{PayURL: "https://pay.example.com/" + order.OrderNo}, nil
+}
+
+func (p *paymentProviderStub) VerifyCallback(_ context.Context, req *PaymentCallbackRequest) (*PaymentCallbackResult, error) {
+	result := &PaymentCallbackResult{
+		OrderNo:  req.Params.Get("order_no"),
+		TradeNo:  "T" + req.Params.Get("order_no"),
+		Currency: req.Params.Get("currency"),
+		Paid:     true,
+	}
+	if raw := req.Params.Get("amount"); raw != "" {
+		amount, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, err
+		}
+		result.Amount = amount
+	}
+	return result, nil
+}
+
+func (p *paymentProviderStub) Refund(_ context.Context, order *PaymentOrder) error {
+	if p.onRefund != nil {
+		if err := p.onRefund(order); err != nil {
+			return err
+		}
+	}
+	p.refunded = append(p.refunded, order.OrderNo)
+	return nil
+}
+
+type paymentServiceTestEnv struct {
+	svc      *PaymentService
+	orders   *paymentOrderRepoStub
+	users    *paymentUserRepoStub
+	provider *paymentProviderStub
+}
+
+func newPaymentServiceTestEnv(t *testing.T) *paymentServiceTestEnv {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { _ = client.Close() })
+
+	env := &paymentServiceTestEnv{
+		orders:   &paymentOrderRepoStub{orders: map[int64]*PaymentOrder{}},
+		users:    &paymentUserRepoStub{},
+		provider: &paymentProviderStub{},
+	}
+	cfg := &config.Config{Payment: config.PaymentConfig{
+		Enabled:       true,
+		NotifyBaseURL: "https://example.com",
+		Currency:      "CNY",
+		CreditRate:    2,
+		MinAmount:     1,
+		MaxAmount:     1000,
+	}}
+	env.svc = NewPaymentService(env.orders, env.users, nil, nil, client, nil, NewPaymentProviderRegistry(env.provider), cfg)
+	return env
+}
+
+func (e *paymentServiceTestEnv) createBalanceOrder(t *testing.T, amount float64) *PaymentOrder {
+	t.Helper()
+	order, err := e.svc.CreateOrder(context.Background(), &CreatePaymentOrderInput{
+		UserID:   1,
+		Type:     PaymentOrderTypeBalance,
+		Provider: paymentProviderStubName,
+		Amount:   amount,
+	})
+	require.NoError(t, err)
+	return order
+}
+
+func (e *paymentServiceTestEnv) callback(order *PaymentOrder, amount, currency string) error {
+	params := url.Values{}
+	params.Set("order_no", order.OrderNo)
+	if amount != "" {
+		params.Set("amount", amount)
+	}
+	if currency != "" {
+		params.Set("currency", currency)
+	}
+	_, err := e.svc.HandleCallback(context.Background(), paymentProviderStubName, &PaymentCallbackRequest{Params: params})
+	return err
+}
+
+func TestPaymentServiceFulfill_RequiresMatchingAmountAndCurrency(t *testing.T) {
+	env := newPaymentServiceTestEnv(t)
+	order := env.createBalanceOrder(t, 10)
+
+	cases := []struct {
+		amount   string
+		currency string
+		want     error
+	}{
+		{"", "CNY", ErrPaymentAmountMismatch},
+		{"0", "CNY", ErrPaymentAmountMismatch},
+		{"9.99", "CNY", ErrPaymentAmountMismatch},
+		{"10.00", "", ErrPaymentCurrencyMismatch},
+		{"10.00", "USD", ErrPaymentCurrencyMismatch},
+	}
+	for _, tc := range cases {
+		require.ErrorIs(t, env.callback(order, tc.amount, tc.currency), tc.want, "amount=%q currency=%q", tc.amount, tc.currency)
+	}
+	require.Zero(t, env.users.balance)
+	current, err := env.svc.GetByID(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusPending, current.Status)
+
+	require.NoError(t, env.callback(order, "10.00", "cny"))
+	require.InDelta(t, 20.0, env.users.balance, 1e-9)
+}
+
+func TestPaymentServiceFulfill_DuplicateCallbackCreditsOnce(t *testing.T) {
+	env := newPaymentServiceTestEnv(t)
+	order := env.createBalanceOrder(t, 5)
+
+	require.NoError(t, env.callback(order, "5.00", "CNY"))
+	require.NoError(t, env.callback(order, "5.00", "CNY"))
+	require.InDelta(t, 10.0, env.users.balance, 1e-9)
+
+	paid, err := env.svc.GetByID(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusPaid, paid.Status)
+	require.Equal(t, "T"+order.OrderNo, *paid.ProviderTradeNo)
+}
+
+func TestPaymentServiceRefund_FulfilledOrder(t *testing.T) {
+	env := newPaymentServiceTestEnv(t)
+	ctx := context.Background()
+	order := env.createBalanceOrder(t, 5)
+
+	// 未支付订单不可退款
+	_, err := env.svc.Refund(ctx, order.ID, "")
+	require.ErrorIs(t, err, ErrPaymentOrderNotRefundable)
+
+	require.NoError(t, env.callback(order, "5.00", "CNY"))
+	refunded, err := env.svc.Refund(ctx, order.ID, "duplicate payment")
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusRefunded, refunded.Status)
+	require.Equal(t, []string{order.OrderNo}, env.provider.refunded)
+	require.InDelta(t, 0.0, env.users.balance, 1e-9)
+
+	// 重复退款与退款后的重复回调均被拒绝，余额不变
+	_, err = env.svc.Refund(ctx, order.ID, "")
+	require.ErrorIs(t, err, ErrPaymentOrderNotRefundable)
+	require.ErrorIs(t, env.callback(order, "5.00", "CNY"), ErrPaymentOrderNotPayable)
+	require.Len(t, env.provider.refunded, 1)
+	require.InDelta(t, 0.0, env.users.balance, 1e-9)
+}
+
+func TestPaymentServiceRefund_ProviderCalledOutsideTransaction(t *testing.T) {
+	env := newPaymentServiceTestEnv(t)
+	ctx := context.Background()
+	order := env.createBalanceOrder(t, 5)
+	require.NoError(t, env.callback(order, "5.00", "CNY"))
+
+	// 渠道退款期间订单处于 refunding，权益尚未扣回；失败后恢复为 paid
+	env.provider.onRefund = func(o *PaymentOrder) error {
+		current, err := env.svc.GetByID(ctx, o.ID)
+		require.NoError(t, err)
+		require.Equal(t, PaymentOrderStatusRefunding, current.Status)
+		require.InDelta(t, 10.0, env.users.balance, 1e-9)
+		return context.DeadlineExceeded
+	}
+	_, err := env.svc.Refund(ctx, order.ID, "")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	current, err := env.svc.GetByID(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusPaid, current.Status)
+	require.InDelta(t, 10.0, env.users.balance, 1e-9)
+
+	// 退款中的订单收到重复支付回调时直接应答，不重复发放
+	env.provider.onRefund = func(o *PaymentOrder) error {
+		require.NoError(t, env.callback(o, "5.00", "CNY"))
+		return nil
+	}
+	refunded, err := env.svc.Refund(ctx, order.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusRefunded, refunded.Status)
+	require.InDelta(t, 0.0, env.users.balance, 1e-9)
+}
+
+func TestPaymentServiceVoid(t *testing.T) {
+	env := newPaymentServiceTestEnv(t)
+	ctx := context.Background()
+
+	pending := env.createBalanceOrder(t, 3)
+	voided, err := env.svc.Void(ctx, pending.ID, "expired")
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusVoided, voided.Status)
+	require.ErrorIs(t, env.callback(pending, "3.00", "CNY"), ErrPaymentOrderNotPayable)
+
+	paid := env.createBalanceOrder(t, 3)
+	require.NoError(t, env.callback(paid, "3.00", "CNY"))
+	_, err = env.svc.Void(ctx, paid.ID, "")
+	require.ErrorIs(t, err, ErrPaymentOrderNotVoidable)
+	require.InDelta(t, 6.0, env.users.balance, 1e-9)
+}
@@ -91,6 +91,11 @@ func (s *RateLimitService) CheckErrorPolicy(ctx context.Context, account *Accoun
 // HandleUpstreamError 处理上游错误响应，标记账号状态
 // 返回是否应该停止该账号的调度
 func (s *RateLimitService) HandleUpstreamError(ctx context.Context, account *Account, statusCode int, headers http.Header, responseBody []byte) (shouldDisable bool) {
+	// Bedrock 账号：按 x-amzn-ErrorType 归一化状态码（如 ThrottlingException / ServiceQuotaExceededException → 429）
+	if account.IsBedrock() {
+		statusCode = bedrockErrorStatusCode(statusCode, headers)
+	}
+
 	// apikey 类型账号：检查自定义错误码配置
 	// 如果启用且错误码不在列表中，则不处理（不停止调度、不标记限流/过载）
 	customErrorCodesEnabled := account.IsCustomErrorCodesEnabled()
@@ -142,6 +147,12 @@ func (s *RateLimitService) HandleUpstreamError(ctx context.Context, account *Acc
 				slog.Info("oauth_401_force_refresh_set", "account_id", account.ID, "platform", account.Platform)
 			}
 		}
+		// Vertex 账号：失效缓存的访问令牌，账号恢复后重新签发
+		if account.IsVertex() && s.tokenCacheInvalidator != nil {
+			if err := s.tokenCacheInvalidator.InvalidateToken(ctx, account); err != nil {
+				slog.Warn("vertex_401_invalidate_cache_failed", "account_id", account.ID, "error", err)
+			}
+		}
 		msg := "Authentication failed (401): invalid or expired credentials"
 		if upstreamMsg != "" {
 			msg = "Authentication failed (401): " + upstreamMsg
@@ -369,7 +380,18 @@ func (s *RateLimitService) handleCustomErrorCode(ctx context.Context, account *A
 // handle429 处理429限流错误
 // 解析响应头获取重置时间，标记账号为限流状态
 func (s *RateLimitService) handle429(ctx context.Context, account *Account, headers http.Header, responseBody []byte) {
-	// 1. OpenAI 平台：优先尝试解析 x-codex-* 响应头（用于 rate_limit_exceeded）
+	// Bedrock 限流按分钟配额计算，没有重置时间头：优先 Retry-After，否则冷却 1 分钟
+	if account.IsBedrock() {
+		resetAt := time.Now().Add(bedrockRetryAfter(headers))
+		if err := s.accountRepo.SetRateLimited(ctx, account.ID, resetAt); err != nil {
+			slog.Warn("rate_limit_set_failed", "account_id", account.ID, "error", err)
+			return
+		}
+		slog.Info("bedrock_account_rate_limited", "account_id", account.ID, "error_type", headers.Get(bedrockErrorTypeHeader), "reset_at", resetAt)
+		return
+	}
+
+	// 1. OpenAI 平台：优先尝试解析 x-codex-* 响应头（用于 rate_limit_exceeded），其次 x-ratelimit-* / Retry-After
 	if account.Platform == PlatformOpenAI {
 		if resetAt := s.calculateOpenAI429ResetTime(headers); resetAt != nil {
 			if err := s.accountRepo.SetRateLimited(ctx, account.ID, *resetAt); err != nil {
@@ -471,16 +493,17 @@ func (s *RateLimitService) handle429(ctx context.Context, account *Account, head
 }
 
 // calculateOpenAI429ResetTime 从 OpenAI 429 响应头计算正确的重置时间
+// 优先使用 x-codex-* 窗口头（ChatGPT OAuth），其次使用 x-ratelimit-* / Retry-After 头（Platform API / Azure）
 // 返回 nil 表示无法从响应头中确定重置时间
 func (s *RateLimitService) calculateOpenAI429ResetTime(headers http.Header) *time.Time {
 	snapshot := ParseCodexRateLimitHeaders(headers)
 	if snapshot == nil {
-		return nil
+		return calculateOpenAIRateLimitHeaderResetTime(headers)
 	}
 
 	normalized := snapshot.Normalize()
 	if normalized == nil {
-		return nil
+		return calculateOpenAIRateLimitHeaderResetTime(headers)
 	}
 
 	now := time.Now()
@@ -515,7 +538,68 @@ func (s *RateLimitService) calculateOpenAI429ResetTime(headers http.Header) *tim
 		return &resetAt
 	}
 
-	return nil
+	return calculateOpenAIRateLimitHeaderResetTime(headers)
+}
+
+// calculateOpenAIRateLimitHeaderResetTime 从 x-ratelimit-* 头计算重置时间（OpenAI Platform API 与 Azure OpenAI）：
+//   - 优先使用已耗尽（remaining=0）的请求数/Token 窗口的重置时间，均耗尽时取较长者；
+//   - 否则使用两个窗口中较长的重置时间；
+//   - 没有重置头时回退到 retry-after-ms / Retry-After。
+func calculateOpenAIRateLimitHeaderResetTime(headers http.Header) *time.Time {
+	resetRequests, hasResetRequests := parseOpenAIRateLimitDuration(headers.Get("x-ratelimit-reset-requests"))
+	resetTokens, hasResetTokens := parseOpenAIRateLimitDuration(headers.Get("x-ratelimit-reset-tokens"))
+	requestsExhausted := strings.TrimSpace(headers.Get("x-ratelimit-remaining-requests")) == "0"
+	tokensExhausted := strings.TrimSpace(headers.Get("x-ratelimit-remaining-tokens")) == "0"
+
+	var wait time.Duration
+	if requestsExhausted && hasResetRequests {
+		wait = resetRequests
+	}
+	if tokensExhausted && hasResetTokens && resetTokens > wait {
+		wait = resetTokens
+	}
+	if wait == 0 {
+		if hasResetRequests {
+			wait = resetRequests
+		}
+		if hasResetTokens && resetTokens > wait {
+			wait = resetTokens
+		}
+	}
+	if wait == 0 {
+		if ms, err := strconv.ParseInt(strings.TrimSpace(headers.Get("retry-after-ms")), 10, 64); err == nil && ms > 0 {
+			wait = time.Duration(ms) * time.Millisecond
+		} else if secs, err := strconv.Atoi(strings.TrimSpace(headers.Get("Retry-After"))); err == nil && secs > 0 {
+			wait = time.Duration(secs) * time.Second
+		}
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	resetAt := time.Now().Add(wait)
+	slog.Info("openai_429_ratelimit_headers",
+		"remaining_requests", headers.Get("x-ratelimit-remaining-requests"),
+		"remaining_tokens", headers.Get("x-ratelimit-remaining-tokens"),
+		"reset_after", wait,
+		"reset_at", resetAt,
+	)
+	return &resetAt
+}
+
+// parseOpenAIRateLimitDuration 解析 x-ratelimit-reset-* 头：Go 风格时长（如 "6m0s"、"20ms"）或秒数
+func parseOpenAIRateLimitDuration(raw string) (time.Duration, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, false
+	}
+	if d, err := time.ParseDuration(raw); err == nil && d > 0 {
+		return d, true
+	}
+	if secs, err := strconv.ParseFloat(raw, 64); err == nil && secs > 0 {
+		return time.Duration(secs * float64(time.Second)), true
+	}
+	return 0, false
 }
 
 // anthropic429Result holds the parsed Anthropic 429 rate-limit information.
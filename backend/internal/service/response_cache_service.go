@@ -0,0 +1,400 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Response cache endpoints（参与缓存键计算，避免不同协议的同一请求体互相命中）
+const (
+	ResponseCacheEndpointMessages  = "messages"
+	ResponseCacheEndpointResponses = "responses"
+)
+
+// Response cache stats fields
+const (
+	ResponseCacheStatHits   = "hits"
+	ResponseCacheStatMisses = "misses"
+	ResponseCacheStatStores = "stores"
+)
+
+// ResponseCacheHeader 响应缓存状态头（HIT / MISS）
+const ResponseCacheHeader = "X-Cache"
+
+// responseCacheLookupContextKey 未命中时绑定到 gin.Context 的缓存键，供 Forward 写入缓存
+const responseCacheLookupContextKey = "response_cache_lookup"
+
+// responseCacheVolatileFields 不影响模型输出的请求字段，计算缓存键前移除
+var responseCacheVolatileFields = []string{"stream", "metadata", "user", "prompt_cache_key"}
+
+// ResponseCacheEntry 缓存的上游响应
+type ResponseCacheEntry struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type"`
+	Body        []byte          `json:"body"`
+	Usage       json.RawMessage `json:"usage"`
+	AccountID   int64           `json:"account_id"` // 产生该响应的账号，命中时的使用量记入该账号
+	CreatedAt   int64           `json:"created_at"`
+}
+
+// ResponseCacheGroupStats 分组维度的缓存统计
+type ResponseCacheGroupStats struct {
+	GroupID  int64   `json:"group_id"`
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	Stores   int64   `json:"stores"`
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+// ResponseCacheStats 缓存统计汇总
+type ResponseCacheStats struct {
+	Enabled           bool                      `json:"enabled"`
+	HitCostMultiplier float64                   `json:"hit_cost_multiplier"`
+	Total             ResponseCacheGroupStats   `json:"total"`
+	Groups            []ResponseCacheGroupStats `json:"groups"`
+}
+
+// ResponseCache 响应缓存存储（Redis）
+type ResponseCache interface {
+	// Get 未命中时返回 nil, nil
+	Get(ctx context.Context, key string) (*ResponseCacheEntry, error)
+	Set(ctx context.Context, key string, entry *ResponseCacheEntry, ttl time.Duration) error
+	IncrStat(ctx context.Context, groupID int64, field string) error
+	// GetStats 返回 groupID -> field -> count
+	GetStats(ctx context.Context) (map[int64]map[string]int64, error)
+	ResetStats(ctx context.Context) error
+}
+
+// ResponseCacheLookup 单次请求的缓存上下文（仅可缓存请求才会创建）
+type ResponseCacheLookup struct {
+	GroupID int64
+	Key     string
+	TTL     time.Duration
+}
+
+// ResponseCacheService 确定性请求的精确响应缓存：
+// 非流式、temperature=0 的请求按"规范化请求体 + 模型"命中，分组需单独开启。
+type ResponseCacheService struct {
+	cache ResponseCache
+	cfg   config.GatewayResponseCacheConfig
+}
+
+// NewResponseCacheService 创建响应缓存服务
+func NewResponseCacheService(cache ResponseCache, cfg *config.Config) *ResponseCacheService {
+	svc := &ResponseCacheService{cache: cache}
+	if cfg != nil {
+		svc.cfg = cfg.Gateway.ResponseCache
+	}
+	return svc
+}
+
+// HitCostMultiplier 命中缓存时的计费比例
+func (s *ResponseCacheService) HitCostMultiplier() float64 {
+	if s == nil {
+		return 1
+	}
+	return s.cfg.HitCostMultiplier
+}
+
+// Prepare 判断请求是否可缓存并计算缓存键；不可缓存时返回 nil。
+func (s *ResponseCacheService) Prepare(ctx context.Context, endpoint, model string, body []byte) *ResponseCacheLookup {
+	if s == nil || s.cache == nil || !s.cfg.Enabled {
+		return nil
+	}
+	group, ok := ctx.Value(ctxkey.Group).(*Group)
+	if !ok || group == nil || !group.ResponseCacheEnabled {
+		return nil
+	}
+	if len(body) == 0 || len(body) > s.cfg.MaxRequestBytes {
+		return nil
+	}
+	normalized, ok := normalizeResponseCacheBody(body)
+	if !ok {
+		return nil
+	}
+
+	h := sha256.New()
+	h.Write([]byte(strconv.FormatInt(group.ID, 10)))
+	h.Write([]byte{0})
+	h.Write([]byte(endpoint))
+	h.Write([]byte{0})
+	h.Write([]byte(model))
+	h.Write([]byte{0})
+	h.Write(normalized)
+
+	return &ResponseCacheLookup{
+		GroupID: group.ID,
+		Key:     hex.EncodeToString(h.Sum(nil)),
+		TTL:     s.groupTTL(group),
+	}
+}
+
+func (s *ResponseCacheService) groupTTL(group *Group) time.Duration {
+	ttl := s.cfg.DefaultTTLSeconds
+	if group.ResponseCacheTTLSeconds > 0 {
+		ttl = group.ResponseCacheTTLSeconds
+	}
+	if s.cfg.MaxTTLSeconds > 0 && ttl > s.cfg.MaxTTLSeconds {
+		ttl = s.cfg.MaxTTLSeconds
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// normalizeResponseCacheBody 规范化请求体：仅接受非流式且 temperature 显式为 0 的请求，
+// 移除不影响输出的字段，并以稳定的 key 顺序重新序列化。
+func normalizeResponseCacheBody(body []byte) ([]byte, bool) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var req map[string]any
+	if err := dec.Decode(&req); err != nil {
+		return nil, false
+	}
+	if stream, _ := req["stream"].(bool); stream {
+		return nil, false
+	}
+	temperature, ok := req["temperature"].(json.Number)
+	if !ok {
+		return nil, false
+	}
+	if f, err := temperature.Float64(); err != nil || f != 0 {
+		return nil, false
+	}
+	for _, field := range responseCacheVolatileFields {
+		delete(req, field)
+	}
+	// encoding/json 对 map 按 key 排序输出，保证同一语义的请求体得到相同结果
+	out, err := json.Marshal(req)
+	if err != nil {
+		return nil, false
+	}
+	return out, true
+}
+
+// Get 查询缓存并记录命中/未命中统计；出错时视为未命中
+func (s *ResponseCacheService) Get(ctx context.Context, lookup *ResponseCacheLookup) *ResponseCacheEntry {
+	if s == nil || lookup == nil {
+		return nil
+	}
+	entry, err := s.cache.Get(ctx, lookup.Key)
+	if err != nil {
+		log.Printf("[ResponseCache] get failed: group=%d err=%v", lookup.GroupID, err)
+	}
+	if entry == nil {
+		s.incrStat(ctx, lookup.GroupID, ResponseCacheStatMisses)
+		return nil
+	}
+	s.incrStat(ctx, lookup.GroupID, ResponseCacheStatHits)
+	return entry
+}
+
+// Lookup 在选择账号之前查询缓存：命中时写回客户端，返回缓存条目与产生该响应的账号（使用量记入该账号）；
+// 未命中时将缓存键绑定到请求上下文，由 Forward 在上游成功响应后写入缓存。
+// 条目未记录账号或账号已不存在时按未命中处理，重新请求上游后覆盖该条目。
+func (s *ResponseCacheService) Lookup(c *gin.Context, accountRepo AccountRepository, endpoint, model string, body []byte) (*ResponseCacheEntry, *Account) {
+	if s == nil || c == nil {
+		return nil, nil
+	}
+	ctx := c.Request.Context()
+	lookup := s.Prepare(ctx, endpoint, model, body)
+	if lookup == nil {
+		return nil, nil
+	}
+	entry, err := s.cache.Get(ctx, lookup.Key)
+	if err != nil {
+		log.Printf("[ResponseCache] get failed: group=%d err=%v", lookup.GroupID, err)
+	}
+	var account *Account
+	if entry != nil && entry.AccountID > 0 && accountRepo != nil {
+		if account, err = accountRepo.GetByID(ctx, entry.AccountID); err != nil {
+			log.Printf("[ResponseCache] load account failed: group=%d account=%d err=%v", lookup.GroupID, entry.AccountID, err)
+			account = nil
+		}
+	}
+	if account == nil {
+		s.incrStat(ctx, lookup.GroupID, ResponseCacheStatMisses)
+		c.Set(responseCacheLookupContextKey, lookup)
+		return nil, nil
+	}
+	s.incrStat(ctx, lookup.GroupID, ResponseCacheStatHits)
+	s.WriteHit(c, entry)
+	return entry, account
+}
+
+func boundResponseCacheLookup(c *gin.Context) *ResponseCacheLookup {
+	if c == nil {
+		return nil
+	}
+	v, ok := c.Get(responseCacheLookupContextKey)
+	if !ok {
+		return nil
+	}
+	lookup, _ := v.(*ResponseCacheLookup)
+	return lookup
+}
+
+// WriteHit 将缓存的响应写回客户端
+func (s *ResponseCacheService) WriteHit(c *gin.Context, entry *ResponseCacheEntry) {
+	contentType := entry.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	c.Header(ResponseCacheHeader, "HIT")
+	c.Data(entry.StatusCode, contentType, entry.Body)
+}
+
+// NewHitRequestID 为缓存命中生成唯一请求 ID（usage_logs 以 request_id 去重）
+func (s *ResponseCacheService) NewHitRequestID() string {
+	return "cache-" + uuid.NewString()
+}
+
+// BeginCapture 标记 MISS 并接管 c.Writer 记录即将写出的响应体，accountID 为请求上游的账号；
+// 调用方必须在写出响应后调用 Finish 恢复 writer。
+func (s *ResponseCacheService) BeginCapture(c *gin.Context, lookup *ResponseCacheLookup, accountID int64) *ResponseCacheCapture {
+	if s == nil || lookup == nil || c == nil {
+		return nil
+	}
+	c.Header(ResponseCacheHeader, "MISS")
+	w := &responseCacheCaptureWriter{ResponseWriter: c.Writer, limit: s.cfg.MaxResponseBytes}
+	c.Writer = w
+	return &ResponseCacheCapture{svc: s, c: c, lookup: lookup, accountID: accountID, writer: w}
+}
+
+// ResponseCacheCapture 一次未命中请求的响应捕获
+type ResponseCacheCapture struct {
+	svc       *ResponseCacheService
+	c         *gin.Context
+	lookup    *ResponseCacheLookup
+	accountID int64
+	writer    *responseCacheCaptureWriter
+}
+
+// Finish 恢复 c.Writer；usage 非 nil 且响应成功完整时写入缓存
+func (rc *ResponseCacheCapture) Finish(ctx context.Context, usage any) {
+	if rc == nil {
+		return
+	}
+	rc.c.Writer = rc.writer.ResponseWriter
+	if usage == nil || rc.writer.overflow || rc.writer.Status() != http.StatusOK || rc.writer.buf.Len() == 0 {
+		return
+	}
+	usageJSON, err := json.Marshal(usage)
+	if err != nil || string(usageJSON) == "null" {
+		return
+	}
+	entry := &ResponseCacheEntry{
+		StatusCode:  http.StatusOK,
+		ContentType: rc.writer.Header().Get("Content-Type"),
+		Body:        bytes.Clone(rc.writer.buf.Bytes()),
+		Usage:       usageJSON,
+		AccountID:   rc.accountID,
+		CreatedAt:   time.Now().Unix(),
+	}
+	if err := rc.svc.cache.Set(ctx, rc.lookup.Key, entry, rc.lookup.TTL); err != nil {
+		log.Printf("[ResponseCache] set failed: group=%d err=%v", rc.lookup.GroupID, err)
+		return
+	}
+	rc.svc.incrStat(ctx, rc.lookup.GroupID, ResponseCacheStatStores)
+}
+
+func (s *ResponseCacheService) incrStat(ctx context.Context, groupID int64, field string) {
+	if err := s.cache.IncrStat(ctx, groupID, field); err != nil {
+		log.Printf("[ResponseCache] incr stat failed: group=%d field=%s err=%v", groupID, field, err)
+	}
+}
+
+// Stats 返回各分组命中率统计
+func (s *ResponseCacheService) Stats(ctx context.Context) (*ResponseCacheStats, error) {
+	out := &ResponseCacheStats{
+		Enabled:           s.cfg.Enabled,
+		HitCostMultiplier: s.cfg.HitCostMultiplier,
+		Groups:            []ResponseCacheGroupStats{},
+	}
+	raw, err := s.cache.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for groupID, fields := range raw {
+		g := ResponseCacheGroupStats{
+			GroupID: groupID,
+			Hits:    fields[ResponseCacheStatHits],
+			Misses:  fields[ResponseCacheStatMisses],
+			Stores:  fields[ResponseCacheStatStores],
+		}
+		g.HitRatio = responseCacheHitRatio(g.Hits, g.Misses)
+		out.Groups = append(out.Groups, g)
+		out.Total.Hits += g.Hits
+		out.Total.Misses += g.Misses
+		out.Total.Stores += g.Stores
+	}
+	out.Total.HitRatio = responseCacheHitRatio(out.Total.Hits, out.Total.Misses)
+	sort.Slice(out.Groups, func(i, j int) bool { return out.Groups[i].GroupID < out.Groups[j].GroupID })
+	return out, nil
+}
+
+// ResetStats 清空命中率统计
+func (s *ResponseCacheService) ResetStats(ctx context.Context) error {
+	return s.cache.ResetStats(ctx)
+}
+
+func responseCacheHitRatio(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// applyResponseCacheHitDiscount 命中缓存时按配置比例折算各项费用
+func applyResponseCacheHitDiscount(cost *CostBreakdown, multiplier float64) {
+	if cost == nil {
+		return
+	}
+	cost.InputCost *= multiplier
+	cost.OutputCost *= multiplier
+	cost.CacheCreationCost *= multiplier
+	cost.CacheReadCost *= multiplier
+	cost.TotalCost *= multiplier
+	cost.ActualCost *= multiplier
+}
+
+// responseCacheCaptureWriter 在透传写出的同时记录响应体（超过上限后停止记录）
+type responseCacheCaptureWriter struct {
+	gin.ResponseWriter
+	limit    int
+	buf      bytes.Buffer
+	overflow bool
+}
+
+func (w *responseCacheCaptureWriter) capture(n int, write func()) {
+	if w.overflow {
+		return
+	}
+	if w.limit > 0 && w.buf.Len()+n > w.limit {
+		w.overflow = true
+		w.buf.Reset()
+		return
+	}
+	write()
+}
+
+func (w *responseCacheCaptureWriter) Write(b []byte) (int, error) {
+	w.capture(len(b), func() { _, _ = w.buf.Write(b) })
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseCacheCaptureWriter) WriteString(s string) (int, error) {
+	w.capture(len(s), func() { _, _ = w.buf.WriteString(s) })
+	return w.ResponseWriter.WriteString(s)
+}
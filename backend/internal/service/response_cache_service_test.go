@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type responseCacheStub struct {
+	entries map[string]*ResponseCacheEntry
+	ttls    map[string]time.Duration
+	stats   map[int64]map[string]int64
+}
+
+func newResponseCacheStub() *responseCacheStub {
+	return &responseCacheStub{
+		entries: map[string]*ResponseCacheEntry{},
+		ttls:    map[string]time.Duration{},
+		stats:   map[int64]map[string]int64{},
+	}
+}
+
+func (s *responseCacheStub) Get(_ context.Context, key string) (*ResponseCacheEntry, error) {
+	return s.entries[key], nil
+}
+
+func (s *responseCacheStub) Set(_ context.Context, key string, entry *ResponseCacheEntry, ttl time.Duration) error {
+	s.entries[key] = entry
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *responseCacheStub) IncrStat(_ context.Context, groupID int64, field string) error {
+	if s.stats[groupID] == nil {
+		s.stats[groupID] = map[string]int64{}
+	}
+	s.stats[groupID][field]++
+	return nil
+}
+
+func (s *responseCacheStub) GetStats(_ context.Context) (map[int64]map[string]int64, error) {
+	return s.stats, nil
+}
+
+func (s *responseCacheStub) ResetStats(_ context.Context) error {
+	s.stats = map[int64]map[string]int64{}
+	return nil
+}
+
+func newTestResponseCacheService(stub *responseCacheStub) *ResponseCacheService {
+	cfg := &config.Config{}
+	cfg.Gateway.ResponseCache = config.GatewayResponseCacheConfig{
+		Enabled:           true,
+		DefaultTTLSeconds: 3600,
+		MaxTTLSeconds:     7200,
+		MaxRequestBytes:   1 << 20,
+		MaxResponseBytes:  1 << 20,
+		HitCostMultiplier: 0.1,
+	}
+	return NewResponseCacheService(stub, cfg)
+}
+
+func responseCacheGroupCtx(group *Group) context.Context {
+	return context.WithValue(context.Background(), ctxkey.Group, group)
+}
+
+func TestResponseCachePrepare_Eligibility(t *testing.T) {
+	svc := newTestResponseCacheService(newResponseCacheStub())
+	enabled := responseCacheGroupCtx(&Group{ID: 1, ResponseCacheEnabled: true})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		body string
+		want bool
+	}{
+		{name: "deterministic", ctx: enabled, body: `{"model":"m","temperature":0,"messages":[]}`, want: true},
+		{name: "float_zero", ctx: enabled, body: `{"model":"m","temperature":0.0}`, want: true},
+		{name: "no_temperature", ctx: enabled, body: `{"model":"m"}`, want: false},
+		{name: "non_zero_temperature", ctx: enabled, body: `{"model":"m","temperature":0.2}`, want: false},
+		{name: "stream", ctx: enabled, body: `{"model":"m","temperature":0,"stream":true}`, want: false},
+		{name: "invalid_json", ctx: enabled, body: `{"model":`, want: false},
+		{name: "group_disabled", ctx: responseCacheGroupCtx(&Group{ID: 1}), body: `{"temperature":0}`, want: false},
+		{name: "no_group", ctx: context.Background(), body: `{"temperature":0}`, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := svc.Prepare(tc.ctx, ResponseCacheEndpointMessages, "m", []byte(tc.body))
+			require.Equal(t, tc.want, got != nil)
+		})
+	}
+}
+
+func TestResponseCachePrepare_KeyNormalization(t *testing.T) {
+	svc := newTestResponseCacheService(newResponseCacheStub())
+	ctx := responseCacheGroupCtx(&Group{ID: 1, ResponseCacheEnabled: true})
+
+	a := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m", []byte(`{"model":"m","temperature":0,"max_tokens":10,"metadata":{"user_id":"a"}}`))
+	b := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m", []byte(`{"max_tokens":10, "stream":false, "temperature":0, "model":"m","metadata":{"user_id":"b"}}`))
+	require.NotNil(t, a)
+	require.NotNil(t, b)
+	require.Equal(t, a.Key, b.Key, "key order, whitespace and metadata must not affect the key")
+
+	other := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m", []byte(`{"model":"m","temperature":0,"max_tokens":11}`))
+	require.NotEqual(t, a.Key, other.Key)
+
+	otherModel := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m2", []byte(`{"model":"m","temperature":0,"max_tokens":10}`))
+	require.NotEqual(t, a.Key, otherModel.Key)
+
+	otherEndpoint := svc.Prepare(ctx, ResponseCacheEndpointResponses, "m", []byte(`{"model":"m","temperature":0,"max_tokens":10}`))
+	require.NotEqual(t, a.Key, otherEndpoint.Key)
+
+	otherGroup := svc.Prepare(responseCacheGroupCtx(&Group{ID: 2, ResponseCacheEnabled: true}), ResponseCacheEndpointMessages, "m", []byte(`{"model":"m","temperature":0,"max_tokens":10}`))
+	require.NotEqual(t, a.Key, otherGroup.Key)
+}
+
+func TestResponseCachePrepare_TTL(t *testing.T) {
+	svc := newTestResponseCacheService(newResponseCacheStub())
+	body := []byte(`{"temperature":0}`)
+
+	lookup := svc.Prepare(responseCacheGroupCtx(&Group{ID: 1, ResponseCacheEnabled: true}), ResponseCacheEndpointMessages, "m", body)
+	require.Equal(t, time.Hour, lookup.TTL)
+
+	lookup = svc.Prepare(responseCacheGroupCtx(&Group{ID: 1, ResponseCacheEnabled: true, ResponseCacheTTLSeconds: 60}), ResponseCacheEndpointMessages, "m", body)
+	require.Equal(t, time.Minute, lookup.TTL)
+
+	lookup = svc.Prepare(responseCacheGroupCtx(&Group{ID: 1, ResponseCacheEnabled: true, ResponseCacheTTLSeconds: 99999}), ResponseCacheEndpointMessages, "m", body)
+	require.Equal(t, 2*time.Hour, lookup.TTL)
+}
+
+func TestResponseCacheCaptureAndHit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	stub := newResponseCacheStub()
+	svc := newTestResponseCacheService(stub)
+	ctx := responseCacheGroupCtx(&Group{ID: 7, ResponseCacheEnabled: true})
+	lookup := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m", []byte(`{"temperature":0}`))
+	require.NotNil(t, lookup)
+
+	// 第一次：未命中，写出响应并入缓存
+	require.Nil(t, svc.Get(ctx, lookup))
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	original := c.Writer
+	capture := svc.BeginCapture(c, lookup, 42)
+	c.Data(http.StatusOK, "application/json", []byte(`{"id":"msg_1"}`))
+	capture.Finish(ctx, &ClaudeUsage{InputTokens: 3, OutputTokens: 5})
+	require.Equal(t, original, c.Writer)
+	require.Equal(t, "MISS", rec.Header().Get(ResponseCacheHeader))
+	require.Equal(t, time.Hour, stub.ttls[lookup.Key])
+
+	// 第二次：命中并原样回放
+	entry := svc.Get(ctx, lookup)
+	require.NotNil(t, entry)
+	require.Equal(t, int64(42), entry.AccountID)
+	rec = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(rec)
+	svc.WriteHit(c, entry)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "HIT", rec.Header().Get(ResponseCacheHeader))
+	require.JSONEq(t, `{"id":"msg_1"}`, rec.Body.String())
+	var usage ClaudeUsage
+	require.NoError(t, json.Unmarshal(entry.Usage, &usage))
+	require.Equal(t, ClaudeUsage{InputTokens: 3, OutputTokens: 5}, usage)
+
+	stats, err := svc.Stats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats.Groups, 1)
+	require.Equal(t, int64(1), stats.Groups[0].Hits)
+	require.Equal(t, int64(1), stats.Groups[0].Misses)
+	require.Equal(t, int64(1), stats.Groups[0].Stores)
+	require.InDelta(t, 0.5, stats.Total.HitRatio, 1e-9)
+}
+
+func TestResponseCacheCapture_SkipsErrorsAndOversized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	stub := newResponseCacheStub()
+	svc := newTestResponseCacheService(stub)
+	svc.cfg.MaxResponseBytes = 8
+	ctx := responseCacheGroupCtx(&Group{ID: 7, ResponseCacheEnabled: true})
+	lookup := svc.Prepare(ctx, ResponseCacheEndpointMessages, "m", []byte(`{"temperature":0}`))
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	capture := svc.BeginCapture(c, lookup, 42)
+	c.Data(http.StatusBadRequest, "application/json", []byte(`{}`))
+	capture.Finish(ctx, &ClaudeUsage{})
+	require.Empty(t, stub.entries)
+
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	capture = svc.BeginCapture(c, lookup, 42)
+	c.Data(http.StatusOK, "application/json", []byte(`{"id":"too_large"}`))
+	capture.Finish(ctx, &ClaudeUsage{})
+	require.Empty(t, stub.entries)
+}
+
+func TestResponseCache_NilServiceIsNoop(t *testing.T) {
+	var svc *ResponseCacheService
+	lookup := svc.Prepare(context.Background(), ResponseCacheEndpointMessages, "m", []byte(`{"temperature":0}`))
+	require.Nil(t, lookup)
+	require.Nil(t, svc.Get(context.Background(), lookup))
+	svc.BeginCapture(nil, lookup, 0).Finish(context.Background(), nil)
+	require.Equal(t, 1.0, svc.HitCostMultiplier())
+}
+
+func TestApplyResponseCacheHitDiscount(t *testing.T) {
+	cost := &CostBreakdown{InputCost: 1, OutputCost: 2, CacheCreationCost: 3, CacheReadCost: 4, TotalCost: 10, ActualCost: 20}
+	applyResponseCacheHitDiscount(cost, 0.1)
+	require.InDelta(t, 0.1, cost.InputCost, 1e-9)
+	require.InDelta(t, 1.0, cost.TotalCost, 1e-9)
+	require.InDelta(t, 2.0, cost.ActualCost, 1e-9)
+}
+
+type responseCacheAccountRepoStub struct {
+	AccountRepository
+	accounts map[int64]*Account
+}
+
+func (s *responseCacheAccountRepoStub) GetByID(_ context.Context, id int64) (*Account, error) {
+	if account, ok := s.accounts[id]; ok {
+		return account, nil
+	}
+	return nil, ErrAccountNotFound
+}
+
+func TestResponseCacheLookup_BindsLookupOnMiss(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	stub := newResponseCacheStub()
+	svc := newTestResponseCacheService(stub)
+	accounts := &responseCacheAccountRepoStub{accounts: map[int64]*Account{42: {ID: 42, Platform: PlatformAnthropic}}}
+	ctx := responseCacheGroupCtx(&Group{ID: 7, ResponseCacheEnabled: true})
+	body := []byte(`{"temperature":0}`)
+	newContext := func() (*gin.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil).WithContext(ctx)
+		return c, rec
+	}
+
+	// 未命中：不写响应，缓存键绑定到请求上下文供 Forward 写入缓存
+	c, rec := newContext()
+	entry, account := svc.Lookup(c, accounts, ResponseCacheEndpointMessages, "m", body)
+	require.Nil(t, entry)
+	require.Nil(t, account)
+	require.Zero(t, rec.Body.Len())
+	lookup := boundResponseCacheLookup(c)
+	require.NotNil(t, lookup)
+	capture := svc.BeginCapture(c, lookup, 42)
+	c.Data(http.StatusOK, "application/json", []byte(`{"id":"msg_1"}`))
+	capture.Finish(ctx, &ClaudeUsage{InputTokens: 3, OutputTokens: 5})
+
+	// 命中：直接写回客户端并返回产生该响应的账号，不绑定缓存键
+	c, rec = newContext()
+	entry, account = svc.Lookup(c, accounts, ResponseCacheEndpointMessages, "m", body)
+	require.NotNil(t, entry)
+	require.Equal(t, int64(42), account.ID)
+	require.Equal(t, "HIT", rec.Header().Get(ResponseCacheHeader))
+	require.JSONEq(t, `{"id":"msg_1"}`, rec.Body.String())
+	require.Nil(t, boundResponseCacheLookup(c))
+
+	// 账号已删除：按未命中处理，重新请求上游
+	delete(accounts.accounts, 42)
+	c, rec = newContext()
+	entry, account = svc.Lookup(c, accounts, ResponseCacheEndpointMessages, "m", body)
+	require.Nil(t, entry)
+	require.Nil(t, account)
+	require.Zero(t, rec.Body.Len())
+	require.NotNil(t, boundResponseCacheLookup(c))
+}
+
+type usageLogCaptureRepoStub struct {
+	UsageLogRepository
+	logs []*UsageLog
+}
+
+func (s *usageLogCaptureRepoStub) Create(_ context.Context, log *UsageLog) (bool, error) {
+	s.logs = append(s.logs, log)
+	return true, nil
+}
+
+func TestRecordUsage_ResponseCacheHitUsesCachedAccount(t *testing.T) {
+	cfg := &config.Config{RunMode: config.RunModeSimple}
+	cfg.Default.RateMultiplier = 1
+	repo := &usageLogCaptureRepoStub{}
+	svc := &GatewayService{
+		cfg:             cfg,
+		billingService:  NewBillingService(cfg, nil),
+		responseCache:   newTestResponseCacheService(newResponseCacheStub()),
+		usageLogRepo:    repo,
+		deferredService: &DeferredService{},
+	}
+	rate := 0.5
+	account := &Account{ID: 42, Platform: PlatformAnthropic, RateMultiplier: &rate}
+
+	err := svc.RecordUsage(context.Background(), &RecordUsageInput{
+		Result: &ForwardResult{
+			RequestID:        "cache-1",
+			Model:            "claude-sonnet-4",
+			Usage:            ClaudeUsage{InputTokens: 1000, OutputTokens: 1000},
+			ResponseCacheHit: true,
+		},
+		APIKey:  &APIKey{ID: 1},
+		User:    &User{ID: 2},
+		Account: account,
+	})
+	require.NoError(t, err)
+	require.Len(t, repo.logs, 1)
+	log := repo.logs[0]
+	require.Equal(t, int64(42), log.AccountID)
+	require.NotNil(t, log.AccountRateMultiplier)
+	require.InDelta(t, 0.5, *log.AccountRateMultiplier, 1e-9)
+	require.True(t, log.ResponseCacheHit)
+	require.InDelta(t, (1000*3e-6+1000*15e-6)*0.1, log.TotalCost, 1e-12)
+}
@@ -231,6 +231,8 @@ func (s *SchedulerSnapshotService) pollOutbox() {
 		return
 	}
 	if len(events) == 0 {
+		schedulerOutboxLagSeconds.WithLabelValues().Set(0)
+		schedulerOutboxBacklog.WithLabelValues().Set(0)
 		return
 	}
 
@@ -483,6 +485,7 @@ func (s *SchedulerSnapshotService) checkOutboxLag(ctx context.Context, oldest Sc
 	}
 
 	lag := time.Since(oldest.CreatedAt)
+	schedulerOutboxLagSeconds.WithLabelValues().Set(lag.Seconds())
 	if lagSeconds := int(lag.Seconds()); lagSeconds >= s.cfg.Gateway.Scheduling.OutboxLagWarnSeconds && s.cfg.Gateway.Scheduling.OutboxLagWarnSeconds > 0 {
 		log.Printf("[Scheduler] outbox lag warning: %ds", lagSeconds)
 	}
@@ -508,15 +511,16 @@ func (s *SchedulerSnapshotService) checkOutboxLag(ctx context.Context, oldest Sc
 		s.lagMu.Unlock()
 	}
 
-	threshold := s.cfg.Gateway.Scheduling.OutboxBacklogRebuildRows
-	if threshold <= 0 || s.outboxRepo == nil {
+	if s.outboxRepo == nil {
 		return
 	}
 	maxID, err := s.outboxRepo.MaxID(ctx)
 	if err != nil {
 		return
 	}
-	if maxID-watermark >= int64(threshold) {
+	schedulerOutboxBacklog.WithLabelValues().Set(float64(maxID - watermark))
+	threshold := s.cfg.Gateway.Scheduling.OutboxBacklogRebuildRows
+	if threshold > 0 && maxID-watermark >= int64(threshold) {
 		log.Printf("[Scheduler] outbox backlog rebuild triggered: backlog=%d", maxID-watermark)
 		if err := s.triggerFullRebuild("outbox_backlog"); err != nil {
 			log.Printf("[Scheduler] outbox backlog rebuild failed: %v", err)
@@ -139,7 +139,9 @@ func (s *TokenRefreshService) processRefresh() {
 			needsRefresh++
 
 			// 执行刷新
-			if err := s.refreshWithRetry(ctx, account, refresher); err != nil {
+			err := s.refreshWithRetry(ctx, account, refresher)
+			recordTokenRefreshMetric(account.Platform, err)
+			if err != nil {
 				log.Printf("[TokenRefresh] Account %d (%s) failed: %v", account.ID, account.Name, err)
 				failed++
 			} else {
@@ -7,6 +7,12 @@ const (
 	BillingTypeSubscription int8 = 1 // 订阅套餐
 )
 
+// 使用日志请求类型（空字符串表示常规对话/生成请求）
+const (
+	UsageRequestTypeEmbedding = "embedding" // 向量（embeddings）请求
+	UsageRequestTypeBatch     = "batch"     // Message Batches 批处理请求
+)
+
 type UsageLog struct {
 	ID        int64
 	UserID    int64
@@ -49,6 +55,12 @@ type UsageLog struct {
 	// Cache TTL Override 标记（管理员强制替换了缓存 TTL 计费）
 	CacheTTLOverridden bool
 
+	// 精确响应缓存命中（未请求上游，按折扣计费）
+	ResponseCacheHit bool
+
+	// 请求类型（空表示常规对话/生成请求，见 UsageRequestType* 常量）
+	RequestType string
+
 	// 图片生成字段
 	ImageCount int
 	ImageSize  *string
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	vertexTokenScope      = "https://www.googleapis.com/auth/cloud-platform"
+	vertexDefaultTokenURI = "https://oauth2.googleapis.com/token"
+	vertexJWTBearerGrant  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	vertexAssertionTTL    = time.Hour
+	vertexTokenCacheSkew  = 5 * time.Minute
+	vertexRefreshLockTTL  = 30 * time.Second
+)
+
+// vertexServiceAccount 服务账号 JSON 密钥中用到的字段
+type vertexServiceAccount struct {
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// parseVertexServiceAccount 解析 credentials.service_account_json（JSON 字符串或对象）
+func parseVertexServiceAccount(account *Account) (*vertexServiceAccount, error) {
+	if account == nil || account.Credentials == nil {
+		return nil, errors.New("service_account_json not found in credentials")
+	}
+	var raw []byte
+	switch v := account.Credentials["service_account_json"].(type) {
+	case string:
+		raw = []byte(strings.TrimSpace(v))
+	case map[string]any:
+		raw, _ = json.Marshal(v)
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("service_account_json not found in credentials")
+	}
+
+	var key vertexServiceAccount
+	if err := json.Unmarshal(raw, &key); err != nil {
+		return nil, fmt.Errorf("invalid service_account_json: %w", err)
+	}
+	if strings.TrimSpace(key.ClientEmail) == "" || strings.TrimSpace(key.PrivateKey) == "" {
+		return nil, errors.New("service_account_json missing client_email or private_key")
+	}
+	if strings.TrimSpace(key.TokenURI) == "" {
+		key.TokenURI = vertexDefaultTokenURI
+	}
+	return &key, nil
+}
+
+// VertexProjectID 返回 Vertex 账号的 GCP 项目：优先 credentials.project_id，其次服务账号密钥中的 project_id
+func VertexProjectID(account *Account) string {
+	if projectID := strings.TrimSpace(account.GetCredential("project_id")); projectID != "" {
+		return projectID
+	}
+	if key, err := parseVertexServiceAccount(account); err == nil {
+		return strings.TrimSpace(key.ProjectID)
+	}
+	return ""
+}
+
+// VertexTokenCacheKey 按服务账号与密钥 ID 区分缓存，更换密钥后自动使用新 token
+func VertexTokenCacheKey(account *Account) string {
+	key, err := parseVertexServiceAccount(account)
+	if err != nil {
+		return "vertex:account:" + strconv.FormatInt(account.ID, 10)
+	}
+	sum := sha256.Sum256([]byte(key.ClientEmail + "\x00" + key.PrivateKeyID))
+	return "vertex:" + hex.EncodeToString(sum[:8])
+}
+
+// VertexTokenProvider 使用服务账号 JSON 密钥签发 JWT 换取 Vertex AI 访问令牌，令牌缓存在 GeminiTokenCache 中。
+//
+// 凭证字段（account.credentials）：
+//   - service_account_json：必填，GCP 服务账号 JSON 密钥
+//   - project_id：可选，默认取密钥中的 project_id
+//   - location：可选，默认 global
+type VertexTokenProvider struct {
+	tokenCache   GeminiTokenCache
+	httpUpstream HTTPUpstream
+}
+
+// NewVertexTokenProvider 创建 Vertex 令牌提供者
+func NewVertexTokenProvider(tokenCache GeminiTokenCache, httpUpstream HTTPUpstream) *VertexTokenProvider {
+	return &VertexTokenProvider{
+		tokenCache:   tokenCache,
+		httpUpstream: httpUpstream,
+	}
+}
+
+// GetAccessToken 返回 Vertex 账号当前可用的访问令牌
+func (p *VertexTokenProvider) GetAccessToken(ctx context.Context, account *Account) (string, error) {
+	if account == nil {
+		return "", errors.New("account is nil")
+	}
+	if !account.IsVertex() {
+		return "", errors.New("not a vertex account")
+	}
+	key, err := parseVertexServiceAccount(account)
+	if err != nil {
+		return "", err
+	}
+
+	cacheKey := VertexTokenCacheKey(account)
+	if p.tokenCache != nil {
+		if token, err := p.tokenCache.GetAccessToken(ctx, cacheKey); err == nil && strings.TrimSpace(token) != "" {
+			return token, nil
+		}
+		locked, err := p.tokenCache.AcquireRefreshLock(ctx, cacheKey, vertexRefreshLockTTL)
+		if err == nil && locked {
+			defer func() { _ = p.tokenCache.ReleaseRefreshLock(ctx, cacheKey) }()
+
+			// 拿到锁后再查一次（其他实例可能已签发）
+			if token, err := p.tokenCache.GetAccessToken(ctx, cacheKey); err == nil && strings.TrimSpace(token) != "" {
+				return token, nil
+			}
+		}
+	}
+
+	token, expiresIn, err := p.mintAccessToken(ctx, account, key)
+	if err != nil {
+		return "", err
+	}
+
+	if p.tokenCache != nil {
+		ttl := expiresIn - vertexTokenCacheSkew
+		if ttl < time.Minute {
+			ttl = time.Minute
+		}
+		_ = p.tokenCache.SetAccessToken(ctx, cacheKey, token, ttl)
+	}
+	return token, nil
+}
+
+// mintAccessToken 以 RS256 签名的 JWT 断言调用令牌端点（jwt-bearer 授权）
+func (p *VertexTokenProvider) mintAccessToken(ctx context.Context, account *Account, key *vertexServiceAccount) (string, time.Duration, error) {
+	if p.httpUpstream == nil {
+		return "", 0, errors.New("vertex http upstream not configured")
+	}
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	if err != nil {
+		return "", 0, fmt.Errorf("parse service account private key: %w", err)
+	}
+
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   key.ClientEmail,
+		"scope": vertexTokenScope,
+		"aud":   key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(vertexAssertionTTL).Unix(),
+	})
+	if key.PrivateKeyID != "" {
+		assertion.Header["kid"] = key.PrivateKeyID
+	}
+	signed, err := assertion.SignedString(privateKey)
+	if err != nil {
+		return "", 0, fmt.Errorf("sign jwt assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", vertexJWTBearerGrant)
+	form.Set("assertion", signed)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, key.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	proxyURL := ""
+	if account.ProxyID != nil && account.Proxy != nil {
+		proxyURL = account.Proxy.URL()
+	}
+	resp, err := p.httpUpstream.Do(req, proxyURL, account.ID, account.Concurrency)
+	if err != nil {
+		return "", 0, fmt.Errorf("vertex token request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	var tokenResp struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	_ = json.Unmarshal(body, &tokenResp)
+	if resp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(tokenResp.Error + ": " + tokenResp.ErrorDescription)
+		if tokenResp.Error == "" {
+			msg = truncateString(string(body), 512)
+		}
+		return "", 0, fmt.Errorf("vertex token request failed: status %d: %s", resp.StatusCode, msg)
+	}
+	if strings.TrimSpace(tokenResp.AccessToken) == "" {
+		return "", 0, errors.New("vertex token response missing access_token")
+	}
+	expiresIn := time.Duration(tokenResp.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = vertexAssertionTTL
+	}
+	return tokenResp.AccessToken, expiresIn, nil
+}
@@ -80,6 +80,38 @@ func ProvideSubscriptionExpiryService(userSubRepo UserSubscriptionRepository) *S
 	return svc
 }
 
+// ProvideBudgetAlertService creates and starts BudgetAlertService.
+func ProvideBudgetAlertService(
+	repo BudgetAlertRepository,
+	userRepo UserRepository,
+	apiKeyService *APIKeyService,
+	subscriptionService *SubscriptionService,
+	emailQueueService *EmailQueueService,
+	settingService *SettingService,
+	cfg *config.Config,
+) *BudgetAlertService {
+	svc := NewBudgetAlertService(repo, userRepo, apiKeyService, subscriptionService, emailQueueService, settingService, cfg, time.Minute)
+	svc.Start()
+	return svc
+}
+
+// ProvideMessageBatchService creates and starts MessageBatchService.
+func ProvideMessageBatchService(
+	repo MessageBatchRepository,
+	apiKeyRepo APIKeyRepository,
+	gatewayService *GatewayService,
+	antigravityGatewayService *AntigravityGatewayService,
+	concurrencyService *ConcurrencyService,
+	billingCacheService *BillingCacheService,
+	subscriptionService *SubscriptionService,
+	apiKeyService *APIKeyService,
+	cfg *config.Config,
+) *MessageBatchService {
+	svc := NewMessageBatchService(repo, apiKeyRepo, gatewayService, antigravityGatewayService, concurrencyService, billingCacheService, subscriptionService, apiKeyService, cfg)
+	svc.Start()
+	return svc
+}
+
 // ProvideTimingWheelService creates and starts TimingWheelService
 func ProvideTimingWheelService() (*TimingWheelService, error) {
 	svc, err := NewTimingWheelService()
@@ -119,6 +151,22 @@ func ProvideSchedulerSnapshotService(
 	return svc
 }
 
+// ProvideAPIKeyService 创建 API Key 服务并注入请求限制计数缓存
+func ProvideAPIKeyService(
+	apiKeyRepo APIKeyRepository,
+	userRepo UserRepository,
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	userGroupRateRepo UserGroupRateRepository,
+	cache APIKeyCache,
+	requestLimitCache APIKeyRequestLimitCache,
+	cfg *config.Config,
+) *APIKeyService {
+	svc := NewAPIKeyService(apiKeyRepo, userRepo, groupRepo, userSubRepo, userGroupRateRepo, cache, cfg)
+	svc.SetRequestLimitCache(requestLimitCache)
+	return svc
+}
+
 // ProvideRateLimitService creates RateLimitService with optional dependencies.
 func ProvideRateLimitService(
 	accountRepo AccountRepository,
@@ -170,10 +218,11 @@ func ProvideOpsAlertEvaluatorService(
 	opsService *OpsService,
 	opsRepo OpsRepository,
 	emailService *EmailService,
+	notificationService *OpsNotificationService,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsAlertEvaluatorService {
-	svc := NewOpsAlertEvaluatorService(opsService, opsRepo, emailService, redisClient, cfg)
+	svc := NewOpsAlertEvaluatorService(opsService, opsRepo, emailService, notificationService, redisClient, cfg)
 	svc.Start()
 	return svc
 }
@@ -190,15 +239,42 @@ func ProvideOpsCleanupService(
 	return svc
 }
 
+// ProvideAuditLogCleanupService creates and starts AuditLogCleanupService (cron scheduled).
+func ProvideAuditLogCleanupService(
+	opsRepo OpsRepository,
+	db *sql.DB,
+	redisClient *redis.Client,
+	cfg *config.Config,
+) *AuditLogCleanupService {
+	svc := NewAuditLogCleanupService(opsRepo, db, redisClient, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideAccountHealthProbeService creates and starts AccountHealthProbeService.
+func ProvideAccountHealthProbeService(
+	accountRepo AccountRepository,
+	probeRepo AccountHealthProbeRepository,
+	accountTestService *AccountTestService,
+	opsRepo OpsRepository,
+	redisClient *redis.Client,
+	cfg *config.Config,
+) *AccountHealthProbeService {
+	svc := NewAccountHealthProbeService(accountRepo, probeRepo, accountTestService, opsRepo, redisClient, cfg)
+	svc.Start()
+	return svc
+}
+
 // ProvideOpsScheduledReportService creates and starts OpsScheduledReportService.
 func ProvideOpsScheduledReportService(
 	opsService *OpsService,
 	userService *UserService,
 	emailService *EmailService,
+	notificationService *OpsNotificationService,
 	redisClient *redis.Client,
 	cfg *config.Config,
 ) *OpsScheduledReportService {
-	svc := NewOpsScheduledReportService(opsService, userService, emailService, redisClient, cfg)
+	svc := NewOpsScheduledReportService(opsService, userService, emailService, notificationService, redisClient, cfg)
 	svc.Start()
 	return svc
 }
@@ -215,7 +291,7 @@ var ProviderSet = wire.NewSet(
 	// Core services
 	NewAuthService,
 	NewUserService,
-	NewAPIKeyService,
+	ProvideAPIKeyService,
 	ProvideAPIKeyAuthCacheInvalidator,
 	NewGroupService,
 	NewAccountService,
@@ -243,12 +319,16 @@ var ProviderSet = wire.NewSet(
 	NewAntigravityTokenProvider,
 	NewOpenAITokenProvider,
 	NewClaudeTokenProvider,
+	NewBedrockCredentialProvider,
+	NewVertexTokenProvider,
 	NewAntigravityGatewayService,
 	ProvideRateLimitService,
 	NewAccountUsageService,
 	NewAccountTestService,
 	NewSettingService,
 	NewOpsService,
+	NewOpsNotificationService,
+	NewGatewayMetricsCollector,
 	ProvideOpsMetricsCollector,
 	ProvideOpsAggregationService,
 	ProvideOpsAlertEvaluatorService,
@@ -266,6 +346,9 @@ var ProviderSet = wire.NewSet(
 	ProvideTokenRefreshService,
 	ProvideAccountExpiryService,
 	ProvideSubscriptionExpiryService,
+	ProvideBudgetAlertService,
+	ProvideMessageBatchService,
+	NewFileService,
 	ProvideTimingWheelService,
 	ProvideDashboardAggregationService,
 	ProvideUsageCleanupService,
@@ -277,4 +360,10 @@ var ProviderSet = wire.NewSet(
 	NewErrorPassthroughService,
 	NewDigestSessionStore,
 	ProvideRequestContentLogService,
+	NewAuditLogService,
+	ProvideAuditLogCleanupService,
+	NewPaymentService,
+	NewResponseCacheService,
+	NewIdempotencyService,
+	ProvideAccountHealthProbeService,
 )
@@ -89,7 +89,10 @@ func (s *FrontendServer) Middleware() gin.HandlerFunc {
 			strings.HasPrefix(path, "/antigravity/") ||
 			strings.HasPrefix(path, "/setup/") ||
 			path == "/health" ||
-			path == "/responses" {
+			path == "/metrics" ||
+			path == "/responses" ||
+			path == "/chat/completions" ||
+			path == "/embeddings" {
 			c.Next()
 			return
 		}
@@ -212,7 +215,10 @@ func ServeEmbeddedFrontend() gin.HandlerFunc {
 			strings.HasPrefix(path, "/antigravity/") ||
 			strings.HasPrefix(path, "/setup/") ||
 			path == "/health" ||
-			path == "/responses" {
+			path == "/metrics" ||
+			path == "/responses" ||
+			path == "/chat/completions" ||
+			path == "/embeddings" {
 			c.Next()
 			return
 		}
@@ -365,7 +365,10 @@ func TestFrontendServer_Middleware(t *testing.T) {
 			"/antigravity/test",
 			"/setup/init",
 			"/health",
+			"/metrics",
 			"/responses",
+			"/chat/completions",
+			"/embeddings",
 		}
 
 		for _, path := range apiPaths {
@@ -540,7 +543,10 @@ func TestServeEmbeddedFrontend(t *testing.T) {
 			"/antigravity/test",
 			"/setup/init",
 			"/health",
+			"/metrics",
 			"/responses",
+			"/chat/completions",
+			"/embeddings",
 		}
 
 		for _, path := range apiPaths {
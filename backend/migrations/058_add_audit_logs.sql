@@ -0,0 +1,40 @@
+-- Admin audit logs table
+-- 记录管理后台的每次变更操作（操作者、IP、路由、目标实体、脱敏后的变更差异）
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    actor_user_id BIGINT,
+    auth_method VARCHAR(20) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    method VARCHAR(10) NOT NULL,
+    route VARCHAR(255) NOT NULL,
+    path VARCHAR(512) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    target_type VARCHAR(50) NOT NULL DEFAULT '',
+    target_id VARCHAR(100) NOT NULL DEFAULT '',
+    changes JSONB,
+    request_body TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 索引：按创建时间查询（也用于清理任务）
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at
+    ON audit_logs (created_at);
+
+-- 复合索引：按操作者+时间范围查询
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_created
+    ON audit_logs (actor_user_id, created_at);
+
+-- 索引：按目标实体查询
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target
+    ON audit_logs (target_type, target_id);
+
+-- 索引：按路由查询
+CREATE INDEX IF NOT EXISTS idx_audit_logs_route
+    ON audit_logs (route);
+
+COMMENT ON TABLE audit_logs IS '管理员操作审计日志';
+COMMENT ON COLUMN audit_logs.auth_method IS '认证方式: jwt, admin_api_key';
+COMMENT ON COLUMN audit_logs.changes IS '脱敏后的字段级差异 {"path": {"before": ..., "after": ...}}';
+COMMENT ON COLUMN audit_logs.request_body IS '脱敏并截断后的请求体';
@@ -0,0 +1,46 @@
+-- Payment orders table
+-- 在线支付订单：余额充值 / 订阅套餐购买
+
+CREATE TABLE IF NOT EXISTS orders (
+    id BIGSERIAL PRIMARY KEY,
+    order_no VARCHAR(32) NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(20) NOT NULL DEFAULT 'balance',
+    provider VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    amount DECIMAL(20,8) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    credit_amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    plan_id VARCHAR(64),
+    group_id BIGINT,
+    validity_days INT NOT NULL DEFAULT 0,
+    subscription_id BIGINT,
+    provider_trade_no VARCHAR(128),
+    pay_url TEXT,
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    notes TEXT,
+    paid_at TIMESTAMPTZ,
+    refunded_at TIMESTAMPTZ,
+    voided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 复合索引：用户订单历史
+CREATE INDEX IF NOT EXISTS idx_orders_user_created
+    ON orders (user_id, created_at);
+
+-- 索引：按状态筛选
+CREATE INDEX IF NOT EXISTS idx_orders_status
+    ON orders (status);
+
+-- 索引：按渠道交易号查询（对账）
+CREATE INDEX IF NOT EXISTS idx_orders_provider_trade_no
+    ON orders (provider, provider_trade_no);
+
+COMMENT ON TABLE orders IS '在线支付订单';
+COMMENT ON COLUMN orders.type IS '订单类型: balance, subscription';
+COMMENT ON COLUMN orders.status IS '状态: pending, paid, refunded, voided';
+COMMENT ON COLUMN orders.amount IS '支付金额（支付币种）';
+COMMENT ON COLUMN orders.credit_amount IS '到账余额（USD），仅余额充值订单';
+COMMENT ON COLUMN orders.subscription_id IS '支付成功后分配/续期的订阅 ID';
@@ -0,0 +1,9 @@
+-- Per-group exact response cache for deterministic requests.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS response_cache_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS response_cache_ttl_seconds INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN groups.response_cache_enabled IS '是否对确定性请求启用精确响应缓存';
+COMMENT ON COLUMN groups.response_cache_ttl_seconds IS '响应缓存 TTL（秒），0 表示使用全局默认值';
+
+-- Mark usage logs served from the response cache.
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS response_cache_hit BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,10 @@
+-- Per-API-key model allowlist and request-rate limits.
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS allowed_models JSONB DEFAULT NULL;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS rate_limit_rpm INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS rate_limit_tpm INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_request_limit INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN api_keys.allowed_models IS '允许调用的模型（glob 通配），为空表示不限制';
+COMMENT ON COLUMN api_keys.rate_limit_rpm IS '每分钟请求数上限，0 表示不限制';
+COMMENT ON COLUMN api_keys.rate_limit_tpm IS '每分钟 token 数上限，0 表示不限制';
+COMMENT ON COLUMN api_keys.daily_request_limit IS '每日请求数上限，0 表示不限制';
@@ -0,0 +1,33 @@
+-- Budget alerts table
+-- 用户预算告警：余额 / API Key 额度 / 订阅窗口达到阈值时通知，可选自动禁用 Key
+
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    metric VARCHAR(32) NOT NULL,
+    threshold_type VARCHAR(20) NOT NULL,
+    threshold DECIMAL(20,8) NOT NULL,
+    api_key_id BIGINT,
+    subscription_id BIGINT,
+    notify_email BOOLEAN NOT NULL DEFAULT TRUE,
+    webhook_url VARCHAR(500),
+    auto_disable_key BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    last_triggered_window VARCHAR(64),
+    last_triggered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_budget_alerts_user_id
+    ON budget_alerts (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_budget_alerts_enabled
+    ON budget_alerts (enabled);
+
+COMMENT ON TABLE budget_alerts IS '用户预算告警规则';
+COMMENT ON COLUMN budget_alerts.metric IS '监控指标: balance, api_key_quota, subscription_daily, subscription_weekly, subscription_monthly';
+COMMENT ON COLUMN budget_alerts.threshold_type IS '阈值类型: remaining_usd（剩余金额低于阈值）, percent_used（使用比例达到阈值）';
+COMMENT ON COLUMN budget_alerts.api_key_id IS 'api_key_quota 监控的 Key；也是 auto_disable_key 的禁用目标';
+COMMENT ON COLUMN budget_alerts.last_triggered_window IS '已通知的窗口标识，指标恢复后清空以便再次触发';
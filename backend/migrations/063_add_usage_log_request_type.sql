@@ -0,0 +1,4 @@
+-- Distinguish billable request types (e.g. embeddings) in usage logs.
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS request_type VARCHAR(20) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN usage_logs.request_type IS '请求类型：空表示常规对话/生成请求，embedding 表示向量请求';
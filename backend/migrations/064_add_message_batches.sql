@@ -0,0 +1,55 @@
+-- Message Batches API executed by the local batch worker pool.
+-- 批次与批次内请求；message_batch_items 同时作为 worker 的任务队列
+
+CREATE TABLE IF NOT EXISTS message_batches (
+    id BIGSERIAL PRIMARY KEY,
+    batch_id VARCHAR(64) NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    api_key_id BIGINT NOT NULL,
+    group_id BIGINT,
+    processing_status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+    request_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    cancel_initiated_at TIMESTAMPTZ,
+    ended_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_batches_user_id_id
+    ON message_batches (user_id, id);
+
+CREATE INDEX IF NOT EXISTS idx_message_batches_processing_status
+    ON message_batches (processing_status);
+
+CREATE TABLE IF NOT EXISTS message_batch_items (
+    id BIGSERIAL PRIMARY KEY,
+    batch_id BIGINT NOT NULL REFERENCES message_batches(id) ON DELETE CASCADE,
+    custom_id VARCHAR(64) NOT NULL,
+    params TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    result TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_message_batch_items_batch_custom_id
+    ON message_batch_items (batch_id, custom_id);
+
+CREATE INDEX IF NOT EXISTS idx_message_batch_items_status_next_attempt
+    ON message_batch_items (status, next_attempt_at);
+
+COMMENT ON TABLE message_batches IS 'Message Batches 批次';
+COMMENT ON COLUMN message_batches.processing_status IS '处理状态: in_progress, canceling, ended';
+COMMENT ON TABLE message_batch_items IS 'Message Batches 批次内请求（worker 任务队列）';
+COMMENT ON COLUMN message_batch_items.status IS '状态: pending, processing, succeeded, errored, canceled, expired';
+COMMENT ON COLUMN message_batch_items.result IS '成功时为 message 对象，失败时为 error 对象（JSON）';
+
+-- Per-group discount applied to batch requests.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS batch_discount_multiplier DECIMAL(10,4) NOT NULL DEFAULT 1.0;
+
+COMMENT ON COLUMN groups.batch_discount_multiplier IS '批处理请求按正常费用的该比例计费，1 表示不打折';
@@ -0,0 +1,26 @@
+-- Files API passthrough: file_id -> upstream account mapping.
+-- 上游文件归属映射：引用 file_id 的请求固定调度到持有文件的账号，并用于按用户统计存储配额
+
+CREATE TABLE IF NOT EXISTS upstream_files (
+    id BIGSERIAL PRIMARY KEY,
+    file_id VARCHAR(128) NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    api_key_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    filename VARCHAR(512) NOT NULL DEFAULT '',
+    mime_type VARCHAR(255) NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    downloadable BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_upstream_files_user_id_id
+    ON upstream_files (user_id, id);
+
+CREATE INDEX IF NOT EXISTS idx_upstream_files_account_id
+    ON upstream_files (account_id);
+
+COMMENT ON TABLE upstream_files IS '通过 /v1/files 上传到上游账号的文件';
+COMMENT ON COLUMN upstream_files.account_id IS '持有该文件的上游账号，引用该文件的请求固定调度到此账号';
+COMMENT ON COLUMN upstream_files.size_bytes IS '文件大小，计入用户存储配额';
@@ -0,0 +1,7 @@
+-- Per-group hedged requests: after waiting hedge_delay_ms for the first byte,
+-- the same streaming request is sent to a second account and the first responder wins.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS hedge_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS hedge_delay_ms INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN groups.hedge_enabled IS '是否对流式请求启用对冲';
+COMMENT ON COLUMN groups.hedge_delay_ms IS '发起对冲请求前等待首字节的时间（毫秒），0 表示按滚动 p95 首字时间自动计算';
@@ -0,0 +1,5 @@
+-- Per-group prompt-cache-aware routing: requests sharing a cache_control prefix
+-- prefer the account that most recently served that prefix.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS prompt_cache_routing_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN groups.prompt_cache_routing_enabled IS '是否按 cache_control 前缀将请求路由到最近服务过该前缀的账号';
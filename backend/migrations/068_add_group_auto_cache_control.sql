@@ -0,0 +1,5 @@
+-- Per-group automatic prompt-cache breakpoint insertion for clients that do not
+-- send cache_control themselves.
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS auto_cache_control_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN groups.auto_cache_control_enabled IS '是否为未设置 cache_control 的请求自动插入缓存断点';
@@ -0,0 +1,27 @@
+-- Account health probe history
+-- 记录后台定时健康探测的结果，用于连续失败自动隔离与连续成功自动恢复
+
+CREATE TABLE IF NOT EXISTS account_health_probes (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    platform VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    result VARCHAR(20) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    action VARCHAR(20) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 复合索引：按账号查询最近的探测记录
+CREATE INDEX IF NOT EXISTS idx_account_health_probes_account_created
+    ON account_health_probes (account_id, created_at);
+
+-- 索引：保留期清理
+CREATE INDEX IF NOT EXISTS idx_account_health_probes_created_at
+    ON account_health_probes (created_at);
+
+COMMENT ON TABLE account_health_probes IS '账号定时健康探测记录';
+COMMENT ON COLUMN account_health_probes.result IS '探测结果: success, failure, rate_limited';
+COMMENT ON COLUMN account_health_probes.action IS '本次探测触发的状态变更: quarantined, recovered';